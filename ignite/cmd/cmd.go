@@ -20,6 +20,8 @@ import (
 	"github.com/ignite/cli/ignite/pkg/cosmosver"
 	"github.com/ignite/cli/ignite/pkg/gitpod"
 	"github.com/ignite/cli/ignite/pkg/goenv"
+	"github.com/ignite/cli/ignite/pkg/xdg"
+	"github.com/ignite/cli/ignite/pkg/xfilepath"
 	"github.com/ignite/cli/ignite/pkg/xgenny"
 	"github.com/ignite/cli/ignite/services/chain"
 	"github.com/ignite/cli/ignite/services/scaffolder"
@@ -296,12 +298,16 @@ func printSection(session cliui.Session, title string) error {
 }
 
 func newCache(cmd *cobra.Command) (cache.Storage, error) {
-	cacheRootDir, err := chainconfig.ConfigDirPath()
+	// the cache storage is under the XDG cache directory when relocated, in the Ignite config dir otherwise
+	cachePath, err := xdg.New().CachePath(
+		xfilepath.Join(chainconfig.ConfigDirPath, xfilepath.Path(cacheFileName)),
+		cacheFileName,
+	)()
 	if err != nil {
 		return cache.Storage{}, err
 	}
 
-	storage, err := cache.NewStorage(filepath.Join(cacheRootDir, cacheFileName))
+	storage, err := cache.NewStorage(cachePath)
 	if err != nil {
 		return cache.Storage{}, err
 	}
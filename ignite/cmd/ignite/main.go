@@ -9,6 +9,7 @@ import (
 	ignitecmd "github.com/ignite/cli/ignite/cmd"
 	"github.com/ignite/cli/ignite/pkg/clictx"
 	"github.com/ignite/cli/ignite/pkg/validation"
+	"github.com/ignite/cli/ignite/pkg/xerrors"
 )
 
 func main() {
@@ -26,6 +27,12 @@ func main() {
 
 		if errors.As(err, &validationErr) {
 			fmt.Println(validationErr.ValidationInfo())
+		} else if errs := xerrors.Errors(err); len(errs) > 1 {
+			// the independent errors are listed together
+			fmt.Printf("%d errors occurred:\n", len(errs))
+			for i, err := range errs {
+				fmt.Printf("%d. %s\n", i+1, err)
+			}
 		} else {
 			fmt.Println(err)
 		}
@@ -1,14 +1,26 @@
 package ignitecmd
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/auditlog"
+	"github.com/ignite/cli/ignite/pkg/cliui"
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/dockercmd"
 	"github.com/ignite/cli/ignite/pkg/events"
 	"github.com/ignite/cli/ignite/pkg/gitpod"
+	"github.com/ignite/cli/ignite/pkg/sandbox"
+	"github.com/ignite/cli/ignite/pkg/xdg"
+	"github.com/ignite/cli/ignite/pkg/xfilepath"
+	"github.com/ignite/cli/ignite/pkg/xhttp"
 	"github.com/ignite/cli/ignite/services/network"
 	"github.com/ignite/cli/ignite/services/network/networkchain"
 	"github.com/ignite/cli/ignite/services/network/networktypes"
@@ -20,6 +32,22 @@ var (
 
 	spnNodeAddress   string
 	spnFaucetAddress string
+
+	caFile      string
+	httpProxy   string
+	httpTimeout time.Duration
+
+	insecureGenesisURL bool
+	maxGenesisSize     int64
+
+	strictValidation bool
+
+	fundsWait time.Duration
+
+	useSandbox bool
+
+	dockerBuild  bool
+	builderImage string
 )
 
 const (
@@ -29,11 +57,36 @@ const (
 	flagSPNNodeAddress   = "spn-node-address"
 	flagSPNFaucetAddress = "spn-faucet-address"
 
+	flagCAFile             = "ca-file"
+	flagHTTPProxy          = "http-proxy"
+	flagHTTPTimeout        = "http-timeout"
+	flagInsecureGenesisURL = "insecure-genesis-url"
+	flagMaxGenesisSize     = "max-genesis-size"
+	flagSandbox            = "sandbox"
+	flagStrict             = "strict"
+	flagWaitFunds          = "wait-funds"
+
+	flagDockerBuild  = "docker-build"
+	flagBuilderImage = "builder-image"
+
+	flagSkipCoordinatorCheck = "skip-coordinator-check"
+	flagJSON                 = "json"
+
+	// networkGasFallback is the gas limit of the SPN txs when the node doesn't support the tx simulation
+	networkGasFallback = 300000
+
+	// sandboxCPUTime and sandboxMemory are the resource limits of the chain binary when validating the genesis,
+	// the memory is the address space of the binary which is larger than its resident memory
+	sandboxCPUTime = 5 * time.Minute
+	sandboxMemory  = 16 << 30
+
 	spnNodeAddressNightly   = "http://178.128.251.28:26657"
 	spnFaucetAddressNightly = "http://178.128.251.28:4500"
 
 	spnNodeAddressLocal   = "http://0.0.0.0:26661"
 	spnFaucetAddressLocal = "http://0.0.0.0:4502"
+
+	networkAuditLogFile = "audit.log"
 )
 
 // NewNetwork creates a new network command that holds some other sub commands
@@ -52,6 +105,16 @@ func NewNetwork() *cobra.Command {
 	c.PersistentFlags().BoolVar(&nightly, flagNightly, false, "Use nightly SPN network")
 	c.PersistentFlags().StringVar(&spnNodeAddress, flagSPNNodeAddress, spnNodeAddressNightly, "SPN node address")
 	c.PersistentFlags().StringVar(&spnFaucetAddress, flagSPNFaucetAddress, spnFaucetAddressNightly, "SPN faucet address")
+	c.PersistentFlags().StringVar(&caFile, flagCAFile, "", "PEM file of additional CA certificates to fetch the chain source and genesis")
+	c.PersistentFlags().StringVar(&httpProxy, flagHTTPProxy, "", "Proxy URL to fetch the chain source and genesis (default from the HTTPS_PROXY environment variable)")
+	c.PersistentFlags().BoolVar(&insecureGenesisURL, flagInsecureGenesisURL, false, "Allow to fetch the genesis from a URL not served over HTTPS")
+	c.PersistentFlags().Int64Var(&maxGenesisSize, flagMaxGenesisSize, cosmosutil.DefaultMaxGenesisSize, "Maximum size in bytes of the chain genesis, decompressed included (0 for no limit)")
+	c.PersistentFlags().BoolVar(&strictValidation, flagStrict, false, "Fail the validation of the chain on warnings")
+	c.PersistentFlags().DurationVar(&fundsWait, flagWaitFunds, 0, "Time to wait for the account to receive the funds paying the SPN fees before broadcasting (default no wait)")
+	c.PersistentFlags().DurationVar(&httpTimeout, flagHTTPTimeout, 0, "Time limit to fetch the chain genesis (default no limit)")
+	c.PersistentFlags().BoolVar(&useSandbox, flagSandbox, false, "Validate the genesis with the chain binary in a sandbox without network and with limited resources")
+	c.PersistentFlags().BoolVar(&dockerBuild, flagDockerBuild, false, "Build the chain binary for linux/amd64 in a Docker container to get the same binary on every host")
+	c.PersistentFlags().StringVar(&builderImage, flagBuilderImage, networkchain.DefaultBuilderImage, "Image of the Docker container building the chain binary")
 
 	// add sub commands.
 	c.AddCommand(
@@ -91,6 +154,34 @@ func flagSetSPNAccountPrefixes() *flag.FlagSet {
 	return fs
 }
 
+func flagSetSkipCoordinatorCheck() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Bool(flagSkipCoordinatorCheck, false, "Don't check the account is the coordinator of the chain, the transactions are signed by another account")
+	return fs
+}
+
+func flagSetJSON() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Bool(flagJSON, false, "Print the progress of the command as JSON events, one per line")
+	return fs
+}
+
+// sessionOptions returns the options of the session of a command printing its events as JSON with the json flag.
+func sessionOptions(cmd *cobra.Command) (options []cliui.Option) {
+	if jsonEvents, _ := cmd.Flags().GetBool(flagJSON); jsonEvents {
+		options = append(options, cliui.WithJSONEvents())
+	}
+	return options
+}
+
+// coordinatorOptions returns the network options of the coordinator-only commands.
+func coordinatorOptions(cmd *cobra.Command) (options []network.Option) {
+	if skip, _ := cmd.Flags().GetBool(flagSkipCoordinatorCheck); skip {
+		options = append(options, network.SkipCoordinatorCheck())
+	}
+	return options
+}
+
 func newNetworkBuilder(cmd *cobra.Command, options ...NetworkBuilderOption) (NetworkBuilder, error) {
 	var (
 		err error
@@ -114,25 +205,89 @@ func (n NetworkBuilder) Chain(source networkchain.SourceOption, options ...netwo
 		options = append(options, networkchain.WithHome(home))
 	}
 
+	if caFile != "" {
+		options = append(options, networkchain.WithCAFile(caFile))
+	}
+	if httpProxy != "" {
+		options = append(options, networkchain.WithHTTPProxy(httpProxy))
+	}
+	if httpTimeout != 0 {
+		options = append(options, networkchain.WithHTTPTimeout(httpTimeout))
+	}
+	authTokens, err := xhttp.AuthTokensFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if len(authTokens) > 0 {
+		options = append(options, networkchain.WithAuthTokens(authTokens))
+	}
+	if insecureGenesisURL {
+		options = append(options, networkchain.WithInsecureGenesisURL())
+	}
+	options = append(options, networkchain.WithMaxGenesisSize(maxGenesisSize))
+	if strictValidation {
+		options = append(options, networkchain.WithStrictValidation())
+	}
+
+	if useSandbox {
+		options = append(options, networkchain.WithSandbox(sandbox.New(
+			sandbox.WithCPUTime(sandboxCPUTime),
+			sandbox.WithMemory(sandboxMemory),
+			sandbox.WithFallbackHandler(func(err error) {
+				// the sandbox was requested, the chain binary running without a restriction must not go unnoticed
+				n.ev.Send(events.NewWarning(fmt.Sprintf("the chain binary runs with a partial sandbox: %s", err)))
+			}),
+		)))
+	}
+
+	if dockerBuild {
+		options = append(options, networkchain.WithDockerBuild(
+			dockercmd.New(),
+			networkchain.WithBuilderImage(builderImage),
+		))
+	}
+
 	options = append(options, networkchain.CollectEvents(n.ev))
 
 	return networkchain.New(n.cmd.Context(), n.AccountRegistry, source, options...)
 }
 
+// QueryCache returns the option caching the read-only SPN queries of the commands not broadcasting any transaction.
+func (n NetworkBuilder) QueryCache() (network.Option, error) {
+	cacheStorage, err := newCache(n.cmd)
+	if err != nil {
+		return nil, err
+	}
+	return network.WithQueryCache(cacheStorage, spnNodeAddress, network.DefaultQueryCacheFreshness), nil
+}
+
 func (n NetworkBuilder) Network(options ...network.Option) (network.Network, error) {
-	var (
-		err     error
-		from    = getFrom(n.cmd)
-		account = cosmosaccount.Account{}
+	options = append(options, network.CollectEvents(n.ev), network.WithAccountRegistry(cosmos.AccountRegistry))
+	if fundsWait > 0 {
+		options = append(options, network.WithFundsWait(fundsWait))
+	}
+
+	// state-changing operations are recorded in the network audit log, a data path under the Ignite config dir
+	// without relocation
+	auditLogPath := xdg.New().DataPath(
+		xfilepath.Join(chainconfig.ConfigDirPath, xfilepath.Path("network"), xfilepath.Path(networkAuditLogFile)),
+		"network",
+		networkAuditLogFile,
 	)
-	if from != "" {
-		account, err = cosmos.AccountRegistry.GetByName(getFrom(n.cmd))
-		if err != nil {
-			return network.Network{}, errors.Wrap(err, "make sure that this account exists, use 'ignite account -h' to manage accounts")
-		}
+	if path, err := auditLogPath(); err == nil {
+		options = append(options, network.WithAuditLog(auditlog.New(path)))
 	}
 
-	options = append(options, network.CollectEvents(n.ev))
+	// without account only the read operations are available
+	from := getFrom(n.cmd)
+	if from == "" {
+		return network.NewQueryOnly(*cosmos, options...), nil
+	}
+
+	account, err := cosmos.AccountRegistry.GetByName(from)
+	if err != nil {
+		return network.Network{}, errors.Wrap(err, "make sure that this account exists, use 'ignite account -h' to manage accounts")
+	}
 
 	return network.New(*cosmos, account, options...), nil
 }
@@ -157,6 +312,10 @@ func getNetworkCosmosClient(cmd *cobra.Command) (cosmosclient.Client, error) {
 		cosmosclient.WithUseFaucet(spnFaucetAddress, networktypes.SPNDenom, 5),
 		cosmosclient.WithKeyringServiceName(cosmosaccount.KeyringServiceName),
 		cosmosclient.WithKeyringDir(getKeyringDir(cmd)),
+		// the gas of the SPN txs is simulated with a safety margin, settling many requests needs a lot of gas
+		cosmosclient.WithGas(cosmosclient.GasAuto),
+		cosmosclient.WithGasAdjustment(cosmosclient.DefaultSimulatedGasAdjustment),
+		cosmosclient.WithGasFallback(networkGasFallback),
 	}
 
 	keyringBackend := getKeyringBackend(cmd)
@@ -22,6 +22,9 @@ func NewNetworkChain() *cobra.Command {
 		NewNetworkChainShow(),
 		NewNetworkChainLaunch(),
 		NewNetworkChainRevertLaunch(),
+		NewNetworkChainNotice(),
+		NewNetworkChainDeadline(),
+		NewNetworkChainDoctor(),
 	)
 
 	return c
@@ -0,0 +1,64 @@
+package ignitecmd
+
+import (
+	"time"
+
+	timeparser "github.com/aws/smithy-go/time"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/services/network"
+)
+
+// NewNetworkChainDeadline creates a new chain deadline command to set the time the requests
+// of a chain must be sent by as a coordinator.
+func NewNetworkChainDeadline() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "deadline [launch-id] [time]",
+		Short: "Set the time the requests of a chain must be sent by as a coordinator",
+		Long: `Set the time the requests of a chain must be sent by as a coordinator.
+
+The time is a RFC3339 date time, like 2022-10-21T18:00:00Z. The requests are compared to the deadline
+with the time of the SPN block they were created in. The late requests are flagged in the request list,
+their approval asks for a confirmation. Run the command without time to remove the deadline.
+`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: networkChainDeadlineHandler,
+	}
+
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringDir())
+
+	return c
+}
+
+func networkChainDeadlineHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
+	if err != nil {
+		return err
+	}
+
+	// parse launch ID
+	launchID, err := network.ParseID(args[0])
+	if err != nil {
+		return err
+	}
+
+	var deadline time.Time
+	if len(args) > 1 {
+		if deadline, err = timeparser.ParseDateTime(args[1]); err != nil {
+			return err
+		}
+	}
+
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	return n.SetRequestDeadline(cmd.Context(), launchID, deadline)
+}
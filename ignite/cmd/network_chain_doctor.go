@@ -0,0 +1,99 @@
+package ignitecmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/network/networkchain"
+)
+
+const flagIncludeAddresses = "include-addresses"
+
+// NewNetworkChainDoctor returns a new command to collect the environment of a chain for a bug report.
+func NewNetworkChainDoctor() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "doctor [launch-id]",
+		Short: "Collect the environment of the chain for a bug report",
+		Long: `Collect the environment of the chain for a bug report.
+
+The report includes the Go toolchain, the versions of the chain binary and the Cosmos SDK,
+the layout of the chain home and the hashes of the genesis stages. The content of the files
+of the chain home is never included, the mnemonics and the addresses are redacted from the report.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkChainDoctorHandler,
+	}
+
+	c.Flags().String(flagOut, "./"+networkchain.DoctorReportFile, "Path to output the report file")
+	c.Flags().Bool(flagIncludeAddresses, false, "Keep the addresses in the report")
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func networkChainDoctorHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	out, _ := cmd.Flags().GetString(flagOut)
+	includeAddresses, _ := cmd.Flags().GetBool(flagIncludeAddresses)
+
+	nb, launchID, err := networkChainLaunch(cmd, args, session)
+	if err != nil {
+		return err
+	}
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	chainLaunch, err := n.ChainLaunch(cmd.Context(), launchID)
+	if err != nil {
+		return err
+	}
+
+	c, err := nb.Chain(networkchain.SourceLaunch(chainLaunch))
+	if err != nil {
+		return err
+	}
+
+	var options []networkchain.DoctorOption
+	lastErr, err := c.LastError()
+	if err != nil {
+		return err
+	}
+	if lastErr != "" {
+		options = append(options, networkchain.DoctorWithError(errors.New(lastErr)))
+	}
+	if includeAddresses {
+		options = append(options, networkchain.DoctorIncludeAddresses())
+	}
+
+	report, err := c.Doctor(cmd.Context(), out, options...)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	for _, problem := range report.Problems {
+		session.Printf("%s %s\n", icons.NotOK, problem)
+	}
+	return session.Printf("%s Doctor report written to %s, review it before sharing it\n", icons.OK, out)
+}
+
+// chainFailure saves the error of the failed command of the chain for the doctor report and prints the hint
+// to collect the environment of the chain, the error is returned unchanged.
+func chainFailure(session cliui.Session, c *networkchain.Chain, launchID uint64, cmdErr error) error {
+	session.StopSpinner()
+
+	// the error is saved on a best effort basis, the command fails with its own error
+	_ = c.SaveLastError(cmdErr)
+	session.Printf(
+		"%s Run 'ignite network chain doctor %d' to collect the environment of the chain for a bug report\n",
+		icons.Info,
+		launchID,
+	)
+	return cmdErr
+}
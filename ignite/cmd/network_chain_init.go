@@ -3,7 +3,11 @@ package ignitecmd
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/ignite/cli/ignite/pkg/cliui"
 	"github.com/ignite/cli/ignite/pkg/cliui/cliquiz"
@@ -13,6 +17,7 @@ import (
 	"github.com/ignite/cli/ignite/services/chain"
 	"github.com/ignite/cli/ignite/services/network"
 	"github.com/ignite/cli/ignite/services/network/networkchain"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
 )
 
 const (
@@ -24,6 +29,9 @@ const (
 	flagValidatorIdentity        = "validator-identity"
 	flagValidatorSelfDelegation  = "validator-self-delegation"
 	flagValidatorGasPrice        = "validator-gas-price"
+	flagValidatorKeyType         = "validator-key-type"
+	flagPreserveKeyring          = "preserve-keyring"
+	flagEvents                   = "events"
 )
 
 // NewNetworkChainInit returns a new command to initialize a chain from a published chain ID
@@ -44,6 +52,9 @@ func NewNetworkChainInit() *cobra.Command {
 	c.Flags().String(flagValidatorIdentity, "", "Validator identity signature (ex. UPort or Keybase)")
 	c.Flags().String(flagValidatorSelfDelegation, "", "Validator minimum self delegation")
 	c.Flags().String(flagValidatorGasPrice, "", "Validator gas price")
+	c.Flags().AddFlagSet(flagSetValidatorKeyType())
+	c.Flags().Bool(flagPreserveKeyring, false, "Keep the keys of the chain keyring when overwriting the home directory")
+	c.Flags().StringSlice(flagEvents, nil, "Only show the events of the categories (build|genesis|network|peers|node)")
 	c.Flags().AddFlagSet(flagNetworkFrom())
 	c.Flags().AddFlagSet(flagSetHome())
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
@@ -54,7 +65,8 @@ func NewNetworkChainInit() *cobra.Command {
 }
 
 func networkChainInitHandler(cmd *cobra.Command, args []string) error {
-	session := cliui.New()
+	categories, _ := cmd.Flags().GetStringSlice(flagEvents)
+	session := cliui.New(cliui.WithEventCategories(categories...))
 	defer session.Cleanup()
 
 	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
@@ -68,6 +80,11 @@ func networkChainInitHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	validatorKeyType, err := flagGetValidatorKeyType(cmd)
+	if err != nil {
+		return err
+	}
+
 	// check if the provided account for the validator exists.
 	validatorAccount, _ := cmd.Flags().GetString(flagValidatorAccount)
 	if _, err = nb.AccountRegistry.GetByName(validatorAccount); err != nil {
@@ -112,13 +129,22 @@ func networkChainInitHandler(cmd *cobra.Command, args []string) error {
 		networkOptions = append(networkOptions, networkchain.CheckDependencies())
 	}
 
+	if validatorKeyType != "" {
+		networkOptions = append(networkOptions, networkchain.WithValidatorKeyType(validatorKeyType))
+	}
+
 	c, err := nb.Chain(networkchain.SourceLaunch(chainLaunch), networkOptions...)
 	if err != nil {
 		return err
 	}
 
-	if err := c.Init(cmd.Context(), cacheStorage); err != nil {
-		return err
+	var initOptions []networkchain.InitOption
+	if preserveKeyring, _ := cmd.Flags().GetBool(flagPreserveKeyring); preserveKeyring {
+		initOptions = append(initOptions, networkchain.PreserveKeyring())
+	}
+
+	if err := c.Init(cmd.Context(), cacheStorage, initOptions...); err != nil {
+		return chainFailure(session, c, launchID, err)
 	}
 
 	genesisPath, err := c.GenesisPath()
@@ -131,11 +157,36 @@ func networkChainInitHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// the self-delegation of a campaign-backed chain is prefilled and checked with the allocation of the validator
+	stakingAmount := "95000000" + genesis.StakeDenom
+	var allocation *networktypes.GentxAllocation
+	if chainLaunch.CampaignID != 0 {
+		validatorNetwork, err := n.WithSigner(validatorAccount)
+		if err != nil {
+			return err
+		}
+		gentxAllocation, err := validatorNetwork.GentxAllocation(cmd.Context(), chainLaunch.CampaignID, genesis.StakeDenom)
+		if err != nil {
+			return err
+		}
+		allocation = &gentxAllocation
+		stakingAmount = gentxAllocation.DefaultSelfDelegation().String()
+	}
+
 	// ask validator information.
-	v, err := askValidatorInfo(cmd, session, genesis.StakeDenom)
+	v, err := askValidatorInfo(cmd, session, genesis.StakeDenom, stakingAmount)
 	if err != nil {
 		return err
 	}
+	if allocation != nil {
+		selfDelegation, err := sdk.ParseCoinNormalized(v.StakingAmount)
+		if err != nil {
+			return errors.Wrapf(err, "invalid staking amount %s", v.StakingAmount)
+		}
+		if err := allocation.CheckSelfDelegation(selfDelegation); err != nil {
+			return err
+		}
+	}
 	session.StartSpinner("Generating your Gentx")
 
 	gentxPath, err := c.InitAccount(cmd.Context(), v, validatorAccount)
@@ -149,7 +200,12 @@ func networkChainInitHandler(cmd *cobra.Command, args []string) error {
 }
 
 // askValidatorInfo prompts to the user questions to query validator information
-func askValidatorInfo(cmd *cobra.Command, session cliui.Session, stakeDenom string) (chain.Validator, error) {
+func askValidatorInfo(
+	cmd *cobra.Command,
+	session cliui.Session,
+	stakeDenom,
+	stakingAmount string,
+) (chain.Validator, error) {
 	var (
 		account, _         = cmd.Flags().GetString(flagValidatorAccount)
 		website, _         = cmd.Flags().GetString(flagValidatorWebsite)
@@ -177,7 +233,7 @@ func askValidatorInfo(cmd *cobra.Command, session cliui.Session, stakeDenom stri
 	questions := append([]cliquiz.Question{},
 		cliquiz.NewQuestion("Staking amount",
 			&v.StakingAmount,
-			cliquiz.DefaultAnswer("95000000stake"),
+			cliquiz.DefaultAnswer(stakingAmount),
 			cliquiz.Required(),
 		),
 		cliquiz.NewQuestion("Commission rate",
@@ -198,3 +254,18 @@ func askValidatorInfo(cmd *cobra.Command, session cliui.Session, stakeDenom stri
 	)
 	return v, session.Ask(questions...)
 }
+
+func flagSetValidatorKeyType() *flag.FlagSet {
+	usage := "Consensus key type of the validator (ed25519, secp256k1 or bls12_381), the type required by the chain is used by default"
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagValidatorKeyType, "", usage)
+	return fs
+}
+
+func flagGetValidatorKeyType(cmd *cobra.Command) (networktypes.ValidatorKeyType, error) {
+	keyType, _ := cmd.Flags().GetString(flagValidatorKeyType)
+	if keyType == "" {
+		return "", nil
+	}
+	return networktypes.ParseValidatorKeyType(keyType)
+}
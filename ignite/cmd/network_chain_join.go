@@ -14,6 +14,7 @@ import (
 
 	"github.com/ignite/cli/ignite/pkg/cliui"
 	"github.com/ignite/cli/ignite/pkg/cliui/cliquiz"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
 	"github.com/ignite/cli/ignite/pkg/gitpod"
 	"github.com/ignite/cli/ignite/pkg/xchisel"
 	"github.com/ignite/cli/ignite/services/network"
@@ -39,6 +40,7 @@ func NewNetworkChainJoin() *cobra.Command {
 	c.Flags().String(flagGentx, "", "Path to a gentx json file")
 	c.Flags().String(flagAmount, "", "Amount of coins for account request (ignored if coordinator has fixed the account balances or if --no-acount flag is set)")
 	c.Flags().Bool(flagNoAccount, false, "Prevent sending a request for a genesis account")
+	c.Flags().AddFlagSet(flagSetValidatorKeyType())
 	c.Flags().AddFlagSet(flagNetworkFrom())
 	c.Flags().AddFlagSet(flagSetHome())
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
@@ -60,6 +62,11 @@ func networkChainJoinHandler(cmd *cobra.Command, args []string) error {
 		noAccount, _ = cmd.Flags().GetBool(flagNoAccount)
 	)
 
+	validatorKeyType, err := flagGetValidatorKeyType(cmd)
+	if err != nil {
+		return err
+	}
+
 	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
 	if err != nil {
 		return err
@@ -103,6 +110,16 @@ func networkChainJoinHandler(cmd *cobra.Command, args []string) error {
 		networkOptions = append(networkOptions, networkchain.CheckDependencies())
 	}
 
+	// the gentx must be signed with the consensus key type required by the chain
+	if validatorKeyType != "" {
+		networkOptions = append(networkOptions, networkchain.WithValidatorKeyType(validatorKeyType))
+	} else {
+		validatorKeyType = chainLaunch.ValidatorKeyType
+	}
+	if validatorKeyType != "" {
+		joinOptions = append(joinOptions, network.WithValidatorKeyType(validatorKeyType))
+	}
+
 	c, err := nb.Chain(networkchain.SourceLaunch(chainLaunch), networkOptions...)
 	if err != nil {
 		return err
@@ -121,6 +138,23 @@ func networkChainJoinHandler(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// the self-delegation of the gentx of a campaign-backed chain is checked with the allocation of the account
+	if chainLaunch.CampaignID != 0 {
+		genesisPath, err := c.GenesisPath()
+		if err != nil {
+			return err
+		}
+		genesis, err := cosmosutil.ParseGenesisFromPath(genesisPath)
+		if err != nil {
+			return err
+		}
+		allocation, err := n.GentxAllocation(cmd.Context(), chainLaunch.CampaignID, genesis.StakeDenom)
+		if err != nil {
+			return err
+		}
+		joinOptions = append(joinOptions, network.WithGentxAllocation(allocation))
+	}
+
 	// genesis account request
 	if !noAccount {
 		switch {
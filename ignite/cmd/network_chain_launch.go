@@ -1,6 +1,8 @@
 package ignitecmd
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	timeparser "github.com/aws/smithy-go/time"
@@ -11,7 +13,11 @@ import (
 )
 
 const (
-	flagLauchTime = "launch-time"
+	flagLauchTime       = "launch-time"
+	flagSPNHeight       = "spn-height"
+	flagAdjustClockSkew = "adjust-clock-skew"
+
+	flagIgnorePendingRequests = "ignore-pending-requests"
 )
 
 // NewNetworkChainLaunch creates a new chain launch command to launch
@@ -29,9 +35,18 @@ func NewNetworkChainLaunch() *cobra.Command {
 		"",
 		"Timestamp the chain is effectively launched (example \"2022-01-01T00:00:00Z\")",
 	)
+	c.Flags().Int64(
+		flagSPNHeight,
+		0,
+		"Launch the chain at the estimated time of the SPN block at this height instead of a launch time",
+	)
+	c.Flags().Bool(flagAdjustClockSkew, false, "Compute the launch time range from the time of the SPN node if the system clock is skewed")
+	c.Flags().Bool(flagIgnorePendingRequests, false, "Launch the chain even if requests are still pending")
 	c.Flags().AddFlagSet(flagNetworkFrom())
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
 	c.Flags().AddFlagSet(flagSetKeyringDir())
+	c.Flags().AddFlagSet(flagSetSkipCoordinatorCheck())
+	c.Flags().AddFlagSet(flagSetYes())
 
 	return c
 }
@@ -62,10 +77,59 @@ func networkChainLaunchHandler(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	n, err := nb.Network()
+	spnHeight, _ := cmd.Flags().GetInt64(flagSPNHeight)
+	if spnHeight != 0 && launchTimeStr != "" {
+		return fmt.Errorf("--%s and --%s can't be used together", flagLauchTime, flagSPNHeight)
+	}
+
+	networkOptions := coordinatorOptions(cmd)
+	if adjustClockSkew, _ := cmd.Flags().GetBool(flagAdjustClockSkew); adjustClockSkew {
+		networkOptions = append(networkOptions, network.AdjustClockSkew())
+	}
+
+	var launchOptions []network.TriggerLaunchOption
+	if ignorePendingRequests, _ := cmd.Flags().GetBool(flagIgnorePendingRequests); ignorePendingRequests {
+		launchOptions = append(launchOptions, network.WithIgnorePendingRequests())
+	}
+
+	n, err := nb.Network(networkOptions...)
 	if err != nil {
 		return err
 	}
 
-	return n.TriggerLaunch(cmd.Context(), launchID, launchTime)
+	if spnHeight != 0 {
+		estimate, err := n.TriggerLaunchAtHeight(cmd.Context(), launchID, spnHeight, launchOptions...)
+		if err != nil {
+			return err
+		}
+		session.StopSpinner()
+		return session.Printf(
+			"Launch time set to %s ± %s, the estimated time of the SPN block %d\n",
+			estimate.Time.UTC().Format(time.RFC3339),
+			estimate.ErrorBand.Round(time.Second),
+			spnHeight,
+		)
+	}
+
+	err = n.TriggerLaunch(cmd.Context(), launchID, launchTime, launchOptions...)
+
+	// offer to launch the chain at the nearest valid launch time
+	var errLaunchTime network.ErrInvalidLaunchTime
+	if !errors.As(err, &errLaunchTime) {
+		return err
+	}
+	if !getYes(cmd) {
+		session.StopSpinner()
+		session.Println(err)
+
+		question := fmt.Sprintf("Use %s instead", errLaunchTime.Suggestion.UTC().Format(time.RFC3339))
+		if err := session.AskConfirm(question); err != nil {
+			return session.PrintSaidNo()
+		}
+	}
+
+	// the nearest launch time is computed again when the launch is triggered, the suggestion is already
+	// too early once the user confirmed it
+	launchOptions = append(launchOptions, network.WithNearestLaunchTime())
+	return n.TriggerLaunch(cmd.Context(), launchID, launchTime, launchOptions...)
 }
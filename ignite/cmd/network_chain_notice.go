@@ -0,0 +1,70 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/services/network"
+)
+
+const flagBlocking = "blocking"
+
+// NewNetworkChainNotice creates a new chain notice command to publish a notice to the validators
+// of a launched chain as a coordinator.
+func NewNetworkChainNotice() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "notice [launch-id] [message]",
+		Short: "Publish a notice to the validators of a chain as a coordinator",
+		Long: `Publish a notice to the validators of a chain as a coordinator.
+
+The notice is displayed to the validators when they prepare the chain. A blocking notice asks the
+validators to hold off starting their node, the node is not scheduled to start at launch time until
+the notice is cleared. Run the command without message to clear the notice.
+`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: networkChainNoticeHandler,
+	}
+
+	c.Flags().Bool(flagBlocking, false, "Ask the validators to hold off starting their node")
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringDir())
+
+	return c
+}
+
+func networkChainNoticeHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	blocking, _ := cmd.Flags().GetBool(flagBlocking)
+
+	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
+	if err != nil {
+		return err
+	}
+
+	// parse launch ID
+	launchID, err := network.ParseID(args[0])
+	if err != nil {
+		return err
+	}
+
+	var (
+		notice  string
+		options []network.LaunchNoticeOption
+	)
+	if len(args) > 1 {
+		notice = args[1]
+	}
+	if blocking {
+		options = append(options, network.BlockingNotice())
+	}
+
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	return n.SetLaunchNotice(cmd.Context(), launchID, notice, options...)
+}
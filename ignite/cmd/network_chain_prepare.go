@@ -3,6 +3,7 @@ package ignitecmd
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -17,7 +18,17 @@ import (
 )
 
 const (
-	flagForce = "force"
+	flagForce             = "force"
+	flagSupervisor        = "supervisor"
+	flagKubernetesImage   = "kubernetes-image"
+	flagAddrBook          = "addrbook"
+	flagNoPersistentPeers = "no-persistent-peers"
+	flagIgnoreNotice      = "ignore-notice"
+	flagGenesisHash       = "genesis-hash"
+	flagReferenceGenesis  = "reference-genesis"
+	flagKeepGenesisStages = "keep-genesis-stages"
+	flagCosmovisor        = "cosmovisor"
+	flagPruning           = "pruning"
 )
 
 // NewNetworkChainPrepare returns a new command to prepare the chain for launch
@@ -36,6 +47,16 @@ func NewNetworkChainPrepare() *cobra.Command {
 	c.Flags().AddFlagSet(flagSetKeyringDir())
 	c.Flags().AddFlagSet(flagSetHome())
 	c.Flags().AddFlagSet(flagSetCheckDependencies())
+	c.Flags().StringSlice(flagSupervisor, []string{}, "Render the files to start the node at launch time with supervisors (systemd, kubernetes)")
+	c.Flags().String(flagKubernetesImage, "", "Container image of the Kubernetes CronJob running the node, required with --supervisor kubernetes")
+	c.Flags().Bool(flagAddrBook, false, "Seed the node address book with the validator peers")
+	c.Flags().Bool(flagNoPersistentPeers, false, "Don't set the validator peers as persistent peers, requires --addrbook")
+	c.Flags().Bool(flagIgnoreNotice, false, "Schedule the node to start at launch time even if the coordinator asks to hold off")
+	c.Flags().String(flagGenesisHash, "", "Verify the prepared genesis matches the sha256 hash published by the coordinator")
+	c.Flags().String(flagReferenceGenesis, "", "URL or launch bundle of the reference genesis to diff with on a genesis hash mismatch")
+	c.Flags().Bool(flagKeepGenesisStages, false, "Keep a copy of the downloaded and initial genesis next to the prepared genesis")
+	c.Flags().Bool(flagCosmovisor, false, "Install the chain binary in the Cosmovisor layout of the chain home instead of the PATH")
+	c.Flags().Bool(flagPruning, false, "Set the recommended pruning in the app.toml when the free disk space doesn't fit the chain growth")
 
 	return c
 }
@@ -45,6 +66,36 @@ func networkChainPrepareHandler(cmd *cobra.Command, args []string) error {
 	defer session.Cleanup()
 
 	force, _ := cmd.Flags().GetBool(flagForce)
+	supervisors, _ := cmd.Flags().GetStringSlice(flagSupervisor)
+	kubernetesImage, _ := cmd.Flags().GetString(flagKubernetesImage)
+	addrBook, _ := cmd.Flags().GetBool(flagAddrBook)
+	noPersistentPeers, _ := cmd.Flags().GetBool(flagNoPersistentPeers)
+	if noPersistentPeers && !addrBook {
+		return fmt.Errorf("--%s requires --%s, the node would have no peer to connect to", flagNoPersistentPeers, flagAddrBook)
+	}
+	ignoreNotice, _ := cmd.Flags().GetBool(flagIgnoreNotice)
+	genesisHash, _ := cmd.Flags().GetString(flagGenesisHash)
+	referenceGenesis, _ := cmd.Flags().GetString(flagReferenceGenesis)
+	keepGenesisStages, _ := cmd.Flags().GetBool(flagKeepGenesisStages)
+	cosmovisor, _ := cmd.Flags().GetBool(flagCosmovisor)
+	pruning, _ := cmd.Flags().GetBool(flagPruning)
+
+	if referenceGenesis != "" && genesisHash == "" {
+		return fmt.Errorf("--%s requires --%s", flagReferenceGenesis, flagGenesisHash)
+	}
+
+	// the supervisors are checked before fetching the chain, their files are rendered at the end of the preparation
+	chainSupervisors := make([]networkchain.Supervisor, len(supervisors))
+	for i, supervisor := range supervisors {
+		chainSupervisor, err := networkchain.ParseSupervisor(supervisor)
+		if err != nil {
+			return err
+		}
+		if chainSupervisor == networkchain.SupervisorKubernetes && kubernetesImage == "" {
+			return fmt.Errorf("--%s %s requires --%s", flagSupervisor, chainSupervisor, flagKubernetesImage)
+		}
+		chainSupervisors[i] = chainSupervisor
+	}
 
 	cacheStorage, err := newCache(cmd)
 	if err != nil {
@@ -62,7 +113,8 @@ func networkChainPrepareHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	n, err := nb.Network()
+	// pin the queries to the same height to build the genesis from a consistent state
+	n, err := nb.Network(network.WithPinnedHeight())
 	if err != nil {
 		return err
 	}
@@ -83,6 +135,33 @@ func networkChainPrepareHandler(cmd *cobra.Command, args []string) error {
 		networkOptions = append(networkOptions, networkchain.CheckDependencies())
 	}
 
+	if len(chainSupervisors) > 0 {
+		networkOptions = append(
+			networkOptions,
+			networkchain.WithSupervisors(chainSupervisors...),
+			networkchain.WithKubernetesImage(kubernetesImage),
+		)
+	}
+
+	if addrBook {
+		networkOptions = append(networkOptions, networkchain.WithAddrBook())
+	}
+	if noPersistentPeers {
+		networkOptions = append(networkOptions, networkchain.WithoutPersistentPeers())
+	}
+	if ignoreNotice {
+		networkOptions = append(networkOptions, networkchain.IgnoreBlockingNotice())
+	}
+	if keepGenesisStages {
+		networkOptions = append(networkOptions, networkchain.KeepGenesisStages())
+	}
+	if cosmovisor {
+		networkOptions = append(networkOptions, networkchain.WithCosmovisor())
+	}
+	if pruning {
+		networkOptions = append(networkOptions, networkchain.WithRecommendedPruning())
+	}
+
 	c, err := nb.Chain(networkchain.SourceLaunch(chainLaunch), networkOptions...)
 	if err != nil {
 		return err
@@ -96,7 +175,21 @@ func networkChainPrepareHandler(cmd *cobra.Command, args []string) error {
 		c,
 		chainLaunch,
 	); err != nil {
-		return err
+		return chainFailure(session, c, launchID, err)
+	}
+
+	if genesisHash != "" {
+		var reference networkchain.GenesisReference
+		switch {
+		case referenceGenesis == "":
+		case strings.HasPrefix(referenceGenesis, "http://"), strings.HasPrefix(referenceGenesis, "https://"):
+			reference = networkchain.GenesisReferenceURL(referenceGenesis)
+		default:
+			reference = networkchain.GenesisReferenceBundle(referenceGenesis)
+		}
+		if err := c.VerifyGenesisHash(cmd.Context(), genesisHash, reference); err != nil {
+			return err
+		}
 	}
 
 	chainHome, err := c.Home()
@@ -111,7 +204,25 @@ func networkChainPrepareHandler(cmd *cobra.Command, args []string) error {
 
 	session.StopSpinner()
 	session.Printf("%s Chain is prepared for launch\n", icons.OK)
-	session.Println("\nYou can start your node by running the following command:")
+	if chainLaunch.LaunchNotice.IsBlocking() && !ignoreNotice {
+		session.Printf(
+			"\n%s The coordinator asks to hold off starting your node: %s\n",
+			icons.Info,
+			chainLaunch.LaunchNotice.Message,
+		)
+		session.Println("Prepare the chain again once the notice is cleared before starting your node with:")
+	} else {
+		session.Println("\nYou can start your node by running the following command:")
+	}
+	if cosmovisor {
+		commandStr := fmt.Sprintf("cosmovisor run start --home %s", chainHome)
+		session.Printf("\t%s\n", colors.Info(commandStr))
+		session.Printf(
+			"with the environment of %s\n",
+			filepath.Join(chainHome, networkchain.CosmovisorLaunchFile),
+		)
+		return nil
+	}
 	commandStr := fmt.Sprintf("%s start --home %s", binaryName, chainHome)
 	session.Printf("\t%s/%s\n", binaryDir, colors.Info(commandStr))
 
@@ -2,7 +2,9 @@ package ignitecmd
 
 import (
 	"fmt"
+	"net/url"
 	"os"
+	"path"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
@@ -14,6 +16,7 @@ import (
 	"github.com/ignite/cli/ignite/pkg/xurl"
 	"github.com/ignite/cli/ignite/services/network"
 	"github.com/ignite/cli/ignite/services/network/networkchain"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
 )
 
 const (
@@ -29,6 +32,10 @@ const (
 	flagAccountBalance = "account-balance"
 	flagRewardCoins    = "reward.coins"
 	flagRewardHeight   = "reward.height"
+	flagBinaryName     = "binary-name"
+	flagDefaultHome    = "default-home"
+	flagSignGenesis    = "sign-genesis"
+	flagGenesisMirrors = "genesis-mirrors"
 )
 
 // NewNetworkChainPublish returns a new command to publish a new chain to start a new network.
@@ -36,15 +43,22 @@ func NewNetworkChainPublish() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "publish [source-url]",
 		Short: "Publish a new chain to start a new network",
-		Args:  cobra.ExactArgs(1),
-		RunE:  networkChainPublishHandler,
+		Long: `Publish a new chain to start a new network.
+
+The source is a git repository, the URL of a source tarball (https://example.com/mars-v1.0.0.tar.gz)
+or an OCI artifact (oci://ghcr.io/ignite/mars-source:v1.0.0). The digest of a tarball or an OCI artifact
+is published as the source hash, the --hash flag pins the expected digest.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkChainPublishHandler,
 	}
 
 	flagSetClearCache(c)
 	c.Flags().String(flagBranch, "", "Git branch to use for the repo")
 	c.Flags().String(flagTag, "", "Git tag to use for the repo")
-	c.Flags().String(flagHash, "", "Git hash to use for the repo")
+	c.Flags().String(flagHash, "", "Git hash to use for the repo, or the sha256 digest of a tarball or OCI source")
 	c.Flags().String(flagGenesis, "", "URL to a custom Genesis")
+	c.Flags().Bool(flagSignGenesis, false, "Sign the hash of the custom Genesis with the account, the signature is written to a file to publish next to the Genesis")
+	c.Flags().String(flagGenesisMirrors, "", "URL of a JSON list of mirrors serving the custom Genesis, published in the chain metadata")
 	c.Flags().String(flagChainID, "", "Chain ID to use for this network")
 	c.Flags().Uint64(flagCampaign, 0, "Campaign ID to use for this network")
 	c.Flags().Bool(flagNoCheck, false, "Skip verifying chain's integrity")
@@ -56,6 +70,8 @@ func NewNetworkChainPublish() *cobra.Command {
 	c.Flags().String(flagRewardCoins, "", "Reward coins")
 	c.Flags().Int64(flagRewardHeight, 0, "Last reward height")
 	c.Flags().String(flagAmount, "", "Amount of coins for account request")
+	c.Flags().String(flagBinaryName, "", "Name of the chain binary when it differs from the repository name")
+	c.Flags().String(flagDefaultHome, "", "Default home of the chain binary when it differs from the one guessed from the repository name")
 	c.Flags().AddFlagSet(flagNetworkFrom())
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
 	c.Flags().AddFlagSet(flagSetKeyringDir())
@@ -75,6 +91,8 @@ func networkChainPublishHandler(cmd *cobra.Command, args []string) error {
 		branch, _                 = cmd.Flags().GetString(flagBranch)
 		hash, _                   = cmd.Flags().GetString(flagHash)
 		genesisURL, _             = cmd.Flags().GetString(flagGenesis)
+		signGenesis, _            = cmd.Flags().GetBool(flagSignGenesis)
+		genesisMirrors, _         = cmd.Flags().GetString(flagGenesisMirrors)
 		chainID, _                = cmd.Flags().GetString(flagChainID)
 		campaign, _               = cmd.Flags().GetUint64(flagCampaign)
 		noCheck, _                = cmd.Flags().GetBool(flagNoCheck)
@@ -86,6 +104,8 @@ func networkChainPublishHandler(cmd *cobra.Command, args []string) error {
 		rewardCoinsStr, _         = cmd.Flags().GetString(flagRewardCoins)
 		rewardDuration, _         = cmd.Flags().GetInt64(flagRewardHeight)
 		amount, _                 = cmd.Flags().GetString(flagAmount)
+		binaryName, _             = cmd.Flags().GetString(flagBinaryName)
+		defaultHome, _            = cmd.Flags().GetString(flagDefaultHome)
 	)
 
 	// parse the amount.
@@ -99,9 +119,12 @@ func networkChainPublishHandler(cmd *cobra.Command, args []string) error {
 		return errors.Wrap(err, "error parsing account balance")
 	}
 
-	source, err := xurl.MightHTTPS(args[0])
-	if err != nil {
-		return fmt.Errorf("invalid source url format: %w", err)
+	// the OCI references have their own scheme
+	source := args[0]
+	if !networkchain.IsOCISource(source) {
+		if source, err = xurl.MightHTTPS(source); err != nil {
+			return fmt.Errorf("invalid source url format: %w", err)
+		}
 	}
 
 	cacheStorage, err := newCache(cmd)
@@ -127,6 +150,9 @@ func networkChainPublishHandler(cmd *cobra.Command, args []string) error {
 	}
 
 	if chainID != "" {
+		if err := networktypes.ValidateChainID(chainID); err != nil {
+			return err
+		}
 		chainName, _, err := chainid.ParseGenesisChainID(chainID)
 		if err != nil {
 			return errors.Wrapf(err, "invalid chain id: %s", chainID)
@@ -146,6 +172,18 @@ func networkChainPublishHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// check the denoms before building the chain
+	for flag, coins := range map[string]sdk.Coins{
+		flagAmount:              amountCoins,
+		flagAccountBalance:      accountBalanceCoins,
+		flagCampaignTotalSupply: totalSupply,
+		flagRewardCoins:         rewardCoins,
+	} {
+		if err := networktypes.ValidateCoinsDenom(coins); err != nil {
+			return errors.Wrapf(err, "invalid %s flag", flag)
+		}
+	}
+
 	if (!rewardCoins.Empty() && rewardDuration == 0) ||
 		(rewardCoins.Empty() && rewardDuration > 0) {
 		return fmt.Errorf("%s and %s flags must be provided together", flagRewardCoins, flagRewardHeight)
@@ -175,6 +213,7 @@ func networkChainPublishHandler(cmd *cobra.Command, args []string) error {
 	// use custom genesis from url if given.
 	if genesisURL != "" {
 		initOptions = append(initOptions, networkchain.WithGenesisFromURL(genesisURL))
+		initOptions = append(initOptions, networkchain.WithGenesisMirrorList(genesisMirrors))
 	}
 
 	// init in a temp dir.
@@ -192,6 +231,19 @@ func networkChainPublishHandler(cmd *cobra.Command, args []string) error {
 	if genesisURL != "" {
 		publishOptions = append(publishOptions, network.WithCustomGenesis(genesisURL))
 	}
+	// the detached signature of the genesis hash is written in the current directory
+	var signaturePath string
+	if signGenesis {
+		u, err := url.Parse(genesisURL)
+		if err != nil {
+			return err
+		}
+		signaturePath = path.Base(u.Path) + networktypes.GenesisSignatureSuffix
+		publishOptions = append(publishOptions, network.WithGenesisSignature(signaturePath))
+	}
+	if genesisMirrors != "" {
+		publishOptions = append(publishOptions, network.WithGenesisMirrorList(genesisMirrors))
+	}
 
 	if campaign != 0 {
 		publishOptions = append(publishOptions, network.WithCampaign(campaign))
@@ -231,6 +283,15 @@ func networkChainPublishHandler(cmd *cobra.Command, args []string) error {
 		publishOptions = append(publishOptions, network.WithPercentageShares(sharePercentages))
 	}
 
+	// the binary name and the default home are published for the validators to initialize the chain
+	if binaryName != "" || defaultHome != "" {
+		initOptions = append(initOptions, networkchain.WithBinaryName(binaryName), networkchain.WithDefaultHome(defaultHome))
+		publishOptions = append(publishOptions, network.WithChainMetadata(networktypes.ChainMetadata{
+			BinaryName:  binaryName,
+			DefaultHome: defaultHome,
+		}))
+	}
+
 	// TODO: Issue an error or warning when this flag is used with "no-check"?
 	//       The "check-dependencies" flag is ignored when the "no-check" one is present.
 	if flagGetCheckDependencies(cmd) {
@@ -283,6 +344,14 @@ func networkChainPublishHandler(cmd *cobra.Command, args []string) error {
 	if campaignID != 0 {
 		session.Printf("%s Campaign ID: %d \n", icons.Bullet, campaignID)
 	}
+	if signaturePath != "" {
+		session.Printf(
+			"%s Publish the Genesis signature %s at %s\n",
+			icons.Bullet,
+			signaturePath,
+			networktypes.GenesisSignatureURL(genesisURL),
+		)
+	}
 
 	return nil
 }
@@ -8,6 +8,8 @@ import (
 	"github.com/ignite/cli/ignite/services/network/networkchain"
 )
 
+const flagBumpEpoch = "bump-epoch"
+
 // NewNetworkChainRevertLaunch creates a new chain revert launch command
 // to revert a launched chain.
 func NewNetworkChainRevertLaunch() *cobra.Command {
@@ -21,6 +23,8 @@ func NewNetworkChainRevertLaunch() *cobra.Command {
 	c.Flags().AddFlagSet(flagNetworkFrom())
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
 	c.Flags().AddFlagSet(flagSetKeyringDir())
+	c.Flags().AddFlagSet(flagSetSkipCoordinatorCheck())
+	c.Flags().Bool(flagBumpEpoch, false, "Increment the epoch of the chain ID for the relaunch (e.g. mars-1 becomes mars-2), refused when the chain has approved genesis validators")
 
 	return c
 }
@@ -40,7 +44,7 @@ func networkChainRevertLaunchHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	n, err := nb.Network()
+	n, err := nb.Network(coordinatorOptions(cmd)...)
 	if err != nil {
 		return err
 	}
@@ -55,5 +59,10 @@ func networkChainRevertLaunchHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	return n.RevertLaunch(cmd.Context(), launchID, c)
+	var revertOptions []network.RevertLaunchOption
+	if bumpEpoch, _ := cmd.Flags().GetBool(flagBumpEpoch); bumpEpoch {
+		revertOptions = append(revertOptions, network.BumpChainIDEpoch())
+	}
+
+	return n.RevertLaunch(cmd.Context(), launchID, c, revertOptions...)
 }
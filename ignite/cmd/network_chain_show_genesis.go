@@ -9,6 +9,7 @@ import (
 	"github.com/ignite/cli/ignite/pkg/cliui"
 	"github.com/ignite/cli/ignite/pkg/cliui/icons"
 	"github.com/ignite/cli/ignite/pkg/xos"
+	"github.com/ignite/cli/ignite/services/network"
 	"github.com/ignite/cli/ignite/services/network/networkchain"
 )
 
@@ -41,7 +42,8 @@ func networkChainShowGenesisHandler(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	n, err := nb.Network()
+	// pin the queries to the same height to build the genesis from a consistent state
+	n, err := nb.Network(network.WithPinnedHeight())
 	if err != nil {
 		return err
 	}
@@ -17,6 +17,9 @@ func newNetworkChainShowInfo() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE:  networkChainShowInfoHandler,
 	}
+
+	flagSetClearCache(c)
+
 	return c
 }
 
@@ -28,7 +31,11 @@ func networkChainShowInfoHandler(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	n, err := nb.Network()
+	queryCache, err := nb.QueryCache()
+	if err != nil {
+		return err
+	}
+	n, err := nb.Network(queryCache)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,11 @@ func NewNetworkRequest() *cobra.Command {
 		NewNetworkRequestApprove(),
 		NewNetworkRequestReject(),
 		NewNetworkRequestVerify(),
+		NewNetworkRequestExport(),
+		NewNetworkRequestImport(),
+		NewNetworkRequestStats(),
+		NewNetworkRequestQueue(),
+		NewNetworkRequestAddAccounts(),
 	)
 
 	return c
@@ -0,0 +1,118 @@
+package ignitecmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/network"
+)
+
+const flagResults = "results"
+
+// NewNetworkRequestAddAccounts creates a new request add-accounts command to send
+// the genesis account requests of an accounts file.
+func NewNetworkRequestAddAccounts() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "add-accounts [launch-id] [accounts-file]",
+		Short: "Send the genesis account requests of a CSV or JSON accounts file",
+		Long: `Send the genesis account requests of a CSV or JSON accounts file.
+
+The CSV file has a header naming the columns address, amount and the optional vesting_amount
+and vesting_end_time, a JSON file is a list of objects with the same fields. An account with
+a vesting amount is a vesting account, the vesting end time is a RFC3339 time or a unix timestamp.
+
+The invalid rows are skipped unless --strict is used, the request ID or the error of each row
+is written to the results file.`,
+		RunE: networkRequestAddAccountsHandler,
+		Args: cobra.ExactArgs(2),
+	}
+	c.Flags().String(flagResults, "", "Path of the results file (default \"<accounts-file>.results.json\")")
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringDir())
+	return c
+}
+
+func networkRequestAddAccountsHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	var (
+		resultsPath, _ = cmd.Flags().GetString(flagResults)
+		accountsPath   = args[1]
+	)
+	if resultsPath == "" {
+		resultsPath = strings.TrimSuffix(accountsPath, filepath.Ext(accountsPath)) + ".results.json"
+	}
+
+	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
+	if err != nil {
+		return err
+	}
+
+	// parse launch ID
+	launchID, err := network.ParseID(args[0])
+	if err != nil {
+		return err
+	}
+
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(accountsPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var rows []network.GenesisAccountRow
+	if strings.EqualFold(filepath.Ext(accountsPath), ".json") {
+		rows, err = network.ParseGenesisAccountsJSON(f)
+	} else {
+		rows, err = network.ParseGenesisAccountsCSV(f)
+	}
+	if err != nil {
+		return err
+	}
+
+	var options []network.ImportGenesisAccountsOption
+	if strictValidation {
+		options = append(options, network.WithStrictImport())
+	}
+	result, importErr := n.ImportGenesisAccounts(cmd.Context(), launchID, rows, options...)
+	if result.Results != nil {
+		resultsFile, err := os.Create(resultsPath)
+		if err != nil {
+			return err
+		}
+		defer resultsFile.Close()
+
+		if err := result.WriteJSON(resultsFile); err != nil {
+			return err
+		}
+	}
+	if importErr != nil {
+		return importErr
+	}
+
+	session.StopSpinner()
+
+	failed := len(result.Failed())
+	if err := session.Printf(
+		"%s %d genesis account request(s) sent, %d row(s) skipped\n",
+		icons.OK,
+		len(result.Results)-failed,
+		failed,
+	); err != nil {
+		return err
+	}
+	return session.Printf("%s Results written to %s\n", icons.Info, resultsPath)
+}
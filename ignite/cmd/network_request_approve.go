@@ -1,11 +1,14 @@
 package ignitecmd
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/ignite/pkg/cliui"
-	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/events"
 	"github.com/ignite/cli/ignite/pkg/numbers"
 	"github.com/ignite/cli/ignite/services/network"
 )
@@ -31,11 +34,14 @@ func NewNetworkRequestApprove() *cobra.Command {
 	c.Flags().AddFlagSet(flagSetHome())
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
 	c.Flags().AddFlagSet(flagSetKeyringDir())
+	c.Flags().AddFlagSet(flagSetSkipCoordinatorCheck())
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().AddFlagSet(flagSetJSON())
 	return c
 }
 
 func networkRequestApproveHandler(cmd *cobra.Command, args []string) error {
-	session := cliui.New()
+	session := cliui.New(sessionOptions(cmd)...)
 	defer session.Cleanup()
 
 	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
@@ -61,7 +67,7 @@ func networkRequestApproveHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	n, err := nb.Network()
+	n, err := nb.Network(coordinatorOptions(cmd)...)
 	if err != nil {
 		return err
 	}
@@ -71,12 +77,37 @@ func networkRequestApproveHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// the requests created after the request deadline are only approved with an explicit confirmation
+	deadline, late, err := n.LateRequests(cmd.Context(), launchID, ids...)
+	if err != nil {
+		return err
+	}
+	if len(late) > 0 && !getYes(cmd) {
+		session.StopSpinner()
+		question := fmt.Sprintf(
+			"Request(s) %s were created after the request deadline %s, approve them anyway",
+			numbers.List(late, "#"),
+			deadline.UTC().Format(time.RFC3339),
+		)
+		if err := session.AskConfirm(question); err != nil {
+			return session.PrintSaidNo()
+		}
+	}
+
+	// requests declaring the same validator as another request would make the genesis invalid
+	if err := n.VerifyRequestConflicts(cmd.Context(), launchID, ids...); err != nil {
+		return errors.Wrap(err, "request(s) not valid")
+	}
+
 	// if requests must be verified, we simulate the chain in a temporary directory with the requests
 	if !noVerification {
 		if err := verifyRequest(cmd.Context(), cacheStorage, nb, launchID, ids...); err != nil {
 			return errors.Wrap(err, "request(s) not valid")
 		}
-		session.Printf("%s Request(s) %s verified\n", icons.OK, numbers.List(ids, "#"))
+		session.EventBus().Send(events.New(
+			events.StatusDone,
+			fmt.Sprintf("Request(s) %s verified", numbers.List(ids, "#")),
+		))
 	}
 
 	// Submit the approved requests
@@ -88,7 +119,9 @@ func networkRequestApproveHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	session.StopSpinner()
-
-	return session.Printf("%s Request(s) %s approved\n", icons.OK, numbers.List(ids, "#"))
+	session.EventBus().Send(events.New(
+		events.StatusDone,
+		fmt.Sprintf("Request(s) %s approved", numbers.List(ids, "#")),
+	))
+	return nil
 }
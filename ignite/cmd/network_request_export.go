@@ -0,0 +1,66 @@
+package ignitecmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/network"
+)
+
+// NewNetworkRequestExport creates a new request export command to export
+// the pending requests of a chain into a bundle reviewed offline.
+func NewNetworkRequestExport() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "export [launch-id] [bundle-file]",
+		Short: "Export the pending requests into a bundle to review them offline",
+		Long: `Export the pending requests into a bundle to review them offline.
+
+Set the "decision" field of the requests of the bundle to "approve" or "reject" and
+import the bundle with the "import" command to settle them.`,
+		RunE: networkRequestExportHandler,
+		Args: cobra.ExactArgs(2),
+	}
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringDir())
+	return c
+}
+
+func networkRequestExportHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
+	if err != nil {
+		return err
+	}
+
+	// parse launch ID
+	launchID, err := network.ParseID(args[0])
+	if err != nil {
+		return err
+	}
+
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(args[1])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := n.ExportRequests(cmd.Context(), launchID, f); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf("%s Requests exported to %s\n", icons.OK, args[1])
+}
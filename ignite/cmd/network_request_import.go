@@ -0,0 +1,57 @@
+package ignitecmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+)
+
+// NewNetworkRequestImport creates a new request import command to settle
+// the requests of a reviewed bundle.
+func NewNetworkRequestImport() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "import [bundle-file]",
+		Short: "Settle the requests of a reviewed bundle",
+		RunE:  networkRequestImportHandler,
+		Args:  cobra.ExactArgs(1),
+	}
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringDir())
+	c.Flags().AddFlagSet(flagSetSkipCoordinatorCheck())
+	return c
+}
+
+func networkRequestImportHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
+	if err != nil {
+		return err
+	}
+
+	n, err := nb.Network(coordinatorOptions(cmd)...)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	reviewals, err := n.ImportDecisions(cmd.Context(), f)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf("%s %d request(s) settled\n", icons.OK, len(reviewals))
+}
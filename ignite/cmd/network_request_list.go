@@ -2,6 +2,7 @@ package ignitecmd
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
@@ -24,6 +25,7 @@ func NewNetworkRequestList() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 	}
 
+	flagSetClearCache(c)
 	c.Flags().AddFlagSet(flagSetSPNAccountPrefixes())
 
 	return c
@@ -46,7 +48,16 @@ func networkRequestListHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	n, err := nb.Network()
+	queryCache, err := nb.QueryCache()
+	if err != nil {
+		return err
+	}
+	n, err := nb.Network(queryCache)
+	if err != nil {
+		return err
+	}
+
+	launch, err := n.ChainLaunch(cmd.Context(), launchID)
 	if err != nil {
 		return err
 	}
@@ -55,15 +66,29 @@ func networkRequestListHandler(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	reasons, err := n.RejectionReasons(cmd.Context(), launchID, requests...)
+	if err != nil {
+		return err
+	}
+	for i := range requests {
+		requests[i].RejectionReason = reasons[requests[i].RequestID]
+	}
 
 	session.StopSpinner()
 
-	return renderRequestSummaries(requests, session, addressPrefix)
+	deadline := launch.RequestDeadline()
+	if !deadline.IsZero() {
+		session.Printf("Request deadline: %s\n\n", deadline.UTC().Format(time.RFC3339))
+	}
+
+	return renderRequestSummaries(requests, deadline, session, addressPrefix)
 }
 
-// renderRequestSummaries writes into the provided out, the list of summarized requests
+// renderRequestSummaries writes into the provided out, the list of summarized requests.
+// The pending requests created after the deadline are flagged as late, the rejected requests show their reason.
 func renderRequestSummaries(
 	requests []networktypes.Request,
+	deadline time.Time,
 	session cliui.Session,
 	addressPrefix string,
 ) error {
@@ -158,9 +183,17 @@ func renderRequestSummaries(
 			content = address
 		}
 
+		status := request.Status
+		if request.Status == launchtypes.Request_PENDING.String() && request.IsLate(deadline) {
+			status += " (late)"
+		}
+		if request.RejectionReason != "" {
+			status += ": " + request.RejectionReason
+		}
+
 		requestEntries = append(requestEntries, []string{
 			id,
-			request.Status,
+			status,
 			requestType,
 			content,
 		})
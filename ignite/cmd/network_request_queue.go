@@ -0,0 +1,85 @@
+package ignitecmd
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/network"
+)
+
+// NewNetworkRequestQueue creates a new request queue command to show the position
+// of a pending request in the queue of the requests of a chain.
+func NewNetworkRequestQueue() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "queue [launch-id] [request-id]",
+		Short: "Show the position of a pending request in the queue and its estimated settlement",
+		Long: `Show the position of a pending request in the queue and its estimated settlement.
+
+The requests are reviewed in the order they were sent. The settlement of the request is estimated
+from the number of requests the coordinator settled per hour recently, it's only an estimate:
+the coordinator can review the requests at any time. The chains without recent settlements only
+show the position of the request.`,
+		RunE: networkRequestQueueHandler,
+		Args: cobra.ExactArgs(2),
+	}
+	return c
+}
+
+func networkRequestQueueHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
+	if err != nil {
+		return err
+	}
+
+	// parse launch ID
+	launchID, err := network.ParseID(args[0])
+	if err != nil {
+		return err
+	}
+
+	// parse request ID
+	requestID, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "error parsing requestID")
+	}
+
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	position, err := n.RequestQueuePosition(cmd.Context(), launchID, requestID)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	if err := session.Printf(
+		"%s Request %d is %d of %d pending request(s)\n",
+		icons.Info,
+		requestID,
+		position.Position,
+		position.Pending,
+	); err != nil {
+		return err
+	}
+	if !position.HasEstimate() {
+		return session.Printf("%s The coordinator didn't settle any request recently, no estimate available\n", icons.Info)
+	}
+	return session.Printf(
+		"%s Estimated settlement in %s (%s) at %.1f request(s) settled per hour, this is only an estimate\n",
+		icons.Info,
+		position.EstimatedWait.Round(time.Minute),
+		position.EstimatedSettlement.Local().Format(time.RFC1123),
+		position.SettlementRate,
+	)
+}
@@ -1,14 +1,22 @@
 package ignitecmd
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/ignite/pkg/cliui"
-	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/events"
 	"github.com/ignite/cli/ignite/pkg/numbers"
 	"github.com/ignite/cli/ignite/services/network"
 )
 
+const (
+	flagReason = "reason"
+)
+
 // NewNetworkRequestReject creates a new request reject
 // command to reject requests for a chain.
 func NewNetworkRequestReject() *cobra.Command {
@@ -16,18 +24,31 @@ func NewNetworkRequestReject() *cobra.Command {
 		Use:     "reject [launch-id] [number<,...>]",
 		Aliases: []string{"accept"},
 		Short:   "Reject requests",
-		RunE:    networkRequestRejectHandler,
-		Args:    cobra.ExactArgs(2),
+		Long: `Reject requests with a reason for the requesters.
+
+Each request is rejected in its own transaction with the reason in the memo. The reason is shown
+with the request in the request list.
+`,
+		RunE: networkRequestRejectHandler,
+		Args: cobra.ExactArgs(2),
 	}
+	c.Flags().String(flagReason, "", "reason of the rejection shown to the requesters (required)")
 	c.Flags().AddFlagSet(flagNetworkFrom())
 	c.Flags().AddFlagSet(flagSetHome())
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
 	c.Flags().AddFlagSet(flagSetKeyringDir())
+	c.Flags().AddFlagSet(flagSetSkipCoordinatorCheck())
+	c.Flags().AddFlagSet(flagSetJSON())
 	return c
 }
 
 func networkRequestRejectHandler(cmd *cobra.Command, args []string) error {
-	session := cliui.New()
+	reason, _ := cmd.Flags().GetString(flagReason)
+	if strings.TrimSpace(reason) == "" {
+		return errors.Errorf("the reason of the rejection is required, set it with --%s", flagReason)
+	}
+
+	session := cliui.New(sessionOptions(cmd)...)
 	defer session.Cleanup()
 
 	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
@@ -47,7 +68,7 @@ func networkRequestRejectHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	n, err := nb.Network()
+	n, err := nb.Network(coordinatorOptions(cmd)...)
 	if err != nil {
 		return err
 	}
@@ -55,13 +76,15 @@ func networkRequestRejectHandler(cmd *cobra.Command, args []string) error {
 	// Submit the rejected requests
 	reviewals := make([]network.Reviewal, 0)
 	for _, id := range ids {
-		reviewals = append(reviewals, network.RejectRequest(id))
+		reviewals = append(reviewals, network.RejectRequest(id, reason))
 	}
 	if err := n.SubmitRequest(cmd.Context(), launchID, reviewals...); err != nil {
 		return err
 	}
 
-	session.StopSpinner()
-
-	return session.Printf("%s Request(s) %s rejected\n", icons.OK, numbers.List(ids, "#"))
+	session.EventBus().Send(events.New(
+		events.StatusDone,
+		fmt.Sprintf("Request(s) %s rejected", numbers.List(ids, "#")),
+	))
+	return nil
 }
@@ -53,6 +53,11 @@ func networkRequestShowHandler(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	reasons, err := n.RejectionReasons(cmd.Context(), launchID, request)
+	if err != nil {
+		return err
+	}
+	request.RejectionReason = reasons[requestID]
 
 	// convert the request object to YAML to be more readable
 	// and convert the byte array fields to string.
@@ -0,0 +1,90 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/network"
+)
+
+const (
+	flagStatsFormat = "format"
+
+	statsFormatCSV  = "csv"
+	statsFormatJSON = "json"
+)
+
+// NewNetworkRequestStats creates a new request stats command to export
+// the statistics of the requests of a chain.
+func NewNetworkRequestStats() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "stats [launch-id] [stats-file]",
+		Short: "Export the statistics of the requests as CSV or JSON",
+		Long: `Export the statistics of the requests as CSV or JSON.
+
+The statistics contain the daily counts of the requests by type and status, the percentiles
+of the approval latency in seconds and the distribution of the validator self-delegations.`,
+		RunE: networkRequestStatsHandler,
+		Args: cobra.ExactArgs(2),
+	}
+	c.Flags().String(flagStatsFormat, statsFormatCSV, "Format of the statistics (csv|json)")
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringDir())
+	return c
+}
+
+func networkRequestStatsHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	format, _ := cmd.Flags().GetString(flagStatsFormat)
+	if format != statsFormatCSV && format != statsFormatJSON {
+		return fmt.Errorf("invalid stats format %q, must be %s or %s", format, statsFormatCSV, statsFormatJSON)
+	}
+
+	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
+	if err != nil {
+		return err
+	}
+
+	// parse launch ID
+	launchID, err := network.ParseID(args[0])
+	if err != nil {
+		return err
+	}
+
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	stats, err := n.RequestStats(cmd.Context(), launchID)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(args[1])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if format == statsFormatJSON {
+		err = stats.WriteJSON(f)
+	} else {
+		err = stats.WriteCSV(f)
+	}
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf("%s Statistics of %d requests exported to %s\n", icons.OK, stats.Total, args[1])
+}
@@ -58,7 +58,16 @@ func networkRequestVerifyHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
 	// verify the requests
+	if err := n.VerifyRequestConflicts(cmd.Context(), launchID, ids...); err != nil {
+		session.Printf("%s Request(s) %s not valid\n", icons.NotOK, numbers.List(ids, "#"))
+		return err
+	}
 	if err := verifyRequest(cmd.Context(), cacheStorage, nb, launchID, ids...); err != nil {
 		session.Printf("%s Request(s) %s not valid\n", icons.NotOK, numbers.List(ids, "#"))
 		return err
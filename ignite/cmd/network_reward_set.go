@@ -3,27 +3,47 @@ package ignitecmd
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
 	"github.com/ignite/cli/ignite/services/network"
 )
 
+const (
+	flagExpectedBlockTime = "expected-block-time"
+	flagChainRPC          = "chain-rpc"
+)
+
 // NewNetworkRewardSet creates a new chain reward set command to
 // add the chain reward to the network as a coordinator.
 func NewNetworkRewardSet() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "set [launch-id] [last-reward-height] [coins]",
 		Short: "set a network chain reward",
-		Args:  cobra.ExactArgs(3),
-		RunE:  networkChainRewardSetHandler,
+		Long: `Set a network chain reward.
+
+The last reward height is either a block height or a reward duration like "72h". The duration
+is converted into a height with the expected block time before the launch of the chain. After
+the launch, pass the RPC address of the chain with --chain-rpc to measure its block time.
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: networkChainRewardSetHandler,
 	}
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
 	c.Flags().AddFlagSet(flagSetKeyringDir())
 	c.Flags().AddFlagSet(flagNetworkFrom())
 	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().Duration(
+		flagExpectedBlockTime,
+		network.DefaultExpectedBlockTime,
+		"Expected block time used to convert the reward duration into a height before the launch",
+	)
+	c.Flags().String(flagChainRPC, "", "RPC address of the launched chain used to measure its block time")
 	return c
 }
 
@@ -42,12 +62,6 @@ func networkChainRewardSetHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	// parse the last reward height
-	lastRewardHeight, err := strconv.ParseInt(args[1], 10, 64)
-	if err != nil {
-		return err
-	}
-
 	coins, err := sdk.ParseCoinsNormalized(args[2])
 	if err != nil {
 		return fmt.Errorf("failed to parse coins: %w", err)
@@ -58,5 +72,58 @@ func networkChainRewardSetHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// parse the last reward height, either a height or a reward duration
+	lastRewardHeight, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		duration, durationErr := time.ParseDuration(args[1])
+		if durationErr != nil {
+			return fmt.Errorf("the last reward height must be a height or a duration: %w", err)
+		}
+
+		rewardHeight, err := rewardHeightFromDuration(cmd, n, launchID, duration)
+		if err != nil {
+			return err
+		}
+		lastRewardHeight = rewardHeight.Height
+
+		blockTime := "expected"
+		if rewardHeight.Measured {
+			blockTime = "measured"
+		}
+		session.Printf(
+			"%s Reward duration of %s converted into the height %d with the %s block time of %s\n",
+			icons.Info,
+			duration,
+			lastRewardHeight,
+			blockTime,
+			rewardHeight.BlockTime,
+		)
+	} else if err := n.CheckRewardHeight(cmd.Context(), launchID, lastRewardHeight); err != nil {
+		return err
+	}
+
 	return n.SetReward(cmd.Context(), launchID, lastRewardHeight, coins)
 }
+
+// rewardHeightFromDuration converts the reward duration into a height, the block time is measured from the chain
+// when its RPC address is set.
+func rewardHeightFromDuration(
+	cmd *cobra.Command,
+	n network.Network,
+	launchID uint64,
+	duration time.Duration,
+) (network.RewardHeight, error) {
+	var (
+		expectedBlockTime, _ = cmd.Flags().GetDuration(flagExpectedBlockTime)
+		chainRPC, _          = cmd.Flags().GetString(flagChainRPC)
+		options              = []network.RewardHeightOption{network.WithExpectedBlockTime(expectedBlockTime)}
+	)
+	if chainRPC != "" {
+		chainClient, err := cosmosclient.New(cmd.Context(), cosmosclient.WithNodeAddress(chainRPC))
+		if err != nil {
+			return network.RewardHeight{}, err
+		}
+		options = append(options, network.WithMeasuredBlockTime(network.NewNode(chainClient)))
+	}
+	return n.RewardHeight(cmd.Context(), launchID, duration, options...)
+}
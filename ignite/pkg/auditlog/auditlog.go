@@ -0,0 +1,202 @@
+// Package auditlog provides an append-only log of operations persisted locally in JSONL format.
+package auditlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMaxSize is the default size in bytes after which the log file is rotated.
+	DefaultMaxSize = 10 * 1024 * 1024
+
+	// DefaultMaxBackups is the default number of rotated log files kept.
+	DefaultMaxBackups = 3
+)
+
+// Entry is an operation recorded in the audit log.
+type Entry struct {
+	// Time is the time when the operation was performed.
+	Time time.Time `json:"time"`
+
+	// Operation is the name of the operation.
+	Operation string `json:"operation"`
+
+	// LaunchID is the ID of the chain launch the operation applies to, if any.
+	LaunchID uint64 `json:"launch_id,omitempty"`
+
+	// Args contains the arguments of the operation.
+	Args json.RawMessage `json:"args,omitempty"`
+
+	// TxHash is the hash of the transaction broadcasted for the operation.
+	TxHash string `json:"tx_hash,omitempty"`
+
+	// Error is the error returned by the operation, it is empty when the operation succeeded.
+	Error string `json:"error,omitempty"`
+}
+
+// Log is an append-only audit log rotated by size.
+type Log struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+	mu         *sync.Mutex
+}
+
+// Option configures the audit log.
+type Option func(*Log)
+
+// WithMaxSize sets the size in bytes after which the log file is rotated.
+func WithMaxSize(size int64) Option {
+	return func(l *Log) {
+		l.maxSize = size
+	}
+}
+
+// WithMaxBackups sets the number of rotated log files kept, older files are removed.
+func WithMaxBackups(n int) Option {
+	return func(l *Log) {
+		l.maxBackups = n
+	}
+}
+
+// New creates an audit log stored in the file at path.
+func New(path string, options ...Option) Log {
+	l := Log{
+		path:       path,
+		maxSize:    DefaultMaxSize,
+		maxBackups: DefaultMaxBackups,
+		mu:         &sync.Mutex{},
+	}
+	for _, apply := range options {
+		apply(&l)
+	}
+	return l
+}
+
+// Path returns the path of the current log file.
+func (l Log) Path() string {
+	return l.path
+}
+
+// Append appends an entry to the log, the log file is rotated first if the entry
+// would make it exceed the max size.
+func (l Log) Append(entry Entry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0o755); err != nil {
+		return err
+	}
+
+	info, err := os.Stat(l.path)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return err
+	case info.Size() > 0 && info.Size()+int64(len(line)) > l.maxSize:
+		if err := l.rotate(); err != nil {
+			return err
+		}
+	}
+
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(line); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Recent returns the last entries of the log, oldest first, up to limit entries.
+// When launchID is not zero, only the entries of this launch are returned.
+// A limit of zero returns all the entries.
+func (l Log) Recent(launchID uint64, limit int) ([]Entry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var entries []Entry
+	for i := l.maxBackups; i >= 0; i-- {
+		fileEntries, err := readEntries(l.filePath(i))
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range fileEntries {
+			if launchID == 0 || entry.LaunchID == launchID {
+				entries = append(entries, entry)
+			}
+		}
+	}
+
+	if limit > 0 && len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+	return entries, nil
+}
+
+// rotate shifts the rotated log files and moves the current log file to the first backup.
+func (l Log) rotate() error {
+	if l.maxBackups == 0 {
+		return os.Remove(l.path)
+	}
+	if err := os.Remove(l.filePath(l.maxBackups)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for i := l.maxBackups - 1; i >= 0; i-- {
+		if err := os.Rename(l.filePath(i), l.filePath(i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// filePath returns the path of the log file for the backup index, 0 is the current log file.
+func (l Log) filePath(index int) string {
+	if index == 0 {
+		return l.path
+	}
+	return fmt.Sprintf("%s.%d", l.path, index)
+}
+
+func readEntries(path string) ([]Entry, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		entries []Entry
+		scanner = bufio.NewScanner(f)
+	)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry Entry
+		// an entry can be partially written if the process was interrupted, it is skipped
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
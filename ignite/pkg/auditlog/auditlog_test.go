@@ -0,0 +1,105 @@
+package auditlog_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/auditlog"
+)
+
+func newEntry(launchID uint64, operation string) auditlog.Entry {
+	return auditlog.Entry{
+		Time:      time.Unix(1000, 0).UTC(),
+		Operation: operation,
+		LaunchID:  launchID,
+		Args:      json.RawMessage(`{"foo":"bar"}`),
+		TxHash:    "ABCD",
+	}
+}
+
+func TestLog(t *testing.T) {
+	t.Run("append and list recent entries", func(t *testing.T) {
+		log := auditlog.New(filepath.Join(t.TempDir(), "audit", "audit.log"))
+
+		entries := []auditlog.Entry{
+			newEntry(1, "foo"),
+			newEntry(2, "bar"),
+			newEntry(1, "baz"),
+		}
+		entries[1].Error = "failed"
+		for _, entry := range entries {
+			require.NoError(t, log.Append(entry))
+		}
+
+		all, err := log.Recent(0, 0)
+		require.NoError(t, err)
+		require.Equal(t, entries, all)
+
+		filtered, err := log.Recent(1, 0)
+		require.NoError(t, err)
+		require.Equal(t, []auditlog.Entry{entries[0], entries[2]}, filtered)
+
+		last, err := log.Recent(0, 1)
+		require.NoError(t, err)
+		require.Equal(t, []auditlog.Entry{entries[2]}, last)
+	})
+
+	t.Run("list entries from an empty log", func(t *testing.T) {
+		log := auditlog.New(filepath.Join(t.TempDir(), "audit.log"))
+
+		entries, err := log.Recent(0, 0)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("rotate the log by size", func(t *testing.T) {
+		line, err := json.Marshal(newEntry(1, "foo"))
+		require.NoError(t, err)
+
+		var (
+			path = filepath.Join(t.TempDir(), "audit.log")
+			log  = auditlog.New(
+				path,
+				auditlog.WithMaxSize(int64(len(line)+1)*2),
+				auditlog.WithMaxBackups(1),
+			)
+			operations = []string{"foo", "bar", "baz", "qux", "quux"}
+		)
+		for _, op := range operations {
+			require.NoError(t, log.Append(newEntry(1, op)))
+		}
+
+		// two entries are stored per file and only one backup is kept
+		require.FileExists(t, path+".1")
+		require.NoFileExists(t, path+".2")
+
+		entries, err := log.Recent(0, 0)
+		require.NoError(t, err)
+		require.Equal(t, []auditlog.Entry{
+			newEntry(1, "baz"),
+			newEntry(1, "qux"),
+			newEntry(1, "quux"),
+		}, entries)
+	})
+
+	t.Run("skip partially written entries", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit.log")
+		log := auditlog.New(path)
+		require.NoError(t, log.Append(newEntry(1, "foo")))
+
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+		require.NoError(t, err)
+		_, err = f.WriteString(`{"time":`)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		entries, err := log.Recent(0, 0)
+		require.NoError(t, err)
+		require.Equal(t, []auditlog.Entry{newEntry(1, "foo")}, entries)
+	})
+}
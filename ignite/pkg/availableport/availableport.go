@@ -8,17 +8,23 @@ import (
 )
 
 // Find finds n number of unused ports.
-// it is not guaranteed that these ports will not be allocated to
+// the ports are distinct, it is not guaranteed that these ports will not be allocated to
 // another program in the time of calling Find().
 func Find(n int) (ports []int, err error) {
 	min := 44000
 	max := 55000
 
+	found := make(map[int]struct{})
 	for i := 0; i < n; i++ {
 		for {
 			rand.Seed(time.Now().UnixNano())
 			port := rand.Intn(max-min+1) + min
 
+			// the same port can be picked twice before being bound
+			if _, ok := found[port]; ok {
+				continue
+			}
+
 			conn, err := net.Dial("tcp", fmt.Sprintf(":%d", port))
 			// if there is an error, this might mean that no one is listening from this port
 			// which is what we need.
@@ -26,6 +32,7 @@ func Find(n int) (ports []int, err error) {
 				conn.Close()
 				continue
 			}
+			found[port] = struct{}{}
 			ports = append(ports, port)
 			break
 		}
@@ -2,6 +2,7 @@ package chaincmd
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 
@@ -76,6 +77,10 @@ type ChainCmd struct {
 	nodeAddress     string
 	legacySend      bool
 
+	initFlags          []string
+	gentxFlags         []string
+	collectGentxsFlags []string
+
 	isAutoChainIDDetectionEnabled bool
 
 	sdkVersion cosmosver.Version
@@ -184,6 +189,44 @@ func WithLegacySendCommand() Option {
 	}
 }
 
+// WithInitFlags provides extra flags appended to the init command.
+// Use CheckExtraFlags to verify the flags don't override the flags set by ChainCmd.
+func WithInitFlags(flags ...string) Option {
+	return func(c *ChainCmd) {
+		c.initFlags = flags
+	}
+}
+
+// WithGentxFlags provides extra flags appended to the gentx command.
+// Use CheckExtraFlags to verify the flags don't override the flags set by ChainCmd.
+func WithGentxFlags(flags ...string) Option {
+	return func(c *ChainCmd) {
+		c.gentxFlags = flags
+	}
+}
+
+// WithCollectGentxsFlags provides extra flags appended to the collect-gentxs command.
+// Use CheckExtraFlags to verify the flags don't override the flags set by ChainCmd.
+func WithCollectGentxsFlags(flags ...string) Option {
+	return func(c *ChainCmd) {
+		c.collectGentxsFlags = flags
+	}
+}
+
+// CheckExtraFlags checks the extra flags provided to a command don't override
+// the home and the chain ID flags that are set by ChainCmd.
+func CheckExtraFlags(flags []string) error {
+	for _, flag := range flags {
+		name := strings.SplitN(flag, "=", 2)[0]
+		for _, controlled := range []string{optionHome, optionChainID} {
+			if name == controlled {
+				return fmt.Errorf("the flag %s cannot be overridden, it is set from the chain configuration", controlled)
+			}
+		}
+	}
+	return nil
+}
+
 // StartCommand returns the command to start the daemon of the chain
 func (c ChainCmd) StartCommand(options ...string) step.Option {
 	command := append([]string{
@@ -199,6 +242,7 @@ func (c ChainCmd) InitCommand(moniker string) step.Option {
 		moniker,
 	}
 	command = c.attachChainID(command)
+	command = append(command, c.initFlags...)
 	return c.daemonCommand(command)
 }
 
@@ -451,6 +495,7 @@ func (c ChainCmd) GentxCommand(
 	for _, applyOption := range options {
 		command = applyOption(command)
 	}
+	command = append(command, c.gentxFlags...)
 
 	// Add necessary flags
 	if c.sdkVersion.IsFamily(cosmosver.Stargate) {
@@ -467,6 +512,7 @@ func (c ChainCmd) CollectGentxsCommand() step.Option {
 	command := []string{
 		commandCollectGentxs,
 	}
+	command = append(command, c.collectGentxsFlags...)
 	return c.daemonCommand(command)
 }
 
@@ -0,0 +1,112 @@
+package chaincmd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+)
+
+func execution(option step.Option) step.Execution {
+	return step.New(option).Exec
+}
+
+func TestExtraFlags(t *testing.T) {
+	c := chaincmd.New(
+		"food",
+		chaincmd.WithHome("/home/foo"),
+		chaincmd.WithChainID("foo-1"),
+		chaincmd.WithInitFlags("--default-denom=ufoo", "--recover", "--overwrite", "true"),
+		chaincmd.WithGentxFlags("--consensus-key-algo=secp256k1"),
+		chaincmd.WithCollectGentxsFlags("--gentx-dir=/tmp/gentxs"),
+	)
+
+	require.Equal(t, step.Execution{
+		Command: "food",
+		Args: []string{
+			"init",
+			"moniker",
+			"--chain-id",
+			"foo-1",
+			"--default-denom=ufoo",
+			"--recover",
+			"--overwrite",
+			"true",
+			"--home",
+			"/home/foo",
+		},
+	}, execution(c.InitCommand("moniker")))
+
+	require.Equal(t, step.Execution{
+		Command: "food",
+		Args: []string{
+			"gentx",
+			"alice",
+			"100stake",
+			"--consensus-key-algo=secp256k1",
+			"--chain-id",
+			"foo-1",
+			"--home",
+			"/home/foo",
+		},
+	}, execution(c.GentxCommand("alice", "100stake")))
+
+	require.Equal(t, step.Execution{
+		Command: "food",
+		Args: []string{
+			"collect-gentxs",
+			"--gentx-dir=/tmp/gentxs",
+			"--home",
+			"/home/foo",
+		},
+	}, execution(c.CollectGentxsCommand()))
+
+	// commands without extra flags are not changed
+	require.Equal(t, step.Execution{
+		Command: "food",
+		Args:    []string{"validate-genesis", "--home", "/home/foo"},
+	}, execution(c.ValidateGenesisCommand()))
+}
+
+func TestCheckExtraFlags(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags []string
+		err   string
+	}{
+		{
+			name: "no flags",
+		},
+		{
+			name:  "valid flags",
+			flags: []string{"--default-denom=ufoo", "--recover", "--home-client", "foo", "--chain-identifier=foo"},
+		},
+		{
+			name:  "home flag",
+			flags: []string{"--recover", "--home", "/tmp"},
+			err:   "the flag --home cannot be overridden, it is set from the chain configuration",
+		},
+		{
+			name:  "home flag with value",
+			flags: []string{"--home=/tmp"},
+			err:   "the flag --home cannot be overridden, it is set from the chain configuration",
+		},
+		{
+			name:  "chain id flag",
+			flags: []string{"--chain-id=foo-1"},
+			err:   "the flag --chain-id cannot be overridden, it is set from the chain configuration",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := chaincmd.CheckExtraFlags(tt.flags)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,20 +1,24 @@
 package cliui
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/manifoldco/promptui"
 
 	"github.com/ignite/cli/ignite/pkg/cliui/cliquiz"
 	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
 	"github.com/ignite/cli/ignite/pkg/cliui/entrywriter"
-	"github.com/ignite/cli/ignite/pkg/cliui/icons"
 	"github.com/ignite/cli/ignite/pkg/events"
 )
 
+// shutdownTimeout is the maximum duration to print the pending events on cleanup.
+const shutdownTimeout = 5 * time.Second
+
 // Session controls command line interaction with users.
 type Session struct {
 	ev       events.Bus
@@ -25,6 +29,13 @@ type Session struct {
 	in          io.Reader
 	out         io.Writer
 	printLoopWg *sync.WaitGroup
+
+	// errOut receives the report of the first event that failed to print.
+	errOut       io.Writer
+	printFailure *sync.Once
+
+	categories []string
+	jsonEvents bool
 }
 
 type Option func(s *Session)
@@ -43,20 +54,40 @@ func WithInput(input io.Reader) Option {
 	}
 }
 
+// WithEventCategories only prints the events of the categories and the events without category.
+func WithEventCategories(categories ...string) Option {
+	return func(s *Session) {
+		s.categories = categories
+	}
+}
+
+// WithJSONEvents prints the events as JSON values, one per line, instead of displaying them.
+// The spinner is disabled to keep the output parsable.
+func WithJSONEvents() Option {
+	return func(s *Session) {
+		s.jsonEvents = true
+	}
+}
+
 // New creates new Session.
 func New(options ...Option) Session {
 	wg := &sync.WaitGroup{}
 	session := Session{
-		ev:          events.NewBus(events.WithWaitGroup(wg)),
-		in:          os.Stdin,
-		out:         os.Stdout,
-		eventsWg:    wg,
-		printLoopWg: &sync.WaitGroup{},
+		in:           os.Stdin,
+		out:          os.Stdout,
+		errOut:       os.Stderr,
+		eventsWg:     wg,
+		printLoopWg:  &sync.WaitGroup{},
+		printFailure: &sync.Once{},
 	}
 	for _, apply := range options {
 		apply(&session)
 	}
-	session.spinner = clispinner.New(clispinner.WithWriter(session.out))
+	session.ev = events.NewBus(events.WithWaitGroup(wg), events.WithCategories(session.categories...))
+	// the JSON events are the only output of the session, there is no spinner
+	if !session.jsonEvents {
+		session.spinner = clispinner.New(clispinner.WithWriter(session.out))
+	}
 	session.printLoopWg.Add(1)
 	go session.printLoop()
 	return session
@@ -67,18 +98,27 @@ func (s Session) EventBus() events.Bus {
 	return s.ev
 }
 
-// StartSpinner starts spinner.
+// StartSpinner starts spinner, it does nothing when the session prints JSON events.
 func (s Session) StartSpinner(text string) {
+	if s.spinner == nil {
+		return
+	}
 	s.spinner.SetText(text).Start()
 }
 
-// StopSpinner stops spinner.
+// StopSpinner stops spinner, it does nothing when the session prints JSON events.
 func (s Session) StopSpinner() {
+	if s.spinner == nil {
+		return
+	}
 	s.spinner.Stop()
 }
 
 // PauseSpinner pauses spinner, returns resume function to start paused spinner again.
 func (s Session) PauseSpinner() (mightResume func()) {
+	if s.spinner == nil {
+		return func() {}
+	}
 	isActive := s.spinner.IsActive()
 	f := func() {
 		if isActive {
@@ -150,33 +190,60 @@ func (s Session) Wait() {
 }
 
 // Cleanup ensure spinner is stopped and printLoop exited correctly.
+// The pending events are printed until the shutdown timeout, a consumer that stopped
+// printing never blocks the exit.
 func (s Session) Cleanup() {
 	s.StopSpinner()
-	s.ev.Shutdown()
-	s.printLoopWg.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	s.ev.Shutdown(ctx)
+
+	printLoopDone := make(chan struct{})
+	go func() {
+		s.printLoopWg.Wait()
+		close(printLoopDone)
+	}()
+	select {
+	case <-printLoopDone:
+	case <-ctx.Done():
+	}
 }
 
 // printLoop handles events.
 func (s Session) printLoop() {
-	for event := range s.ev.Events() {
-		switch event.Status {
-		case events.StatusOngoing:
-			s.StartSpinner(event.Text())
-
-		case events.StatusDone:
-			if event.Icon == "" {
-				event.Icon = icons.OK
-			}
-			s.StopSpinner()
-			fmt.Fprintf(s.out, "%s %s\n", event.Icon, event.Text())
-
-		case events.StatusNeutral:
-			resume := s.PauseSpinner()
-			fmt.Fprint(s.out, event.Text())
-			resume()
+	defer s.printLoopWg.Done()
+
+	if s.jsonEvents {
+		enc := events.NewJSONEncoder(s.out)
+		for event := range s.ev.Events() {
+			_ = enc.Encode(event)
+			s.eventsWg.Done()
 		}
+		return
+	}
 
+	printer := eventPrinter{term: sessionTerminal{s}}
+	for event := range s.ev.Events() {
+		s.printEvent(printer, event)
 		s.eventsWg.Done()
 	}
-	s.printLoopWg.Done()
+}
+
+// printEvent prints the event. A failure of the printer doesn't stop the consumption of the events,
+// the first failure is reported to the error output.
+func (s Session) printEvent(printer eventPrinter, event events.Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.printFailure.Do(func() {
+				fmt.Fprintf(
+					s.errOut,
+					"cannot print the event %q: %v, the next events failing to print are not reported\n",
+					event.Description,
+					r,
+				)
+			})
+		}
+	}()
+	printer.print(event)
 }
@@ -0,0 +1,85 @@
+package cliui
+
+import (
+	"fmt"
+
+	"github.com/gookit/color"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+// terminal is the output where events are displayed.
+type terminal interface {
+	startSpinner(text string)
+	stopSpinner()
+	pauseSpinner() (mightResume func())
+	write(text string)
+}
+
+// eventPrinter displays the events in a terminal.
+// progress events are collapsed into the spinner line, which is restored
+// after any other event displayed while the progress group is not finished.
+type eventPrinter struct {
+	term     terminal
+	progress *events.Event
+}
+
+func (p *eventPrinter) print(event events.Event) {
+	switch event.Status {
+	case events.StatusOngoing:
+		if event.Progress != nil {
+			p.progress = &event
+		}
+		p.term.startSpinner(event.Text())
+
+	case events.StatusDone, events.StatusFailed:
+		if event.Progress != nil {
+			p.progress = nil
+		}
+		if event.Icon == "" {
+			event.Icon = icons.OK
+			if event.Status == events.StatusFailed {
+				event.Icon = icons.NotOK
+			}
+		}
+		p.term.stopSpinner()
+		p.term.write(fmt.Sprintf("%s %s\n", event.Icon, event.Text()))
+
+		// restore the line of the unfinished progress group
+		if p.progress != nil {
+			p.term.startSpinner(p.progress.Text())
+		}
+
+	case events.StatusNeutral:
+		resume := p.term.pauseSpinner()
+		p.term.write(event.Text())
+		resume()
+
+	case events.StatusWarning:
+		resume := p.term.pauseSpinner()
+		p.term.write(color.Yellow.Sprintf("warning: %s\n", event.Text()))
+		resume()
+	}
+}
+
+// sessionTerminal displays events in the session output.
+type sessionTerminal struct {
+	s Session
+}
+
+func (t sessionTerminal) startSpinner(text string) {
+	t.s.StartSpinner(text)
+}
+
+func (t sessionTerminal) stopSpinner() {
+	t.s.StopSpinner()
+}
+
+func (t sessionTerminal) pauseSpinner() (mightResume func()) {
+	return t.s.PauseSpinner()
+}
+
+func (t sessionTerminal) write(text string) {
+	fmt.Fprint(t.s.out, text)
+}
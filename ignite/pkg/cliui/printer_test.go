@@ -0,0 +1,127 @@
+package cliui
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/gookit/color"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+// recordTerminal records the sequence of rendered strings.
+type recordTerminal struct {
+	t        *testing.T
+	rendered []string
+	spinning bool
+}
+
+func (t *recordTerminal) startSpinner(text string) {
+	t.spinning = true
+	t.rendered = append(t.rendered, "spinner: "+color.ClearCode(text))
+}
+
+func (t *recordTerminal) stopSpinner() {
+	t.spinning = false
+}
+
+func (t *recordTerminal) pauseSpinner() (mightResume func()) {
+	wasSpinning := t.spinning
+	t.spinning = false
+	return func() { t.spinning = wasSpinning }
+}
+
+func (t *recordTerminal) write(text string) {
+	require.False(t.t, t.spinning, "text written while the spinner is active")
+	t.rendered = append(t.rendered, color.ClearCode(text))
+}
+
+func TestEventPrinterProgress(t *testing.T) {
+	var (
+		bus  = events.NewBus(events.WithCustomBufferSize(20))
+		term = &recordTerminal{t: t}
+		p    = eventPrinter{term: term}
+	)
+
+	group := bus.StartProgress("Fetching gentxs", 3, events.ProgressMinInterval(0))
+	group.Increment()
+	bus.Send(events.New(events.StatusDone, "Source code fetched"))
+	bus.Send(events.NewNeutral("neutral\n"))
+	group.Add(2)
+	group.Finish("Gentxs fetched")
+	bus.Send(events.NewOngoing("Building the genesis"))
+	bus.Shutdown(context.Background())
+
+	for event := range bus.Events() {
+		p.print(event)
+	}
+
+	require.Equal(t, []string{
+		"spinner: Fetching gentxs (0/3)...",
+		"spinner: Fetching gentxs (1/3)...",
+		icons.OK + " Source code fetched\n",
+		"spinner: Fetching gentxs (1/3)...",
+		"neutral\n",
+		"spinner: Fetching gentxs (3/3)...",
+		icons.OK + " Gentxs fetched (3/3)\n",
+		"spinner: Building the genesis...",
+	}, term.rendered)
+	require.True(t, term.spinning)
+}
+
+func TestEventPrinterWarning(t *testing.T) {
+	var (
+		term = &recordTerminal{t: t}
+		p    = eventPrinter{term: term}
+	)
+
+	p.print(events.NewOngoing("Preparing the genesis"))
+	p.print(events.NewWarning("the hash of the genesis is not published"))
+
+	require.Equal(t, []string{
+		"spinner: Preparing the genesis...",
+		"warning: the hash of the genesis is not published\n",
+	}, term.rendered)
+	require.True(t, term.spinning)
+}
+
+func TestSessionJSONEvents(t *testing.T) {
+	var out bytes.Buffer
+	session := New(WithOutput(&out), WithJSONEvents())
+
+	session.EventBus().Send(events.NewOngoing("Submitting requests"))
+	session.EventBus().Send(events.New(events.StatusDone, "Requests submitted"))
+	session.Cleanup()
+
+	require.Equal(t, `{"status":"ongoing","description":"Submitting requests"}
+{"status":"done","description":"Requests submitted"}
+`, out.String())
+}
+
+// panicTerminal panics when displaying an event.
+type panicTerminal struct{}
+
+func (panicTerminal) startSpinner(string)                { panic("spinner failure") }
+func (panicTerminal) stopSpinner()                       {}
+func (panicTerminal) pauseSpinner() (mightResume func()) { return func() {} }
+func (panicTerminal) write(string)                       { panic("write failure") }
+
+func TestSessionPrintEventFailure(t *testing.T) {
+	var errOut bytes.Buffer
+	session := Session{errOut: &errOut, printFailure: &sync.Once{}}
+	printer := eventPrinter{term: panicTerminal{}}
+
+	// only the first failure is reported
+	session.printEvent(printer, events.NewOngoing("Fetching the source"))
+	session.printEvent(printer, events.NewOngoing("Building the binary"))
+
+	require.Equal(
+		t,
+		"cannot print the event \"Fetching the source\": spinner failure, the next events failing to print are not reported\n",
+		errOut.String(),
+	)
+}
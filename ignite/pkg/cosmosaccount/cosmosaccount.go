@@ -15,6 +15,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/go-bip39"
@@ -158,6 +159,26 @@ func (a Account) PubKey() (string, error) {
 	return pk.String(), nil
 }
 
+// Sign signs the message with the private key of the account.
+// Only the accounts with a private key stored in the keyring can sign, the offline and ledger accounts can't.
+func (a Account) Sign(msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	local := a.Record.GetLocal()
+	if local == nil || local.PrivKey == nil {
+		return nil, nil, fmt.Errorf("the account %s has no local private key", a.Name)
+	}
+
+	privKey, ok := local.PrivKey.GetCachedValue().(cryptotypes.PrivKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("cannot read the private key of the account %s", a.Name)
+	}
+
+	sig, err := privKey.Sign(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sig, privKey.PubKey(), nil
+}
+
 func toBech32(prefix string, addr []byte) (string, error) {
 	bech32Addr, err := bech32.ConvertAndEncode(prefix, addr)
 	if err != nil {
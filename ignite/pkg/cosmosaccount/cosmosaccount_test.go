@@ -70,3 +70,24 @@ func TestRegistry(t *testing.T) {
 	_, err = registry.GetByAddress(addr)
 	require.ErrorAs(t, err, &expectedErr)
 }
+
+func TestAccountSign(t *testing.T) {
+	registry, err := cosmosaccount.New(cosmosaccount.WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	account, _, err := registry.Create(testAccountName)
+	require.NoError(t, err)
+
+	// the account loaded from the keyring signs with the same key
+	account, err = registry.GetByName(testAccountName)
+	require.NoError(t, err)
+
+	msg := []byte("message")
+	sig, pubKey, err := account.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	accountPubKey, err := account.Record.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, accountPubKey.Equals(pubKey))
+}
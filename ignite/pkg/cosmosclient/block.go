@@ -0,0 +1,77 @@
+package cosmosclient
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+const (
+	// blockchainInfoMaxRange is the maximum number of block metas returned by a blockchain info query.
+	blockchainInfoMaxRange = 20
+
+	// searchTxsPerPage is the number of txs fetched for each page of a tx search.
+	searchTxsPerPage = 100
+)
+
+// BlockTimes returns the times of the blocks at the heights. The lookups are batched: the times
+// of the blocks within a range of 20 heights are fetched with a single blockchain info query.
+func (c Client) BlockTimes(ctx context.Context, heights ...int64) (map[int64]time.Time, error) {
+	sorted := make([]int64, 0, len(heights))
+	seen := make(map[int64]bool)
+	for _, h := range heights {
+		if !seen[h] {
+			seen[h] = true
+			sorted = append(sorted, h)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	times := make(map[int64]time.Time, len(sorted))
+	for i := 0; i < len(sorted); {
+		// group the heights fitting in the range starting at the lowest height
+		minHeight, maxHeight := sorted[i], sorted[i]
+		for i < len(sorted) && sorted[i] < minHeight+blockchainInfoMaxRange {
+			maxHeight = sorted[i]
+			i++
+		}
+
+		res, err := c.RPC.BlockchainInfo(ctx, minHeight, maxHeight)
+		if err != nil {
+			return nil, err
+		}
+		for _, meta := range res.BlockMetas {
+			if seen[meta.Header.Height] {
+				times[meta.Header.Height] = meta.Header.Time
+			}
+		}
+	}
+
+	for _, h := range sorted {
+		if _, ok := times[h]; !ok {
+			return nil, errors.Errorf("block %d not found", h)
+		}
+	}
+	return times, nil
+}
+
+// SearchTxs returns all the txs matching the query in ascending order, all the result pages are fetched.
+func (c Client) SearchTxs(ctx context.Context, query string) ([]*ctypes.ResultTx, error) {
+	var (
+		txs     []*ctypes.ResultTx
+		perPage = searchTxsPerPage
+	)
+	for page := 1; ; page++ {
+		res, err := c.RPC.TxSearch(ctx, query, false, &page, &perPage, "asc")
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, res.Txs...)
+		if len(res.Txs) == 0 || len(txs) >= res.TotalCount {
+			return txs, nil
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package cosmosclient_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func newBlockchainInfo(genesisTime time.Time, heights ...int64) *ctypes.ResultBlockchainInfo {
+	res := &ctypes.ResultBlockchainInfo{}
+	// the block metas are returned from the highest block
+	for i := len(heights) - 1; i >= 0; i-- {
+		res.BlockMetas = append(res.BlockMetas, &tmtypes.BlockMeta{
+			Header: tmtypes.Header{
+				Height: heights[i],
+				Time:   genesisTime.Add(time.Duration(heights[i]) * time.Second),
+			},
+		})
+	}
+	return res
+}
+
+func TestClientBlockTimes(t *testing.T) {
+	genesisTime := time.Date(2022, time.May, 1, 12, 0, 0, 0, time.UTC)
+	blockTime := func(height int64) time.Time {
+		return genesisTime.Add(time.Duration(height) * time.Second)
+	}
+
+	tests := []struct {
+		name          string
+		heights       []int64
+		setup         func(suite)
+		expectedTimes map[int64]time.Time
+		expectedError string
+	}{
+		{
+			name:          "no heights",
+			expectedTimes: map[int64]time.Time{},
+		},
+		{
+			name:    "heights batched by range",
+			heights: []int64{45, 3, 10, 22, 10},
+			setup: func(s suite) {
+				s.rpcClient.EXPECT().BlockchainInfo(mock.Anything, int64(3), int64(22)).
+					Return(newBlockchainInfo(genesisTime, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22), nil).
+					Once()
+				s.rpcClient.EXPECT().BlockchainInfo(mock.Anything, int64(45), int64(45)).
+					Return(newBlockchainInfo(genesisTime, 45), nil).
+					Once()
+			},
+			expectedTimes: map[int64]time.Time{
+				3:  blockTime(3),
+				10: blockTime(10),
+				22: blockTime(22),
+				45: blockTime(45),
+			},
+		},
+		{
+			name:    "block not found",
+			heights: []int64{8},
+			setup: func(s suite) {
+				s.rpcClient.EXPECT().BlockchainInfo(mock.Anything, int64(8), int64(8)).
+					Return(&ctypes.ResultBlockchainInfo{}, nil).
+					Once()
+			},
+			expectedError: "block 8 not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(t, tt.setup)
+
+			times, err := c.BlockTimes(context.Background(), tt.heights...)
+
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedTimes, times)
+		})
+	}
+}
+
+func TestClientSearchTxs(t *testing.T) {
+	const query = "message.action='send'"
+	var (
+		page1 = []*ctypes.ResultTx{{Height: 1}, {Height: 2}}
+		page2 = []*ctypes.ResultTx{{Height: 3}}
+	)
+	c := newClient(t, func(s suite) {
+		s.rpcClient.EXPECT().
+			TxSearch(mock.Anything, query, false, mock.MatchedBy(func(page *int) bool { return *page == 1 }), mock.Anything, "asc").
+			Return(&ctypes.ResultTxSearch{Txs: page1, TotalCount: 3}, nil).
+			Once()
+		s.rpcClient.EXPECT().
+			TxSearch(mock.Anything, query, false, mock.MatchedBy(func(page *int) bool { return *page == 2 }), mock.Anything, "asc").
+			Return(&ctypes.ResultTxSearch{Txs: page2, TotalCount: 3}, nil).
+			Once()
+	})
+
+	txs, err := c.SearchTxs(context.Background(), query)
+
+	require.NoError(t, err)
+	require.Equal(t, append(page1, page2...), txs)
+}
@@ -64,3 +64,20 @@ func (c Client) ConsensusInfo(ctx context.Context, height int64) (ConsensusInfo,
 		ValidatorSet:       protoValset,
 	}, nil
 }
+
+// DefaultMempoolMaxTxBytes is the default maximum size of a tx accepted by the mempool of a node.
+// The mempool config of a node isn't exposed by its RPC, the nodes are assumed to use the default.
+const DefaultMempoolMaxTxBytes = 1024 * 1024
+
+// MaxTxBytes returns the maximum size in bytes of a tx accepted by the node, the smallest of
+// the maximum size of a block of the chain and the default maximum size of a tx in the mempool.
+func (c Client) MaxTxBytes(ctx context.Context) (int64, error) {
+	res, err := c.RPC.ConsensusParams(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	if maxBytes := res.ConsensusParams.Block.MaxBytes; maxBytes > 0 && maxBytes < DefaultMempoolMaxTxBytes {
+		return maxBytes, nil
+	}
+	return DefaultMempoolMaxTxBytes, nil
+}
@@ -0,0 +1,50 @@
+package cosmosclient_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+)
+
+func TestClientMaxTxBytes(t *testing.T) {
+	tests := []struct {
+		name          string
+		blockMaxBytes int64
+		expected      int64
+	}{
+		{
+			name:          "block smaller than the mempool limit",
+			blockMaxBytes: 200_000,
+			expected:      200_000,
+		},
+		{
+			name:          "block larger than the mempool limit",
+			blockMaxBytes: 22_020_096,
+			expected:      cosmosclient.DefaultMempoolMaxTxBytes,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(t, func(s suite) {
+				s.rpcClient.EXPECT().ConsensusParams(mock.Anything, (*int64)(nil)).
+					Return(&ctypes.ResultConsensusParams{
+						ConsensusParams: tmproto.ConsensusParams{
+							Block: tmproto.BlockParams{MaxBytes: tt.blockMaxBytes},
+						},
+					}, nil).
+					Once()
+			})
+
+			maxBytes, err := c.MaxTxBytes(context.Background())
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, maxBytes)
+		})
+	}
+}
@@ -51,6 +51,9 @@ const (
 	defaultNodeAddress   = "http://localhost:26657"
 	defaultGasAdjustment = 1.0
 	defaultGasLimit      = 300000
+
+	// DefaultSimulatedGasAdjustment is the default multiplier applied to the simulated gas.
+	DefaultSimulatedGasAdjustment = 1.3
 )
 
 const (
@@ -110,10 +113,12 @@ type Client struct {
 	keyringBackend     cosmosaccount.KeyringBackend
 	keyringDir         string
 
-	gas          string
-	gasPrices    string
-	fees         string
-	generateOnly bool
+	gas           string
+	gasAdjustment float64
+	gasFallback   uint64
+	gasPrices     string
+	fees          string
+	generateOnly  bool
 }
 
 // Option configures your client.
@@ -185,6 +190,22 @@ func WithGas(gas string) Option {
 	}
 }
 
+// WithGasAdjustment sets the multiplier applied to the simulated gas when the gas is calculated automatically,
+// the adjusted gas is capped at the block gas limit. By default, a fixed amount is added to the simulated gas.
+func WithGasAdjustment(adjustment float64) Option {
+	return func(c *Client) {
+		c.gasAdjustment = adjustment
+	}
+}
+
+// WithGasFallback sets the gas-limit used when the gas is calculated automatically
+// but the node doesn't support tx simulation. By default, the tx creation fails.
+func WithGasFallback(gas uint64) Option {
+	return func(c *Client) {
+		c.gasFallback = gas
+	}
+}
+
 // WithGasPrices sets the price per gas (e.g. 0.1uatom)
 func WithGasPrices(gasPrices string) Option {
 	return func(c *Client) {
@@ -450,6 +471,9 @@ type Response struct {
 
 	// TxResponse is the underlying tx response.
 	*sdktypes.TxResponse
+
+	// GasEstimation describes how the gas limit of the tx was chosen.
+	GasEstimation GasEstimation
 }
 
 // Decode decodes the proto func response defined in your Msg service into your message type.
@@ -494,6 +518,49 @@ func (r Response) Decode(message proto.Message) error {
 	}, message)
 }
 
+// DecodeAll decodes the responses of the messages of the tx in order into messages,
+// one message must be provided for each message of the tx.
+func (r Response) DecodeAll(messages ...proto.Message) error {
+	data, err := hex.DecodeString(r.Data)
+	if err != nil {
+		return err
+	}
+
+	var txMsgData sdktypes.TxMsgData
+	if err := r.Codec.Unmarshal(data, &txMsgData); err != nil {
+		return err
+	}
+
+	// check deprecated Data
+	if len(txMsgData.Data) != 0 {
+		if len(txMsgData.Data) != len(messages) {
+			return fmt.Errorf("the tx has %d message responses, %d expected", len(txMsgData.Data), len(messages))
+		}
+		for i, resData := range txMsgData.Data {
+			if err := prototypes.UnmarshalAny(&prototypes.Any{
+				TypeUrl: resData.MsgType + "Response",
+				Value:   resData.Data,
+			}, messages[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(txMsgData.MsgResponses) != len(messages) {
+		return fmt.Errorf("the tx has %d message responses, %d expected", len(txMsgData.MsgResponses), len(messages))
+	}
+	for i, resData := range txMsgData.MsgResponses {
+		if err := prototypes.UnmarshalAny(&prototypes.Any{
+			TypeUrl: resData.TypeUrl,
+			Value:   resData.Value,
+		}, messages[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Status returns the node status
 func (c Client) Status(ctx context.Context) (*ctypes.ResultStatus, error) {
 	return c.RPC.Status(ctx)
@@ -518,7 +585,33 @@ func (c Client) BroadcastTx(ctx context.Context, account cosmosaccount.Account,
 	return txService.Broadcast(ctx)
 }
 
+// BroadcastTxWithMemo broadcasts the messages in a tx with the memo.
+// The memo is part of the simulated tx, the estimated gas includes its size.
+func (c Client) BroadcastTxWithMemo(
+	ctx context.Context,
+	account cosmosaccount.Account,
+	memo string,
+	msgs ...sdktypes.Msg,
+) (Response, error) {
+	txService, err := c.CreateTxWithMemo(ctx, account, memo, msgs...)
+	if err != nil {
+		return Response{}, err
+	}
+
+	return txService.Broadcast(ctx)
+}
+
 func (c Client) CreateTx(goCtx context.Context, account cosmosaccount.Account, msgs ...sdktypes.Msg) (TxService, error) {
+	return c.CreateTxWithMemo(goCtx, account, "", msgs...)
+}
+
+// CreateTxWithMemo creates a tx of the messages with the memo, the tx has no memo when it's empty.
+func (c Client) CreateTxWithMemo(
+	goCtx context.Context,
+	account cosmosaccount.Account,
+	memo string,
+	msgs ...sdktypes.Msg,
+) (TxService, error) {
 	defer c.lockBech32Prefix()()
 
 	if c.useFaucet && !c.generateOnly {
@@ -544,23 +637,23 @@ func (c Client) CreateTx(goCtx context.Context, account cosmosaccount.Account, m
 	if err != nil {
 		return TxService{}, err
 	}
+	if memo != "" {
+		txf = txf.WithMemo(memo)
+	}
 
-	var gas uint64
-	if c.gas != "" && c.gas != "auto" {
-		gas, err = strconv.ParseUint(c.gas, 10, 64)
+	var gas GasEstimation
+	if c.gas != "" && c.gas != GasAuto {
+		gas.Gas, err = strconv.ParseUint(c.gas, 10, 64)
 		if err != nil {
 			return TxService{}, errors.WithStack(err)
 		}
 	} else {
-		_, gas, err = c.gasometer.CalculateGas(ctx, txf, msgs...)
+		gas, err = c.estimateGas(goCtx, ctx, txf, msgs...)
 		if err != nil {
-			return TxService{}, errors.WithStack(err)
+			return TxService{}, err
 		}
-		// the simulated gas can vary from the actual gas needed for a real transaction
-		// we add an additional amount to ensure sufficient gas is provided
-		gas += 20000
 	}
-	txf = txf.WithGas(gas)
+	txf = txf.WithGas(gas.Gas)
 	txf = txf.WithFees(c.fees)
 
 	if c.gasPrices != "" {
@@ -579,6 +672,7 @@ func (c Client) CreateTx(goCtx context.Context, account cosmosaccount.Account, m
 		clientContext: ctx,
 		txBuilder:     txUnsigned,
 		txFactory:     txf,
+		gasEstimation: gas,
 	}, nil
 }
 
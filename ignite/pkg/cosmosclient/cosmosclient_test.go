@@ -18,7 +18,10 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/p2p"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite/cli/ignite/pkg/cosmosclient"
@@ -430,6 +433,7 @@ func TestClientCreateTx(t *testing.T) {
 		name           string
 		opts           []cosmosclient.Option
 		msg            sdktypes.Msg
+		memo           string
 		expectedJSONTx string
 		expectedError  string
 		setup          func(s suite)
@@ -456,6 +460,21 @@ func TestClientCreateTx(t *testing.T) {
 				s.expectPrepareFactory(sdkaddress)
 			},
 		},
+		{
+			name: "ok: with memo",
+			msg: &banktypes.MsgSend{
+				FromAddress: "from",
+				ToAddress:   "to",
+				Amount: sdktypes.NewCoins(
+					sdktypes.NewCoin("token", sdktypes.NewIntFromUint64((1))),
+				),
+			},
+			memo:           "hello",
+			expectedJSONTx: `{"body":{"messages":[{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"from","to_address":"to","amount":[{"denom":"token","amount":"1"}]}],"memo":"hello","timeout_height":"0","extension_options":[],"non_critical_extension_options":[]},"auth_info":{"signer_infos":[],"fee":{"amount":[],"gas_limit":"300000","payer":"","granter":""},"tip":null},"signatures":[]}`,
+			setup: func(s suite) {
+				s.expectPrepareFactory(sdkaddress)
+			},
+		},
 		{
 			name: "ok: with faucet enabled, account balance is high enough",
 			opts: []cosmosclient.Option{
@@ -586,6 +605,91 @@ func TestClientCreateTx(t *testing.T) {
 					Return(nil, 42, nil)
 			},
 		},
+		{
+			name: "ok: with auto gas limit and custom adjustment",
+			opts: []cosmosclient.Option{
+				cosmosclient.WithGas("auto"),
+				cosmosclient.WithGasAdjustment(2),
+			},
+			msg: &banktypes.MsgSend{
+				FromAddress: "from",
+				ToAddress:   "to",
+				Amount: sdktypes.NewCoins(
+					sdktypes.NewCoin("token", sdktypes.NewIntFromUint64((1))),
+				),
+			},
+			expectedJSONTx: `{"body":{"messages":[{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"from","to_address":"to","amount":[{"denom":"token","amount":"1"}]}],"memo":"","timeout_height":"0","extension_options":[],"non_critical_extension_options":[]},"auth_info":{"signer_infos":[],"fee":{"amount":[],"gas_limit":"200000","payer":"","granter":""},"tip":null},"signatures":[]}`,
+			setup: func(s suite) {
+				s.expectPrepareFactory(sdkaddress)
+				s.gasometer.EXPECT().
+					CalculateGas(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, 100000, nil)
+				s.expectBlockMaxGas(-1)
+			},
+		},
+		{
+			name: "ok: with auto gas limit capped at block gas limit",
+			opts: []cosmosclient.Option{
+				cosmosclient.WithGas("auto"),
+				cosmosclient.WithGasAdjustment(cosmosclient.DefaultSimulatedGasAdjustment),
+			},
+			msg: &banktypes.MsgSend{
+				FromAddress: "from",
+				ToAddress:   "to",
+				Amount: sdktypes.NewCoins(
+					sdktypes.NewCoin("token", sdktypes.NewIntFromUint64((1))),
+				),
+			},
+			expectedJSONTx: `{"body":{"messages":[{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"from","to_address":"to","amount":[{"denom":"token","amount":"1"}]}],"memo":"","timeout_height":"0","extension_options":[],"non_critical_extension_options":[]},"auth_info":{"signer_infos":[],"fee":{"amount":[],"gas_limit":"1000000","payer":"","granter":""},"tip":null},"signatures":[]}`,
+			setup: func(s suite) {
+				s.expectPrepareFactory(sdkaddress)
+				s.gasometer.EXPECT().
+					CalculateGas(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, 1000000, nil)
+				s.expectBlockMaxGas(1000000)
+			},
+		},
+		{
+			name: "ok: with auto gas limit and simulation unsupported",
+			opts: []cosmosclient.Option{
+				cosmosclient.WithGas("auto"),
+				cosmosclient.WithGasFallback(500000),
+			},
+			msg: &banktypes.MsgSend{
+				FromAddress: "from",
+				ToAddress:   "to",
+				Amount: sdktypes.NewCoins(
+					sdktypes.NewCoin("token", sdktypes.NewIntFromUint64((1))),
+				),
+			},
+			expectedJSONTx: `{"body":{"messages":[{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"from","to_address":"to","amount":[{"denom":"token","amount":"1"}]}],"memo":"","timeout_height":"0","extension_options":[],"non_critical_extension_options":[]},"auth_info":{"signer_infos":[],"fee":{"amount":[],"gas_limit":"500000","payer":"","granter":""},"tip":null},"signatures":[]}`,
+			setup: func(s suite) {
+				s.expectPrepareFactory(sdkaddress)
+				s.gasometer.EXPECT().
+					CalculateGas(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, 0, status.Error(codes.Unimplemented, "unknown service cosmos.tx.v1beta1.Service"))
+			},
+		},
+		{
+			name: "fail: with auto gas limit and simulation error",
+			opts: []cosmosclient.Option{
+				cosmosclient.WithGas("auto"),
+			},
+			msg: &banktypes.MsgSend{
+				FromAddress: "from",
+				ToAddress:   "to",
+				Amount: sdktypes.NewCoins(
+					sdktypes.NewCoin("token", sdktypes.NewIntFromUint64((1))),
+				),
+			},
+			expectedError: "out of gas",
+			setup: func(s suite) {
+				s.expectPrepareFactory(sdkaddress)
+				s.gasometer.EXPECT().
+					CalculateGas(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, 0, errors.New("out of gas"))
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -598,7 +702,7 @@ func TestClientCreateTx(t *testing.T) {
 			account, err := c.AccountRegistry.Import(accountName, key, passphrase)
 			require.NoError(err)
 
-			txs, err := c.CreateTx(ctx, account, tt.msg)
+			txs, err := c.CreateTxWithMemo(ctx, account, tt.memo, tt.msg)
 
 			if tt.expectedError != "" {
 				require.EqualError(err, tt.expectedError)
@@ -653,6 +757,16 @@ func (s suite) expectMakeSureAccountHasToken(address string, balance int64) {
 	).Once()
 }
 
+func (s suite) expectBlockMaxGas(maxGas int64) {
+	s.rpcClient.EXPECT().
+		ConsensusParams(mock.Anything, mock.Anything).
+		Return(&ctypes.ResultConsensusParams{
+			ConsensusParams: tmproto.ConsensusParams{
+				Block: tmproto.BlockParams{MaxGas: maxGas},
+			},
+		}, nil)
+}
+
 func (s suite) expectPrepareFactory(sdkaddress sdktypes.Address) {
 	s.accountRetriever.EXPECT().
 		EnsureExists(mock.Anything, sdkaddress).
@@ -0,0 +1,88 @@
+package cosmosclient
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GasAuto is the gas setting that enables gas estimation by simulation.
+const GasAuto = "auto"
+
+// GasEstimation describes the gas chosen for a transaction.
+type GasEstimation struct {
+	// Gas is the gas limit set for the transaction.
+	Gas uint64
+
+	// Simulated is true when the gas limit was estimated by simulating the transaction,
+	// it is false when the gas limit comes from a fixed or a fallback value.
+	Simulated bool
+}
+
+// estimateGas estimates the gas limit for the msgs by simulating them.
+// The simulated gas is multiplied by the gas adjustment and capped at the block gas limit when the client has
+// a gas adjustment, a fixed amount is added otherwise. When simulation isn't supported by the node, the fallback
+// gas is used instead if the client has one.
+func (c Client) estimateGas(
+	ctx context.Context,
+	clientCtx client.Context,
+	txf tx.Factory,
+	msgs ...sdktypes.Msg,
+) (GasEstimation, error) {
+	_, simulated, err := c.gasometer.CalculateGas(clientCtx, txf, msgs...)
+	if c.gasFallback > 0 && isSimulationUnsupported(err) {
+		return GasEstimation{Gas: c.gasFallback}, nil
+	}
+	if err != nil {
+		return GasEstimation{}, errors.WithStack(err)
+	}
+
+	if c.gasAdjustment <= 0 {
+		// the simulated gas can vary from the actual gas needed for a real transaction
+		// we add an additional amount to ensure sufficient gas is provided
+		return GasEstimation{Gas: simulated + 20000, Simulated: true}, nil
+	}
+
+	gas := uint64(math.Ceil(float64(simulated) * c.gasAdjustment))
+
+	maxGas, err := c.blockMaxGas(ctx)
+	if err != nil {
+		return GasEstimation{}, err
+	}
+	if maxGas > 0 && gas > maxGas {
+		gas = maxGas
+	}
+
+	return GasEstimation{Gas: gas, Simulated: true}, nil
+}
+
+// blockMaxGas returns the maximum gas allowed in a block, zero means unlimited.
+func (c Client) blockMaxGas(ctx context.Context) (uint64, error) {
+	res, err := c.RPC.ConsensusParams(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot fetch the block gas limit")
+	}
+	if maxGas := res.ConsensusParams.Block.MaxGas; maxGas > 0 {
+		return uint64(maxGas), nil
+	}
+	return 0, nil
+}
+
+// isSimulationUnsupported checks if the error is returned because the node doesn't support tx simulation.
+func isSimulationUnsupported(err error) bool {
+	if err == nil {
+		return false
+	}
+	if status.Code(errors.Cause(err)) == codes.Unimplemented {
+		return true
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "unknown service") || strings.Contains(msg, "unknown query path")
+}
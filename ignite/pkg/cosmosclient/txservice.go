@@ -14,6 +14,7 @@ type TxService struct {
 	clientContext client.Context
 	txBuilder     client.TxBuilder
 	txFactory     tx.Factory
+	gasEstimation GasEstimation
 }
 
 // Gas is gas decided to use for this tx.
@@ -22,6 +23,11 @@ func (s TxService) Gas() uint64 {
 	return s.txBuilder.GetTx().GetGas()
 }
 
+// GasEstimation describes how the gas of this tx was decided.
+func (s TxService) GasEstimation() GasEstimation {
+	return s.gasEstimation
+}
+
 // Broadcast signs and broadcasts this tx.
 // If faucet is enabled and if the from account doesn't have enough funds, is
 // it automatically filled with the default amount, and the tx is broadcasted
@@ -49,7 +55,12 @@ func (s TxService) Broadcast(ctx context.Context) (Response, error) {
 
 	resp, err := s.clientContext.BroadcastTx(txBytes)
 	if err := handleBroadcastResult(resp, err); err != nil {
-		return Response{}, err
+		// the response of a tx rejected by the node contains the code of the error
+		return Response{
+			Codec:         s.clientContext.Codec,
+			TxResponse:    resp,
+			GasEstimation: s.gasEstimation,
+		}, err
 	}
 
 	res, err := s.client.WaitForTx(ctx, resp.TxHash)
@@ -66,8 +77,9 @@ func (s TxService) Broadcast(ctx context.Context) (Response, error) {
 	resp = sdktypes.NewResponseResultTx(res, nil, "")
 
 	return Response{
-		Codec:      s.clientContext.Codec,
-		TxResponse: resp,
+		Codec:         s.clientContext.Codec,
+		TxResponse:    resp,
+		GasEstimation: s.gasEstimation,
 	}, handleBroadcastResult(resp, err)
 }
 
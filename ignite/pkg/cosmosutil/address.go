@@ -1,21 +1,28 @@
 package cosmosutil
 
 import (
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 )
 
+// hexAddressPrefix is the prefix of the hex addresses used by the EVM compatible chains.
+const hexAddressPrefix = "0x"
+
 // ChangeAddressPrefix returns the address with another prefix
+// the address can also be the hex address of an account of an EVM compatible chain
 func ChangeAddressPrefix(address, newPrefix string) (string, error) {
 	if newPrefix == "" {
 		return "", errors.New("empty prefix")
 	}
-	_, pubKey, err := bech32.DecodeAndConvert(address)
+	bz, err := AddressBytes(address)
 	if err != nil {
 		return "", err
 	}
-	return bech32.ConvertAndEncode(newPrefix, pubKey)
+	return bech32.ConvertAndEncode(newPrefix, bz)
 }
 
 // GetAddressPrefix returns the bech 32 prefix used by the address
@@ -23,3 +30,21 @@ func GetAddressPrefix(address string) (string, error) {
 	prefix, _, err := bech32.DecodeAndConvert(address)
 	return prefix, err
 }
+
+// AddressBytes returns the bytes of a bech32 address or of a hex address of an EVM compatible chain
+func AddressBytes(address string) ([]byte, error) {
+	if !IsHexAddress(address) {
+		_, bz, err := bech32.DecodeAndConvert(address)
+		return bz, err
+	}
+	bz, err := hex.DecodeString(address[len(hexAddressPrefix):])
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex address %s: %w", address, err)
+	}
+	return bz, nil
+}
+
+// IsHexAddress checks if the address is the hex address of an account of an EVM compatible chain
+func IsHexAddress(address string) bool {
+	return strings.HasPrefix(strings.ToLower(address), hexAddressPrefix)
+}
@@ -40,6 +40,18 @@ func TestChangePrefix(t *testing.T) {
 			prefix:  "earth",
 			want:    "earth1c6ac48k2ur8tl3tf0cpntlw5068kvp8x0xyl2v",
 		},
+		{
+			name:    "hex address to evmos address",
+			address: "0x6b555d101a15182908a8b9f094d741fffd4213e7",
+			prefix:  "evmos",
+			want:    "evmos1dd246yq6z5vzjz9gh8cff46pll75yyl8xfzr26",
+		},
+		{
+			name:    "invalid hex address",
+			address: "0x6b555d101a15182908a8b9f094d741fffd4213eg",
+			prefix:  "evmos",
+			wantErr: true,
+		},
 		{
 			name:    "invalid bech32 address",
 			address: "mars1c6ac48k2ur9tl3tf0cpntlw5068kvp8xf4xq37",
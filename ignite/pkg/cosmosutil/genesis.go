@@ -10,11 +10,13 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/buger/jsonparser"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
 )
 
@@ -30,6 +32,9 @@ const (
 	FieldConsumerRevisionHeight      = "app_state.monitoringp.params.consumerRevisionHeight"
 )
 
+// EthAccountType is the type of the accounts of the Ethermint based chains, it wraps a base account.
+const EthAccountType = "/ethermint.types.v1.EthAccount"
+
 type (
 	// Genesis represents a more readable version of the stargate genesis file
 	Genesis struct {
@@ -37,14 +42,16 @@ type (
 		StakeDenom string
 	}
 	// ChainGenesis represents the stargate genesis file
+	// the typed fields are decoded from the state of their module, the other modules are kept raw
 	ChainGenesis struct {
 		ChainID  string `json:"chain_id"`
 		AppState struct {
 			Auth struct {
-				Accounts []struct {
-					Address string `json:"address"`
-				} `json:"accounts"`
+				Accounts []GenesisAccount `json:"accounts"`
 			} `json:"auth"`
+			Bank struct {
+				Balances []GenesisBalance `json:"balances"`
+			} `json:"bank"`
 			Staking struct {
 				Params struct {
 					BondDenom string `json:"bond_denom"`
@@ -54,6 +61,22 @@ type (
 				GenTxs []struct{} `json:"gen_txs"`
 			} `json:"genutil"`
 		} `json:"app_state"`
+
+		// file gives access to the raw state of the modules without typed fields.
+		file *GenesisFile
+	}
+
+	// GenesisAccount is an account of the auth module genesis
+	GenesisAccount struct {
+		Type    string `json:"@type"`
+		Address string `json:"address"`
+	}
+
+	// GenesisBalance is a balance of the bank module genesis
+	// the amounts are parsed as big integers, the 18 decimals denoms of the EVM compatible chains don't overflow
+	GenesisBalance struct {
+		Address string    `json:"address"`
+		Coins   sdk.Coins `json:"coins"`
 	}
 
 	// fields to update from genesis
@@ -63,15 +86,69 @@ type (
 )
 
 // HasAccount checks if account exist into the genesis account
+// the accounts are compared by address bytes to match the hex addresses of the EVM compatible chains
 func (g Genesis) HasAccount(address string) bool {
+	bz, err := AddressBytes(address)
 	for _, account := range g.Accounts {
 		if account == address {
 			return true
 		}
+		if err != nil {
+			continue
+		}
+		if accountBz, err := AddressBytes(account); err == nil && bytes.Equal(accountBz, bz) {
+			return true
+		}
 	}
 	return false
 }
 
+// UnmarshalJSON implements json.Unmarshaler
+// the address of the accounts wrapping a base account, like the module, vesting or Ethermint accounts, is
+// read from the wrapped base account
+func (a *GenesisAccount) UnmarshalJSON(b []byte) error {
+	type baseAccount struct {
+		Address string `json:"address"`
+	}
+	var account struct {
+		Type               string       `json:"@type"`
+		Address            string       `json:"address"`
+		BaseAccount        *baseAccount `json:"base_account"`
+		BaseVestingAccount *struct {
+			BaseAccount *baseAccount `json:"base_account"`
+		} `json:"base_vesting_account"`
+	}
+	if err := json.Unmarshal(b, &account); err != nil {
+		return err
+	}
+
+	a.Type = account.Type
+	a.Address = account.Address
+	switch {
+	case a.Address != "":
+	case account.BaseAccount != nil:
+		a.Address = account.BaseAccount.Address
+	case account.BaseVestingAccount != nil && account.BaseVestingAccount.BaseAccount != nil:
+		a.Address = account.BaseVestingAccount.BaseAccount.Address
+	}
+	return nil
+}
+
+// IsEthAccount checks if the account is an account of an Ethermint based chain
+func (a GenesisAccount) IsEthAccount() bool {
+	return a.Type == EthAccountType
+}
+
+// Balance returns the balance of the address in the bank module genesis
+func (cg ChainGenesis) Balance(address string) sdk.Coins {
+	for _, balance := range cg.AppState.Bank.Balances {
+		if balance.Address == address {
+			return balance.Coins
+		}
+	}
+	return nil
+}
+
 // GenTxCount returns the number of gentxs inside the genesis
 func (cg ChainGenesis) GenTxCount() int {
 	return len(cg.AppState.Genutil.GenTxs)
@@ -140,9 +217,10 @@ func UpdateGenesis(genesisPath string, options ...GenesisField) error {
 	return os.WriteFile(genesisPath, genesisBytes, 0o644)
 }
 
-// ParseGenesisFromPath parse ChainGenesis object from a genesis file
+// ParseGenesisFromPath parse ChainGenesis object from a genesis file, the file is not read when it exceeds
+// the DefaultMaxGenesisSize
 func ParseGenesisFromPath(genesisPath string) (Genesis, error) {
-	genesisFile, err := os.ReadFile(genesisPath)
+	genesisFile, err := ReadGenesisFile(genesisPath, DefaultMaxGenesisSize)
 	if err != nil {
 		return Genesis{}, errors.Wrap(err, "cannot open genesis file")
 	}
@@ -150,11 +228,96 @@ func ParseGenesisFromPath(genesisPath string) (Genesis, error) {
 }
 
 // ParseChainGenesis parse ChainGenesis object from a byte slice
+// the genesis is decoded once into the raw state of its modules, the typed fields are decoded from
+// the state of their module
 func ParseChainGenesis(genesisFile []byte) (chainGenesis ChainGenesis, err error) {
-	if err := json.Unmarshal(genesisFile, &chainGenesis); err != nil {
+	file, err := ParseGenesisFile(genesisFile)
+	if err != nil {
 		return chainGenesis, errors.New("cannot unmarshal the chain genesis file: " + err.Error())
 	}
-	return chainGenesis, err
+	chainGenesis.ChainID = file.ChainID()
+	chainGenesis.file = file
+
+	appState := &chainGenesis.AppState
+	for module, v := range map[string]interface{}{
+		moduleAuth:    &appState.Auth,
+		moduleBank:    &appState.Bank,
+		moduleStaking: &appState.Staking,
+		moduleGenutil: &appState.Genutil,
+	} {
+		if err := file.ModuleState(module, v); err != nil {
+			return chainGenesis, errors.New("cannot unmarshal the chain genesis file: " + err.Error())
+		}
+	}
+	return chainGenesis, nil
+}
+
+// Module returns the raw state of the module, false is returned when the genesis has no state for the module.
+// The returned state must not be modified.
+func (cg ChainGenesis) Module(module string) (json.RawMessage, bool) {
+	if cg.file == nil {
+		return nil, false
+	}
+	return cg.file.Module(module)
+}
+
+// ModuleState unmarshals the raw state of the module into v, v is not changed when the module has no state.
+func (cg ChainGenesis) ModuleState(module string, v interface{}) error {
+	if cg.file == nil {
+		return nil
+	}
+	return cg.file.ModuleState(module, v)
+}
+
+// GenesisModules returns the sorted names of the modules with a state in the app_state of the genesis
+func GenesisModules(genesisFile []byte) ([]string, error) {
+	var genesis struct {
+		AppState map[string]json.RawMessage `json:"app_state"`
+	}
+	if err := json.Unmarshal(genesisFile, &genesis); err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal the genesis file")
+	}
+	modules := make([]string, 0, len(genesis.AppState))
+	for module := range genesis.AppState {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+	return modules, nil
+}
+
+// GenesisModuleVersions returns the consensus versions of the modules by name from the version map
+// of the upgrade module genesis, the genesis exported from a running chain carries the version map.
+// The versions are nil when the genesis has no version map.
+func GenesisModuleVersions(genesisFile []byte) (map[string]uint64, error) {
+	var genesis struct {
+		AppState struct {
+			Upgrade struct {
+				ModuleVersions []struct {
+					Name string `json:"name"`
+
+					// Version is encoded as a string by the proto JSON encoding of the uint64.
+					Version json.Number `json:"version"`
+				} `json:"module_versions"`
+			} `json:"upgrade"`
+		} `json:"app_state"`
+	}
+	if err := json.Unmarshal(genesisFile, &genesis); err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal the genesis file")
+	}
+
+	moduleVersions := genesis.AppState.Upgrade.ModuleVersions
+	if len(moduleVersions) == 0 {
+		return nil, nil
+	}
+	versions := make(map[string]uint64, len(moduleVersions))
+	for _, mv := range moduleVersions {
+		version, err := strconv.ParseUint(mv.Version.String(), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid version of the module %s", mv.Name)
+		}
+		versions[mv.Name] = version
+	}
+	return versions, nil
 }
 
 // ParseGenesis parse ChainGenesis object from a byte slice into a Genesis object
@@ -185,21 +348,53 @@ func CheckGenesisContainsAddress(genesisPath, addr string) (bool, error) {
 	return genesis.HasAccount(addr), nil
 }
 
+type fetchOptions struct {
+	client        *http.Client
+	resumeFile    string
+	maxSize       int64
+	retryBudget   time.Duration
+	retryMinDelay time.Duration
+	retryMaxDelay time.Duration
+	retryNotify   func(FetchRetry)
+	progress      func(DownloadProgress)
+}
+
+// FetchOption configures the fetch of a genesis.
+type FetchOption func(*fetchOptions)
+
+// WithHTTPClient sets the HTTP client used to fetch the genesis, http.DefaultClient is used by default.
+func WithHTTPClient(client *http.Client) FetchOption {
+	return func(o *fetchOptions) {
+		o.client = client
+	}
+}
+
 // GenesisAndHashFromURL fetches the genesis from the given url and returns its content along with the sha256 hash.
-func GenesisAndHashFromURL(ctx context.Context, url string) (genesis []byte, hash string, err error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return nil, "", err
+// An ErrGenesisNotJSON error is returned once the beginning of the download is read when the url doesn't point
+// to a JSON file. A gzip genesis is decompressed, the hash is the hash of the decompressed genesis.
+// An ErrGenesisTooLarge error is returned when the genesis exceeds the maximum size.
+func GenesisAndHashFromURL(ctx context.Context, url string, options ...FetchOption) (genesis []byte, hash string, err error) {
+	o := fetchOptions{
+		client:        http.DefaultClient,
+		maxSize:       DefaultMaxGenesisSize,
+		retryBudget:   DefaultFetchRetryBudget,
+		retryMinDelay: defaultRetryMinDelay,
+		retryMaxDelay: defaultRetryMaxDelay,
+	}
+	for _, apply := range options {
+		apply(&o)
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	genesis, err = fetchWithRetry(ctx, url, o, func() ([]byte, error) {
+		if o.resumeFile != "" {
+			return downloadResumable(ctx, o.client, url, o.resumeFile, o.maxSize, o.progress)
+		}
+		return download(ctx, o.client, url, o.maxSize, o.progress)
+	})
 	if err != nil {
 		return nil, "", err
 	}
-	defer resp.Body.Close()
-
-	genesis, err = io.ReadAll(resp.Body)
-	if err != nil {
+	if genesis, err = decompressGenesis(genesis, o.maxSize); err != nil {
 		return nil, "", err
 	}
 
@@ -212,3 +407,36 @@ func GenesisAndHashFromURL(ctx context.Context, url string) (genesis []byte, has
 
 	return genesis, hexHash, nil
 }
+
+// download fetches the content at the url, the download fails once the content exceeds the maximum size.
+func download(
+	ctx context.Context,
+	client *http.Client,
+	url string,
+	maxSize int64,
+	progress func(DownloadProgress),
+) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newHTTPStatusError(resp)
+	}
+	if err := checkContentLength(resp, maxSize, 0); err != nil {
+		return nil, err
+	}
+	reader := newProgressReader(resp.Body, 0, resp.ContentLength, progress)
+	body, err := sniffGenesis(newSizeLimitReader(reader, maxSize, 0, false), url)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(body)
+}
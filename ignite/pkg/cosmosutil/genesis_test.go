@@ -1,13 +1,19 @@
 package cosmosutil_test
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/buger/jsonparser"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ignite/cli/ignite/pkg/cosmosutil"
@@ -36,6 +42,20 @@ func TestChainGenesis_HasAccount(t *testing.T) {
 				"cosmos1mmlqwyqk7neqegffp99q86eckpm4pjah3ytlpa",
 			},
 			want: false,
+		}, {
+			name:    "found account with hex address",
+			address: "0x6b555d101a15182908a8b9f094d741fffd4213e7",
+			accounts: []string{
+				"evmos1dd246yq6z5vzjz9gh8cff46pll75yyl8xfzr26",
+			},
+			want: true,
+		}, {
+			name:    "found account with another prefix",
+			address: "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj",
+			accounts: []string{
+				"evmos1dd246yq6z5vzjz9gh8cff46pll75yyl8xfzr26",
+			},
+			want: true,
 		}, {
 			name:     "empty accounts",
 			address:  "cosmos1mmlqwyqk7neqegffp99q86eckpm4pjah3ytlpa",
@@ -54,16 +74,26 @@ func TestChainGenesis_HasAccount(t *testing.T) {
 
 func TestParseChainGenesis(t *testing.T) {
 	genesis1 := cosmosutil.ChainGenesis{ChainID: "earth-1"}
-	genesis1.AppState.Auth.Accounts = []struct {
-		Address string `json:"address"`
-	}{{Address: "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj"}}
+	genesis1.AppState.Auth.Accounts = []cosmosutil.GenesisAccount{{
+		Type:    "/cosmos.auth.v1beta1.BaseAccount",
+		Address: "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj",
+	}}
+	genesis1.AppState.Bank.Balances = []cosmosutil.GenesisBalance{{
+		Address: "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj",
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin("stake", 95000000)),
+	}}
 	genesis1.AppState.Staking.Params.BondDenom = "stake"
 	genesis1.AppState.Genutil.GenTxs = []struct{}{{}} // 1 gentx
 
 	genesis2 := cosmosutil.ChainGenesis{ChainID: "earth-1"}
-	genesis2.AppState.Auth.Accounts = []struct {
-		Address string `json:"address"`
-	}{{Address: "cosmos1mmlqwyqk7neqegffp99q86eckpm4pjah3ytlpa"}}
+	genesis2.AppState.Auth.Accounts = []cosmosutil.GenesisAccount{{
+		Type:    "/cosmos.auth.v1beta1.BaseAccount",
+		Address: "cosmos1mmlqwyqk7neqegffp99q86eckpm4pjah3ytlpa",
+	}}
+	genesis2.AppState.Bank.Balances = []cosmosutil.GenesisBalance{{
+		Address: "cosmos1mmlqwyqk7neqegffp99q86eckpm4pjah3ytlpa",
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin("stake", 95000000)),
+	}}
 	genesis2.AppState.Staking.Params.BondDenom = "stake"
 	genesis2.AppState.Genutil.GenTxs = []struct{}{{}} // 1 gentx
 
@@ -98,7 +128,22 @@ func TestParseChainGenesis(t *testing.T) {
 				return
 			}
 			require.NoError(t, err)
-			require.EqualValues(t, tt.want, got)
+
+			// the raw state of the modules is checked separately
+			var staking struct {
+				Params struct {
+					BondDenom string `json:"bond_denom"`
+				} `json:"params"`
+			}
+			require.NoError(t, got.ModuleState("staking", &staking))
+			require.Equal(t, tt.want.AppState.Staking.Params.BondDenom, staking.Params.BondDenom)
+			_, ok := got.Module("staking")
+			require.True(t, ok)
+			_, ok = got.Module("oracle")
+			require.False(t, ok)
+
+			require.Equal(t, tt.want.ChainID, got.ChainID)
+			require.EqualValues(t, tt.want.AppState, got.AppState)
 		})
 	}
 }
@@ -147,6 +192,36 @@ func TestParseGenesis(t *testing.T) {
 	}
 }
 
+func TestParseGenesisEvmos(t *testing.T) {
+	genesisFile, err := os.ReadFile("testdata/genesis_evmos.json")
+	require.NoError(t, err)
+
+	chainGenesis, err := cosmosutil.ParseChainGenesis(genesisFile)
+	require.NoError(t, err)
+	require.Equal(t, "evmos_9000-1", chainGenesis.ChainID)
+	require.Equal(t, []cosmosutil.GenesisAccount{
+		{Type: cosmosutil.EthAccountType, Address: "evmos1dd246yq6z5vzjz9gh8cff46pll75yyl8xfzr26"},
+		{Type: "/evmos.vesting.v1.ClawbackVestingAccount", Address: "evmos1mmlqwyqk7neqegffp99q86eckpm4pjahn963m4"},
+		{Type: "/cosmos.auth.v1beta1.ModuleAccount", Address: "evmos1c6ac48k2ur8tl3tf0cpntlw5068kvp8xkfwh7d"},
+	}, chainGenesis.AppState.Auth.Accounts)
+	require.True(t, chainGenesis.AppState.Auth.Accounts[0].IsEthAccount())
+	require.False(t, chainGenesis.AppState.Auth.Accounts[2].IsEthAccount())
+
+	// 18 decimals amounts exceed the uint64 range
+	amount, ok := sdk.NewIntFromString("100000000000000000000000000")
+	require.True(t, ok)
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewCoin("aevmos", amount)),
+		chainGenesis.Balance("evmos1dd246yq6z5vzjz9gh8cff46pll75yyl8xfzr26"),
+	)
+	require.Nil(t, chainGenesis.Balance("evmos1c6ac48k2ur8tl3tf0cpntlw5068kvp8xkfwh7d"))
+
+	genesis, err := cosmosutil.ParseGenesis(genesisFile)
+	require.NoError(t, err)
+	require.Equal(t, "aevmos", genesis.StakeDenom)
+	require.True(t, genesis.HasAccount("0xdefe071016f4f20ca129094a03eb38b07750cbb7"))
+}
+
 func TestParseGenesisFromPath(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -374,3 +449,87 @@ func TestChainGenesis_GenTxCount(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisModules(t *testing.T) {
+	genesisFile, err := os.ReadFile("testdata/genesis1.json")
+	require.NoError(t, err)
+
+	modules, err := cosmosutil.GenesisModules(genesisFile)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"auth",
+		"bank",
+		"capability",
+		"crisis",
+		"distribution",
+		"earth",
+		"evidence",
+		"feegrant",
+		"genutil",
+		"gov",
+		"ibc",
+		"mint",
+		"params",
+		"slashing",
+		"staking",
+		"transfer",
+		"upgrade",
+		"vesting",
+	}, modules)
+
+	_, err = cosmosutil.GenesisModules([]byte("invalid"))
+	require.Error(t, err)
+}
+
+func TestGenesisModuleVersions(t *testing.T) {
+	t.Run("genesis with a version map", func(t *testing.T) {
+		versions, err := cosmosutil.GenesisModuleVersions([]byte(`{"app_state":{"upgrade":{"module_versions":[
+			{"name":"auth","version":"2"},
+			{"name":"bank","version":3}
+		]}}}`))
+		require.NoError(t, err)
+		require.Equal(t, map[string]uint64{"auth": 2, "bank": 3}, versions)
+	})
+
+	t.Run("genesis without version map", func(t *testing.T) {
+		genesisFile, err := os.ReadFile("testdata/genesis1.json")
+		require.NoError(t, err)
+
+		versions, err := cosmosutil.GenesisModuleVersions(genesisFile)
+		require.NoError(t, err)
+		require.Nil(t, versions)
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+		_, err := cosmosutil.GenesisModuleVersions([]byte(`{"app_state":{"upgrade":{"module_versions":[
+			{"name":"auth","version":"-1"}
+		]}}}`))
+		require.ErrorContains(t, err, "invalid version of the module auth")
+	})
+}
+
+func TestGenesisAndHashFromURL(t *testing.T) {
+	genesis := []byte(`{"chain_id":"foo-1"}`)
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(genesis)
+	}))
+	defer srv.Close()
+
+	t.Run("custom HTTP client", func(t *testing.T) {
+		gotGenesis, hash, err := cosmosutil.GenesisAndHashFromURL(
+			context.Background(),
+			srv.URL,
+			cosmosutil.WithHTTPClient(srv.Client()),
+		)
+		require.NoError(t, err)
+		require.Equal(t, genesis, gotGenesis)
+
+		sum := sha256.Sum256(genesis)
+		require.Equal(t, hex.EncodeToString(sum[:]), hash)
+	})
+
+	t.Run("default HTTP client", func(t *testing.T) {
+		_, _, err := cosmosutil.GenesisAndHashFromURL(context.Background(), srv.URL)
+		require.ErrorContains(t, err, "certificate")
+	})
+}
@@ -0,0 +1,261 @@
+package cosmosutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// resumeMetaExt is the extension of the sidecar file describing the partial download of a genesis.
+const resumeMetaExt = ".meta"
+
+// resumeMeta describes the partial download of a genesis, the download is resumed only when the
+// genesis at the URL didn't change since the partial download.
+type resumeMeta struct {
+	URL          string `json:"url"`
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+	Written      int64  `json:"written"`
+}
+
+// validator returns the value of the If-Range header validating the partial download.
+func (m resumeMeta) validator() string {
+	if m.ETag != "" {
+		return m.ETag
+	}
+	return m.LastModified
+}
+
+// DownloadProgress is the progress of the download of a genesis.
+type DownloadProgress struct {
+	// Downloaded is the number of bytes downloaded, including the bytes of a resumed download.
+	Downloaded int64
+
+	// Total is the size of the genesis announced by the server, -1 when unknown.
+	Total int64
+}
+
+// WithDownloadProgress calls notify each time bytes of the genesis are downloaded.
+// notify is called for every read of the response, the caller throttles the reporting of the progress.
+func WithDownloadProgress(notify func(DownloadProgress)) FetchOption {
+	return func(o *fetchOptions) {
+		o.progress = notify
+	}
+}
+
+// progressReader reports the bytes read from the response of a download.
+type progressReader struct {
+	r        io.Reader
+	progress DownloadProgress
+	notify   func(DownloadProgress)
+}
+
+// newProgressReader returns a reader reporting the bytes read from r, offset is the number of bytes
+// already downloaded. The reader is returned unchanged without notify function.
+func newProgressReader(r io.Reader, offset, total int64, notify func(DownloadProgress)) io.Reader {
+	if notify == nil {
+		return r
+	}
+	return &progressReader{
+		r:        r,
+		progress: DownloadProgress{Downloaded: offset, Total: total},
+		notify:   notify,
+	}
+}
+
+// Read implements io.Reader.
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		r.progress.Downloaded += int64(n)
+		r.notify(r.progress)
+	}
+	return n, err
+}
+
+// WithResumeFile keeps the partial download of the genesis in the file, an interrupted download
+// is resumed from the file on the next fetch with an HTTP range request. The download restarts from
+// the beginning when the server doesn't support ranges or when the genesis changed.
+// The file is removed once the genesis is completely downloaded.
+func WithResumeFile(path string) FetchOption {
+	return func(o *fetchOptions) {
+		o.resumeFile = path
+	}
+}
+
+// downloadResumable downloads the genesis from the url into the resume file and returns its complete content.
+// The maximum size applies to the bytes of the previous downloads and of the current download.
+func downloadResumable(
+	ctx context.Context,
+	client *http.Client,
+	url, path string,
+	maxSize int64,
+	progress func(DownloadProgress),
+) ([]byte, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+
+	meta, offset := loadPartialDownload(url, path)
+
+	resp, err := requestRange(ctx, client, url, meta, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// the partial download is discarded when the server ignored the range or the genesis changed
+	resumed := offset > 0 && resp.StatusCode == http.StatusPartialContent && contentRangeStart(resp) == offset
+	if resumed && meta.ETag != "" && resp.Header.Get("ETag") != "" && resp.Header.Get("ETag") != meta.ETag {
+		resumed = false
+	}
+	if !resumed {
+		if resp.StatusCode != http.StatusOK {
+			// the partial download is kept when the server is unavailable
+			if statusErr := newHTTPStatusError(resp); offset == 0 || statusErr.retryable() {
+				return nil, statusErr
+			}
+			resp.Body.Close()
+			if resp, err = requestRange(ctx, client, url, resumeMeta{}, 0); err != nil {
+				return nil, err
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				return nil, newHTTPStatusError(resp)
+			}
+		}
+		offset = 0
+	}
+	if err := checkContentLength(resp, maxSize, offset); err != nil {
+		return nil, err
+	}
+
+	flags := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	if !resumed {
+		flags |= os.O_TRUNC
+	}
+	f, err := os.OpenFile(path, flags, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	meta = resumeMeta{
+		URL:          url,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		Written:      offset,
+	}
+	if err := saveResumeMeta(path, meta); err != nil {
+		return nil, err
+	}
+
+	// the beginning of a resumed download was checked by the first download
+	total := int64(-1)
+	if resp.ContentLength >= 0 {
+		total = offset + resp.ContentLength
+	}
+	body := newSizeLimitReader(newProgressReader(resp.Body, offset, total, progress), maxSize, offset, false)
+	if !resumed {
+		if body, err = sniffGenesis(body, url); err != nil {
+			return nil, err
+		}
+	}
+
+	n, copyErr := io.Copy(f, body)
+	meta.Written += n
+	if err := saveResumeMeta(path, meta); err != nil {
+		return nil, err
+	}
+	if copyErr != nil {
+		// the partial download of a genesis too large is not resumed
+		var tooLarge ErrGenesisTooLarge
+		if errors.As(copyErr, &tooLarge) {
+			f.Close()
+			os.Remove(path)
+			os.Remove(path + resumeMetaExt)
+			return nil, copyErr
+		}
+		return nil, errors.Wrapf(copyErr, "genesis download interrupted after %d bytes", meta.Written)
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	// the genesis is read from the file to include the bytes of the previous downloads
+	genesis, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Remove(path); err != nil {
+		return nil, err
+	}
+	if err := os.Remove(path + resumeMetaExt); err != nil {
+		return nil, err
+	}
+	return genesis, nil
+}
+
+// requestRange requests the genesis from the offset, the whole genesis is requested when the offset is zero.
+func requestRange(ctx context.Context, client *http.Client, url string, meta resumeMeta, offset int64) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		if v := meta.validator(); v != "" {
+			req.Header.Set("If-Range", v)
+		}
+	}
+	return client.Do(req)
+}
+
+// loadPartialDownload returns the metadata and the size of the partial download of the url.
+// The size is zero when there is no partial download to resume.
+func loadPartialDownload(url, path string) (resumeMeta, int64) {
+	bz, err := os.ReadFile(path + resumeMetaExt)
+	if err != nil {
+		return resumeMeta{}, 0
+	}
+	var meta resumeMeta
+	if err := json.Unmarshal(bz, &meta); err != nil || meta.URL != url || meta.validator() == "" {
+		return resumeMeta{}, 0
+	}
+	info, err := os.Stat(path)
+	if err != nil || info.Size() < meta.Written {
+		return resumeMeta{}, 0
+	}
+	return meta, info.Size()
+}
+
+func saveResumeMeta(path string, meta resumeMeta) error {
+	bz, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path+resumeMetaExt, bz, 0o644)
+}
+
+// contentRangeStart returns the first byte of the content range of the response, -1 if the range is invalid.
+func contentRangeStart(resp *http.Response) int64 {
+	// Content-Range: bytes <start>-<end>/<size>
+	cr := strings.TrimPrefix(resp.Header.Get("Content-Range"), "bytes ")
+	start, _, ok := strings.Cut(cr, "-")
+	if !ok {
+		return -1
+	}
+	n, err := strconv.ParseInt(start, 10, 64)
+	if err != nil {
+		return -1
+	}
+	return n
+}
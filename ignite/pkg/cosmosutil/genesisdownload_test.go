@@ -0,0 +1,170 @@
+package cosmosutil_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+)
+
+// interruptedHandler serves the first half of the content and aborts the response.
+func interruptedHandler(content []byte, etag string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+		_, _ = w.Write(content[:len(content)/2])
+		w.(http.Flusher).Flush()
+		panic(http.ErrAbortHandler)
+	}
+}
+
+func TestGenesisAndHashFromURLResume(t *testing.T) {
+	genesis := bytes.Repeat([]byte(`{"chain_id":"foo-1"}`), 1000)
+	sum := sha256.Sum256(genesis)
+	wantHash := hex.EncodeToString(sum[:])
+
+	t.Run("resume with a range request", func(t *testing.T) {
+		var (
+			handler     http.Handler = interruptedHandler(genesis, `"v1"`)
+			rangeHeader string
+		)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler.ServeHTTP(w, r)
+		}))
+		defer srv.Close()
+		resumeFile := filepath.Join(t.TempDir(), "genesis.json")
+
+		_, _, err := cosmosutil.GenesisAndHashFromURL(context.Background(), srv.URL, cosmosutil.WithResumeFile(resumeFile))
+		require.ErrorContains(t, err, "genesis download interrupted")
+		partial, err := os.ReadFile(resumeFile)
+		require.NoError(t, err)
+		require.Equal(t, genesis[:len(genesis)/2], partial)
+
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rangeHeader = r.Header.Get("Range")
+			w.Header().Set("ETag", `"v1"`)
+			http.ServeContent(w, r, "genesis.json", time.Time{}, bytes.NewReader(genesis))
+		})
+
+		gotGenesis, hash, err := cosmosutil.GenesisAndHashFromURL(context.Background(), srv.URL, cosmosutil.WithResumeFile(resumeFile))
+		require.NoError(t, err)
+		require.Equal(t, "bytes="+strconv.Itoa(len(genesis)/2)+"-", rangeHeader)
+		require.Equal(t, genesis, gotGenesis)
+		require.Equal(t, wantHash, hash)
+		require.NoFileExists(t, resumeFile)
+		require.NoFileExists(t, resumeFile+".meta")
+	})
+
+	t.Run("restart when the genesis changed", func(t *testing.T) {
+		var handler http.Handler = interruptedHandler(bytes.Repeat([]byte("x"), len(genesis)), `"v1"`)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler.ServeHTTP(w, r)
+		}))
+		defer srv.Close()
+		resumeFile := filepath.Join(t.TempDir(), "genesis.json")
+
+		_, _, err := cosmosutil.GenesisAndHashFromURL(context.Background(), srv.URL, cosmosutil.WithResumeFile(resumeFile))
+		require.Error(t, err)
+
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("ETag", `"v2"`)
+			http.ServeContent(w, r, "genesis.json", time.Time{}, bytes.NewReader(genesis))
+		})
+
+		gotGenesis, hash, err := cosmosutil.GenesisAndHashFromURL(context.Background(), srv.URL, cosmosutil.WithResumeFile(resumeFile))
+		require.NoError(t, err)
+		require.Equal(t, genesis, gotGenesis)
+		require.Equal(t, wantHash, hash)
+	})
+
+	t.Run("restart when the server doesn't support ranges", func(t *testing.T) {
+		var handler http.Handler = interruptedHandler(genesis, `"v1"`)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler.ServeHTTP(w, r)
+		}))
+		defer srv.Close()
+		resumeFile := filepath.Join(t.TempDir(), "genesis.json")
+
+		_, _, err := cosmosutil.GenesisAndHashFromURL(context.Background(), srv.URL, cosmosutil.WithResumeFile(resumeFile))
+		require.Error(t, err)
+
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write(genesis)
+		})
+
+		gotGenesis, hash, err := cosmosutil.GenesisAndHashFromURL(context.Background(), srv.URL, cosmosutil.WithResumeFile(resumeFile))
+		require.NoError(t, err)
+		require.Equal(t, genesis, gotGenesis)
+		require.Equal(t, wantHash, hash)
+	})
+}
+
+func TestGenesisAndHashFromURLProgress(t *testing.T) {
+	genesis := bytes.Repeat([]byte(`{"chain_id":"foo-1"}`), 1000)
+
+	t.Run("report the downloaded bytes", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.ServeContent(w, r, "genesis.json", time.Time{}, bytes.NewReader(genesis))
+		}))
+		defer srv.Close()
+
+		var progress []cosmosutil.DownloadProgress
+		_, _, err := cosmosutil.GenesisAndHashFromURL(
+			context.Background(),
+			srv.URL,
+			cosmosutil.WithDownloadProgress(func(p cosmosutil.DownloadProgress) {
+				progress = append(progress, p)
+			}),
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, progress)
+		require.Equal(t, cosmosutil.DownloadProgress{
+			Downloaded: int64(len(genesis)),
+			Total:      int64(len(genesis)),
+		}, progress[len(progress)-1])
+	})
+
+	t.Run("count the bytes of a resumed download", func(t *testing.T) {
+		var handler http.Handler = interruptedHandler(genesis, `"v1"`)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler.ServeHTTP(w, r)
+		}))
+		defer srv.Close()
+		resumeFile := filepath.Join(t.TempDir(), "genesis.json")
+
+		_, _, err := cosmosutil.GenesisAndHashFromURL(context.Background(), srv.URL, cosmosutil.WithResumeFile(resumeFile))
+		require.Error(t, err)
+
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("ETag", `"v1"`)
+			http.ServeContent(w, r, "genesis.json", time.Time{}, bytes.NewReader(genesis))
+		})
+
+		var progress []cosmosutil.DownloadProgress
+		_, _, err = cosmosutil.GenesisAndHashFromURL(
+			context.Background(),
+			srv.URL,
+			cosmosutil.WithResumeFile(resumeFile),
+			cosmosutil.WithDownloadProgress(func(p cosmosutil.DownloadProgress) {
+				progress = append(progress, p)
+			}),
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, progress)
+		require.Greater(t, progress[0].Downloaded, int64(len(genesis)/2))
+		require.Equal(t, int64(len(genesis)), progress[0].Total)
+		require.Equal(t, int64(len(genesis)), progress[len(progress)-1].Downloaded)
+	})
+}
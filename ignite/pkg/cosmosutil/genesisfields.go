@@ -0,0 +1,124 @@
+package cosmosutil
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// FieldGenTxs is the path of the gentxs in the genesis.
+const FieldGenTxs = "app_state.genutil.gen_txs"
+
+// ErrFieldNotFound is returned when the genesis has no field at the path.
+var ErrFieldNotFound = errors.New("genesis field not found")
+
+// FieldBytes returns the raw JSON value of the genesis field at the path, like FieldChainID.
+// The genesis is decoded token by token up to the field, the other fields are skipped without being kept
+// in memory, the genesis after the field is not read. The keys of the path are only matched at their level,
+// the fields with the same key nested elsewhere are skipped. ErrFieldNotFound is returned when the genesis
+// has no field at the path.
+func FieldBytes(genesis io.Reader, path string) ([]byte, error) {
+	var value json.RawMessage
+	dec := json.NewDecoder(genesis)
+	found, err := walkJSONPath(dec, strings.Split(path, "."), func() error {
+		return dec.Decode(&value)
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read the genesis field %s", path)
+	}
+	if !found {
+		return nil, ErrFieldNotFound
+	}
+	return value, nil
+}
+
+// ChainIDFromReader returns the chain ID of the genesis without decoding the rest of the genesis.
+// An empty chain ID is returned when the genesis has none.
+func ChainIDFromReader(genesis io.Reader) (string, error) {
+	value, err := FieldBytes(genesis, FieldChainID)
+	if errors.Is(err, ErrFieldNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var chainID string
+	if err := json.Unmarshal(value, &chainID); err != nil {
+		return "", errors.Wrap(err, "invalid genesis chain ID")
+	}
+	return chainID, nil
+}
+
+// GenTxCountFromReader returns the number of gentxs of the genesis like ChainGenesis.GenTxCount,
+// the gentxs are counted one by one without being kept in memory.
+func GenTxCountFromReader(genesis io.Reader) (int, error) {
+	var (
+		count int
+		dec   = json.NewDecoder(genesis)
+	)
+	_, err := walkJSONPath(dec, strings.Split(FieldGenTxs, "."), func() error {
+		// the gentxs are null in the genesis of the chains initialized without gentx
+		t, err := dec.Token()
+		if err != nil || t == nil {
+			return err
+		}
+		if t != json.Delim('[') {
+			return errors.Errorf("expected an array, got %v", t)
+		}
+		for dec.More() {
+			if err := skipJSONValue(dec); err != nil {
+				return err
+			}
+			count++
+		}
+		return expectJSONDelim(dec, ']')
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count the genesis gentxs")
+	}
+	return count, nil
+}
+
+// walkJSONPath reads the JSON object from the decoder up to the value at the path and calls value to consume it,
+// the reading stops once the value is consumed. False is returned when there is no value at the path.
+func walkJSONPath(dec *json.Decoder, path []string, value func() error) (found bool, err error) {
+	// errFound stops the walk of the objects once the value is consumed
+	errFound := errors.New("found")
+
+	var walkFields func(path []string) func(key string) error
+	walkFields = func(path []string) func(key string) error {
+		return func(key string) error {
+			if key != path[0] {
+				return skipJSONValue(dec)
+			}
+			if len(path) == 1 {
+				if err := value(); err != nil {
+					return err
+				}
+				return errFound
+			}
+
+			// a field of the path that is not an object has no value at the rest of the path
+			t, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			if t != json.Delim('{') {
+				return skipJSONValueFrom(dec, t)
+			}
+			return walkJSONObjectFields(dec, walkFields(path[1:]))
+		}
+	}
+
+	switch err := walkJSONObject(dec, walkFields(path)); err {
+	case errFound:
+		return true, nil
+	case nil:
+		return false, nil
+	default:
+		return false, err
+	}
+}
@@ -0,0 +1,160 @@
+package cosmosutil_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+)
+
+func TestGenesisFieldExtractors(t *testing.T) {
+	// the extractors return the same results as the full parse
+	for _, fixture := range []string{"genesis1.json", "genesis2.json", "genesis_evmos.json", "genesis_supply.json"} {
+		t.Run(fixture, func(t *testing.T) {
+			genesisFile, err := os.ReadFile("testdata/" + fixture)
+			require.NoError(t, err)
+			genesis, err := cosmosutil.ParseChainGenesis(genesisFile)
+			require.NoError(t, err)
+
+			chainID, err := cosmosutil.ChainIDFromReader(bytes.NewReader(genesisFile))
+			require.NoError(t, err)
+			require.Equal(t, genesis.ChainID, chainID)
+
+			count, err := cosmosutil.GenTxCountFromReader(bytes.NewReader(genesisFile))
+			require.NoError(t, err)
+			require.Equal(t, genesis.GenTxCount(), count)
+		})
+	}
+}
+
+func TestFieldBytes(t *testing.T) {
+	// the keys of the path are nested elsewhere and the fields are in any order
+	const genesis = `{
+		"app_state": {
+			"chain_id": "nested",
+			"bank": {"params": {"chain_id": "bank"}},
+			"genutil": {"params": {"gen_txs": [{}, {}, {}]}, "gen_txs": [{"body": {"gen_txs": []}}, {}]}
+		},
+		"consensus_params": {"chain_id": "consensus"},
+		"chain_id": "earth-1"
+	}`
+
+	value, err := cosmosutil.FieldBytes(strings.NewReader(genesis), cosmosutil.FieldChainID)
+	require.NoError(t, err)
+	require.Equal(t, `"earth-1"`, string(value))
+
+	value, err = cosmosutil.FieldBytes(strings.NewReader(genesis), "app_state.bank.params")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"chain_id": "bank"}`, string(value))
+
+	chainID, err := cosmosutil.ChainIDFromReader(strings.NewReader(genesis))
+	require.NoError(t, err)
+	require.Equal(t, "earth-1", chainID)
+
+	count, err := cosmosutil.GenTxCountFromReader(strings.NewReader(genesis))
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	t.Run("missing field", func(t *testing.T) {
+		_, err := cosmosutil.FieldBytes(strings.NewReader(genesis), "app_state.staking.params")
+		require.ErrorIs(t, err, cosmosutil.ErrFieldNotFound)
+
+		// a field of the path that is not an object has no nested field
+		_, err = cosmosutil.FieldBytes(strings.NewReader(genesis), "chain_id.foo")
+		require.ErrorIs(t, err, cosmosutil.ErrFieldNotFound)
+
+		chainID, err := cosmosutil.ChainIDFromReader(strings.NewReader(`{"app_state":{"chain_id":"nested"}}`))
+		require.NoError(t, err)
+		require.Empty(t, chainID)
+
+		count, err := cosmosutil.GenTxCountFromReader(strings.NewReader(`{"app_state":{"genutil":{"gen_txs":null}}}`))
+		require.NoError(t, err)
+		require.Zero(t, count)
+	})
+
+	t.Run("invalid genesis", func(t *testing.T) {
+		_, err := cosmosutil.GenTxCountFromReader(strings.NewReader(`{"app_state":{"genutil":`))
+		require.Error(t, err)
+
+		_, err = cosmosutil.ChainIDFromReader(strings.NewReader(`[]`))
+		require.Error(t, err)
+
+		_, err = cosmosutil.ChainIDFromReader(strings.NewReader(`{"chain_id":1}`))
+		require.ErrorContains(t, err, "invalid genesis chain ID")
+	})
+}
+
+// bigGenesis returns a genesis with the accounts and balances, the gentxs are after the balances
+// like in the genesis exported by the chains.
+func bigGenesis(accounts int) []byte {
+	var b bytes.Buffer
+	b.WriteString(`{"app_state":{"auth":{"accounts":[`)
+	for i := 0; i < accounts; i++ {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(&b, `{"@type":"/cosmos.auth.v1beta1.BaseAccount","address":"cosmos1account%d","pub_key":null}`, i)
+	}
+	b.WriteString(`]},"bank":{"balances":[`)
+	for i := 0; i < accounts; i++ {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(&b, `{"address":"cosmos1account%d","coins":[{"denom":"stake","amount":"1000"}]}`, i)
+	}
+	b.WriteString(`]},"genutil":{"gen_txs":[{},{}]}},"chain_id":"earth-1"}`)
+	return b.Bytes()
+}
+
+func BenchmarkGenTxCount(b *testing.B) {
+	genesis := bigGenesis(10000)
+
+	b.Run("full parse", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			chainGenesis, err := cosmosutil.ParseChainGenesis(genesis)
+			if err != nil || chainGenesis.GenTxCount() != 2 {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("reader", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			count, err := cosmosutil.GenTxCountFromReader(bytes.NewReader(genesis))
+			if err != nil || count != 2 {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkChainID(b *testing.B) {
+	genesis := bigGenesis(10000)
+
+	b.Run("full parse", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			chainGenesis, err := cosmosutil.ParseChainGenesis(genesis)
+			if err != nil || chainGenesis.ChainID != "earth-1" {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("reader", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			chainID, err := cosmosutil.ChainIDFromReader(bytes.NewReader(genesis))
+			if err != nil || chainID != "earth-1" {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,204 @@
+package cosmosutil
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/buger/jsonparser"
+	"github.com/pkg/errors"
+)
+
+const (
+	// the names of the modules read by the typed accessors of GenesisFile
+	moduleAuth    = "auth"
+	moduleBank    = "bank"
+	moduleGenutil = "genutil"
+	moduleStaking = "staking"
+)
+
+// GenesisFile is a genesis file with access to the state of its modules without decoding the whole genesis.
+// The state of the modules is decoded on the first access to a typed accessor and cached. The modules set
+// with SetModule are written back into the genesis by Bytes and Save, the rest of the genesis is kept byte
+// for byte. A GenesisFile is not safe for concurrent use.
+type GenesisFile struct {
+	raw      []byte
+	chainID  string
+	appState map[string]json.RawMessage
+
+	// changed are the names of the modules set since the genesis was parsed, in their order
+	changed []string
+
+	// the typed states decoded on the first access
+	accounts        []GenesisAccount
+	accountsDecoded bool
+	balances        []GenesisBalance
+	balancesDecoded bool
+}
+
+// ParseGenesisFile indexes the state of the modules of the genesis, the state of each module is kept raw.
+func ParseGenesisFile(genesis []byte) (*GenesisFile, error) {
+	var index struct {
+		ChainID  string                     `json:"chain_id"`
+		AppState map[string]json.RawMessage `json:"app_state"`
+	}
+	if err := json.Unmarshal(genesis, &index); err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal the genesis file")
+	}
+	if index.AppState == nil {
+		index.AppState = make(map[string]json.RawMessage)
+	}
+	return &GenesisFile{
+		raw:      genesis,
+		chainID:  index.ChainID,
+		appState: index.AppState,
+	}, nil
+}
+
+// GenesisFileFromPath reads and parses the genesis file, the file is not read when it exceeds
+// the DefaultMaxGenesisSize.
+func GenesisFileFromPath(genesisPath string) (*GenesisFile, error) {
+	genesis, err := ReadGenesisFile(genesisPath, DefaultMaxGenesisSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot open genesis file")
+	}
+	return ParseGenesisFile(genesis)
+}
+
+// ChainID returns the chain ID of the genesis.
+func (g *GenesisFile) ChainID() string {
+	return g.chainID
+}
+
+// Module returns the raw state of the module, false is returned when the genesis has no state for the module.
+// The returned state must not be modified, SetModule replaces it.
+func (g *GenesisFile) Module(name string) (json.RawMessage, bool) {
+	state, ok := g.appState[name]
+	return state, ok
+}
+
+// ModuleState unmarshals the state of the module into v, v is not changed when the module has no state.
+func (g *GenesisFile) ModuleState(name string, v interface{}) error {
+	state, ok := g.appState[name]
+	if !ok {
+		return nil
+	}
+	if err := json.Unmarshal(state, v); err != nil {
+		return errors.Wrapf(err, "cannot unmarshal the state of the module %s", name)
+	}
+	return nil
+}
+
+// SetModule replaces the state of the module, the module is added when the genesis has no state for it.
+func (g *GenesisFile) SetModule(name string, state json.RawMessage) error {
+	if !json.Valid(state) {
+		return errors.Errorf("invalid JSON state for the module %s", name)
+	}
+	if !g.isChanged(name) {
+		g.changed = append(g.changed, name)
+	}
+	g.appState[name] = state
+
+	// the typed state of the module is decoded again on the next access
+	switch name {
+	case moduleAuth:
+		g.accountsDecoded = false
+	case moduleBank:
+		g.balancesDecoded = false
+	}
+	return nil
+}
+
+// Accounts returns the accounts of the auth module genesis.
+func (g *GenesisFile) Accounts() ([]GenesisAccount, error) {
+	if !g.accountsDecoded {
+		var auth struct {
+			Accounts []GenesisAccount `json:"accounts"`
+		}
+		if err := g.ModuleState(moduleAuth, &auth); err != nil {
+			return nil, err
+		}
+		g.accounts = auth.Accounts
+		g.accountsDecoded = true
+	}
+	return g.accounts, nil
+}
+
+// Balances returns the balances of the bank module genesis.
+func (g *GenesisFile) Balances() ([]GenesisBalance, error) {
+	if !g.balancesDecoded {
+		var bank struct {
+			Balances []GenesisBalance `json:"balances"`
+		}
+		if err := g.ModuleState(moduleBank, &bank); err != nil {
+			return nil, err
+		}
+		g.balances = bank.Balances
+		g.balancesDecoded = true
+	}
+	return g.balances, nil
+}
+
+// GenTxCount returns the number of gentxs of the genutil module genesis.
+func (g *GenesisFile) GenTxCount() (int, error) {
+	var genutil struct {
+		GenTxs []json.RawMessage `json:"gen_txs"`
+	}
+	if err := g.ModuleState(moduleGenutil, &genutil); err != nil {
+		return 0, err
+	}
+	return len(genutil.GenTxs), nil
+}
+
+// BondDenom returns the bond denom of the staking module params, it is empty when the genesis doesn't set it.
+func (g *GenesisFile) BondDenom() (string, error) {
+	var staking struct {
+		Params struct {
+			BondDenom string `json:"bond_denom"`
+		} `json:"params"`
+	}
+	if err := g.ModuleState(moduleStaking, &staking); err != nil {
+		return "", err
+	}
+	return staking.Params.BondDenom, nil
+}
+
+// Bytes returns the genesis with the state of the modules set with SetModule.
+// Only the states of these modules are serialized again, the rest of the genesis is unchanged.
+func (g *GenesisFile) Bytes() ([]byte, error) {
+	if len(g.changed) == 0 {
+		return g.raw, nil
+	}
+
+	// the genesis is copied since setting a value can write in the array of the genesis
+	genesis := append([]byte(nil), g.raw...)
+	for _, name := range g.changed {
+		var err error
+		genesis, err = jsonparser.Set(genesis, g.appState[name], "app_state", name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot set the state of the module %s", name)
+		}
+	}
+
+	// the raw states of the modules point to the previous genesis, they are still valid
+	g.raw = genesis
+	g.changed = nil
+	return genesis, nil
+}
+
+// Save writes the genesis with the state of the modules set with SetModule to the path.
+func (g *GenesisFile) Save(path string) error {
+	genesis, err := g.Bytes()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, genesis, 0o644)
+}
+
+func (g *GenesisFile) isChanged(name string) bool {
+	for _, changed := range g.changed {
+		if changed == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+package cosmosutil_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+)
+
+// formattedGenesis is a genesis whose formatting, key order and number notations are lost when it's
+// unmarshaled and marshaled again.
+const formattedGenesis = `{
+  "genesis_time": "2022-01-01T00:00:00Z",
+  "chain_id":   "earth-1",
+  "app_state": {
+    "staking": { "params": {"unbonding_time": "1814400s", "bond_denom": "ustake"} },
+    "bank": {
+      "balances": [
+        {"address": "cosmos1foo", "coins": [{"denom": "ustake", "amount": "1000"}]}
+      ]
+    },
+    "oracle":   {"feeders": [], "threshold": 1.50e0, "z": 1, "a": 2},
+    "auth": {"accounts": [{"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": "cosmos1foo"}]}
+  },
+  "consensus_params": {"block": {"max_bytes": "22020096"}}
+}
+`
+
+const bankState = `{
+      "balances": [
+        {"address": "cosmos1foo", "coins": [{"denom": "ustake", "amount": "1000"}]}
+      ]
+    }`
+
+func TestGenesisFileAccessors(t *testing.T) {
+	genesis, err := cosmosutil.ParseGenesisFile([]byte(formattedGenesis))
+	require.NoError(t, err)
+
+	require.Equal(t, "earth-1", genesis.ChainID())
+
+	oracle, ok := genesis.Module("oracle")
+	require.True(t, ok)
+	require.Equal(t, `{"feeders": [], "threshold": 1.50e0, "z": 1, "a": 2}`, string(oracle))
+	_, ok = genesis.Module("gov")
+	require.False(t, ok)
+
+	accounts, err := genesis.Accounts()
+	require.NoError(t, err)
+	require.Equal(t, []cosmosutil.GenesisAccount{
+		{Type: "/cosmos.auth.v1beta1.BaseAccount", Address: "cosmos1foo"},
+	}, accounts)
+
+	balances, err := genesis.Balances()
+	require.NoError(t, err)
+	require.Equal(t, []cosmosutil.GenesisBalance{
+		{Address: "cosmos1foo", Coins: sdk.NewCoins(sdk.NewInt64Coin("ustake", 1000))},
+	}, balances)
+
+	bondDenom, err := genesis.BondDenom()
+	require.NoError(t, err)
+	require.Equal(t, "ustake", bondDenom)
+
+	gentxCount, err := genesis.GenTxCount()
+	require.NoError(t, err)
+	require.Zero(t, gentxCount)
+
+	// the cached balances are decoded again once the bank module is set
+	require.NoError(t, genesis.SetModule("bank", json.RawMessage(`{"balances":[]}`)))
+	balances, err = genesis.Balances()
+	require.NoError(t, err)
+	require.Empty(t, balances)
+
+	require.Error(t, genesis.SetModule("bank", json.RawMessage(`{"balances":`)))
+}
+
+func TestGenesisFileSave(t *testing.T) {
+	t.Run("untouched modules are preserved byte for byte", func(t *testing.T) {
+		genesis, err := cosmosutil.ParseGenesisFile([]byte(formattedGenesis))
+		require.NoError(t, err)
+
+		newBank := `{"balances":[{"address":"cosmos1foo","coins":[{"denom":"ustake","amount":"2000"}]}]}`
+		require.NoError(t, genesis.SetModule("bank", json.RawMessage(newBank)))
+
+		path := filepath.Join(t.TempDir(), "genesis.json")
+		require.NoError(t, genesis.Save(path))
+
+		saved, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, strings.Replace(formattedGenesis, bankState, newBank, 1), string(saved))
+
+		// the saved genesis is parsed back with the new state
+		reparsed, err := cosmosutil.GenesisFileFromPath(path)
+		require.NoError(t, err)
+		balances, err := reparsed.Balances()
+		require.NoError(t, err)
+		require.Equal(t, "2000ustake", balances[0].Coins.String())
+	})
+
+	t.Run("new module", func(t *testing.T) {
+		genesis, err := cosmosutil.ParseGenesisFile([]byte(formattedGenesis))
+		require.NoError(t, err)
+		require.NoError(t, genesis.SetModule("gov", json.RawMessage(`{"proposals":[]}`)))
+
+		saved, err := genesis.Bytes()
+		require.NoError(t, err)
+		gov, err := cosmosutil.FieldBytes(strings.NewReader(string(saved)), "app_state.gov")
+		require.NoError(t, err)
+		require.JSONEq(t, `{"proposals":[]}`, string(gov))
+
+		// the other modules are unchanged
+		for _, module := range []string{"staking", "bank", "oracle", "auth"} {
+			state, err := cosmosutil.FieldBytes(strings.NewReader(string(saved)), "app_state."+module)
+			require.NoError(t, err)
+			require.Contains(t, formattedGenesis, string(state))
+		}
+	})
+
+	t.Run("genesis without changes", func(t *testing.T) {
+		genesis, err := cosmosutil.ParseGenesisFile([]byte(formattedGenesis))
+		require.NoError(t, err)
+		saved, err := genesis.Bytes()
+		require.NoError(t, err)
+		require.Equal(t, formattedGenesis, string(saved))
+	})
+
+	t.Run("the parsed genesis is not modified", func(t *testing.T) {
+		// the genesis has room after its end for a value written in place
+		raw := make([]byte, len(formattedGenesis), 2*len(formattedGenesis))
+		copy(raw, formattedGenesis)
+
+		genesis, err := cosmosutil.ParseGenesisFile(raw)
+		require.NoError(t, err)
+		require.NoError(t, genesis.SetModule("gov", json.RawMessage(`{}`)))
+		_, err = genesis.Bytes()
+		require.NoError(t, err)
+		require.Equal(t, formattedGenesis, string(raw))
+	})
+}
+
+func TestParseGenesisFileInvalid(t *testing.T) {
+	_, err := cosmosutil.ParseGenesisFile([]byte(`{"app_state": []}`))
+	require.Error(t, err)
+
+	genesis, err := cosmosutil.ParseGenesisFile([]byte(`{"app_state": {"auth": {"accounts": {}}}}`))
+	require.NoError(t, err)
+	_, err = genesis.Accounts()
+	require.ErrorContains(t, err, "cannot unmarshal the state of the module auth")
+}
@@ -0,0 +1,199 @@
+package cosmosutil
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/xhttp"
+)
+
+const (
+	// DefaultFetchRetryBudget is the default maximum time spent waiting between the retries of a genesis fetch.
+	DefaultFetchRetryBudget = 2 * time.Minute
+
+	defaultRetryMinDelay = time.Second
+	defaultRetryMaxDelay = 30 * time.Second
+)
+
+// ErrGenesisUnavailable is returned when the genesis server still responds with an error status
+// once the retry budget is spent.
+type ErrGenesisUnavailable struct {
+	URL string
+
+	// StatusCode is the status of the last response.
+	StatusCode int
+
+	// Attempts is the number of requests sent.
+	Attempts int
+}
+
+// Error implements error.
+func (e ErrGenesisUnavailable) Error() string {
+	msg := fmt.Sprintf(
+		"cannot fetch the genesis from %s: %d %s after %d attempt(s)",
+		e.URL,
+		e.StatusCode,
+		http.StatusText(e.StatusCode),
+		e.Attempts,
+	)
+	if e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden {
+		msg += ", " + authHint(e.URL)
+	}
+	return msg
+}
+
+// authHint describes how to configure the bearer token of the host of the URL, the token is never printed.
+func authHint(rawURL string) string {
+	host := rawURL
+	if u, err := url.Parse(rawURL); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	return fmt.Sprintf(
+		"the server requires a valid bearer token: set it for the host %s with %s=%s=<token> or %s for api.github.com",
+		host,
+		xhttp.EnvAuthTokens,
+		host,
+		xhttp.EnvGitHubToken,
+	)
+}
+
+// FetchRetry describes a retry of a genesis fetch.
+type FetchRetry struct {
+	// Attempt is the number of the failed attempt, starting at 1.
+	Attempt int
+
+	// StatusCode is the status of the failed attempt.
+	StatusCode int
+
+	// Delay is the time waited before the next attempt.
+	Delay time.Duration
+}
+
+// WithRetryBudget sets the maximum time spent waiting between the retries of the fetch,
+// the fetch is not retried when the budget is zero. DefaultFetchRetryBudget is used by default.
+// The fetch is retried when the server responds with a 429 or a 5xx status.
+func WithRetryBudget(budget time.Duration) FetchOption {
+	return func(o *fetchOptions) {
+		o.retryBudget = budget
+	}
+}
+
+// WithRetryBackoff sets the delays of the exponential backoff between the retries of the fetch.
+// The Retry-After header of the response is honored over the backoff.
+func WithRetryBackoff(minDelay, maxDelay time.Duration) FetchOption {
+	return func(o *fetchOptions) {
+		o.retryMinDelay = minDelay
+		o.retryMaxDelay = maxDelay
+	}
+}
+
+// WithRetryNotify calls notify before each retry of the fetch.
+func WithRetryNotify(notify func(FetchRetry)) FetchOption {
+	return func(o *fetchOptions) {
+		o.retryNotify = notify
+	}
+}
+
+// errHTTPStatus is returned when the genesis server responds with an unexpected status.
+type errHTTPStatus struct {
+	statusCode int
+	retryAfter string
+}
+
+func (e errHTTPStatus) Error() string {
+	return fmt.Sprintf("unexpected status %d %s", e.statusCode, http.StatusText(e.statusCode))
+}
+
+// retryable checks if the request can succeed later, the other client errors are permanent.
+func (e errHTTPStatus) retryable() bool {
+	return e.statusCode == http.StatusTooManyRequests || e.statusCode >= http.StatusInternalServerError
+}
+
+func newHTTPStatusError(resp *http.Response) errHTTPStatus {
+	return errHTTPStatus{
+		statusCode: resp.StatusCode,
+		retryAfter: resp.Header.Get("Retry-After"),
+	}
+}
+
+// fetchWithRetry calls fetch until it succeeds, fails with a permanent error or the retry budget is spent.
+func fetchWithRetry(ctx context.Context, url string, o fetchOptions, fetch func() ([]byte, error)) ([]byte, error) {
+	var waited time.Duration
+	for attempt := 1; ; attempt++ {
+		genesis, err := fetch()
+
+		var statusErr errHTTPStatus
+		if !errors.As(err, &statusErr) {
+			return genesis, err
+		}
+		unavailable := ErrGenesisUnavailable{
+			URL:        url,
+			StatusCode: statusErr.statusCode,
+			Attempts:   attempt,
+		}
+		if !statusErr.retryable() || o.retryBudget <= 0 {
+			return nil, unavailable
+		}
+
+		delay := retryDelay(attempt, statusErr.retryAfter, o.retryMinDelay, o.retryMaxDelay)
+		if waited+delay > o.retryBudget {
+			return nil, unavailable
+		}
+		if o.retryNotify != nil {
+			o.retryNotify(FetchRetry{
+				Attempt:    attempt,
+				StatusCode: statusErr.statusCode,
+				Delay:      delay,
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+		waited += delay
+	}
+}
+
+// retryDelay returns the delay before the next attempt. The Retry-After header, in seconds or as an
+// HTTP date, is honored when set, otherwise the delay is a capped exponential backoff with jitter.
+// The Retry-After delay is at least the minimum delay, the budget is spent even if the server asks to retry now.
+func retryDelay(attempt int, retryAfter string, minDelay, maxDelay time.Duration) time.Duration {
+	if retryAfter != "" {
+		var delay time.Duration
+		if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+			delay = time.Duration(seconds) * time.Second
+		} else if date, err := http.ParseTime(retryAfter); err == nil {
+			if delay = time.Until(date); delay < 0 {
+				delay = 0
+			}
+		} else {
+			delay = -1
+		}
+		if delay >= 0 {
+			if delay < minDelay {
+				return minDelay
+			}
+			return delay
+		}
+	}
+
+	delay := maxDelay
+	if shift := attempt - 1; shift < 32 && minDelay<<shift < maxDelay && minDelay<<shift > 0 {
+		delay = minDelay << shift
+	}
+	// the validators retrying at the same time are spread over the second half of the delay
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
@@ -0,0 +1,187 @@
+package cosmosutil_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/xhttp"
+)
+
+// failingHandler responds with the statuses before serving the genesis.
+func failingHandler(genesis []byte, statuses ...int) (http.Handler, *int) {
+	var requests int
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests <= len(statuses) {
+			if statuses[requests-1] == http.StatusTooManyRequests {
+				w.Header().Set("Retry-After", "0")
+			}
+			w.WriteHeader(statuses[requests-1])
+			return
+		}
+		_, _ = w.Write(genesis)
+	}), &requests
+}
+
+func TestGenesisAndHashFromURLRetry(t *testing.T) {
+	var (
+		genesis = []byte(`{"chain_id":"foo-1"}`)
+		backoff = cosmosutil.WithRetryBackoff(time.Millisecond, 10*time.Millisecond)
+	)
+
+	t.Run("retry the unavailable server", func(t *testing.T) {
+		handler, requests := failingHandler(genesis, http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusBadGateway)
+		srv := httptest.NewServer(handler)
+		defer srv.Close()
+
+		var retries []cosmosutil.FetchRetry
+		got, _, err := cosmosutil.GenesisAndHashFromURL(
+			context.Background(),
+			srv.URL,
+			backoff,
+			cosmosutil.WithRetryNotify(func(r cosmosutil.FetchRetry) {
+				retries = append(retries, r)
+			}),
+		)
+		require.NoError(t, err)
+		require.Equal(t, genesis, got)
+		require.Equal(t, 4, *requests)
+		require.Len(t, retries, 3)
+		require.Equal(t, http.StatusServiceUnavailable, retries[0].StatusCode)
+		require.Equal(t, 1, retries[0].Attempt)
+		require.Equal(t, cosmosutil.FetchRetry{Attempt: 2, StatusCode: http.StatusTooManyRequests, Delay: time.Millisecond}, retries[1])
+		require.Equal(t, http.StatusBadGateway, retries[2].StatusCode)
+		require.LessOrEqual(t, retries[2].Delay, 10*time.Millisecond)
+	})
+
+	t.Run("honor the retry after header", func(t *testing.T) {
+		var requests int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if requests == 1 {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			_, _ = w.Write(genesis)
+		}))
+		defer srv.Close()
+
+		var delays []time.Duration
+		_, _, err := cosmosutil.GenesisAndHashFromURL(
+			context.Background(),
+			srv.URL,
+			backoff,
+			cosmosutil.WithRetryNotify(func(r cosmosutil.FetchRetry) {
+				delays = append(delays, r.Delay)
+			}),
+		)
+		require.NoError(t, err)
+		require.Equal(t, []time.Duration{time.Second}, delays)
+	})
+
+	t.Run("give up once the budget is spent", func(t *testing.T) {
+		handler, requests := failingHandler(genesis, http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests)
+		srv := httptest.NewServer(handler)
+		defer srv.Close()
+
+		// the server asks to retry now, the minimum delay is waited
+		_, _, err := cosmosutil.GenesisAndHashFromURL(
+			context.Background(),
+			srv.URL,
+			cosmosutil.WithRetryBackoff(10*time.Millisecond, 10*time.Millisecond),
+			cosmosutil.WithRetryBudget(25*time.Millisecond),
+		)
+		require.Equal(t, cosmosutil.ErrGenesisUnavailable{
+			URL:        srv.URL,
+			StatusCode: http.StatusTooManyRequests,
+			Attempts:   3,
+		}, err)
+		require.EqualError(t, err, "cannot fetch the genesis from "+srv.URL+": 429 Too Many Requests after 3 attempt(s)")
+		require.Equal(t, 3, *requests)
+	})
+
+	t.Run("no retry without budget", func(t *testing.T) {
+		handler, requests := failingHandler(genesis, http.StatusInternalServerError)
+		srv := httptest.NewServer(handler)
+		defer srv.Close()
+
+		_, _, err := cosmosutil.GenesisAndHashFromURL(context.Background(), srv.URL, cosmosutil.WithRetryBudget(0))
+		require.ErrorAs(t, err, &cosmosutil.ErrGenesisUnavailable{})
+		require.Equal(t, 1, *requests)
+	})
+
+	t.Run("no retry of the client errors", func(t *testing.T) {
+		handler, requests := failingHandler(genesis, http.StatusNotFound)
+		srv := httptest.NewServer(handler)
+		defer srv.Close()
+
+		_, _, err := cosmosutil.GenesisAndHashFromURL(context.Background(), srv.URL, backoff)
+		require.Equal(t, cosmosutil.ErrGenesisUnavailable{
+			URL:        srv.URL,
+			StatusCode: http.StatusNotFound,
+			Attempts:   1,
+		}, err)
+		require.Equal(t, 1, *requests)
+	})
+
+	t.Run("retry the resumable download", func(t *testing.T) {
+		handler, requests := failingHandler(genesis, http.StatusServiceUnavailable)
+		srv := httptest.NewServer(handler)
+		defer srv.Close()
+		resumeFile := filepath.Join(t.TempDir(), "genesis.json")
+
+		got, _, err := cosmosutil.GenesisAndHashFromURL(
+			context.Background(),
+			srv.URL,
+			backoff,
+			cosmosutil.WithResumeFile(resumeFile),
+		)
+		require.NoError(t, err)
+		require.Equal(t, genesis, got)
+		require.Equal(t, 2, *requests)
+	})
+}
+
+func TestGenesisAndHashFromURLAuth(t *testing.T) {
+	const token = "s3cr3t"
+	genesis := []byte(`{"chain_id":"foo-1"}`)
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write(genesis)
+	}))
+	defer srv.Close()
+	srvURL, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	t.Run("fetch with the token of the host", func(t *testing.T) {
+		client := *srv.Client()
+		client.Transport = xhttp.NewAuthTransport(client.Transport, xhttp.AuthTokens{srvURL.Host: token})
+
+		got, _, err := cosmosutil.GenesisAndHashFromURL(context.Background(), srv.URL, cosmosutil.WithHTTPClient(&client))
+		require.NoError(t, err)
+		require.Equal(t, genesis, got)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		client := *srv.Client()
+		client.Transport = xhttp.NewAuthTransport(client.Transport, xhttp.AuthTokens{srvURL.Host: "invalid" + token})
+
+		_, _, err := cosmosutil.GenesisAndHashFromURL(context.Background(), srv.URL, cosmosutil.WithHTTPClient(&client))
+		require.ErrorAs(t, err, &cosmosutil.ErrGenesisUnavailable{})
+		require.ErrorContains(t, err, "401 Unauthorized after 1 attempt(s)")
+		require.ErrorContains(t, err, "IGNITE_AUTH_TOKENS="+srvURL.Host+"=<token>")
+		require.NotContains(t, err.Error(), token)
+	})
+}
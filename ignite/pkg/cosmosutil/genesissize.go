@@ -0,0 +1,164 @@
+package cosmosutil
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultMaxGenesisSize is the default maximum size of a genesis, 2 GiB.
+const DefaultMaxGenesisSize int64 = 2 << 30
+
+// gzipMagic are the first bytes of a gzip stream.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// ErrGenesisTooLarge is returned when the genesis is larger than the maximum size.
+type ErrGenesisTooLarge struct {
+	// Limit is the maximum size of the genesis in bytes.
+	Limit int64
+
+	// Size is the number of bytes received when the genesis exceeded the limit,
+	// or the size announced by the server when Announced is true.
+	Size int64
+
+	// Announced is true when the size is the Content-Length of the response.
+	Announced bool
+
+	// Decompressed is true when the size is the size of the decompressed genesis.
+	Decompressed bool
+}
+
+// Error implements error.
+func (e ErrGenesisTooLarge) Error() string {
+	received := "received"
+	switch {
+	case e.Announced:
+		received = "announced"
+	case e.Decompressed:
+		received = "decompressed"
+	}
+	return fmt.Sprintf(
+		"the genesis is larger than the maximum size of %d bytes (%d bytes %s)",
+		e.Limit,
+		e.Size,
+		received,
+	)
+}
+
+// WithMaxGenesisSize sets the maximum size of the genesis in bytes, the limit applies to the downloaded
+// bytes and to the decompressed bytes of a gzip genesis. DefaultMaxGenesisSize is used by default,
+// the size is not limited when the limit is zero.
+func WithMaxGenesisSize(limit int64) FetchOption {
+	return func(o *fetchOptions) {
+		o.maxSize = limit
+	}
+}
+
+// sizeLimitReader fails with an ErrGenesisTooLarge error once more bytes than the limit are read.
+type sizeLimitReader struct {
+	r            io.Reader
+	limit        int64
+	read         int64
+	decompressed bool
+}
+
+// newSizeLimitReader limits the bytes read from r, offset is the number of bytes already read by a
+// previous download. The reader is returned unchanged when the limit is zero.
+func newSizeLimitReader(r io.Reader, limit, offset int64, decompressed bool) io.Reader {
+	if limit <= 0 {
+		return r
+	}
+	return &sizeLimitReader{
+		r:            r,
+		limit:        limit,
+		read:         offset,
+		decompressed: decompressed,
+	}
+}
+
+func (l *sizeLimitReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	if l.read > l.limit {
+		return n, ErrGenesisTooLarge{
+			Limit:        l.limit,
+			Size:         l.read,
+			Decompressed: l.decompressed,
+		}
+	}
+	return n, err
+}
+
+// checkContentLength fails before the download when the size announced by the response,
+// added to the bytes of a previous download, exceeds the limit.
+func checkContentLength(resp *http.Response, limit, offset int64) error {
+	if limit <= 0 || resp.ContentLength < 0 {
+		return nil
+	}
+	if size := offset + resp.ContentLength; size > limit {
+		return ErrGenesisTooLarge{
+			Limit:     limit,
+			Size:      size,
+			Announced: true,
+		}
+	}
+	return nil
+}
+
+// isGzip checks if the content starts as a gzip stream.
+func isGzip(content []byte) bool {
+	return bytes.HasPrefix(content, gzipMagic)
+}
+
+// decompressGenesis decompresses a gzip genesis, the decompressed size is limited to prevent zip bombs.
+// The genesis is returned unchanged when it's not compressed.
+func decompressGenesis(genesis []byte, limit int64) ([]byte, error) {
+	if !isGzip(genesis) {
+		return genesis, nil
+	}
+	gr, err := gzip.NewReader(bytes.NewReader(genesis))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid gzip genesis")
+	}
+	defer gr.Close()
+
+	decompressed, err := io.ReadAll(newSizeLimitReader(gr, limit, 0, true))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot decompress the genesis")
+	}
+	return decompressed, nil
+}
+
+// ReadGenesisFile reads the genesis at the path, a gzip genesis is decompressed. The file is not read
+// when it's larger than the limit, the decompressed size is limited too. The size is not limited when
+// the limit is zero.
+func ReadGenesisFile(path string, limit int64) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && info.Size() > limit {
+		return nil, ErrGenesisTooLarge{
+			Limit: limit,
+			Size:  info.Size(),
+		}
+	}
+
+	// the file can grow after the stat
+	genesis, err := io.ReadAll(newSizeLimitReader(f, limit, 0, false))
+	if err != nil {
+		return nil, err
+	}
+	return decompressGenesis(genesis, limit)
+}
@@ -0,0 +1,230 @@
+package cosmosutil_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+)
+
+// sampleGenesis returns a JSON genesis of the size.
+func sampleGenesis(size int) []byte {
+	genesis := []byte(`{"chain_id":"foo-1","padding":"`)
+	genesis = append(genesis, bytes.Repeat([]byte("x"), size-len(genesis)-2)...)
+	return append(genesis, []byte(`"}`)...)
+}
+
+func gzipGenesis(t *testing.T, genesis []byte) []byte {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	_, err := gw.Write(genesis)
+	require.NoError(t, err)
+	require.NoError(t, gw.Close())
+	return buf.Bytes()
+}
+
+func TestErrGenesisTooLarge(t *testing.T) {
+	require.EqualError(
+		t,
+		cosmosutil.ErrGenesisTooLarge{Limit: 100, Size: 101},
+		"the genesis is larger than the maximum size of 100 bytes (101 bytes received)",
+	)
+	require.EqualError(
+		t,
+		cosmosutil.ErrGenesisTooLarge{Limit: 100, Size: 5000, Announced: true},
+		"the genesis is larger than the maximum size of 100 bytes (5000 bytes announced)",
+	)
+	require.EqualError(
+		t,
+		cosmosutil.ErrGenesisTooLarge{Limit: 100, Size: 101, Decompressed: true},
+		"the genesis is larger than the maximum size of 100 bytes (101 bytes decompressed)",
+	)
+}
+
+func TestGenesisAndHashFromURLMaxSize(t *testing.T) {
+	const maxSize = 64 << 10
+
+	t.Run("genesis under the limit", func(t *testing.T) {
+		genesis := sampleGenesis(maxSize)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(genesis)
+		}))
+		defer srv.Close()
+
+		got, _, err := cosmosutil.GenesisAndHashFromURL(
+			context.Background(),
+			srv.URL,
+			cosmosutil.WithMaxGenesisSize(maxSize),
+		)
+		require.NoError(t, err)
+		require.Equal(t, genesis, got)
+	})
+
+	t.Run("announced size over the limit", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			genesis := sampleGenesis(4 * maxSize)
+			w.Header().Set("Content-Length", strconv.Itoa(len(genesis)))
+			_, _ = w.Write(genesis)
+		}))
+		defer srv.Close()
+
+		_, _, err := cosmosutil.GenesisAndHashFromURL(
+			context.Background(),
+			srv.URL,
+			cosmosutil.WithMaxGenesisSize(maxSize),
+		)
+		require.Equal(t, cosmosutil.ErrGenesisTooLarge{Limit: maxSize, Size: 4 * maxSize, Announced: true}, err)
+	})
+
+	t.Run("endless stream", func(t *testing.T) {
+		var written int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// the stream is chunked, the size is not announced
+			_, _ = w.Write([]byte(`{"chain_id":"foo-1","padding":"`))
+			chunk := bytes.Repeat([]byte("x"), 4096)
+			for i := 0; i < 1000; i++ {
+				n, err := w.Write(chunk)
+				written += n
+				if err != nil {
+					return
+				}
+				w.(http.Flusher).Flush()
+			}
+		}))
+		defer srv.Close()
+
+		_, _, err := cosmosutil.GenesisAndHashFromURL(
+			context.Background(),
+			srv.URL,
+			cosmosutil.WithMaxGenesisSize(maxSize),
+		)
+		var tooLarge cosmosutil.ErrGenesisTooLarge
+		require.ErrorAs(t, err, &tooLarge)
+		require.EqualValues(t, maxSize, tooLarge.Limit)
+		require.Greater(t, tooLarge.Size, int64(maxSize))
+		require.False(t, tooLarge.Announced)
+
+		// the download stops once the limit is exceeded
+		srv.Close()
+		require.Less(t, written, 1000*4096)
+	})
+
+	t.Run("endless stream with a resume file", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write(sampleGenesis(2 * maxSize))
+		}))
+		defer srv.Close()
+		resumeFile := filepath.Join(t.TempDir(), "genesis.json")
+
+		_, _, err := cosmosutil.GenesisAndHashFromURL(
+			context.Background(),
+			srv.URL,
+			cosmosutil.WithMaxGenesisSize(maxSize),
+			cosmosutil.WithResumeFile(resumeFile),
+		)
+		require.ErrorAs(t, err, &cosmosutil.ErrGenesisTooLarge{})
+
+		// the partial download is removed
+		require.NoFileExists(t, resumeFile)
+	})
+
+	t.Run("compressed genesis", func(t *testing.T) {
+		genesis := sampleGenesis(maxSize / 2)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(gzipGenesis(t, genesis))
+		}))
+		defer srv.Close()
+
+		got, hash, err := cosmosutil.GenesisAndHashFromURL(
+			context.Background(),
+			srv.URL,
+			cosmosutil.WithMaxGenesisSize(maxSize),
+		)
+		require.NoError(t, err)
+		require.Equal(t, genesis, got)
+
+		// the hash is the hash of the decompressed genesis
+		sum := sha256.Sum256(genesis)
+		require.Equal(t, hex.EncodeToString(sum[:]), hash)
+	})
+
+	t.Run("compressed genesis decompressed over the limit", func(t *testing.T) {
+		// the compressed genesis is much smaller than the limit
+		compressed := gzipGenesis(t, sampleGenesis(100*maxSize))
+		require.Less(t, len(compressed), maxSize)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(compressed)
+		}))
+		defer srv.Close()
+
+		_, _, err := cosmosutil.GenesisAndHashFromURL(
+			context.Background(),
+			srv.URL,
+			cosmosutil.WithMaxGenesisSize(maxSize),
+		)
+		var tooLarge cosmosutil.ErrGenesisTooLarge
+		require.ErrorAs(t, err, &tooLarge)
+		require.True(t, tooLarge.Decompressed)
+		require.EqualValues(t, maxSize, tooLarge.Limit)
+	})
+}
+
+func TestReadGenesisFile(t *testing.T) {
+	const maxSize = 64 << 10
+
+	writeGenesis := func(t *testing.T, content []byte) string {
+		path := filepath.Join(t.TempDir(), "genesis.json")
+		require.NoError(t, os.WriteFile(path, content, 0o644))
+		return path
+	}
+
+	t.Run("genesis under the limit", func(t *testing.T) {
+		genesis := sampleGenesis(maxSize)
+
+		got, err := cosmosutil.ReadGenesisFile(writeGenesis(t, genesis), maxSize)
+		require.NoError(t, err)
+		require.Equal(t, genesis, got)
+	})
+
+	t.Run("genesis over the limit", func(t *testing.T) {
+		_, err := cosmosutil.ReadGenesisFile(writeGenesis(t, sampleGenesis(maxSize+1)), maxSize)
+		require.Equal(t, cosmosutil.ErrGenesisTooLarge{Limit: maxSize, Size: maxSize + 1}, err)
+	})
+
+	t.Run("compressed genesis", func(t *testing.T) {
+		genesis := sampleGenesis(maxSize)
+
+		got, err := cosmosutil.ReadGenesisFile(writeGenesis(t, gzipGenesis(t, genesis)), maxSize)
+		require.NoError(t, err)
+		require.Equal(t, genesis, got)
+	})
+
+	t.Run("compressed genesis decompressed over the limit", func(t *testing.T) {
+		path := writeGenesis(t, gzipGenesis(t, sampleGenesis(100*maxSize)))
+
+		_, err := cosmosutil.ReadGenesisFile(path, maxSize)
+		var tooLarge cosmosutil.ErrGenesisTooLarge
+		require.ErrorAs(t, err, &tooLarge)
+		require.True(t, tooLarge.Decompressed)
+	})
+
+	t.Run("no limit", func(t *testing.T) {
+		genesis := sampleGenesis(2 * maxSize)
+
+		got, err := cosmosutil.ReadGenesisFile(writeGenesis(t, genesis), 0)
+		require.NoError(t, err)
+		require.Equal(t, genesis, got)
+	})
+}
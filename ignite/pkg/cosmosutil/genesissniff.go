@@ -0,0 +1,110 @@
+package cosmosutil
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// genesisSniffSize is the size of the beginning of the genesis read to check its format.
+const genesisSniffSize = 4096
+
+// ErrGenesisNotJSON is returned when the genesis URL points to a content that is not a JSON object,
+// usually the HTML page of a file hosted on GitHub or GitLab instead of the raw file.
+type ErrGenesisNotJSON struct {
+	URL string
+
+	// RawURL is the URL of the raw file when the URL is the page of a file hosted on GitHub or GitLab.
+	RawURL string
+}
+
+// Error implements error.
+func (e ErrGenesisNotJSON) Error() string {
+	msg := fmt.Sprintf("the genesis URL %s doesn't point to a JSON file", e.URL)
+	if e.RawURL != "" {
+		return fmt.Sprintf("%s, did you mean the raw URL %s?", msg, e.RawURL)
+	}
+	return msg + ", the URL must point to the raw genesis file"
+}
+
+// sniffGenesis reads the beginning of the genesis from the body and checks it is a JSON object or a gzip stream
+// before the rest of the genesis is downloaded, the download of a large HTML page fails early. The check doesn't
+// rely on range requests, the servers not supporting ranges are checked the same way. The returned reader reads
+// the whole body.
+func sniffGenesis(body io.Reader, genesisURL string) (io.Reader, error) {
+	head := make([]byte, genesisSniffSize)
+	n, err := io.ReadFull(body, head)
+	head = head[:n]
+	// the genesis can be smaller than the sniffed size, the interrupted downloads are reported
+	// by the caller with the bytes read
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return io.MultiReader(bytes.NewReader(head), errReader{err}), nil
+	}
+
+	if !isGzip(head) && !isJSONObjectPrefix(head) {
+		rawURL, _ := RawFileURL(genesisURL)
+		return nil, ErrGenesisNotJSON{
+			URL:    genesisURL,
+			RawURL: rawURL,
+		}
+	}
+	return io.MultiReader(bytes.NewReader(head), body), nil
+}
+
+// errReader returns the error on each read.
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+// isJSONObjectPrefix checks if the content starts as a JSON object, an empty content is left to the parser.
+func isJSONObjectPrefix(content []byte) bool {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	content = bytes.TrimLeft(content, " \t\r\n")
+	return len(content) == 0 || content[0] == '{'
+}
+
+// RawFileURL returns the URL of the raw file of a file page hosted on GitHub or GitLab:
+// https://github.com/owner/repo/blob/main/genesis.json is the page of the raw file
+// https://raw.githubusercontent.com/owner/repo/main/genesis.json. False is returned for the other URLs.
+func RawFileURL(fileURL string) (string, bool) {
+	u, err := url.Parse(fileURL)
+	if err != nil {
+		return "", false
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	switch u.Host {
+	case "github.com", "www.github.com":
+		// owner/repo/blob/ref/path...
+		if len(parts) < 5 || parts[2] != "blob" {
+			return "", false
+		}
+		raw := url.URL{
+			Scheme: "https",
+			Host:   "raw.githubusercontent.com",
+			Path:   "/" + strings.Join(append(parts[:2:2], parts[3:]...), "/"),
+		}
+		return raw.String(), true
+
+	case "gitlab.com", "www.gitlab.com":
+		// group/.../project/-/blob/ref/path...
+		for i := 0; i+2 < len(parts); i++ {
+			if parts[i] == "-" && parts[i+1] == "blob" && i > 0 {
+				parts[i+1] = "raw"
+				raw := url.URL{
+					Scheme: "https",
+					Host:   u.Host,
+					Path:   "/" + strings.Join(parts, "/"),
+				}
+				return raw.String(), true
+			}
+		}
+	}
+	return "", false
+}
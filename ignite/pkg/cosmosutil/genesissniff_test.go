@@ -0,0 +1,138 @@
+package cosmosutil_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+)
+
+func TestRawFileURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		url    string
+		raw    string
+		isFile bool
+	}{
+		{
+			name:   "GitHub file page",
+			url:    "https://github.com/ignite/mars/blob/main/networks/genesis.json",
+			raw:    "https://raw.githubusercontent.com/ignite/mars/main/networks/genesis.json",
+			isFile: true,
+		},
+		{
+			name:   "GitLab file page",
+			url:    "https://gitlab.com/ignite/sub/mars/-/blob/v1.0.0/genesis.json",
+			raw:    "https://gitlab.com/ignite/sub/mars/-/raw/v1.0.0/genesis.json",
+			isFile: true,
+		},
+		{
+			name: "GitHub raw file",
+			url:  "https://raw.githubusercontent.com/ignite/mars/main/genesis.json",
+		},
+		{
+			name: "GitHub repository page",
+			url:  "https://github.com/ignite/mars",
+		},
+		{
+			name: "other host",
+			url:  "https://example.com/ignite/mars/blob/main/genesis.json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, isFile := cosmosutil.RawFileURL(tt.url)
+			require.Equal(t, tt.isFile, isFile)
+			require.Equal(t, tt.raw, raw)
+		})
+	}
+}
+
+func TestErrGenesisNotJSON(t *testing.T) {
+	err := cosmosutil.ErrGenesisNotJSON{
+		URL:    "https://github.com/ignite/mars/blob/main/genesis.json",
+		RawURL: "https://raw.githubusercontent.com/ignite/mars/main/genesis.json",
+	}
+	require.EqualError(
+		t,
+		err,
+		"the genesis URL https://github.com/ignite/mars/blob/main/genesis.json doesn't point to a JSON file, "+
+			"did you mean the raw URL https://raw.githubusercontent.com/ignite/mars/main/genesis.json?",
+	)
+}
+
+func TestGenesisAndHashFromURLNotJSON(t *testing.T) {
+	page := append([]byte("<!DOCTYPE html>\n<html>"), bytes.Repeat([]byte("<div></div>"), 1<<20)...)
+
+	t.Run("HTML page", func(t *testing.T) {
+		var written int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html")
+			for i := 0; i < len(page); i += 4096 {
+				n, err := w.Write(page[i : i+4096])
+				written += n
+				if err != nil {
+					return
+				}
+			}
+		}))
+		defer srv.Close()
+
+		_, _, err := cosmosutil.GenesisAndHashFromURL(context.Background(), srv.URL)
+		require.Equal(t, cosmosutil.ErrGenesisNotJSON{URL: srv.URL}, err)
+		require.EqualError(t, err, "the genesis URL "+srv.URL+" doesn't point to a JSON file, the URL must point to the raw genesis file")
+
+		// the download stops once the beginning of the page is read
+		srv.Close()
+		require.Less(t, written, len(page))
+	})
+
+	t.Run("HTML page with a resume file", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(page[:8192])
+		}))
+		defer srv.Close()
+
+		_, _, err := cosmosutil.GenesisAndHashFromURL(
+			context.Background(),
+			srv.URL,
+			cosmosutil.WithResumeFile(filepath.Join(t.TempDir(), "genesis.json")),
+		)
+		require.ErrorAs(t, err, &cosmosutil.ErrGenesisNotJSON{})
+	})
+
+	t.Run("JSON genesis", func(t *testing.T) {
+		genesis := append([]byte("\xef\xbb\xbf\n  {\"chain_id\":\"foo-1\",\"padding\":\""), bytes.Repeat([]byte("x"), 10000)...)
+		genesis = append(genesis, []byte("\"}")...)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(genesis)
+		}))
+		defer srv.Close()
+
+		got, _, err := cosmosutil.GenesisAndHashFromURL(context.Background(), srv.URL)
+		require.NoError(t, err)
+		require.Equal(t, genesis, got)
+	})
+
+	t.Run("server without range support", func(t *testing.T) {
+		genesis := []byte(`{"chain_id":"foo-1"}`)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Range") != "" {
+				http.Error(w, "range not supported", http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write(genesis)
+		}))
+		defer srv.Close()
+
+		got, _, err := cosmosutil.GenesisAndHashFromURL(context.Background(), srv.URL)
+		require.NoError(t, err)
+		require.Equal(t, genesis, got)
+	})
+}
@@ -6,21 +6,42 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
+	"sync"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 )
 
 var GentxFilename = "gentx.json"
 
+// ErrGentxUnknownKeyType is returned when the gentx has a key type unknown to the Cosmos SDK, like the keys
+// of the EVM chains or the BLS consensus keys, the signatures of the gentx can't be verified.
+var ErrGentxUnknownKeyType = errors.New("the gentx has a key type unknown to the Cosmos SDK")
+
+var (
+	gentxTxConfig     client.TxConfig
+	gentxTxConfigOnce sync.Once
+)
+
 type (
 	// GentxInfo represents the basic info about gentx file
 	GentxInfo struct {
 		DelegatorAddress string
+		ValidatorAddress string
 		PubKey           ed25519.PubKey
+		PubKeyType       string
 		SelfDelegation   sdk.Coin
 		Memo             string
+		Moniker          string
 	}
 
 	// StargateGentx represents the stargate gentx file
@@ -37,6 +58,9 @@ type (
 					Denom  string `json:"denom"`
 					Amount string `json:"amount"`
 				} `json:"value"`
+				Description struct {
+					Moniker string `json:"moniker"`
+				} `json:"description"`
 			} `json:"messages"`
 			Memo string `json:"memo"`
 		} `json:"body"`
@@ -75,7 +99,10 @@ func ParseGentx(gentx []byte) (info GentxInfo, file []byte, err error) {
 
 	info.Memo = stargateGentx.Body.Memo
 	info.DelegatorAddress = stargateGentx.Body.Messages[0].DelegatorAddress
+	info.ValidatorAddress = stargateGentx.Body.Messages[0].ValidatorAddress
+	info.Moniker = stargateGentx.Body.Messages[0].Description.Moniker
 
+	info.PubKeyType = stargateGentx.Body.Messages[0].PubKey.Type
 	pb := stargateGentx.Body.Messages[0].PubKey.Key
 	info.PubKey, err = base64.StdEncoding.DecodeString(pb)
 	if err != nil {
@@ -94,3 +121,60 @@ func ParseGentx(gentx []byte) (info GentxInfo, file []byte, err error) {
 
 	return info, gentx, nil
 }
+
+// VerifyGentxSignature verifies the signatures of the gentx for the chain ID.
+// The gentxs are signed with the account number and sequence 0 since the accounts don't exist before the genesis,
+// both the direct and the amino JSON sign modes are supported.
+// ErrGentxUnknownKeyType is returned when the gentx can't be decoded because of its key types.
+func VerifyGentxSignature(gentx []byte, chainID string) error {
+	txConfig := gentxConfig()
+
+	tx, err := txConfig.TxJSONDecoder()(gentx)
+	if err != nil && strings.Contains(err.Error(), "unable to resolve type URL") {
+		return fmt.Errorf("%w: %s", ErrGentxUnknownKeyType, err)
+	}
+	if err != nil {
+		return fmt.Errorf("cannot decode the gentx: %w", err)
+	}
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return errors.New("the gentx cannot be verified")
+	}
+
+	// the signer infos are read without the signers of the messages, their address prefix is the one of the chain
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return fmt.Errorf("invalid gentx signatures: %w", err)
+	}
+	if len(sigs) == 0 {
+		return errors.New("the gentx is not signed")
+	}
+	for _, sig := range sigs {
+		if sig.PubKey == nil {
+			return errors.New("the gentx has no public key for its signature")
+		}
+		signerData := authsigning.SignerData{
+			Address:       sdk.AccAddress(sig.PubKey.Address()).String(),
+			ChainID:       chainID,
+			AccountNumber: 0,
+			Sequence:      0,
+			PubKey:        sig.PubKey,
+		}
+		if err := authsigning.VerifySignature(sig.PubKey, signerData, sig.Data, txConfig.SignModeHandler(), tx); err != nil {
+			return fmt.Errorf("invalid gentx signature for the chain %s: %w", chainID, err)
+		}
+	}
+	return nil
+}
+
+// gentxConfig returns the tx config decoding the gentxs with the key types of the Cosmos SDK,
+// its interface registry is built once.
+func gentxConfig() client.TxConfig {
+	gentxTxConfigOnce.Do(func() {
+		interfaceRegistry := codectypes.NewInterfaceRegistry()
+		std.RegisterInterfaces(interfaceRegistry)
+		stakingtypes.RegisterInterfaces(interfaceRegistry)
+		gentxTxConfig = authtx.NewTxConfig(codec.NewProtoCodec(interfaceRegistry), authtx.DefaultSignModes)
+	})
+	return gentxTxConfig
+}
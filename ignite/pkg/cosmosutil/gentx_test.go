@@ -1,7 +1,10 @@
 package cosmosutil_test
 
 import (
+	"bytes"
 	"encoding/base64"
+	"encoding/json"
+	"os"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -29,24 +32,30 @@ func TestParseGentx(t *testing.T) {
 			gentxPath: "testdata/gentx1.json",
 			wantInfo: cosmosutil.GentxInfo{
 				DelegatorAddress: "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj",
+				ValidatorAddress: "cosmosvaloper1dd246yq6z5vzjz9gh8cff46pll75yyl8pu8cup",
 				PubKey:           ed25519.PubKey(pk1),
+				PubKeyType:       "/cosmos.crypto.ed25519.PubKey",
 				SelfDelegation: sdk.Coin{
 					Denom:  "stake",
 					Amount: sdkmath.NewInt(95000000),
 				},
-				Memo: "9b1f4adbfb0c0b513040d914bfb717303c0eaa71@192.168.0.148:26656",
+				Memo:    "9b1f4adbfb0c0b513040d914bfb717303c0eaa71@192.168.0.148:26656",
+				Moniker: "default",
 			},
 		}, {
 			name:      "parse gentx file 2",
 			gentxPath: "testdata/gentx2.json",
 			wantInfo: cosmosutil.GentxInfo{
 				DelegatorAddress: "cosmos1mmlqwyqk7neqegffp99q86eckpm4pjah3ytlpa",
+				ValidatorAddress: "cosmosvaloper1mmlqwyqk7neqegffp99q86eckpm4pjah5sl2dw",
 				PubKey:           ed25519.PubKey(pk2),
+				PubKeyType:       "/cosmos.crypto.ed25519.PubKey",
 				SelfDelegation: sdk.Coin{
 					Denom:  "stake",
 					Amount: sdkmath.NewInt(95000000),
 				},
-				Memo: "a412c917cb29f73cc3ad0592bbd0152fe0e690bd@192.168.0.148:26656",
+				Memo:    "a412c917cb29f73cc3ad0592bbd0152fe0e690bd@192.168.0.148:26656",
+				Moniker: "alice",
 			},
 		}, {
 			name:      "parse invalid file",
@@ -70,3 +79,96 @@ func TestParseGentx(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyGentxSignature(t *testing.T) {
+	flipSignature := func(t *testing.T, gentx []byte) []byte {
+		var tx map[string]interface{}
+		require.NoError(t, json.Unmarshal(gentx, &tx))
+		signatures := tx["signatures"].([]interface{})
+		sig, err := base64.StdEncoding.DecodeString(signatures[0].(string))
+		require.NoError(t, err)
+		sig[0] ^= 0xff
+		signatures[0] = base64.StdEncoding.EncodeToString(sig)
+		gentx, err = json.Marshal(tx)
+		require.NoError(t, err)
+		return gentx
+	}
+
+	tests := []struct {
+		name      string
+		gentxPath string
+		chainID   string
+		flip      bool
+		keyType   [2]string
+		wantErr   bool
+		err       error
+	}{
+		{
+			name:      "valid direct signature",
+			gentxPath: "testdata/gentx_signed_direct.json",
+			chainID:   "mars-1",
+		}, {
+			name:      "valid amino json signature",
+			gentxPath: "testdata/gentx_signed_amino.json",
+			chainID:   "mars-1",
+		}, {
+			name:      "flipped direct signature byte",
+			gentxPath: "testdata/gentx_signed_direct.json",
+			chainID:   "mars-1",
+			flip:      true,
+			wantErr:   true,
+		}, {
+			name:      "flipped amino json signature byte",
+			gentxPath: "testdata/gentx_signed_amino.json",
+			chainID:   "mars-1",
+			flip:      true,
+			wantErr:   true,
+		}, {
+			name:      "signed for another chain",
+			gentxPath: "testdata/gentx_signed_direct.json",
+			chainID:   "mars-2",
+			wantErr:   true,
+		}, {
+			name:      "invalid gentx",
+			gentxPath: "testdata/gentx_invalid.json",
+			chainID:   "mars-1",
+			wantErr:   true,
+		}, {
+			name:      "bls consensus key",
+			gentxPath: "testdata/gentx_signed_direct.json",
+			chainID:   "mars-1",
+			keyType:   [2]string{"/cosmos.crypto.ed25519.PubKey", "/cosmos.crypto.bls12_381.PubKey"},
+			wantErr:   true,
+			err:       cosmosutil.ErrGentxUnknownKeyType,
+		}, {
+			name:      "ethermint account key",
+			gentxPath: "testdata/gentx_signed_direct.json",
+			chainID:   "mars-1",
+			keyType:   [2]string{"/cosmos.crypto.secp256k1.PubKey", "/ethermint.crypto.v1.ethsecp256k1.PubKey"},
+			wantErr:   true,
+			err:       cosmosutil.ErrGentxUnknownKeyType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gentx, err := os.ReadFile(tt.gentxPath)
+			require.NoError(t, err)
+			if tt.flip {
+				gentx = flipSignature(t, gentx)
+			}
+			if tt.keyType[0] != "" {
+				gentx = bytes.Replace(gentx, []byte(tt.keyType[0]), []byte(tt.keyType[1]), 1)
+			}
+
+			err = cosmosutil.VerifyGentxSignature(gentx, tt.chainID)
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.err != nil {
+					require.ErrorIs(t, err, tt.err)
+				}
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
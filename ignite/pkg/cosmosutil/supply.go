@@ -0,0 +1,219 @@
+package cosmosutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// SupplyDelta is the difference for a denom between the supply declared in the bank module genesis
+// and the sum of the genesis balances.
+type SupplyDelta struct {
+	Denom    string
+	Supply   *big.Int
+	Balances *big.Int
+}
+
+// Delta returns the declared supply minus the sum of the balances, positive when the denom is over-supplied.
+func (d SupplyDelta) Delta() *big.Int {
+	return new(big.Int).Sub(d.Supply, d.Balances)
+}
+
+// String implements fmt.Stringer
+func (d SupplyDelta) String() string {
+	return fmt.Sprintf("%s: supply %s, balances %s, delta %s", d.Denom, d.Supply, d.Balances, d.Delta())
+}
+
+// genesisCoin is a coin of the bank module genesis, the amount is kept as a string to be parsed as a big integer.
+type genesisCoin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+// GenesisSupplyDeltas reconciles the supply of the bank module genesis with the sum of the genesis balances
+// and returns the deltas of the denoms that don't match, sorted by denom.
+// No delta is returned when the genesis declares no supply, the supply is then computed by the chain from the balances.
+// The genesis is decoded incrementally, the balances are summed one by one without loading the whole balances array.
+func GenesisSupplyDeltas(genesis io.Reader) ([]SupplyDelta, error) {
+	var (
+		dec      = json.NewDecoder(genesis)
+		supply   = make(map[string]*big.Int)
+		balances = make(map[string]*big.Int)
+	)
+
+	err := walkJSONObject(dec, func(key string) error {
+		if key != "app_state" {
+			return skipJSONValue(dec)
+		}
+		return walkJSONObject(dec, func(key string) error {
+			if key != "bank" {
+				return skipJSONValue(dec)
+			}
+			return walkJSONObject(dec, func(key string) error {
+				switch key {
+				case "supply":
+					var coins []genesisCoin
+					if err := dec.Decode(&coins); err != nil {
+						return errors.Wrap(err, "cannot decode the genesis supply")
+					}
+					return addGenesisCoins(supply, coins)
+				case "balances":
+					return walkJSONArray(dec, func() error {
+						var balance struct {
+							Address string        `json:"address"`
+							Coins   []genesisCoin `json:"coins"`
+						}
+						if err := dec.Decode(&balance); err != nil {
+							return errors.Wrap(err, "cannot decode the genesis balance")
+						}
+						return errors.Wrapf(addGenesisCoins(balances, balance.Coins), "balance of %s", balance.Address)
+					})
+				default:
+					return skipJSONValue(dec)
+				}
+			})
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(supply) == 0 {
+		return nil, nil
+	}
+
+	denoms := make(map[string]struct{}, len(supply))
+	for denom := range supply {
+		denoms[denom] = struct{}{}
+	}
+	for denom := range balances {
+		denoms[denom] = struct{}{}
+	}
+
+	var deltas []SupplyDelta
+	for denom := range denoms {
+		d := SupplyDelta{
+			Denom:    denom,
+			Supply:   valueOrZero(supply[denom]),
+			Balances: valueOrZero(balances[denom]),
+		}
+		if d.Supply.Cmp(d.Balances) != 0 {
+			deltas = append(deltas, d)
+		}
+	}
+	sort.Slice(deltas, func(i, j int) bool {
+		return deltas[i].Denom < deltas[j].Denom
+	})
+	return deltas, nil
+}
+
+// addGenesisCoins adds the amounts of the coins to the totals per denom.
+func addGenesisCoins(totals map[string]*big.Int, coins []genesisCoin) error {
+	for _, coin := range coins {
+		amount, ok := new(big.Int).SetString(coin.Amount, 10)
+		if !ok {
+			return fmt.Errorf("invalid amount %q for the denom %s", coin.Amount, coin.Denom)
+		}
+		total, ok := totals[coin.Denom]
+		if !ok {
+			total = new(big.Int)
+			totals[coin.Denom] = total
+		}
+		total.Add(total, amount)
+	}
+	return nil
+}
+
+func valueOrZero(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return v
+}
+
+// walkJSONObject reads the next JSON object from the decoder and calls field for each key,
+// field must consume the value of the key.
+func walkJSONObject(dec *json.Decoder, field func(key string) error) error {
+	if err := expectJSONDelim(dec, '{'); err != nil {
+		return err
+	}
+	return walkJSONObjectFields(dec, field)
+}
+
+// walkJSONObjectFields calls field for each key of the JSON object whose opening delimiter was read
+// from the decoder and reads its closing delimiter, field must consume the value of the key.
+func walkJSONObjectFields(dec *json.Decoder, field func(key string) error) error {
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := t.(string)
+		if !ok {
+			return fmt.Errorf("invalid JSON object key %v", t)
+		}
+		if err := field(key); err != nil {
+			return err
+		}
+	}
+	return expectJSONDelim(dec, '}')
+}
+
+// walkJSONArray reads the next JSON array from the decoder and calls elem for each element,
+// elem must consume the element.
+func walkJSONArray(dec *json.Decoder, elem func() error) error {
+	if err := expectJSONDelim(dec, '['); err != nil {
+		return err
+	}
+	for dec.More() {
+		if err := elem(); err != nil {
+			return err
+		}
+	}
+	return expectJSONDelim(dec, ']')
+}
+
+// skipJSONValue reads the next JSON value from the decoder token by token without keeping it.
+func skipJSONValue(dec *json.Decoder) error {
+	t, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	return skipJSONValueFrom(dec, t)
+}
+
+// skipJSONValueFrom reads the rest of the JSON value starting with the token already read from the decoder.
+func skipJSONValueFrom(dec *json.Decoder, t json.Token) error {
+	depth := 0
+	for {
+		switch t {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+
+		var err error
+		if t, err = dec.Token(); err != nil {
+			return err
+		}
+	}
+}
+
+func expectJSONDelim(dec *json.Decoder, delim json.Delim) error {
+	t, err := dec.Token()
+	if err != nil {
+		return errors.Wrap(err, "cannot decode the genesis")
+	}
+	if t != delim {
+		return fmt.Errorf("cannot decode the genesis: expected %s, got %v", delim, t)
+	}
+	return nil
+}
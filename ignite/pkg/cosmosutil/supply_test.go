@@ -0,0 +1,90 @@
+package cosmosutil_test
+
+import (
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+)
+
+func TestGenesisSupplyDeltas(t *testing.T) {
+	bigInt := func(s string) *big.Int {
+		i, ok := new(big.Int).SetString(s, 10)
+		require.True(t, ok)
+		return i
+	}
+
+	tests := []struct {
+		name    string
+		genesis string
+		want    []cosmosutil.SupplyDelta
+	}{
+		{
+			name:    "supply matching the balances",
+			genesis: "testdata/genesis_supply.json",
+		},
+		{
+			name:    "over-supplied denoms",
+			genesis: "testdata/genesis_supply_over.json",
+			want: []cosmosutil.SupplyDelta{
+				{
+					Denom:    "aevmos",
+					Supply:   bigInt("1500000000000000000000000001"),
+					Balances: bigInt("1500000000000000000000000000"),
+				},
+				{
+					Denom:    "stake",
+					Supply:   bigInt("200000000"),
+					Balances: bigInt("150000000"),
+				},
+			},
+		},
+		{
+			name:    "missing supply entry",
+			genesis: "testdata/genesis_supply_missing.json",
+			want: []cosmosutil.SupplyDelta{
+				{
+					Denom:    "aevmos",
+					Supply:   big.NewInt(0),
+					Balances: bigInt("1500000000000000000000000000"),
+				},
+			},
+		},
+		{
+			name:    "genesis without supply",
+			genesis: "testdata/genesis1.json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := os.Open(tt.genesis)
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, err := cosmosutil.GenesisSupplyDeltas(f)
+			require.NoError(t, err)
+			require.Len(t, got, len(tt.want))
+			for i, want := range tt.want {
+				require.Equal(t, want.Denom, got[i].Denom)
+				require.Zero(t, want.Supply.Cmp(got[i].Supply))
+				require.Zero(t, want.Balances.Cmp(got[i].Balances))
+			}
+		})
+	}
+
+	t.Run("delta", func(t *testing.T) {
+		d := cosmosutil.SupplyDelta{Denom: "stake", Supply: big.NewInt(100), Balances: big.NewInt(150)}
+		require.Equal(t, "stake: supply 100, balances 150, delta -50", d.String())
+	})
+
+	t.Run("invalid amount", func(t *testing.T) {
+		_, err := cosmosutil.GenesisSupplyDeltas(strings.NewReader(
+			`{"app_state":{"bank":{"balances":[{"address":"cosmos1","coins":[{"denom":"stake","amount":"1.5"}]}]}}}`,
+		))
+		require.ErrorContains(t, err, `invalid amount "1.5" for the denom stake`)
+	})
+}
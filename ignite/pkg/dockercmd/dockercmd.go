@@ -0,0 +1,196 @@
+// Package dockercmd runs containers with the Docker CLI.
+package dockercmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	osexec "os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+)
+
+const (
+	// CommandVersion represents docker "version" command.
+	CommandVersion = "version"
+
+	// CommandImage represents docker "image" command.
+	CommandImage = "image"
+
+	// CommandImageInspect represents docker image "inspect" command.
+	CommandImageInspect = "inspect"
+
+	// CommandPull represents docker "pull" command.
+	CommandPull = "pull"
+
+	// CommandCreate represents docker "create" command.
+	CommandCreate = "create"
+
+	// CommandStart represents docker "start" command.
+	CommandStart = "start"
+
+	// CommandWait represents docker "wait" command.
+	CommandWait = "wait"
+
+	// CommandCopy represents docker "cp" command.
+	CommandCopy = "cp"
+
+	// CommandRemove represents docker "rm" command.
+	CommandRemove = "rm"
+)
+
+const (
+	FlagFormat   = "--format"
+	FlagPlatform = "--platform"
+	FlagEnv      = "--env"
+	FlagVolume   = "--volume"
+	FlagWorkdir  = "--workdir"
+	FlagAttach   = "--attach"
+	FlagForce    = "--force"
+)
+
+var (
+	// ErrNotInstalled is returned when the Docker CLI is not installed.
+	ErrNotInstalled = errors.New("docker is not installed")
+
+	// ErrDaemonUnavailable is returned when the Docker daemon doesn't respond.
+	ErrDaemonUnavailable = errors.New("the Docker daemon is not running")
+)
+
+// Name returns the name of Docker binary to use.
+func Name() string {
+	custom := os.Getenv("DOCKERNAME")
+	if custom != "" {
+		return custom
+	}
+	return "docker"
+}
+
+// Mount is a host directory mounted in a container.
+type Mount struct {
+	Source   string
+	Target   string
+	ReadOnly bool
+}
+
+// ContainerConfig is the configuration of a created container.
+type ContainerConfig struct {
+	Image    string
+	Platform string
+	Cmd      []string
+	Env      []string
+	Mounts   []Mount
+	Workdir  string
+}
+
+// Client runs the Docker CLI.
+type Client struct{}
+
+// New creates a new Docker client.
+func New() Client {
+	return Client{}
+}
+
+// Ping checks the Docker daemon responds.
+func (Client) Ping(ctx context.Context) error {
+	if _, err := osexec.LookPath(Name()); err != nil {
+		return ErrNotInstalled
+	}
+	command := []string{Name(), CommandVersion, FlagFormat, "{{.Server.Version}}"}
+	if err := exec.Exec(ctx, command); err != nil {
+		return fmt.Errorf("%w: %s", ErrDaemonUnavailable, err)
+	}
+	return nil
+}
+
+// ImageDigest returns the ID of the image, the image is pulled when it's not present.
+// The ID is the digest of the image configuration, it changes when the content of the image changes.
+func (c Client) ImageDigest(ctx context.Context, image, platform string) (string, error) {
+	digest, err := c.inspectImage(ctx, image)
+	if err == nil {
+		return digest, nil
+	}
+
+	command := []string{Name(), CommandPull}
+	if platform != "" {
+		command = append(command, FlagPlatform, platform)
+	}
+	command = append(command, image)
+	if err := exec.Exec(ctx, command); err != nil {
+		return "", err
+	}
+	return c.inspectImage(ctx, image)
+}
+
+func (Client) inspectImage(ctx context.Context, image string) (string, error) {
+	var stdout bytes.Buffer
+	command := []string{Name(), CommandImage, CommandImageInspect, FlagFormat, "{{.Id}}", image}
+	if err := exec.Exec(ctx, command, exec.StepOption(step.Stdout(&stdout))); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// Create creates a container and returns its ID.
+func (Client) Create(ctx context.Context, config ContainerConfig) (string, error) {
+	command := []string{Name(), CommandCreate}
+	if config.Platform != "" {
+		command = append(command, FlagPlatform, config.Platform)
+	}
+	for _, env := range config.Env {
+		command = append(command, FlagEnv, env)
+	}
+	for _, mount := range config.Mounts {
+		volume := mount.Source + ":" + mount.Target
+		if mount.ReadOnly {
+			volume += ":ro"
+		}
+		command = append(command, FlagVolume, volume)
+	}
+	if config.Workdir != "" {
+		command = append(command, FlagWorkdir, config.Workdir)
+	}
+	command = append(command, config.Image)
+	command = append(command, config.Cmd...)
+
+	var stdout bytes.Buffer
+	if err := exec.Exec(ctx, command, exec.StepOption(step.Stdout(&stdout))); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// Start starts the container and writes its outputs until it exits.
+func (Client) Start(ctx context.Context, id string, stdout, stderr io.Writer) error {
+	return exec.Exec(
+		ctx,
+		[]string{Name(), CommandStart, FlagAttach, id},
+		exec.StepOption(step.Stdout(stdout)),
+		exec.StepOption(step.Stderr(stderr)),
+	)
+}
+
+// Wait waits for the container to exit and returns its exit code.
+func (Client) Wait(ctx context.Context, id string) (int, error) {
+	var stdout bytes.Buffer
+	if err := exec.Exec(ctx, []string{Name(), CommandWait, id}, exec.StepOption(step.Stdout(&stdout))); err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(strings.TrimSpace(stdout.String()))
+}
+
+// CopyFrom copies the file at the path of the container to the destination path.
+func (Client) CopyFrom(ctx context.Context, id, path, dst string) error {
+	return exec.Exec(ctx, []string{Name(), CommandCopy, id + ":" + path, dst})
+}
+
+// Remove removes the container.
+func (Client) Remove(ctx context.Context, id string) error {
+	return exec.Exec(ctx, []string{Name(), CommandRemove, FlagForce, id})
+}
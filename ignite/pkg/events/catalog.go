@@ -0,0 +1,224 @@
+package events
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+// DefaultLanguage is the language of the default catalog, the messages missing
+// from the catalog of the current language are rendered with the default catalog.
+const DefaultLanguage = "en"
+
+type (
+	// MessageID identifies a message of the catalogs.
+	MessageID string
+
+	// Params are the parameters of a message, referenced by name in its templates.
+	Params map[string]interface{}
+
+	// Catalog contains the templates of the messages of a language.
+	// The templates use the text/template syntax with the parameters as data, e.g. "Chain {{.LaunchID}} launched".
+	// The parameters are formatted with the locale of the catalog with the template functions:
+	//
+	//	{{number .Count}}     a number with the group and decimal separators of the locale
+	//	{{coins .Fee}}        coins with their amounts formatted as numbers
+	//	{{time .LaunchTime}}  a time with the time layout of the locale
+	Catalog struct {
+		// Language is the BCP 47 tag of the language of the catalog, like "en" or "pt-BR".
+		Language string
+
+		// Locale formats the parameters of the messages.
+		Locale Locale
+
+		// Messages are the templates of the messages.
+		Messages map[MessageID]string
+	}
+
+	// Locale contains the formats of the message parameters of a language.
+	Locale struct {
+		// TimeLayout is the layout of the times, time.RFC1123 when empty.
+		TimeLayout string
+
+		// DecimalSeparator separates the integer and the fractional parts of the numbers, "." when empty.
+		DecimalSeparator string
+
+		// GroupSeparator separates the groups of three digits of the integer part of the numbers, none when empty.
+		GroupSeparator string
+	}
+)
+
+// catalogRegistry contains the parsed catalogs by language.
+type catalogRegistry struct {
+	mu        sync.RWMutex
+	language  string
+	templates map[string]map[MessageID]*template.Template
+	locales   map[string]Locale
+}
+
+var catalogs = &catalogRegistry{
+	language:  DefaultLanguage,
+	templates: make(map[string]map[MessageID]*template.Template),
+	locales:   map[string]Locale{DefaultLanguage: {GroupSeparator: ","}},
+}
+
+// RegisterCatalog registers the messages of the catalog for its language. The catalogs of the same language
+// are merged, a message registered again replaces the previous one. The locale of the language is replaced
+// when the catalog has one. An error is returned when a template is invalid, no message is registered then.
+func RegisterCatalog(c Catalog) error {
+	if c.Language == "" {
+		return fmt.Errorf("the catalog has no language")
+	}
+
+	parsed := make(map[MessageID]*template.Template, len(c.Messages))
+	for id, message := range c.Messages {
+		tmpl, err := template.New(string(id)).Funcs(localeFuncs(Locale{})).Option("missingkey=error").Parse(message)
+		if err != nil {
+			return fmt.Errorf("invalid message %s of the %s catalog: %w", id, c.Language, err)
+		}
+		parsed[id] = tmpl
+	}
+
+	catalogs.mu.Lock()
+	defer catalogs.mu.Unlock()
+
+	templates, ok := catalogs.templates[c.Language]
+	if !ok {
+		templates = make(map[MessageID]*template.Template)
+		catalogs.templates[c.Language] = templates
+	}
+	for id, tmpl := range parsed {
+		templates[id] = tmpl
+	}
+	if c.Locale != (Locale{}) {
+		catalogs.locales[c.Language] = c.Locale
+	}
+	return nil
+}
+
+// MustRegisterCatalog registers the catalog and panics when a template is invalid,
+// it's used to register the catalogs shipped with the packages.
+func MustRegisterCatalog(c Catalog) {
+	if err := RegisterCatalog(c); err != nil {
+		panic(err)
+	}
+}
+
+// SetLanguage sets the language of the messages, a catalog of the language must be registered.
+func SetLanguage(language string) error {
+	catalogs.mu.Lock()
+	defer catalogs.mu.Unlock()
+
+	if _, ok := catalogs.templates[language]; !ok && language != DefaultLanguage {
+		return fmt.Errorf("no catalog registered for the language %q", language)
+	}
+	catalogs.language = language
+	return nil
+}
+
+// Language returns the language of the messages.
+func Language() string {
+	catalogs.mu.RLock()
+	defer catalogs.mu.RUnlock()
+	return catalogs.language
+}
+
+// Message renders the message with the catalog of the current language, with the default catalog when the
+// message is missing from the catalog or can't be rendered with the parameters. The ID of the message is
+// returned when no catalog renders it.
+func Message(id MessageID, params Params) string {
+	catalogs.mu.RLock()
+	defer catalogs.mu.RUnlock()
+
+	languages := []string{catalogs.language}
+	if catalogs.language != DefaultLanguage {
+		languages = append(languages, DefaultLanguage)
+	}
+	for _, language := range languages {
+		tmpl, ok := catalogs.templates[language][id]
+		if !ok {
+			continue
+		}
+		locale := catalogs.locales[language]
+
+		var buf bytes.Buffer
+		if err := template.Must(tmpl.Clone()).Funcs(localeFuncs(locale)).Execute(&buf, params); err == nil {
+			return buf.String()
+		}
+	}
+	return string(id)
+}
+
+// localeFuncs returns the template functions formatting the parameters with the locale.
+func localeFuncs(locale Locale) template.FuncMap {
+	return template.FuncMap{
+		"number": func(v interface{}) string { return locale.FormatNumber(fmt.Sprint(v)) },
+		"coins":  func(v interface{}) string { return locale.FormatCoins(fmt.Sprint(v)) },
+		"time":   locale.FormatTime,
+	}
+}
+
+// FormatTime formats the time with the time layout of the locale.
+func (l Locale) FormatTime(t time.Time) string {
+	layout := l.TimeLayout
+	if layout == "" {
+		layout = time.RFC1123
+	}
+	return t.Format(layout)
+}
+
+// FormatNumber formats a decimal number with the separators of the locale,
+// the value is returned unchanged when it's not a decimal number.
+func (l Locale) FormatNumber(number string) string {
+	sign, digits := "", number
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+	integer, fraction, hasFraction := strings.Cut(digits, ".")
+	if integer == "" || strings.Trim(integer, "0123456789") != "" || strings.Trim(fraction, "0123456789") != "" {
+		return number
+	}
+
+	var b strings.Builder
+	b.WriteString(sign)
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			b.WriteString(l.GroupSeparator)
+		}
+		b.WriteRune(digit)
+	}
+	if hasFraction {
+		separator := l.DecimalSeparator
+		if separator == "" {
+			separator = "."
+		}
+		b.WriteString(separator)
+		b.WriteString(fraction)
+	}
+	return b.String()
+}
+
+// coinRegexp matches the amount and the denom of a coin, the denom of a coin in a display unit
+// is separated from the amount by a space.
+var coinRegexp = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)( ?[a-zA-Z][a-zA-Z0-9/:._-]*)$`)
+
+// FormatCoins formats the amounts of coins like "1000000stake,10token" or "1.5 SPN, 10 token" with the separators
+// of the locale, the coins are separated by a comma and a space. The value is returned unchanged when it's not coins.
+func (l Locale) FormatCoins(coins string) string {
+	if coins == "" {
+		return coins
+	}
+	formatted := strings.Split(coins, ",")
+	for i, coin := range formatted {
+		match := coinRegexp.FindStringSubmatch(strings.TrimSpace(coin))
+		if match == nil {
+			return coins
+		}
+		formatted[i] = l.FormatNumber(match[1]) + match[2]
+	}
+	return strings.Join(formatted, ", ")
+}
@@ -0,0 +1,103 @@
+package events_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+const (
+	msgLaunched events.MessageID = "test.launched"
+	msgFee      events.MessageID = "test.fee"
+	msgUnknown  events.MessageID = "test.unknown"
+)
+
+func TestMessageCatalog(t *testing.T) {
+	require.NoError(t, events.RegisterCatalog(events.Catalog{
+		Language: events.DefaultLanguage,
+		Messages: map[events.MessageID]string{
+			msgLaunched: "Chain {{.LaunchID}} launched on {{time .LaunchTime}} with {{number .Validators}} validators",
+			msgFee:      "The request fee is {{.Fee}}",
+		},
+	}))
+	require.NoError(t, events.RegisterCatalog(events.Catalog{
+		Language: "xx",
+		Locale: events.Locale{
+			TimeLayout:       "02.01.2006 15:04",
+			DecimalSeparator: ",",
+			GroupSeparator:   ".",
+		},
+		Messages: map[events.MessageID]string{
+			msgLaunched: "Kette {{.LaunchID}} gestartet am {{time .LaunchTime}} mit {{number .Validators}} Validatoren",
+		},
+	}))
+	t.Cleanup(func() { require.NoError(t, events.SetLanguage(events.DefaultLanguage)) })
+
+	params := events.Params{
+		"LaunchID":   42,
+		"LaunchTime": time.Date(2022, 5, 1, 12, 30, 0, 0, time.UTC),
+		"Validators": 1500,
+		"Fee":        "1000000stake",
+	}
+
+	t.Run("default language", func(t *testing.T) {
+		require.Equal(t,
+			"Chain 42 launched on Sun, 01 May 2022 12:30:00 UTC with 1,500 validators",
+			events.Message(msgLaunched, params),
+		)
+	})
+
+	t.Run("registered language", func(t *testing.T) {
+		require.NoError(t, events.SetLanguage("xx"))
+		require.Equal(t, "xx", events.Language())
+
+		require.Equal(t,
+			"Kette 42 gestartet am 01.05.2022 12:30 mit 1.500 Validatoren",
+			events.Message(msgLaunched, params),
+		)
+
+		// the messages missing from the catalog are rendered in the default language
+		require.Equal(t, "The request fee is 1000000stake", events.Message(msgFee, params))
+	})
+
+	t.Run("message without template", func(t *testing.T) {
+		require.Equal(t, string(msgUnknown), events.Message(msgUnknown, params))
+	})
+
+	t.Run("missing parameter", func(t *testing.T) {
+		require.NoError(t, events.SetLanguage("xx"))
+
+		// the default catalog is used when the message can't be rendered, the ID without it
+		require.Equal(t, string(msgFee), events.Message(msgFee, nil))
+	})
+
+	t.Run("language without catalog", func(t *testing.T) {
+		require.EqualError(t, events.SetLanguage("zz"), `no catalog registered for the language "zz"`)
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		err := events.RegisterCatalog(events.Catalog{
+			Language: "xx",
+			Messages: map[events.MessageID]string{msgFee: "{{.Fee"},
+		})
+		require.ErrorContains(t, err, "invalid message test.fee of the xx catalog")
+	})
+}
+
+func TestLocaleFormat(t *testing.T) {
+	locale := events.Locale{DecimalSeparator: ",", GroupSeparator: "."}
+
+	require.Equal(t, "1.234.567", locale.FormatNumber("1234567"))
+	require.Equal(t, "-1.234,5", locale.FormatNumber("-1234.5"))
+	require.Equal(t, "123", locale.FormatNumber("123"))
+	require.Equal(t, "foo", locale.FormatNumber("foo"))
+
+	require.Equal(t, "1.000.000stake, 10ibc/ABC", locale.FormatCoins("1000000stake,10ibc/ABC"))
+	require.Equal(t, "1.000,5 SPN, 10 token", locale.FormatCoins("1000.5 SPN, 10 token"))
+	require.Equal(t, "not coins", locale.FormatCoins("not coins"))
+
+	require.Equal(t, "Sun, 01 May 2022 12:30:00 UTC", events.Locale{}.FormatTime(time.Date(2022, 5, 1, 12, 30, 0, 0, time.UTC)))
+}
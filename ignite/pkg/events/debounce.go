@@ -0,0 +1,186 @@
+package events
+
+import (
+	"sync"
+	"time"
+)
+
+type (
+	// Debouncer sends events to a bus coalescing the ongoing events sent in rapid succession.
+	// An ongoing event is sent when no ongoing event with the same key has been sent during the
+	// minimum interval, otherwise it replaces the pending event of the key, sent at the end of the interval.
+	// Other events are sent immediately after the pending events, an event never precedes an ongoing
+	// event sent before it. A done or failed event finishes the operation of its key, the pending ongoing
+	// event of the key is dropped as it's outdated. The intervals are measured with the clock of the bus.
+	// A debouncer is safe to use concurrently.
+	Debouncer struct {
+		bus       Bus
+		interval  time.Duration
+		intervals map[string]time.Duration
+		key       func(Event) string
+
+		mu   sync.Mutex
+		keys map[string]*debouncedKey
+		// order is the order of the keys with a pending event
+		order []string
+	}
+
+	// DebouncerOption configures a debouncer.
+	DebouncerOption func(*Debouncer)
+
+	debouncedKey struct {
+		lastSent time.Time
+		pending  *Event
+
+		// stop stops the wait for the end of the interval of the pending event
+		stop chan struct{}
+	}
+)
+
+// DebounceKey sets the function returning the key of an event, ongoing events with the same key are coalesced.
+// By default, the key is the description of the event.
+func DebounceKey(key func(Event) string) DebouncerOption {
+	return func(d *Debouncer) {
+		d.key = key
+	}
+}
+
+// DebounceKeyInterval sets the minimum interval between the ongoing events of a key.
+func DebounceKeyInterval(key string, interval time.Duration) DebouncerOption {
+	return func(d *Debouncer) {
+		d.intervals[key] = interval
+	}
+}
+
+// NewDebouncer creates a debouncer sending at most one ongoing event per key every interval to the bus.
+func NewDebouncer(bus Bus, interval time.Duration, options ...DebouncerOption) *Debouncer {
+	d := &Debouncer{
+		bus:       bus,
+		interval:  interval,
+		intervals: make(map[string]time.Duration),
+		key:       func(e Event) string { return e.Description },
+		keys:      make(map[string]*debouncedKey),
+	}
+	for _, apply := range options {
+		apply(d)
+	}
+	return d
+}
+
+// Send sends the event to the bus, or delays it when it's an ongoing event sent too soon after the previous one.
+func (d *Debouncer) Send(e Event) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	key := d.key(e)
+	if !e.IsOngoing() {
+		if e.Status == StatusDone || e.Status == StatusFailed {
+			d.drop(key)
+		}
+		d.flush()
+		d.bus.Send(e)
+		return
+	}
+
+	k, ok := d.keys[key]
+	if !ok {
+		k = &debouncedKey{}
+		d.keys[key] = k
+	}
+
+	interval := d.keyInterval(key)
+	elapsed := d.bus.now().Sub(k.lastSent)
+	if k.pending == nil && elapsed >= interval {
+		k.lastSent = d.bus.now()
+		d.bus.Send(e)
+		return
+	}
+
+	if k.pending == nil {
+		d.order = append(d.order, key)
+		k.stop = make(chan struct{})
+		go d.waitPending(key, k.stop, d.bus.after(interval-elapsed))
+	}
+	k.pending = &e
+}
+
+// Flush sends the pending events to the bus.
+func (d *Debouncer) Flush() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.flush()
+}
+
+func (d *Debouncer) keyInterval(key string) time.Duration {
+	if interval, ok := d.intervals[key]; ok {
+		return interval
+	}
+	return d.interval
+}
+
+// waitPending sends the pending event of the key at the end of its interval unless the wait is stopped.
+func (d *Debouncer) waitPending(key string, stop chan struct{}, elapsed <-chan time.Time) {
+	select {
+	case <-elapsed:
+		d.sendPending(key, stop)
+	case <-stop:
+	}
+}
+
+// sendPending sends the pending event of the key at the end of its interval.
+func (d *Debouncer) sendPending(key string, stop chan struct{}) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// the event is already sent or dropped when the wait is stopped
+	k := d.keys[key]
+	if k == nil || k.pending == nil || k.stop != stop {
+		return
+	}
+
+	// the events pending before the one of the key are sent first to keep the order
+	for len(d.order) > 0 {
+		next := d.order[0]
+		d.send(next)
+		if next == key {
+			return
+		}
+	}
+}
+
+// drop drops the pending event of the key, the lock must be held.
+func (d *Debouncer) drop(key string) {
+	k := d.keys[key]
+	if k == nil || k.pending == nil {
+		return
+	}
+	close(k.stop)
+	k.stop = nil
+	k.pending = nil
+	for i, pending := range d.order {
+		if pending == key {
+			d.order = append(d.order[:i], d.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// flush sends the pending events in the order they were received, the lock must be held.
+func (d *Debouncer) flush() {
+	for len(d.order) > 0 {
+		d.send(d.order[0])
+	}
+}
+
+// send sends the pending event of the key, which is the first of the order, the lock must be held.
+func (d *Debouncer) send(key string) {
+	d.order = d.order[1:]
+
+	k := d.keys[key]
+	close(k.stop)
+	k.stop = nil
+	k.lastSent = d.bus.now()
+	d.bus.Send(*k.pending)
+	k.pending = nil
+}
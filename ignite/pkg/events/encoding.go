@@ -0,0 +1,63 @@
+package events
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// jsonEvent is the JSON representation of an event.
+type jsonEvent struct {
+	Status      string    `json:"status"`
+	Description string    `json:"description"`
+	Progress    *Progress `json:"progress,omitempty"`
+	Tag         string    `json:"tag,omitempty"`
+	Category    string    `json:"category,omitempty"`
+
+	// the durations are in milliseconds
+	DurationMS     int64 `json:"duration_ms,omitempty"`
+	SelfDurationMS int64 `json:"self_duration_ms,omitempty"`
+}
+
+// String returns the name of the status.
+func (s Status) String() string {
+	switch s {
+	case StatusOngoing:
+		return "ongoing"
+	case StatusDone:
+		return "done"
+	case StatusNeutral:
+		return "neutral"
+	case StatusDebug:
+		return "debug"
+	case StatusFailed:
+		return "failed"
+	case StatusWarning:
+		return "warning"
+	default:
+		return "unknown"
+	}
+}
+
+// JSONEncoder writes events as JSON values, one per line.
+type JSONEncoder struct {
+	enc *json.Encoder
+}
+
+// NewJSONEncoder creates a new JSON encoder writing to w.
+func NewJSONEncoder(w io.Writer) JSONEncoder {
+	return JSONEncoder{enc: json.NewEncoder(w)}
+}
+
+// Encode writes the JSON encoding of the event.
+func (e JSONEncoder) Encode(ev Event) error {
+	return e.enc.Encode(jsonEvent{
+		Status:      ev.Status.String(),
+		Description: ev.Description,
+		Progress:    ev.Progress,
+		Tag:         ev.Tag,
+		Category:    ev.Category,
+
+		DurationMS:     ev.Duration.Milliseconds(),
+		SelfDurationMS: ev.SelfDuration.Milliseconds(),
+	})
+}
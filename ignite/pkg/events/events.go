@@ -3,10 +3,15 @@
 package events
 
 import (
+	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/gookit/color"
+
+	"github.com/ignite/cli/ignite/pkg/xtime"
 )
 
 type (
@@ -23,6 +28,23 @@ type (
 
 		// Icon of the text.
 		Icon string
+
+		// Progress of the multi-item operation the event belongs to, nil for ordinary events.
+		Progress *Progress
+
+		// Tag attributes the event to an item of a concurrent operation, like the launch ID of a batch.
+		Tag string
+
+		// Category of the operation the event belongs to, the consumers of a bus can filter the events by category.
+		// The events without category are received by every consumer.
+		Category string
+
+		// Duration of the operation the done event ends, zero when the operation isn't timed.
+		Duration time.Duration
+
+		// SelfDuration is the duration of the operation without its nested timed operations,
+		// the sum of the self durations of the done events doesn't count the nested operations twice.
+		SelfDuration time.Duration
 	}
 
 	// Status shows if state is ongoing or completed.
@@ -36,6 +58,21 @@ const (
 	StatusOngoing Status = iota
 	StatusDone
 	StatusNeutral
+	// StatusDebug is used for events only relevant when debugging, they're not displayed to end users by default.
+	StatusDebug
+	// StatusFailed is used for events reporting an operation that didn't succeed.
+	StatusFailed
+	// StatusWarning is used for events reporting an issue that doesn't prevent the operation from succeeding.
+	StatusWarning
+)
+
+// Categories of the events emitted by the network services.
+const (
+	CategoryBuild   = "build"
+	CategoryGenesis = "genesis"
+	CategoryNetwork = "network"
+	CategoryPeers   = "peers"
+	CategoryNode    = "node"
 )
 
 // TextColor sets the text color
@@ -52,6 +89,13 @@ func Icon(icon string) Option {
 	}
 }
 
+// Category sets the category of the event.
+func Category(category string) Option {
+	return func(e *Event) {
+		e.Category = category
+	}
+}
+
 // New creates a new event with given config.
 func New(status Status, description string, options ...Option) Event {
 	ev := Event{Status: status, Description: description}
@@ -71,6 +115,21 @@ func NewNeutral(description string) Event {
 	return New(StatusNeutral, description)
 }
 
+// NewDebug creates a new StatusDebug event.
+func NewDebug(description string) Event {
+	return New(StatusDebug, description)
+}
+
+// NewFailed creates a new StatusFailed event.
+func NewFailed(description string) Event {
+	return New(StatusFailed, description)
+}
+
+// NewWarning creates a new StatusWarning event.
+func NewWarning(description string) Event {
+	return New(StatusWarning, description)
+}
+
 // NewDone creates a new StatusDone event.
 func NewDone(description, icon string) Event {
 	return New(StatusDone, description, Icon(icon))
@@ -84,23 +143,76 @@ func (e Event) IsOngoing() bool {
 // Text returns the text state of event.
 func (e Event) Text() string {
 	text := e.Description
+	if e.Progress != nil {
+		text = fmt.Sprintf("%s (%s)", text, e.Progress)
+	}
 	if e.IsOngoing() {
-		text = fmt.Sprintf("%s...", e.Description)
+		text = fmt.Sprintf("%s...", text)
+	}
+	if e.Status == StatusDone && e.Duration > 0 {
+		text = fmt.Sprintf("%s (took %s)", text, formatDuration(e.Duration))
+	}
+	if e.Tag != "" {
+		text = fmt.Sprintf("[%s] %s", e.Tag, text)
 	}
 	return e.TextColor.Render(text)
 }
 
+// DefaultBufferSize is the default number of events buffered for the consumer of a bus.
+const DefaultBufferSize = 1024
+
 // Bus is a send/receive event bus.
+// The sends never block: the events sent while the buffer is full or after the shutdown are dropped,
+// the result of an operation matters more than the display of its progress.
 type (
 	Bus struct {
-		evchan chan Event
-		buswg  *sync.WaitGroup
+		evchan     chan Event
+		buswg      *sync.WaitGroup
+		state      *busState
+		tag        string
+		category   string
+		categories categoryFilter
+		clock      xtime.Clock
 	}
 
 	BusOption func(*Bus)
 )
 
+// busState is the state shared by the copies of a bus.
+type busState struct {
+	mu          sync.RWMutex
+	closed      bool
+	dropped     uint64
+	subscribers []subscriber
+}
+
+// subscriber receives the events of its categories.
+type subscriber struct {
+	evchan     chan Event
+	categories categoryFilter
+}
+
+// categoryFilter is a set of categories, an empty filter matches all the events.
+type categoryFilter map[string]bool
+
+func newCategoryFilter(categories []string) categoryFilter {
+	if len(categories) == 0 {
+		return nil
+	}
+	filter := make(categoryFilter, len(categories))
+	for _, category := range categories {
+		filter[category] = true
+	}
+	return filter
+}
+
+// match checks if the event is in the categories of the filter, the events without category always match.
+func (f categoryFilter) match(e Event) bool {
+	return len(f) == 0 || e.Category == "" || f[e.Category]
+}
+
 // WithWaitGroup sets wait group which is blocked if events bus is not empty.
+// The consumer must call Done for every received event.
 func WithWaitGroup(wg *sync.WaitGroup) BusOption {
 	return func(bus *Bus) {
 		bus.buswg = wg
@@ -114,10 +226,26 @@ func WithCustomBufferSize(size int) BusOption {
 	}
 }
 
+// WithCategories only sends the events of the categories and the events without category
+// to the consumer of the bus, the other events are still sent to the subscribers.
+func WithCategories(categories ...string) BusOption {
+	return func(bus *Bus) {
+		bus.categories = newCategoryFilter(categories)
+	}
+}
+
+// WithClock sets the clock measuring the duration of the timed operations and the intervals of the debouncers.
+func WithClock(clock xtime.Clock) BusOption {
+	return func(bus *Bus) {
+		bus.clock = clock
+	}
+}
+
 // NewBus creates a new event bus to send/receive events.
 func NewBus(options ...BusOption) Bus {
 	bus := Bus{
-		evchan: make(chan Event),
+		evchan: make(chan Event, DefaultBufferSize),
+		state:  &busState{},
 	}
 
 	for _, apply := range options {
@@ -127,15 +255,115 @@ func NewBus(options ...BusOption) Bus {
 	return bus
 }
 
+// WithTag returns a copy of the bus tagging the events sent without tag.
+// The copy shares the channel of the bus, the events of concurrent operations stay attributable.
+func (b Bus) WithTag(tag string) Bus {
+	b.tag = tag
+	return b
+}
+
+// WithCategory returns a copy of the bus setting the category of the events sent without category.
+// The copy shares the channel and the subscribers of the bus.
+func (b Bus) WithCategory(category string) Bus {
+	b.category = category
+	return b
+}
+
+// now returns the time of the clock of the bus, the system time by default.
+func (b Bus) now() time.Time {
+	if b.clock == nil {
+		return time.Now()
+	}
+	return b.clock.Now()
+}
+
+// after waits for the duration to elapse on the clock of the bus, the system clock by default.
+func (b Bus) after(d time.Duration) <-chan time.Time {
+	if b.clock == nil {
+		return time.After(d)
+	}
+	return b.clock.After(d)
+}
+
 // Send sends a new event to bus.
+// The event is dropped when the buffer of the bus is full or when the bus is shut down.
 func (b Bus) Send(e Event) {
 	if b.evchan == nil {
 		return
 	}
-	if b.buswg != nil {
-		b.buswg.Add(1)
+
+	if e.Tag == "" {
+		e.Tag = b.tag
+	}
+	if e.Category == "" {
+		e.Category = b.category
+	}
+
+	b.state.mu.RLock()
+	defer b.state.mu.RUnlock()
+
+	if b.state.closed {
+		atomic.AddUint64(&b.state.dropped, 1)
+		return
+	}
+	for _, s := range b.state.subscribers {
+		if s.categories.match(e) {
+			b.deliver(s.evchan, e, nil)
+		}
+	}
+	if b.categories.match(e) {
+		b.deliver(b.evchan, e, b.buswg)
 	}
-	b.evchan <- e
+}
+
+// deliver sends the event to the channel without blocking, the read lock of the state must be held.
+func (b Bus) deliver(evchan chan Event, e Event, wg *sync.WaitGroup) {
+	if wg != nil {
+		wg.Add(1)
+	}
+	select {
+	case evchan <- e:
+	default:
+		// the consumer is too slow or gone
+		if wg != nil {
+			wg.Done()
+		}
+		atomic.AddUint64(&b.state.dropped, 1)
+	}
+}
+
+// Subscribe returns a channel receiving the events of the categories sent after the subscription and
+// the events without category, all the events are received without categories. Each subscriber receives
+// its events once, independently of the consumer of the bus and of the other subscribers. The events are
+// dropped when the subscriber is too slow like for the consumer of the bus, the wait group of the bus
+// doesn't track them. The channel is closed when the bus is shut down.
+func (b Bus) Subscribe(categories ...string) <-chan Event {
+	evchan := make(chan Event, cap(b.evchan))
+	if b.evchan == nil {
+		close(evchan)
+		return evchan
+	}
+
+	b.state.mu.Lock()
+	defer b.state.mu.Unlock()
+
+	if b.state.closed {
+		close(evchan)
+		return evchan
+	}
+	b.state.subscribers = append(b.state.subscribers, subscriber{
+		evchan:     evchan,
+		categories: newCategoryFilter(categories),
+	})
+	return evchan
+}
+
+// Dropped returns the number of events dropped by the bus.
+func (b Bus) Dropped() uint64 {
+	if b.state == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&b.state.dropped)
 }
 
 // Events returns go channel with Event accessible only for read.
@@ -143,10 +371,36 @@ func (b *Bus) Events() <-chan Event {
 	return b.evchan
 }
 
-// Shutdown shutdowns event bus.
-func (b Bus) Shutdown() {
+// Shutdown shutdowns event bus, the events sent after the shutdown are dropped.
+// The consumer can still receive the pending events. When the bus has a wait group, Shutdown waits
+// for the consumer to handle the pending events until the context is done.
+func (b Bus) Shutdown(ctx context.Context) {
 	if b.evchan == nil {
 		return
 	}
+
+	b.state.mu.Lock()
+	if b.state.closed {
+		b.state.mu.Unlock()
+		return
+	}
+	b.state.closed = true
 	close(b.evchan)
+	for _, s := range b.state.subscribers {
+		close(s.evchan)
+	}
+	b.state.mu.Unlock()
+
+	if b.buswg == nil {
+		return
+	}
+	drained := make(chan struct{})
+	go func() {
+		b.buswg.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+	case <-ctx.Done():
+	}
 }
@@ -1,12 +1,19 @@
 package events_test
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/gookit/color"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/xtime"
 )
 
 func TestBusSend(t *testing.T) {
@@ -53,7 +60,7 @@ func TestBusSend(t *testing.T) {
 			if tt.bus.Events() != nil {
 				require.Equal(t, tt.event, <-tt.bus.Events())
 			}
-			tt.bus.Shutdown()
+			tt.bus.Shutdown(context.Background())
 		})
 	}
 }
@@ -78,11 +85,72 @@ func TestBusShutdown(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.bus.Shutdown()
+			tt.bus.Shutdown(context.Background())
 		})
 	}
 }
 
+func TestBusDrop(t *testing.T) {
+	bus := events.NewBus(events.WithCustomBufferSize(1))
+
+	// nobody consumes the events, the sends past the buffer are dropped without blocking
+	for i := 0; i < 3; i++ {
+		bus.Send(events.NewOngoing(fmt.Sprintf("event %d", i)))
+	}
+	require.EqualValues(t, 2, bus.Dropped())
+
+	bus.Shutdown(context.Background())
+	bus.Send(events.NewOngoing("after shutdown"))
+	require.EqualValues(t, 3, bus.Dropped())
+
+	// the pending event is still delivered after the shutdown
+	require.Equal(t, events.NewOngoing("event 0"), <-bus.Events())
+	_, ok := <-bus.Events()
+	require.False(t, ok)
+}
+
+func TestBusShutdownDrain(t *testing.T) {
+	t.Run("pending events handled", func(t *testing.T) {
+		var (
+			wg       sync.WaitGroup
+			bus      = events.NewBus(events.WithWaitGroup(&wg))
+			received []events.Event
+		)
+		bus.Send(events.NewOngoing("event 0"))
+		bus.Send(events.NewOngoing("event 1"))
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for e := range bus.Events() {
+				received = append(received, e)
+				wg.Done()
+			}
+		}()
+
+		bus.Shutdown(context.Background())
+		<-done
+		require.Len(t, received, 2)
+		require.Zero(t, bus.Dropped())
+	})
+
+	t.Run("consumer gone", func(t *testing.T) {
+		var (
+			wg  sync.WaitGroup
+			bus = events.NewBus(events.WithWaitGroup(&wg))
+		)
+		bus.Send(events.NewOngoing("never consumed"))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		bus.Shutdown(ctx)
+		require.ErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+
+		// shutting down twice is a no-op
+		bus.Shutdown(context.Background())
+	})
+}
+
 func TestEventIsOngoing(t *testing.T) {
 	type fields struct {
 		status      events.Status
@@ -192,7 +260,7 @@ func TestNewBus(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			bus := events.NewBus()
-			defer bus.Shutdown()
+			defer bus.Shutdown(context.Background())
 			for i := 0; i < 10; i++ {
 				go bus.Send(tt.event)
 				require.Equal(t, tt.event, <-bus.Events())
@@ -200,3 +268,389 @@ func TestNewBus(t *testing.T) {
 		})
 	}
 }
+
+func TestBusWithTag(t *testing.T) {
+	var (
+		buf    = &bytes.Buffer{}
+		bus    = events.NewBus(events.WithCustomBufferSize(10))
+		tagged = bus.WithTag("launch 3")
+	)
+
+	tagged.Send(events.NewOngoing("Launching"))
+	tagged.Send(events.Event{Description: "already tagged", Tag: "launch 4"})
+	bus.Send(events.NewNeutral("untagged"))
+	bus.Shutdown(context.Background())
+
+	var received []events.Event
+	for e := range bus.Events() {
+		received = append(received, e)
+	}
+	require.Len(t, received, 3)
+	require.Equal(t, received[0].TextColor.Render("[launch 3] Launching..."), received[0].Text())
+	require.Equal(t, "launch 4", received[1].Tag)
+	require.Empty(t, received[2].Tag)
+
+	require.NoError(t, events.NewJSONEncoder(buf).Encode(received[0]))
+	require.Equal(t, `{"status":"ongoing","description":"Launching","tag":"launch 3"}
+`, buf.String())
+}
+
+func TestBusSubscribe(t *testing.T) {
+	var (
+		bus     = events.NewBus(events.WithCustomBufferSize(10))
+		genesis = bus.Subscribe(events.CategoryGenesis)
+		node    = bus.Subscribe(events.CategoryNode, events.CategoryPeers)
+		all     = bus.Subscribe()
+		build   = bus.WithCategory(events.CategoryBuild)
+	)
+
+	build.Send(events.NewOngoing("Building"))
+	bus.Send(events.New(events.StatusDone, "Genesis initialized", events.Category(events.CategoryGenesis)))
+	bus.Send(events.NewWarning("uncategorized"))
+	bus.Send(events.New(events.StatusNeutral, "Peer connected", events.Category(events.CategoryPeers)))
+	build.Send(events.New(events.StatusOngoing, "Starting the node", events.Category(events.CategoryNode)))
+	bus.Shutdown(context.Background())
+
+	descriptions := func(evchan <-chan events.Event) (received []string) {
+		for e := range evchan {
+			received = append(received, e.Description)
+		}
+		return received
+	}
+	require.Equal(t, []string{"Genesis initialized", "uncategorized"}, descriptions(genesis))
+	require.Equal(t, []string{"uncategorized", "Peer connected", "Starting the node"}, descriptions(node))
+	allEvents := []string{
+		"Building",
+		"Genesis initialized",
+		"uncategorized",
+		"Peer connected",
+		"Starting the node",
+	}
+	require.Equal(t, allEvents, descriptions(all))
+	require.Equal(t, allEvents, descriptions(bus.Events()))
+
+	// the subscriptions after the shutdown are closed
+	_, ok := <-bus.Subscribe(events.CategoryGenesis)
+	require.False(t, ok)
+}
+
+func TestBusWithCategories(t *testing.T) {
+	var (
+		bus     = events.NewBus(events.WithCustomBufferSize(10), events.WithCategories(events.CategoryGenesis))
+		network = bus.Subscribe(events.CategoryNetwork)
+	)
+
+	bus.Send(events.New(events.StatusOngoing, "Fetching the chain", events.Category(events.CategoryNetwork)))
+	bus.Send(events.New(events.StatusOngoing, "Computing the genesis", events.Category(events.CategoryGenesis)))
+	bus.Send(events.NewWarning("uncategorized"))
+	bus.Shutdown(context.Background())
+
+	var received []events.Event
+	for e := range bus.Events() {
+		received = append(received, e)
+	}
+	require.Len(t, received, 2)
+	require.Equal(t, events.CategoryGenesis, received[0].Category)
+	require.Empty(t, received[1].Category)
+	require.Len(t, network, 2, "the filter of the bus doesn't apply to the subscribers")
+	require.Equal(t, uint64(0), bus.Dropped())
+}
+
+func TestProgressGroup(t *testing.T) {
+	bus := events.NewBus(events.WithCustomBufferSize(10))
+
+	// every update is sent without minimum interval
+	group := bus.StartProgress("Fetching", 3, events.ProgressMinInterval(0))
+	group.Increment()
+	group.Add(5)
+	group.Finish("Fetched")
+	bus.Shutdown(context.Background())
+
+	var progress []events.Progress
+	for e := range bus.Events() {
+		require.NotNil(t, e.Progress)
+		progress = append(progress, *e.Progress)
+	}
+	require.Equal(t, []events.Progress{
+		{Current: 0, Total: 3},
+		{Current: 1, Total: 3},
+		{Current: 3, Total: 3},
+		{Current: 3, Total: 3},
+	}, progress)
+}
+
+func TestJSONEncoder(t *testing.T) {
+	var (
+		buf = &bytes.Buffer{}
+		enc = events.NewJSONEncoder(buf)
+		bus = events.NewBus(events.WithCustomBufferSize(10))
+	)
+
+	bus.Send(events.NewOngoing("Building"))
+	group := bus.StartProgress("Fetching", 2, events.ProgressMinInterval(0))
+	group.Increment()
+	group.Finish("Fetched")
+	bus.Send(events.Event{Status: events.StatusDone, Description: "Built", Duration: 1500 * time.Millisecond, SelfDuration: time.Second})
+	bus.Send(events.NewFailed("Chain stuck"))
+	bus.Shutdown(context.Background())
+
+	for e := range bus.Events() {
+		require.NoError(t, enc.Encode(e))
+	}
+	require.Equal(t, `{"status":"ongoing","description":"Building"}
+{"status":"ongoing","description":"Fetching","progress":{"current":0,"total":2}}
+{"status":"ongoing","description":"Fetching","progress":{"current":1,"total":2}}
+{"status":"done","description":"Fetched","progress":{"current":1,"total":2}}
+{"status":"done","description":"Built","duration_ms":1500,"self_duration_ms":1000}
+{"status":"failed","description":"Chain stuck"}
+`, buf.String())
+}
+
+func TestDebouncer(t *testing.T) {
+	t.Run("coalesce a burst of ongoing events", func(t *testing.T) {
+		var (
+			bus = events.NewBus(events.WithCustomBufferSize(1000))
+			d   = events.NewDebouncer(bus, time.Hour)
+		)
+
+		for i := 0; i < 500; i++ {
+			d.Send(events.NewOngoing(fmt.Sprintf("Downloading %d", i%2)))
+		}
+		d.Send(events.NewDone("Downloaded", ""))
+		bus.Shutdown(context.Background())
+
+		var received []string
+		for e := range bus.Events() {
+			received = append(received, e.Description)
+		}
+		require.Equal(t, []string{
+			"Downloading 0",
+			"Downloading 1",
+			"Downloading 0",
+			"Downloading 1",
+			"Downloaded",
+		}, received)
+	})
+
+	t.Run("send the pending event at the end of the interval", func(t *testing.T) {
+		var (
+			clock = xtime.NewClockMock(time.Unix(1000, 0))
+			bus   = events.NewBus(events.WithCustomBufferSize(10), events.WithClock(clock))
+			d     = events.NewDebouncer(bus, time.Second, events.DebounceKeyInterval("Building", time.Hour))
+		)
+
+		d.Send(events.NewOngoing("Downloading"))
+		d.Send(events.NewOngoing("Building"))
+		d.Send(events.NewOngoing("Downloading"))
+		d.Send(events.NewOngoing("Building"))
+
+		e := <-bus.Events()
+		require.Equal(t, "Downloading", e.Description)
+		e = <-bus.Events()
+		require.Equal(t, "Building", e.Description)
+
+		// only the downloading event is sent after the download interval
+		clock.Add(time.Second)
+		e = <-bus.Events()
+		require.Equal(t, "Downloading", e.Description)
+
+		d.Send(events.NewNeutral("Checked"))
+		bus.Shutdown(context.Background())
+		var received []string
+		for e := range bus.Events() {
+			received = append(received, e.Description)
+		}
+		require.Equal(t, []string{"Building", "Checked"}, received)
+	})
+
+	t.Run("drop the pending event of a finished operation", func(t *testing.T) {
+		var (
+			clock = xtime.NewClockMock(time.Unix(1000, 0))
+			bus   = events.NewBus(events.WithCustomBufferSize(10), events.WithClock(clock))
+			d     = events.NewDebouncer(bus, time.Second, events.DebounceKey(func(e events.Event) string {
+				if strings.HasPrefix(e.Description, "Build") {
+					return "build"
+				}
+				return "download"
+			}))
+		)
+
+		d.Send(events.NewOngoing("Downloading 1"))
+		d.Send(events.NewOngoing("Building 1"))
+		d.Send(events.NewOngoing("Downloading 2"))
+		d.Send(events.NewOngoing("Building 2"))
+		d.Send(events.NewDone("Downloaded", ""))
+
+		// the pending events of the other operations are still sent before the done event
+		var received []string
+		for i := 0; i < 4; i++ {
+			received = append(received, (<-bus.Events()).Description)
+		}
+		require.Equal(t, []string{"Downloading 1", "Building 1", "Building 2", "Downloaded"}, received)
+
+		// no stale ongoing event is sent after the done event
+		clock.Add(time.Second)
+		d.Send(events.NewNeutral("Checked"))
+		bus.Shutdown(context.Background())
+		received = nil
+		for e := range bus.Events() {
+			received = append(received, e.Description)
+		}
+		require.Equal(t, []string{"Checked"}, received)
+	})
+
+	t.Run("keep the order of concurrent events", func(t *testing.T) {
+		var (
+			bus = events.NewBus(events.WithCustomBufferSize(10000))
+			d   = events.NewDebouncer(bus, time.Millisecond)
+			wg  sync.WaitGroup
+		)
+
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				for j := 0; j < 100; j++ {
+					d.Send(events.NewOngoing("Downloading"))
+				}
+			}()
+		}
+		wg.Wait()
+		d.Send(events.NewFailed("Download failed"))
+		bus.Shutdown(context.Background())
+
+		var received []events.Event
+		for e := range bus.Events() {
+			received = append(received, e)
+		}
+		require.Less(t, len(received), 1000)
+		require.Equal(t, events.StatusFailed, received[len(received)-1].Status)
+		for _, e := range received[:len(received)-1] {
+			require.True(t, e.IsOngoing())
+		}
+	})
+}
+
+func TestProgressGroupMinInterval(t *testing.T) {
+	bus := events.NewBus(events.WithCustomBufferSize(1000))
+
+	group := bus.StartProgress("Downloading", 100, events.ProgressMinInterval(time.Hour))
+	for i := 0; i < 100; i++ {
+		group.Increment()
+	}
+	group.Finish("Downloaded")
+
+	// the items processed after the group is finished are ignored
+	group.Increment()
+	bus.Shutdown(context.Background())
+
+	// the pending update is dropped by the done event
+	var received []events.Event
+	for e := range bus.Events() {
+		received = append(received, e)
+	}
+	require.Len(t, received, 2)
+	require.Equal(t, events.StatusOngoing, received[0].Status)
+	require.Equal(t, events.Progress{Current: 0, Total: 100}, *received[0].Progress)
+	require.Equal(t, events.StatusDone, received[1].Status)
+	require.Equal(t, events.Progress{Current: 100, Total: 100}, *received[1].Progress)
+}
+
+func TestTimer(t *testing.T) {
+	var (
+		clock = xtime.NewClockMock(time.Unix(1000, 0))
+		bus   = events.NewBus(events.WithCustomBufferSize(10), events.WithClock(clock))
+	)
+
+	build := bus.StartTimer("Building", events.Category(events.CategoryBuild))
+	clock.Add(10 * time.Second)
+	fetch := build.StartTimer("Fetching the source code")
+	clock.Add(23 * time.Second)
+	fetch.Done("Source code fetched")
+	compile := build.StartTimer("Compiling")
+	clock.Add(time.Minute)
+	compile.Done("Compiled")
+	build.Done("Built", events.Category(events.CategoryBuild))
+	bus.Shutdown(context.Background())
+
+	var done []events.Event
+	for e := range bus.Events() {
+		if e.Status == events.StatusDone {
+			done = append(done, e)
+		}
+	}
+	require.Len(t, done, 3)
+
+	requireText(t, "Source code fetched (took 23s)", done[0])
+	require.Equal(t, 23*time.Second, done[0].Duration)
+	require.Equal(t, 23*time.Second, done[0].SelfDuration)
+
+	requireText(t, "Compiled (took 1m0s)", done[1])
+
+	// the nested operations are excluded from the self duration of the parent
+	requireText(t, "Built (took 1m33s)", done[2])
+	require.Equal(t, 93*time.Second, done[2].Duration)
+	require.Equal(t, 10*time.Second, done[2].SelfDuration)
+	require.Equal(t, events.CategoryBuild, done[2].Category)
+
+	var self time.Duration
+	for _, e := range done {
+		self += e.SelfDuration
+	}
+	require.Equal(t, done[2].Duration, self)
+}
+
+func TestTimerConcurrentNested(t *testing.T) {
+	var (
+		clock = xtime.NewClockMock(time.Unix(1000, 0))
+		bus   = events.NewBus(events.WithCustomBufferSize(10), events.WithClock(clock))
+	)
+
+	// the overlapping nested operations are counted once
+	parent := bus.StartTimer("Preparing")
+	first := parent.StartTimer("Fetching the genesis")
+	second := parent.StartTimer("Fetching the peers")
+	clock.Add(2 * time.Second)
+	second.Done("Peers fetched")
+	clock.Add(3 * time.Second)
+	first.Done("Genesis fetched")
+	clock.Add(500 * time.Millisecond)
+	parent.Done("Prepared")
+
+	// the duration is only reported by the first done event
+	parent.Done("Prepared")
+	bus.Shutdown(context.Background())
+
+	var done []events.Event
+	for e := range bus.Events() {
+		if e.Status == events.StatusDone {
+			done = append(done, e)
+		}
+	}
+	require.Len(t, done, 4)
+	requireText(t, "Prepared (took 5.5s)", done[2])
+	require.Equal(t, 500*time.Millisecond, done[2].SelfDuration)
+	requireText(t, "Prepared", done[3])
+	require.Zero(t, done[3].Duration)
+}
+
+func TestTimerText(t *testing.T) {
+	requireText(t, "Genesis fetched (took 250ms)", events.Event{
+		Status:      events.StatusDone,
+		Description: "Genesis fetched",
+		Duration:    250 * time.Millisecond,
+	})
+
+	// only the done events report a duration
+	requireText(t, "Genesis fetched", events.Event{
+		Status:      events.StatusNeutral,
+		Description: "Genesis fetched",
+		Duration:    time.Second,
+	})
+}
+
+func requireText(t *testing.T, expected string, e events.Event) {
+	t.Helper()
+	require.Equal(t, e.TextColor.Render(expected), e.Text())
+}
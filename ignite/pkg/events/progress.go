@@ -0,0 +1,118 @@
+package events
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Progress represents the progress of a multi-item operation.
+type Progress struct {
+	// Current is the number of items processed.
+	Current int `json:"current"`
+
+	// Total is the number of items to process.
+	Total int `json:"total"`
+}
+
+// String returns the progress as a fraction.
+func (p Progress) String() string {
+	return fmt.Sprintf("%d/%d", p.Current, p.Total)
+}
+
+// DefaultProgressInterval is the default minimum interval between the progress updates of a group.
+const DefaultProgressInterval = 100 * time.Millisecond
+
+// ProgressGroup reports the progress of a multi-item operation through the bus.
+// The progress updates sent in rapid succession are coalesced, the pending update is dropped
+// when the group finishes. A group is safe to use concurrently.
+type ProgressGroup struct {
+	bus         Bus
+	description string
+	progress    Progress
+	minInterval time.Duration
+	debouncer   *Debouncer
+	finished    bool
+	mu          sync.Mutex
+}
+
+// ProgressOption configures a progress group.
+type ProgressOption func(*ProgressGroup)
+
+// ProgressMinInterval sets the minimum interval between the progress updates sent by the group,
+// the updates sent in between are coalesced. A zero interval sends every update.
+// The default interval is DefaultProgressInterval.
+func ProgressMinInterval(interval time.Duration) ProgressOption {
+	return func(g *ProgressGroup) {
+		g.minInterval = interval
+	}
+}
+
+// StartProgress starts a progress group of total items and sends its initial progress.
+func (b Bus) StartProgress(description string, total int, options ...ProgressOption) *ProgressGroup {
+	g := &ProgressGroup{
+		bus:         b,
+		description: description,
+		progress:    Progress{Total: total},
+		minInterval: DefaultProgressInterval,
+	}
+	for _, apply := range options {
+		apply(g)
+	}
+	if g.minInterval > 0 {
+		// the events of the group are the updates of a single operation
+		g.debouncer = NewDebouncer(b, g.minInterval, DebounceKey(func(Event) string { return description }))
+	}
+	g.send(g.event(StatusOngoing, g.description))
+	return g
+}
+
+// Increment sends the progress of the group after one more item is processed.
+func (g *ProgressGroup) Increment() {
+	g.Add(1)
+}
+
+// Add sends the progress of the group after n more items are processed.
+// The items processed after the group is finished are ignored.
+func (g *ProgressGroup) Add(n int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.finished {
+		return
+	}
+	g.progress.Current += n
+	if g.progress.Current > g.progress.Total {
+		g.progress.Current = g.progress.Total
+	}
+	g.send(g.event(StatusOngoing, g.description))
+}
+
+// Finish ends the progress group with a done event.
+func (g *ProgressGroup) Finish(description string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.finished = true
+	g.send(g.event(StatusDone, description))
+}
+
+// send sends the event through the debouncer when the group has a minimum interval.
+func (g *ProgressGroup) send(e Event) {
+	if g.debouncer != nil {
+		g.debouncer.Send(e)
+		return
+	}
+	g.bus.Send(e)
+}
+
+func (g *ProgressGroup) event(status Status, description string) Event {
+	progress := g.progress
+	return New(status, description, withProgress(progress))
+}
+
+func withProgress(progress Progress) Option {
+	return func(e *Event) {
+		e.Progress = &progress
+	}
+}
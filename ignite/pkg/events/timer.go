@@ -0,0 +1,125 @@
+package events
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Timer measures the duration of an operation bracketed by an ongoing event and a done event.
+// The done event of the operation reports its duration, the timers of the nested operations are
+// started from the timer of their parent operation so that the parent excludes them from its own
+// duration. A timer is safe to use concurrently.
+type Timer struct {
+	bus    Bus
+	parent *Timer
+	start  time.Time
+
+	mu       sync.Mutex
+	finished bool
+	// nested are the intervals of the finished nested operations
+	nested []timerInterval
+}
+
+type timerInterval struct {
+	start, end time.Time
+}
+
+// StartTimer sends the ongoing event of an operation and starts measuring its duration.
+func (b Bus) StartTimer(description string, options ...Option) *Timer {
+	t := &Timer{
+		bus:   b,
+		start: b.now(),
+	}
+	b.Send(New(StatusOngoing, description, options...))
+	return t
+}
+
+// StartTimer sends the ongoing event of an operation nested in the operation of the timer
+// and starts measuring its duration.
+func (t *Timer) StartTimer(description string, options ...Option) *Timer {
+	nested := t.bus.StartTimer(description, options...)
+	nested.parent = t
+	return nested
+}
+
+// Elapsed returns the time elapsed since the start of the operation.
+func (t *Timer) Elapsed() time.Duration {
+	return t.bus.now().Sub(t.start)
+}
+
+// Done sends the done event of the operation with its duration. The duration of the operation
+// is only reported once, the calls after the first one send the done event without duration.
+func (t *Timer) Done(description string, options ...Option) {
+	end := t.bus.now()
+
+	t.mu.Lock()
+	finished := t.finished
+	t.finished = true
+	nested := t.nested
+	t.mu.Unlock()
+
+	if finished {
+		t.bus.Send(New(StatusDone, description, options...))
+		return
+	}
+	if t.parent != nil {
+		t.parent.addNested(timerInterval{start: t.start, end: end})
+	}
+
+	duration := end.Sub(t.start)
+	options = append(options, withDuration(duration, duration-overlap(nested)))
+	t.bus.Send(New(StatusDone, description, options...))
+}
+
+func (t *Timer) addNested(i timerInterval) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.nested = append(t.nested, i)
+}
+
+// overlap returns the time covered by the intervals, the concurrent nested operations are counted once.
+func overlap(intervals []timerInterval) time.Duration {
+	if len(intervals) == 0 {
+		return 0
+	}
+	sorted := make([]timerInterval, len(intervals))
+	copy(sorted, intervals)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].start.Before(sorted[j].start) })
+
+	var (
+		total   time.Duration
+		current = sorted[0]
+	)
+	for _, i := range sorted[1:] {
+		if i.start.After(current.end) {
+			total += current.end.Sub(current.start)
+			current = i
+			continue
+		}
+		if i.end.After(current.end) {
+			current.end = i.end
+		}
+	}
+	return total + current.end.Sub(current.start)
+}
+
+func withDuration(duration, self time.Duration) Option {
+	return func(e *Event) {
+		e.Duration = duration
+		e.SelfDuration = self
+	}
+}
+
+// formatDuration rounds the duration for the done messages: to the second from one minute,
+// to the tenth of a second from one second and to the millisecond below.
+func formatDuration(d time.Duration) string {
+	switch {
+	case d >= time.Minute:
+		return d.Round(time.Second).String()
+	case d >= time.Second:
+		return d.Round(100 * time.Millisecond).String()
+	default:
+		return d.Round(time.Millisecond).String()
+	}
+}
@@ -1,6 +1,7 @@
 package gocmd
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -28,6 +29,9 @@ const (
 
 	// CommandModVerify represents go mod "verify" command.
 	CommandModVerify = "verify"
+
+	// CommandEnv represents go "env" command.
+	CommandEnv = "env"
 )
 
 const (
@@ -61,6 +65,21 @@ func ModVerify(ctx context.Context, path string, options ...exec.Option) error {
 	return exec.Exec(ctx, []string{Name(), CommandMod, CommandModVerify}, append(options, exec.StepOption(step.Workdir(path)))...)
 }
 
+// Env returns the values of the Go environment variables in the order of the names.
+func Env(ctx context.Context, names []string, options ...exec.Option) ([]string, error) {
+	var stdout bytes.Buffer
+	command := append([]string{Name(), CommandEnv}, names...)
+	if err := exec.Exec(ctx, command, append(options, exec.StepOption(step.Stdout(&stdout)))...); err != nil {
+		return nil, err
+	}
+
+	values := strings.Split(strings.TrimSuffix(stdout.String(), "\n"), "\n")
+	if len(values) != len(names) {
+		return nil, fmt.Errorf("expected %d Go environment values, got %d", len(names), len(values))
+	}
+	return values, nil
+}
+
 // BuildPath runs go install on cmd folder with options.
 func BuildPath(ctx context.Context, output, binary, path string, flags []string, options ...exec.Option) error {
 	binaryOutput, err := binaryPath(output, binary)
@@ -0,0 +1,381 @@
+// Package jsondiff compares JSON documents structurally.
+package jsondiff
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// maxValueLength is the maximum length of the values rendered in the differences.
+const maxValueLength = 64
+
+// Kind is the kind of a difference between two JSON documents.
+type Kind int
+
+const (
+	// KindValue is a value different in the documents.
+	KindValue Kind = iota
+
+	// KindType is a value with a different type in the documents, e.g. an object and an array.
+	KindType
+
+	// KindLength is an array with a different length in the documents.
+	KindLength
+
+	// KindOnlyInFirst is an object key only present in the first document.
+	KindOnlyInFirst
+
+	// KindOnlyInSecond is an object key only present in the second document.
+	KindOnlyInSecond
+)
+
+// Difference is a difference between two JSON documents.
+type Difference struct {
+	// Path is the path of the different value, e.g. "app_state.genutil.gen_txs[3].body.memo".
+	Path string
+
+	Kind Kind
+
+	// First and Second are the short representations of the values in the documents.
+	First, Second string
+}
+
+// String returns the difference with the documents named first and second.
+func (d Difference) String() string {
+	return d.Format("first", "second")
+}
+
+// Format returns the difference with the names of the documents.
+func (d Difference) Format(first, second string) string {
+	path := d.Path
+	if path == "" {
+		path = "the document"
+	}
+	switch d.Kind {
+	case KindType:
+		return fmt.Sprintf("%s has a different type: %s in the %s, %s in the %s", path, d.First, first, d.Second, second)
+	case KindLength:
+		return fmt.Sprintf("%s has a different length: %s in the %s, %s in the %s", path, d.First, first, d.Second, second)
+	case KindOnlyInFirst:
+		return fmt.Sprintf("%s is only in the %s", path, first)
+	case KindOnlyInSecond:
+		return fmt.Sprintf("%s is only in the %s", path, second)
+	default:
+		return fmt.Sprintf("%s differs: %s in the %s, %s in the %s", path, d.First, first, d.Second, second)
+	}
+}
+
+// Compare compares the JSON documents read from first and second, report is called for each difference.
+// The documents are streamed, only the objects with keys in a different order in the documents are
+// decoded in memory to be compared regardless of the order.
+// Compare stops comparing when report returns false.
+func Compare(first, second io.Reader, report func(Difference) bool) error {
+	c := comparer{report: report}
+	err := c.compare(newDecoder(first), newDecoder(second), "")
+	if errors.Is(err, errStop) {
+		return nil
+	}
+	return err
+}
+
+// errStop is returned to stop the comparison once report returns false.
+var errStop = errors.New("stop")
+
+type comparer struct {
+	report func(Difference) bool
+}
+
+func newDecoder(r io.Reader) *json.Decoder {
+	d := json.NewDecoder(r)
+	d.UseNumber()
+	return d
+}
+
+func (c comparer) add(d Difference) error {
+	if !c.report(d) {
+		return errStop
+	}
+	return nil
+}
+
+// compare compares the next values of the decoders.
+func (c comparer) compare(first, second *json.Decoder, path string) error {
+	t1, err := first.Token()
+	if err != nil {
+		return err
+	}
+	t2, err := second.Token()
+	if err != nil {
+		return err
+	}
+
+	d1, isDelim1 := t1.(json.Delim)
+	d2, isDelim2 := t2.(json.Delim)
+	switch {
+	case isDelim1 && isDelim2 && d1 == d2 && d1 == '{':
+		return c.compareObjects(first, second, path)
+	case isDelim1 && isDelim2 && d1 == d2 && d1 == '[':
+		return c.compareArrays(first, second, path)
+	case !isDelim1 && !isDelim2:
+		if t1 == t2 {
+			return nil
+		}
+		kind := KindValue
+		if typeName(t1) != typeName(t2) {
+			kind = KindType
+		}
+		return c.add(Difference{Path: path, Kind: kind, First: render(t1), Second: render(t2)})
+	}
+
+	// the values have a different type, the remaining of the composite values are skipped
+	if err := skipComposite(first, t1); err != nil {
+		return err
+	}
+	if err := skipComposite(second, t2); err != nil {
+		return err
+	}
+	return c.add(Difference{Path: path, Kind: KindType, First: typeName(t1), Second: typeName(t2)})
+}
+
+// compareObjects compares the members of the objects once their opening delimiter is read.
+func (c comparer) compareObjects(first, second *json.Decoder, path string) error {
+	for first.More() && second.More() {
+		k1, err := readKey(first)
+		if err != nil {
+			return err
+		}
+		k2, err := readKey(second)
+		if err != nil {
+			return err
+		}
+
+		if k1 == k2 {
+			if err := c.compare(first, second, joinKey(path, k1)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// the keys are in a different order, the remaining members are compared in memory
+		m1, err := readMembers(first, k1)
+		if err != nil {
+			return err
+		}
+		m2, err := readMembers(second, k2)
+		if err != nil {
+			return err
+		}
+		return c.compareMembers(m1, m2, path)
+	}
+
+	// the remaining members are only in one document
+	if err := c.skipMembers(first, path, KindOnlyInFirst); err != nil {
+		return err
+	}
+	if err := c.skipMembers(second, path, KindOnlyInSecond); err != nil {
+		return err
+	}
+	return readClosing(first, second)
+}
+
+// compareMembers compares the members of objects decoded in memory.
+func (c comparer) compareMembers(m1, m2 map[string]json.RawMessage, path string) error {
+	keys := make([]string, 0, len(m1)+len(m2))
+	for key := range m1 {
+		keys = append(keys, key)
+	}
+	for key := range m2 {
+		if _, ok := m1[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		v1, ok1 := m1[key]
+		v2, ok2 := m2[key]
+		var err error
+		switch {
+		case !ok2:
+			err = c.add(Difference{Path: joinKey(path, key), Kind: KindOnlyInFirst})
+		case !ok1:
+			err = c.add(Difference{Path: joinKey(path, key), Kind: KindOnlyInSecond})
+		default:
+			err = c.compare(newDecoder(bytes.NewReader(v1)), newDecoder(bytes.NewReader(v2)), joinKey(path, key))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compareArrays compares the elements of the arrays once their opening delimiter is read.
+func (c comparer) compareArrays(first, second *json.Decoder, path string) error {
+	var n1, n2 int
+	for first.More() && second.More() {
+		if err := c.compare(first, second, fmt.Sprintf("%s[%d]", path, n1)); err != nil {
+			return err
+		}
+		n1++
+		n2++
+	}
+	for ; first.More(); n1++ {
+		if err := skipValue(first); err != nil {
+			return err
+		}
+	}
+	for ; second.More(); n2++ {
+		if err := skipValue(second); err != nil {
+			return err
+		}
+	}
+	if err := readClosing(first, second); err != nil {
+		return err
+	}
+	if n1 != n2 {
+		return c.add(Difference{Path: path, Kind: KindLength, First: strconv.Itoa(n1), Second: strconv.Itoa(n2)})
+	}
+	return nil
+}
+
+// skipMembers skips the remaining members of an object and reports their keys.
+func (c comparer) skipMembers(d *json.Decoder, path string, kind Kind) error {
+	for d.More() {
+		key, err := readKey(d)
+		if err != nil {
+			return err
+		}
+		if err := skipValue(d); err != nil {
+			return err
+		}
+		if err := c.add(Difference{Path: joinKey(path, key), Kind: kind}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readMembers reads the remaining members of an object once the key of the current member is read.
+func readMembers(d *json.Decoder, key string) (map[string]json.RawMessage, error) {
+	members := make(map[string]json.RawMessage)
+	for {
+		var value json.RawMessage
+		if err := d.Decode(&value); err != nil {
+			return nil, err
+		}
+		members[key] = value
+
+		if !d.More() {
+			break
+		}
+		var err error
+		if key, err = readKey(d); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := d.Token(); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func readKey(d *json.Decoder) (string, error) {
+	t, err := d.Token()
+	if err != nil {
+		return "", err
+	}
+	key, ok := t.(string)
+	if !ok {
+		return "", fmt.Errorf("invalid object key %v", t)
+	}
+	return key, nil
+}
+
+// readClosing reads the closing delimiters of the composite values of both decoders.
+func readClosing(first, second *json.Decoder) error {
+	if _, err := first.Token(); err != nil {
+		return err
+	}
+	_, err := second.Token()
+	return err
+}
+
+// skipValue skips the next value of the decoder.
+func skipValue(d *json.Decoder) error {
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+	return skipComposite(d, t)
+}
+
+// skipComposite skips the remaining of a composite value once its first token is read.
+func skipComposite(d *json.Decoder, t json.Token) error {
+	delim, ok := t.(json.Delim)
+	if !ok || delim == '}' || delim == ']' {
+		return nil
+	}
+	for depth := 1; depth > 0; {
+		t, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+	}
+	return nil
+}
+
+func joinKey(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func typeName(t json.Token) string {
+	switch t := t.(type) {
+	case json.Delim:
+		if t == '{' {
+			return "object"
+		}
+		return "array"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "boolean"
+	default:
+		return "null"
+	}
+}
+
+// render returns a short representation of a scalar value.
+func render(t json.Token) string {
+	var s string
+	switch t := t.(type) {
+	case string:
+		s = strconv.Quote(t)
+	case json.Number:
+		s = t.String()
+	case bool:
+		s = strconv.FormatBool(t)
+	default:
+		s = "null"
+	}
+	if len(s) > maxValueLength {
+		s = s[:maxValueLength] + "..."
+	}
+	return s
+}
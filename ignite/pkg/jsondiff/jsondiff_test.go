@@ -0,0 +1,113 @@
+package jsondiff_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/jsondiff"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name          string
+		first, second string
+		want          []string
+	}{
+		{
+			name:   "same documents",
+			first:  `{"a":1,"b":[1,2,{"c":"d"}]}`,
+			second: `{"a":1,"b":[1,2,{"c":"d"}]}`,
+		},
+		{
+			name:   "keys in a different order",
+			first:  `{"a":1,"b":{"c":true,"d":null},"e":"f"}`,
+			second: `{"b":{"d":null,"c":true},"e":"f","a":1}`,
+		},
+		{
+			name:   "different values",
+			first:  `{"app_state":{"genutil":{"gen_txs":[{"body":{"memo":"a"}},{"body":{"memo":"b"}}]}},"height":1}`,
+			second: `{"app_state":{"genutil":{"gen_txs":[{"body":{"memo":"a"}},{"body":{"memo":"c"}}]}},"height":2}`,
+			want: []string{
+				`app_state.genutil.gen_txs[1].body.memo differs: "b" in the first, "c" in the second`,
+				`height differs: 1 in the first, 2 in the second`,
+			},
+		},
+		{
+			name:   "different values in reordered keys",
+			first:  `{"a":1,"b":2,"c":{"d":[1]}}`,
+			second: `{"a":1,"c":{"d":[2]},"b":3}`,
+			want: []string{
+				`b differs: 2 in the first, 3 in the second`,
+				`c.d[0] differs: 1 in the first, 2 in the second`,
+			},
+		},
+		{
+			name:   "different array length",
+			first:  `{"accounts":[{"a":1},{"a":2},{"a":3}],"b":true}`,
+			second: `{"accounts":[{"a":1}],"b":true}`,
+			want:   []string{`accounts has a different length: 3 in the first, 1 in the second`},
+		},
+		{
+			name:   "different types",
+			first:  `{"a":{"b":[1,2]},"c":"1","d":null}`,
+			second: `{"a":[{"b":1}],"c":1,"d":false}`,
+			want: []string{
+				`a has a different type: object in the first, array in the second`,
+				`c has a different type: "1" in the first, 1 in the second`,
+				`d has a different type: null in the first, false in the second`,
+			},
+		},
+		{
+			name:   "missing keys",
+			first:  `{"a":1,"b":{"c":1,"d":{"e":[1]}}}`,
+			second: `{"a":1,"b":{"c":1},"f":2}`,
+			want: []string{
+				`b.d is only in the first`,
+				`f is only in the second`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			err := jsondiff.Compare(strings.NewReader(tt.first), strings.NewReader(tt.second), func(d jsondiff.Difference) bool {
+				got = append(got, d.String())
+				return true
+			})
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCompareStop(t *testing.T) {
+	var got []jsondiff.Difference
+	err := jsondiff.Compare(
+		strings.NewReader(`[1,2,3,4]`),
+		strings.NewReader(`[5,6,7,8]`),
+		func(d jsondiff.Difference) bool {
+			got = append(got, d)
+			return len(got) < 2
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []jsondiff.Difference{
+		{Path: "[0]", Kind: jsondiff.KindValue, First: "1", Second: "5"},
+		{Path: "[1]", Kind: jsondiff.KindValue, First: "2", Second: "6"},
+	}, got)
+}
+
+func TestCompareInvalid(t *testing.T) {
+	err := jsondiff.Compare(strings.NewReader(`{"a":`), strings.NewReader(`{"a":1}`), func(jsondiff.Difference) bool {
+		return true
+	})
+	require.Error(t, err)
+}
+
+func TestDifferenceFormat(t *testing.T) {
+	d := jsondiff.Difference{Path: "chain_id", Kind: jsondiff.KindValue, First: `"mars-1"`, Second: `"mars-2"`}
+	require.Equal(t, `chain_id differs: "mars-1" in the local genesis, "mars-2" in the reference genesis`,
+		d.Format("local genesis", "reference genesis"))
+}
@@ -0,0 +1,255 @@
+// Package ociregistry fetches the manifests and the blobs of OCI artifacts from the registries
+// implementing the OCI distribution API, with the anonymous token authentication of the public registries.
+package ociregistry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// MediaTypeImageManifest is the media type of an OCI image manifest.
+	MediaTypeImageManifest = "application/vnd.oci.image.manifest.v1+json"
+
+	// MediaTypeDockerManifest is the media type of a Docker image manifest.
+	MediaTypeDockerManifest = "application/vnd.docker.distribution.manifest.v2+json"
+
+	// maxManifestSize is the maximum size of a manifest read from a registry.
+	maxManifestSize = 4 << 20
+)
+
+// ErrInvalidDigest is returned when the content fetched from a registry doesn't match its digest.
+var ErrInvalidDigest = errors.New("the content doesn't match its digest")
+
+// Reference is a reference to an artifact of a registry, registry/repository:tag or registry/repository@digest.
+type Reference struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+// String returns the reference with its digest when set, with its tag otherwise.
+func (r Reference) String() string {
+	if r.Digest != "" {
+		return fmt.Sprintf("%s/%s@%s", r.Registry, r.Repository, r.Digest)
+	}
+	return fmt.Sprintf("%s/%s:%s", r.Registry, r.Repository, r.Tag)
+}
+
+// ParseReference parses a registry/repository:tag or registry/repository@digest reference,
+// the tag is latest when neither a tag nor a digest is set.
+func ParseReference(ref string) (Reference, error) {
+	registry, repository, ok := strings.Cut(ref, "/")
+	if !ok || registry == "" || repository == "" {
+		return Reference{}, fmt.Errorf("invalid reference %q, the format is registry/repository:tag", ref)
+	}
+
+	r := Reference{Registry: registry, Tag: "latest"}
+	if name, digest, ok := strings.Cut(repository, "@"); ok {
+		if !strings.HasPrefix(digest, "sha256:") {
+			return Reference{}, fmt.Errorf("invalid reference %q, only sha256 digests are supported", ref)
+		}
+		r.Repository, r.Tag, r.Digest = name, "", digest
+	} else if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		r.Repository, r.Tag = repository[:i], repository[i+1:]
+	} else {
+		r.Repository = repository
+	}
+	if r.Repository == "" || (r.Digest == "" && r.Tag == "") {
+		return Reference{}, fmt.Errorf("invalid reference %q, the format is registry/repository:tag", ref)
+	}
+	return r, nil
+}
+
+// Descriptor describes a blob of an artifact.
+type Descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Manifest is the manifest of an artifact.
+type Manifest struct {
+	MediaType    string       `json:"mediaType"`
+	ArtifactType string       `json:"artifactType,omitempty"`
+	Config       Descriptor   `json:"config"`
+	Layers       []Descriptor `json:"layers"`
+}
+
+// Client fetches artifacts from registries.
+type Client struct {
+	httpClient *http.Client
+	scheme     string
+}
+
+// Option configures the client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client of the requests to the registries.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithPlainHTTP fetches the artifacts without TLS, for the local registries.
+func WithPlainHTTP() Option {
+	return func(c *Client) {
+		c.scheme = "http"
+	}
+}
+
+// New creates a new registry client.
+func New(options ...Option) Client {
+	c := Client{
+		httpClient: http.DefaultClient,
+		scheme:     "https",
+	}
+	for _, apply := range options {
+		apply(&c)
+	}
+	return c
+}
+
+// Manifest fetches the manifest of the reference and returns it with its digest.
+// The manifest is verified against the digest of the reference when set.
+func (c Client) Manifest(ctx context.Context, ref Reference) (Manifest, string, error) {
+	version := ref.Tag
+	if ref.Digest != "" {
+		version = ref.Digest
+	}
+	resp, err := c.get(ctx, ref, "manifests/"+version, MediaTypeImageManifest+", "+MediaTypeDockerManifest)
+	if err != nil {
+		return Manifest{}, "", err
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize+1))
+	if err != nil {
+		return Manifest{}, "", err
+	}
+	if len(content) > maxManifestSize {
+		return Manifest{}, "", fmt.Errorf("the manifest of %s exceeds %d bytes", ref, maxManifestSize)
+	}
+
+	sum := sha256.Sum256(content)
+	digest := "sha256:" + hex.EncodeToString(sum[:])
+	if ref.Digest != "" && digest != ref.Digest {
+		return Manifest{}, "", fmt.Errorf("%w: the manifest of %s has the digest %s", ErrInvalidDigest, ref, digest)
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return Manifest{}, "", fmt.Errorf("invalid manifest of %s: %w", ref, err)
+	}
+	if manifest.MediaType != "" && manifest.MediaType != MediaTypeImageManifest && manifest.MediaType != MediaTypeDockerManifest {
+		return Manifest{}, "", fmt.Errorf("unsupported manifest of %s with the media type %s", ref, manifest.MediaType)
+	}
+	return manifest, digest, nil
+}
+
+// Blob opens the blob with the digest from the repository of the reference.
+// The content isn't verified, the caller must check it against the digest.
+func (c Client) Blob(ctx context.Context, ref Reference, digest string) (io.ReadCloser, error) {
+	resp, err := c.get(ctx, ref, "blobs/"+digest, "")
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// get requests a path of the repository of the reference, an anonymous token
+// is requested when the registry requires a bearer token.
+func (c Client) get(ctx context.Context, ref Reference, path, accept string) (*http.Response, error) {
+	endpoint := fmt.Sprintf("%s://%s/v2/%s/%s", c.scheme, ref.Registry, ref.Repository, path)
+
+	var token string
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode == http.StatusOK {
+			return resp, nil
+		}
+		resp.Body.Close()
+
+		challenge := resp.Header.Get("WWW-Authenticate")
+		if resp.StatusCode != http.StatusUnauthorized || token != "" || !strings.HasPrefix(challenge, "Bearer ") {
+			return nil, fmt.Errorf("cannot fetch %s from %s: %s", path, ref, resp.Status)
+		}
+		if token, err = c.token(ctx, challenge); err != nil {
+			return nil, fmt.Errorf("cannot authenticate to %s: %w", ref.Registry, err)
+		}
+	}
+}
+
+// token requests an anonymous token from the realm of a bearer challenge.
+func (c Client) token(ctx context.Context, challenge string) (string, error) {
+	params := make(map[string]string)
+	for _, param := range strings.Split(strings.TrimPrefix(challenge, "Bearer "), ",") {
+		if key, value, ok := strings.Cut(strings.TrimSpace(param), "="); ok {
+			params[key] = strings.Trim(value, `"`)
+		}
+	}
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Host == "" {
+		return "", fmt.Errorf("invalid realm in the challenge %q", challenge)
+	}
+	query := realm.Query()
+	for _, key := range []string{"service", "scope"} {
+		if params[key] != "" {
+			query.Set(key, params[key])
+		}
+	}
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token request failed: %s", resp.Status)
+	}
+
+	var res struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return "", err
+	}
+	if res.Token == "" {
+		res.Token = res.AccessToken
+	}
+	if res.Token == "" {
+		return "", errors.New("no token in the token response")
+	}
+	return res.Token, nil
+}
@@ -0,0 +1,122 @@
+package ociregistry_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/ociregistry"
+)
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		ref      string
+		expected ociregistry.Reference
+		err      string
+	}{
+		{
+			ref:      "ghcr.io/ignite/mars:v1.0.0",
+			expected: ociregistry.Reference{Registry: "ghcr.io", Repository: "ignite/mars", Tag: "v1.0.0"},
+		},
+		{
+			ref:      "localhost:5000/mars",
+			expected: ociregistry.Reference{Registry: "localhost:5000", Repository: "mars", Tag: "latest"},
+		},
+		{
+			ref:      "ghcr.io/ignite/mars@sha256:abc",
+			expected: ociregistry.Reference{Registry: "ghcr.io", Repository: "ignite/mars", Digest: "sha256:abc"},
+		},
+		{ref: "mars", err: "the format is registry/repository:tag"},
+		{ref: "ghcr.io/ignite/mars@md5:abc", err: "only sha256 digests are supported"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			ref, err := ociregistry.ParseReference(tt.ref)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, ref)
+		})
+	}
+}
+
+func TestClient(t *testing.T) {
+	manifest, err := json.Marshal(ociregistry.Manifest{
+		MediaType: ociregistry.MediaTypeImageManifest,
+		Layers:    []ociregistry.Descriptor{{MediaType: "application/gzip", Digest: "sha256:layer"}},
+	})
+	require.NoError(t, err)
+	sum := sha256.Sum256(manifest)
+	digest := "sha256:" + hex.EncodeToString(sum[:])
+
+	// the registry requires an anonymous bearer token
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			require.Equal(t, "repository:ignite/mars:pull", r.URL.Query().Get("scope"))
+			fmt.Fprint(w, `{"token":"anonymous"}`)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer anonymous" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:ignite/mars:pull"`, srv.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/v2/ignite/mars/manifests/v1.0.0", "/v2/ignite/mars/manifests/" + digest:
+			require.Contains(t, r.Header.Get("Accept"), ociregistry.MediaTypeImageManifest)
+			w.Write(manifest)
+		case "/v2/ignite/mars/blobs/sha256:layer":
+			fmt.Fprint(w, "layer")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	client := ociregistry.New(ociregistry.WithPlainHTTP())
+	ref, err := ociregistry.ParseReference(strings.TrimPrefix(srv.URL, "http://") + "/ignite/mars:v1.0.0")
+	require.NoError(t, err)
+
+	t.Run("manifest", func(t *testing.T) {
+		m, d, err := client.Manifest(context.Background(), ref)
+		require.NoError(t, err)
+		require.Equal(t, digest, d)
+		require.Equal(t, "sha256:layer", m.Layers[0].Digest)
+	})
+
+	t.Run("manifest pinned to a digest", func(t *testing.T) {
+		pinned := ref
+		pinned.Digest = digest
+		_, d, err := client.Manifest(context.Background(), pinned)
+		require.NoError(t, err)
+		require.Equal(t, digest, d)
+	})
+
+	t.Run("blob", func(t *testing.T) {
+		blob, err := client.Blob(context.Background(), ref, "sha256:layer")
+		require.NoError(t, err)
+		defer blob.Close()
+		content, err := io.ReadAll(blob)
+		require.NoError(t, err)
+		require.Equal(t, "layer", string(content))
+	})
+
+	t.Run("missing manifest", func(t *testing.T) {
+		missing := ref
+		missing.Tag = "v2.0.0"
+		_, _, err := client.Manifest(context.Background(), missing)
+		require.ErrorContains(t, err, "404 Not Found")
+	})
+}
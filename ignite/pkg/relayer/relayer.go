@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite/cli/ignite/pkg/cosmosclient"
@@ -254,6 +255,36 @@ func (r Relayer) balance(ctx context.Context, rpcAddress, account, addressPrefix
 	return res.Balances, nil
 }
 
+// ChannelState returns the state of the channel of the port on the chain of the config.
+func (r Relayer) ChannelState(
+	ctx context.Context,
+	conf relayerconf.Config,
+	chainID,
+	portID,
+	channelID string,
+) (ibcchanneltypes.State, error) {
+	chain, err := conf.ChainByID(chainID)
+	if err != nil {
+		return ibcchanneltypes.UNINITIALIZED, err
+	}
+
+	client, err := cosmosclient.New(ctx, cosmosclient.WithNodeAddress(chain.RPCAddress))
+	if err != nil {
+		return ibcchanneltypes.UNINITIALIZED, err
+	}
+
+	queryClient := ibcchanneltypes.NewQueryClient(client.Context())
+	res, err := queryClient.Channel(ctx, &ibcchanneltypes.QueryChannelRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return ibcchanneltypes.UNINITIALIZED, err
+	}
+
+	return res.Channel.State, nil
+}
+
 // GetPath returns a path by its id.
 func (r Relayer) GetPath(_ context.Context, id string) (relayerconf.Path, error) {
 	conf, err := relayerconf.Get()
@@ -0,0 +1,248 @@
+// Package sandbox runs untrusted binaries with a restricted environment, without network
+// where the platform allows it, and with resource limits.
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+)
+
+// ErrUnsupported is reported to the fallback handler when the platform doesn't support a restriction.
+var ErrUnsupported = errors.New("not supported on this platform")
+
+// Command is a command run in a sandbox.
+type Command struct {
+	// Path is the absolute path of the binary, the sandbox environment has no PATH to look it up.
+	Path string
+
+	// Args are the arguments of the binary.
+	Args []string
+
+	// Dir is the working directory of the command, the temporary home is used when empty.
+	Dir string
+
+	// Stdout and Stderr collect the outputs of the command, they're discarded when nil.
+	Stdout, Stderr io.Writer
+}
+
+// Runner runs commands.
+type Runner interface {
+	Run(ctx context.Context, cmd Command) error
+}
+
+// Limit is a resource limited by a sandbox.
+type Limit string
+
+const (
+	// LimitCPU limits the CPU time used by the command.
+	LimitCPU Limit = "CPU time"
+
+	// LimitMemory limits the address space of the command.
+	LimitMemory Limit = "memory"
+)
+
+// outOfMemoryMessages are the messages of the failed allocations reported by the commands on stderr,
+// the Go runtime reports "fatal error: runtime: out of memory".
+var outOfMemoryMessages = [][]byte{
+	[]byte("out of memory"),
+	[]byte("cannot allocate memory"),
+}
+
+// maxOutOfMemoryMessageLen is the length of the longest out of memory message.
+const maxOutOfMemoryMessageLen = len("cannot allocate memory")
+
+// ErrLimitExceeded is returned when a command is stopped because it exceeded a resource limit.
+type ErrLimitExceeded struct {
+	Limit Limit
+	Value string
+}
+
+// Error implements error.
+func (e ErrLimitExceeded) Error() string {
+	return fmt.Sprintf("the command exceeded the %s limit of %s", e.Limit, e.Value)
+}
+
+// Sandbox runs commands with a scrubbed environment and a temporary home.
+// The network of the commands is disabled and their resources are limited with rlimits when the platform
+// supports it, otherwise the commands run without the restriction and the fallback handler is notified.
+type Sandbox struct {
+	cpuTime    time.Duration
+	memory     uint64
+	env        []string
+	onFallback func(error)
+
+	// isolateNetwork configures the command to run without network, it returns false when not supported.
+	isolateNetwork func(*exec.Cmd) bool
+
+	// limitResources sets the resource limits of the started process.
+	limitResources func(pid int, cpuTime time.Duration, memory uint64) error
+}
+
+// Option configures a sandbox.
+type Option func(*Sandbox)
+
+// WithCPUTime limits the CPU time of the commands, zero means no limit.
+// The limit is rounded up to the second, the granularity of the CPU time rlimit.
+func WithCPUTime(limit time.Duration) Option {
+	return func(s *Sandbox) {
+		s.cpuTime = limit
+	}
+}
+
+// WithMemory limits the address space of the commands in bytes, zero means no limit.
+// The allocations beyond the limit fail, the address space is larger than the resident memory.
+func WithMemory(limit uint64) Option {
+	return func(s *Sandbox) {
+		s.memory = limit
+	}
+}
+
+// WithEnv keeps the environment variables with the names, all the other variables are removed.
+func WithEnv(names ...string) Option {
+	return func(s *Sandbox) {
+		s.env = append(s.env, names...)
+	}
+}
+
+// WithFallbackHandler sets the function called when a restriction can't be applied and the command
+// runs without it.
+func WithFallbackHandler(handler func(error)) Option {
+	return func(s *Sandbox) {
+		s.onFallback = handler
+	}
+}
+
+// New creates a new sandbox.
+func New(options ...Option) Sandbox {
+	s := Sandbox{
+		onFallback:     func(error) {},
+		isolateNetwork: isolateNetwork,
+		limitResources: limitResources,
+	}
+	for _, apply := range options {
+		apply(&s)
+	}
+	if s.cpuTime > 0 {
+		s.cpuTime = s.cpuTime.Round(time.Second)
+		if s.cpuTime < time.Second {
+			s.cpuTime = time.Second
+		}
+	}
+	return s
+}
+
+// Run runs the command in the sandbox until it exits.
+// An ErrLimitExceeded error is returned when the command failed because of a resource limit.
+func (s Sandbox) Run(ctx context.Context, c Command) error {
+	home, err := os.MkdirTemp("", "ignite-sandbox")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(home)
+
+	stderr := &oomDetector{w: c.Stderr}
+	cmd := s.command(ctx, home, c, stderr)
+	if !s.isolateNetwork(cmd) {
+		s.onFallback(fmt.Errorf("cannot disable the network: %w", ErrUnsupported))
+		if err := cmd.Start(); err != nil {
+			return err
+		}
+	} else if err := cmd.Start(); err != nil {
+		// the namespaces can be disabled for unprivileged users
+		s.onFallback(fmt.Errorf("cannot disable the network: %w", err))
+		cmd = s.command(ctx, home, c, stderr)
+		if err := cmd.Start(); err != nil {
+			return err
+		}
+	}
+
+	// the limits are set once the binary is executed, the sandbox has no process to set them on before
+	if s.cpuTime > 0 || s.memory > 0 {
+		if err := s.limitResources(cmd.Process.Pid, s.cpuTime, s.memory); err != nil {
+			s.onFallback(fmt.Errorf("cannot limit the resources: %w", err))
+		}
+	}
+
+	err = cmd.Wait()
+	if err == nil {
+		return nil
+	}
+	if exceeded := s.exceededLimit(cmd.ProcessState, stderr.found); exceeded != nil {
+		return *exceeded
+	}
+	return err
+}
+
+func (s Sandbox) command(ctx context.Context, home string, c Command, stderr io.Writer) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, c.Path, c.Args...)
+	cmd.Dir = c.Dir
+	if cmd.Dir == "" {
+		cmd.Dir = home
+	}
+	cmd.Stdout = c.Stdout
+	cmd.Stderr = stderr
+	cmd.Env = s.environ(home)
+	return cmd
+}
+
+// environ returns the environment of the commands, the home and temporary directories are the sandbox home.
+func (s Sandbox) environ(home string) []string {
+	env := []string{
+		"HOME=" + home,
+		"TMPDIR=" + home,
+	}
+	for _, name := range s.env {
+		if value, ok := os.LookupEnv(name); ok {
+			env = append(env, name+"="+value)
+		}
+	}
+	return env
+}
+
+// exceededLimit returns the limit the failed command exceeded, if any. The kernel stops the command once it used
+// its CPU time, a failed allocation is either reported on stderr or crashes the command.
+func (s Sandbox) exceededLimit(state *os.ProcessState, outOfMemory bool) *ErrLimitExceeded {
+	if state == nil {
+		return nil
+	}
+	cpuSignal, memorySignal := killedByLimit(state)
+	if s.cpuTime > 0 && (cpuSignal || state.UserTime()+state.SystemTime() >= s.cpuTime) {
+		return &ErrLimitExceeded{Limit: LimitCPU, Value: s.cpuTime.String()}
+	}
+	if s.memory > 0 && (outOfMemory || memorySignal) {
+		return &ErrLimitExceeded{Limit: LimitMemory, Value: fmt.Sprintf("%d bytes", s.memory)}
+	}
+	return nil
+}
+
+// oomDetector writes stderr to w and detects the failed allocations reported by the command.
+type oomDetector struct {
+	w     io.Writer
+	tail  []byte
+	found bool
+}
+
+// Write implements io.Writer.
+func (d *oomDetector) Write(p []byte) (int, error) {
+	if !d.found {
+		// the end of the previous write is kept to detect the messages split across writes
+		buf := bytes.ToLower(append(d.tail, p...))
+		for _, msg := range outOfMemoryMessages {
+			d.found = d.found || bytes.Contains(buf, msg)
+		}
+		if len(buf) > maxOutOfMemoryMessageLen {
+			buf = buf[len(buf)-maxOutOfMemoryMessageLen:]
+		}
+		d.tail = append(d.tail[:0], buf...)
+	}
+	if d.w == nil {
+		return len(p), nil
+	}
+	return d.w.Write(p)
+}
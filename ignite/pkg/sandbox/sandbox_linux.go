@@ -0,0 +1,55 @@
+//go:build linux
+// +build linux
+
+package sandbox
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// isolateNetwork runs the command in new user and network namespaces, the network namespace only has
+// a loopback interface. The user of the command is mapped to the current user to keep the file permissions.
+func isolateNetwork(cmd *exec.Cmd) bool {
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Cloneflags: syscall.CLONE_NEWUSER | syscall.CLONE_NEWNET,
+		UidMappings: []syscall.SysProcIDMap{
+			{ContainerID: os.Getuid(), HostID: os.Getuid(), Size: 1},
+		},
+		GidMappings: []syscall.SysProcIDMap{
+			{ContainerID: os.Getgid(), HostID: os.Getgid(), Size: 1},
+		},
+	}
+	return true
+}
+
+// limitResources sets the CPU time and address space rlimits of the process. The kernel sends SIGXCPU to the
+// process when it used its CPU time and SIGKILL a second later, the allocations beyond the address space fail.
+func limitResources(pid int, cpuTime time.Duration, memory uint64) error {
+	if cpuTime > 0 {
+		seconds := uint64(cpuTime / time.Second)
+		if err := unix.Prlimit(pid, unix.RLIMIT_CPU, &unix.Rlimit{Cur: seconds, Max: seconds + 1}, nil); err != nil {
+			return err
+		}
+	}
+	if memory > 0 {
+		if err := unix.Prlimit(pid, unix.RLIMIT_AS, &unix.Rlimit{Cur: memory, Max: memory}, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// killedByLimit tells if the process was killed by the signal of a limit: SIGXCPU for the CPU time and
+// SIGSEGV for the memory, the programs not checking their failed allocations crash when they use them.
+func killedByLimit(state *os.ProcessState) (cpu, memory bool) {
+	status, ok := state.Sys().(syscall.WaitStatus)
+	if !ok || !status.Signaled() {
+		return false, false
+	}
+	return status.Signal() == syscall.SIGXCPU, status.Signal() == syscall.SIGSEGV
+}
@@ -0,0 +1,25 @@
+//go:build !linux
+// +build !linux
+
+package sandbox
+
+import (
+	"os"
+	"os/exec"
+	"time"
+)
+
+// isolateNetwork is not supported, the command runs with the network.
+func isolateNetwork(*exec.Cmd) bool {
+	return false
+}
+
+// limitResources is not supported, the resources of the command are not limited.
+func limitResources(int, time.Duration, uint64) error {
+	return ErrUnsupported
+}
+
+// killedByLimit is not used without resource limits.
+func killedByLimit(*os.ProcessState) (cpu, memory bool) {
+	return false, false
+}
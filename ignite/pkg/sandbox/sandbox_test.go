@@ -0,0 +1,151 @@
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os/exec"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func shell(t *testing.T, script string, stdout io.Writer) Command {
+	if runtime.GOOS == "windows" {
+		t.Skip("the tests require a POSIX shell")
+	}
+	sh, err := exec.LookPath("sh")
+	require.NoError(t, err)
+	return Command{Path: sh, Args: []string{"-c", script}, Stdout: stdout}
+}
+
+func TestSandboxEnv(t *testing.T) {
+	t.Setenv("SANDBOX_SECRET", "secret")
+	t.Setenv("SANDBOX_KEPT", "kept")
+
+	var stdout bytes.Buffer
+	s := New(WithEnv("SANDBOX_KEPT"))
+
+	err := s.Run(context.Background(), shell(t, `echo "$HOME,$PWD,${SANDBOX_SECRET:-none},$SANDBOX_KEPT"`, &stdout))
+	require.NoError(t, err)
+
+	values := strings.Split(strings.TrimSpace(stdout.String()), ",")
+	require.Len(t, values, 4)
+	require.Contains(t, values[0], "ignite-sandbox")
+	require.Equal(t, values[0], values[1])
+	require.Equal(t, "none", values[2])
+	require.Equal(t, "kept", values[3])
+}
+
+func TestSandboxLimits(t *testing.T) {
+	t.Run("CPU time", func(t *testing.T) {
+		if runtime.GOOS != "linux" {
+			t.Skip("the resources are only limited on Linux")
+		}
+
+		// the limit is rounded up to the second
+		s := New(WithCPUTime(100 * time.Millisecond))
+
+		err := s.Run(context.Background(), shell(t, "while :; do :; done", nil))
+		require.Equal(t, ErrLimitExceeded{Limit: LimitCPU, Value: "1s"}, err)
+	})
+
+	t.Run("memory", func(t *testing.T) {
+		if runtime.GOOS != "linux" {
+			t.Skip("the resources are only limited on Linux")
+		}
+
+		s := New(WithMemory(32 << 20))
+
+		err := s.Run(context.Background(), shell(t, `x=$(head -c 100000000 /dev/zero | tr "\0" a); echo "${#x}"`, nil))
+		require.Equal(t, ErrLimitExceeded{Limit: LimitMemory, Value: "33554432 bytes"}, err)
+	})
+
+	t.Run("out of memory reported on stderr", func(t *testing.T) {
+		var stderr bytes.Buffer
+		s := New(WithMemory(1024))
+		s.limitResources = func(int, time.Duration, uint64) error { return nil }
+
+		cmd := shell(t, "echo 'fatal error: runtime: out of memory' >&2; exit 2", nil)
+		cmd.Stderr = &stderr
+		err := s.Run(context.Background(), cmd)
+		require.EqualError(t, err, "the command exceeded the memory limit of 1024 bytes")
+		require.Equal(t, "fatal error: runtime: out of memory\n", stderr.String())
+	})
+
+	t.Run("failure unrelated to the limits", func(t *testing.T) {
+		s := New(WithCPUTime(time.Minute), WithMemory(1<<40))
+		s.limitResources = func(int, time.Duration, uint64) error { return nil }
+
+		err := s.Run(context.Background(), shell(t, "echo failed >&2; exit 1", nil))
+		require.EqualError(t, err, "exit status 1")
+	})
+
+	t.Run("within the limits", func(t *testing.T) {
+		var stdout bytes.Buffer
+		s := New(WithCPUTime(time.Minute), WithMemory(1<<40))
+
+		err := s.Run(context.Background(), shell(t, "sleep 0.1; echo done", &stdout))
+		require.NoError(t, err)
+		require.Equal(t, "done\n", stdout.String())
+	})
+}
+
+func TestOOMDetector(t *testing.T) {
+	var (
+		stderr bytes.Buffer
+		d      = &oomDetector{w: &stderr}
+	)
+
+	// the message is split across writes
+	for _, p := range []string{"fatal error: runtime: Out of ", "mem", "ory\n", "goroutine 1 [running]:\n"} {
+		_, err := d.Write([]byte(p))
+		require.NoError(t, err)
+	}
+	require.True(t, d.found)
+	require.Equal(t, "fatal error: runtime: Out of memory\ngoroutine 1 [running]:\n", stderr.String())
+
+	d = &oomDetector{}
+	_, err := d.Write([]byte("panic: invalid genesis\n"))
+	require.NoError(t, err)
+	require.False(t, d.found)
+}
+
+func TestSandboxFallback(t *testing.T) {
+	var (
+		stdout    bytes.Buffer
+		fallbacks []error
+		s         = New(
+			WithCPUTime(time.Minute),
+			WithFallbackHandler(func(err error) { fallbacks = append(fallbacks, err) }),
+		)
+	)
+
+	// simulate a platform without namespaces and rlimits
+	s.isolateNetwork = func(*exec.Cmd) bool { return false }
+	s.limitResources = func(int, time.Duration, uint64) error { return ErrUnsupported }
+
+	err := s.Run(context.Background(), shell(t, "sleep 0.1; echo done", &stdout))
+	require.NoError(t, err)
+	require.Equal(t, "done\n", stdout.String())
+	require.Len(t, fallbacks, 2)
+	for _, err := range fallbacks {
+		require.True(t, errors.Is(err, ErrUnsupported))
+	}
+}
+
+func TestSandboxNetwork(t *testing.T) {
+	var fallbacks []error
+	s := New(WithFallbackHandler(func(err error) { fallbacks = append(fallbacks, err) }))
+
+	// the command runs even when the namespaces are not allowed for the user
+	err := s.Run(context.Background(), shell(t, "exit 0", nil))
+	require.NoError(t, err)
+	if runtime.GOOS != "linux" {
+		require.Len(t, fallbacks, 1)
+	}
+}
@@ -158,3 +158,35 @@ func (c Client) Status(ctx context.Context) (NodeInfo, error) {
 
 	return info, nil
 }
+
+// LatestBlockHeight retrieves the height of the latest block of the node.
+func (c Client) LatestBlockHeight(ctx context.Context) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url(endpointStatus), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("%d", resp.StatusCode)
+	}
+
+	var out struct {
+		Result struct {
+			SyncInfo struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+			} `json:"sync_info"`
+		} `json:"result"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(out.Result.SyncInfo.LatestBlockHeight, 10, 64)
+}
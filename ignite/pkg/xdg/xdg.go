@@ -0,0 +1,102 @@
+// Package xdg resolves the data and cache paths of Ignite from the XDG base directories.
+//
+// The paths are relocated under the root directory when one is set with WithRoot or the IGNITE_ROOT
+// environment variable, otherwise under $XDG_DATA_HOME/ignite and $XDG_CACHE_HOME/ignite when the variables
+// are set. Without relocation the paths are the legacy locations, e.g. ~/.ignite for the cache. A relocated
+// path missing from the new location is read from its legacy location when it exists there, so the data
+// written before the relocation is still found.
+package xdg
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/pkg/xfilepath"
+)
+
+const (
+	// EnvRoot is the environment variable of the root directory of the data and cache paths.
+	EnvRoot = "IGNITE_ROOT"
+
+	// EnvDataHome is the XDG environment variable of the base directory of the user data.
+	EnvDataHome = "XDG_DATA_HOME"
+
+	// EnvCacheHome is the XDG environment variable of the base directory of the user cache.
+	EnvCacheHome = "XDG_CACHE_HOME"
+
+	// AppDirectory is the directory of Ignite in the XDG base directories.
+	AppDirectory = "ignite"
+
+	// DataDirectory and CacheDirectory are the directories of the data and the cache under the root directory.
+	DataDirectory  = "data"
+	CacheDirectory = "cache"
+)
+
+// Resolver resolves the data and cache paths.
+type Resolver struct {
+	root string
+}
+
+// Option configures the resolver.
+type Option func(*Resolver)
+
+// WithRoot relocates the data and cache paths under the root directory, it overrides IGNITE_ROOT
+// and the XDG environment variables.
+func WithRoot(root string) Option {
+	return func(r *Resolver) {
+		r.root = root
+	}
+}
+
+// New creates a resolver, the environment variables are read when the paths are resolved.
+func New(options ...Option) Resolver {
+	var r Resolver
+	for _, apply := range options {
+		apply(&r)
+	}
+	return r
+}
+
+// DataPath returns the retriever of the data path made of the elements,
+// legacy is the location of the path without relocation.
+func (r Resolver) DataPath(legacy xfilepath.PathRetriever, elem ...string) xfilepath.PathRetriever {
+	return r.path(EnvDataHome, DataDirectory, legacy, elem)
+}
+
+// CachePath returns the retriever of the cache path made of the elements,
+// legacy is the location of the path without relocation.
+func (r Resolver) CachePath(legacy xfilepath.PathRetriever, elem ...string) xfilepath.PathRetriever {
+	return r.path(EnvCacheHome, CacheDirectory, legacy, elem)
+}
+
+func (r Resolver) path(env, rootDirectory string, legacy xfilepath.PathRetriever, elem []string) xfilepath.PathRetriever {
+	return func() (string, error) {
+		var base string
+		switch root := r.rootDir(); {
+		case root != "":
+			base = filepath.Join(root, rootDirectory)
+		case os.Getenv(env) != "":
+			base = filepath.Join(os.Getenv(env), AppDirectory)
+		default:
+			return legacy()
+		}
+		path := filepath.Join(append([]string{base}, elem...)...)
+
+		// the data written before the relocation is read from the legacy location
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			if legacyPath, err := legacy(); err == nil {
+				if _, err := os.Stat(legacyPath); err == nil {
+					return legacyPath, nil
+				}
+			}
+		}
+		return path, nil
+	}
+}
+
+func (r Resolver) rootDir() string {
+	if r.root != "" {
+		return r.root
+	}
+	return os.Getenv(EnvRoot)
+}
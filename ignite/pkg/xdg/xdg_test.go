@@ -0,0 +1,107 @@
+package xdg_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/xdg"
+	"github.com/ignite/cli/ignite/pkg/xfilepath"
+)
+
+func TestResolver(t *testing.T) {
+	legacyDir := t.TempDir()
+	legacy := xfilepath.Path(filepath.Join(legacyDir, "legacy"))
+
+	setEnv := func(t *testing.T, root, dataHome, cacheHome string) {
+		t.Setenv(xdg.EnvRoot, root)
+		t.Setenv(xdg.EnvDataHome, dataHome)
+		t.Setenv(xdg.EnvCacheHome, cacheHome)
+	}
+
+	t.Run("legacy locations without relocation", func(t *testing.T) {
+		setEnv(t, "", "", "")
+		r := xdg.New()
+
+		path, err := r.DataPath(legacy, "spn", "1")()
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(legacyDir, "legacy"), path)
+
+		path, err = r.CachePath(legacy, "cache.db")()
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(legacyDir, "legacy"), path)
+	})
+
+	t.Run("XDG base directories", func(t *testing.T) {
+		setEnv(t, "", "/data", "/cache")
+		r := xdg.New()
+
+		path, err := r.DataPath(legacy, "spn", "1")()
+		require.NoError(t, err)
+		require.Equal(t, "/data/ignite/spn/1", path)
+
+		path, err = r.CachePath(legacy, "cache.db")()
+		require.NoError(t, err)
+		require.Equal(t, "/cache/ignite/cache.db", path)
+	})
+
+	t.Run("only the data directory relocated", func(t *testing.T) {
+		setEnv(t, "", "/data", "")
+		r := xdg.New()
+
+		path, err := r.DataPath(legacy, "spn", "1")()
+		require.NoError(t, err)
+		require.Equal(t, "/data/ignite/spn/1", path)
+
+		path, err = r.CachePath(legacy, "cache.db")()
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(legacyDir, "legacy"), path)
+	})
+
+	t.Run("root environment variable overrides XDG", func(t *testing.T) {
+		setEnv(t, "/srv/ignite", "/data", "/cache")
+		r := xdg.New()
+
+		path, err := r.DataPath(legacy, "spn", "1")()
+		require.NoError(t, err)
+		require.Equal(t, "/srv/ignite/data/spn/1", path)
+
+		path, err = r.CachePath(legacy, "cache.db")()
+		require.NoError(t, err)
+		require.Equal(t, "/srv/ignite/cache/cache.db", path)
+	})
+
+	t.Run("root option overrides the environment", func(t *testing.T) {
+		setEnv(t, "/srv/ignite", "/data", "/cache")
+		r := xdg.New(xdg.WithRoot("/mnt/volume"))
+
+		path, err := r.DataPath(legacy, "spn", "1")()
+		require.NoError(t, err)
+		require.Equal(t, "/mnt/volume/data/spn/1", path)
+	})
+
+	t.Run("legacy data read when missing from the new location", func(t *testing.T) {
+		var (
+			dataHome   = t.TempDir()
+			legacyPath = filepath.Join(legacyDir, "existing")
+		)
+		setEnv(t, "", dataHome, "")
+		require.NoError(t, os.WriteFile(legacyPath, []byte("data"), 0o644))
+		r := xdg.New()
+
+		path, err := r.DataPath(xfilepath.Path(legacyPath), "existing")()
+		require.NoError(t, err)
+		require.Equal(t, legacyPath, path)
+
+		// the new location is used as soon as it exists
+		newPath := filepath.Join(dataHome, xdg.AppDirectory, "existing")
+		require.NoError(t, os.MkdirAll(filepath.Dir(newPath), 0o755))
+		require.NoError(t, os.WriteFile(newPath, []byte("data"), 0o644))
+
+		path, err = r.DataPath(xfilepath.Path(legacyPath), "existing")()
+		require.NoError(t, err)
+		require.Equal(t, newPath, path)
+	})
+}
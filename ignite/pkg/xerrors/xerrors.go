@@ -0,0 +1,79 @@
+// Package xerrors provides helpers to report several independent errors together.
+package xerrors
+
+import (
+	"errors"
+	"strings"
+)
+
+// joinError is an error wrapping several errors.
+type joinError struct {
+	errs []error
+}
+
+// Join returns an error wrapping the non nil errors, errors.Is and errors.As find each of them.
+// Nil is returned when all the errors are nil and the error itself when there is a single one,
+// the errors joined in errs are flattened.
+func Join(errs ...error) error {
+	var joined []error
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		joined = append(joined, Errors(err)...)
+	}
+
+	switch len(joined) {
+	case 0:
+		return nil
+	case 1:
+		return joined[0]
+	default:
+		return &joinError{errs: joined}
+	}
+}
+
+// Errors returns the errors joined in err, or err itself when it doesn't join several errors.
+func Errors(err error) []error {
+	if err == nil {
+		return nil
+	}
+	if j, ok := err.(*joinError); ok {
+		return j.errs
+	}
+	return []error{err}
+}
+
+// Error implements error, the messages of the errors are separated by a newline.
+func (e *joinError) Error() string {
+	messages := make([]string, len(e.errs))
+	for i, err := range e.errs {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+// Unwrap returns the joined errors.
+func (e *joinError) Unwrap() []error {
+	return e.errs
+}
+
+// Is reports whether one of the joined errors matches target.
+func (e *joinError) Is(target error) bool {
+	for _, err := range e.errs {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first joined error that matches target.
+func (e *joinError) As(target interface{}) bool {
+	for _, err := range e.errs {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
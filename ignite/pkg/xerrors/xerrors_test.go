@@ -0,0 +1,57 @@
+package xerrors_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/xerrors"
+)
+
+type typedError struct {
+	name string
+}
+
+func (e typedError) Error() string {
+	return "typed " + e.name
+}
+
+func TestJoin(t *testing.T) {
+	var (
+		errFoo = errors.New("foo")
+		errBar = typedError{name: "bar"}
+		errBaz = fmt.Errorf("baz: %w", errFoo)
+	)
+
+	t.Run("no error", func(t *testing.T) {
+		require.NoError(t, xerrors.Join(nil, nil))
+		require.Nil(t, xerrors.Errors(nil))
+	})
+
+	t.Run("single error", func(t *testing.T) {
+		err := xerrors.Join(nil, errBar, nil)
+		require.Equal(t, errBar, err)
+		require.Equal(t, []error{errBar}, xerrors.Errors(err))
+	})
+
+	t.Run("several errors", func(t *testing.T) {
+		err := xerrors.Join(errFoo, nil, xerrors.Join(errBar, errBaz))
+		require.EqualError(t, err, "foo\ntyped bar\nbaz: foo")
+		require.Equal(t, []error{errFoo, errBar, errBaz}, xerrors.Errors(err))
+
+		require.ErrorIs(t, err, errFoo)
+		var typed typedError
+		require.True(t, errors.As(err, &typed))
+		require.Equal(t, errBar, typed)
+		require.False(t, errors.Is(err, errors.New("foo")))
+	})
+
+	t.Run("wrapped joined errors are not flattened", func(t *testing.T) {
+		wrapped := fmt.Errorf("init: %w", xerrors.Join(errFoo, errBar))
+		err := xerrors.Join(wrapped, errBaz)
+		require.Equal(t, []error{wrapped, errBaz}, xerrors.Errors(err))
+		require.ErrorIs(t, err, errFoo)
+	})
+}
@@ -0,0 +1,120 @@
+package xhttp
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+)
+
+const (
+	// EnvAuthTokens is the environment variable of the bearer tokens sent to the hosts,
+	// it's a comma separated list of host=token entries.
+	EnvAuthTokens = "IGNITE_AUTH_TOKENS"
+
+	// EnvGitHubToken is the environment variable of the token sent to the GitHub API.
+	EnvGitHubToken = "GITHUB_TOKEN"
+
+	// gitHubAPIHost is the host of the GitHub API serving the release assets.
+	gitHubAPIHost = "api.github.com"
+)
+
+// gitHubAssetPath matches the path of a release asset of the GitHub API.
+var gitHubAssetPath = regexp.MustCompile(`^/repos/[^/]+/[^/]+/releases/assets/[0-9]+$`)
+
+// AuthTokens are the bearer tokens sent to the hosts, the keys are host names
+// or host:port when the token only applies to a port.
+type AuthTokens map[string]string
+
+// String implements fmt.Stringer, it lists the hosts without the tokens.
+func (t AuthTokens) String() string {
+	hosts := make([]string, 0, len(t))
+	for host := range t {
+		hosts = append(hosts, host)
+	}
+	return fmt.Sprintf("auth tokens for %s", strings.Join(hosts, ", "))
+}
+
+// token returns the token of the host of the request.
+func (t AuthTokens) token(req *http.Request) (string, bool) {
+	if token, ok := t[strings.ToLower(req.URL.Host)]; ok {
+		return token, true
+	}
+	token, ok := t[strings.ToLower(req.URL.Hostname())]
+	return token, ok
+}
+
+// AuthTokensFromEnv reads the bearer tokens of the IGNITE_AUTH_TOKENS environment variable.
+// The GITHUB_TOKEN environment variable sets the token of the GitHub API unless it's already set.
+func AuthTokensFromEnv() (AuthTokens, error) {
+	tokens := make(AuthTokens)
+	if env := os.Getenv(EnvAuthTokens); env != "" {
+		for i, entry := range strings.Split(env, ",") {
+			host, token, ok := strings.Cut(strings.TrimSpace(entry), "=")
+			if !ok || host == "" || token == "" {
+				// the entry is not printed, it may contain a token
+				return nil, fmt.Errorf("invalid entry %d in %s, host=token is expected", i+1, EnvAuthTokens)
+			}
+			tokens[strings.ToLower(host)] = token
+		}
+	}
+	if token := os.Getenv(EnvGitHubToken); token != "" {
+		if _, ok := tokens[gitHubAPIHost]; !ok {
+			tokens[gitHubAPIHost] = token
+		}
+	}
+	return tokens, nil
+}
+
+// WithAuthTokens sends the bearer tokens to their hosts.
+// A token is only sent over HTTPS and never to the hosts a request is redirected to.
+func WithAuthTokens(tokens AuthTokens) ClientOption {
+	return func(o *clientOptions) {
+		if o.authTokens == nil {
+			o.authTokens = make(AuthTokens)
+		}
+		for host, token := range tokens {
+			o.authTokens[strings.ToLower(host)] = token
+		}
+	}
+}
+
+// isGitHubAsset checks if the request fetches a release asset from the GitHub API.
+func isGitHubAsset(req *http.Request) bool {
+	return strings.EqualFold(req.URL.Hostname(), gitHubAPIHost) && gitHubAssetPath.MatchString(req.URL.Path)
+}
+
+// NewAuthTransport returns a round tripper adding the bearer tokens of the hosts to the requests of the base
+// round tripper, the clients created with NewClient already use it.
+func NewAuthTransport(base http.RoundTripper, tokens AuthTokens) http.RoundTripper {
+	return authTransport{base: base, tokens: tokens}
+}
+
+// authTransport adds the bearer token of the host to the requests.
+// The GitHub API serves the content of a release asset instead of its description
+// when the binary content type is accepted.
+type authTransport struct {
+	base   http.RoundTripper
+	tokens AuthTokens
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, hasToken := t.tokens.token(req)
+	hasToken = hasToken && req.URL.Scheme == "https" && req.Header.Get("Authorization") == ""
+	isAsset := isGitHubAsset(req) && req.Header.Get("Accept") == ""
+	if !hasToken && !isAsset {
+		return t.base.RoundTrip(req)
+	}
+
+	// the request must not be modified by a round tripper
+	req = req.Clone(req.Context())
+	if hasToken {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if isAsset {
+		req.Header.Set("Accept", "application/octet-stream")
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,200 @@
+package xhttp
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testToken = "s3cr3t"
+
+// requireToken responds with the genesis only when the request has the bearer token.
+func requireToken(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, "genesis")
+	}
+}
+
+// roundTripFunc is a round tripper calling the function.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestAuthTokens(t *testing.T) {
+	srv := httptest.NewTLSServer(requireToken(t))
+	defer srv.Close()
+	srvURL, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	t.Run("token of the host", func(t *testing.T) {
+		client, err := NewClient(
+			WithCAFile(writeCAFile(t, srv)),
+			WithAuthTokens(AuthTokens{srvURL.Hostname(): testToken}),
+		)
+		require.NoError(t, err)
+
+		body, err := get(t, client, srv.URL)
+		require.NoError(t, err)
+		require.Equal(t, "genesis", body)
+	})
+
+	t.Run("token of the host and port", func(t *testing.T) {
+		client, err := NewClient(
+			WithCAFile(writeCAFile(t, srv)),
+			WithAuthTokens(AuthTokens{srvURL.Host: testToken}),
+		)
+		require.NoError(t, err)
+
+		body, err := get(t, client, srv.URL)
+		require.NoError(t, err)
+		require.Equal(t, "genesis", body)
+	})
+
+	t.Run("token of another host", func(t *testing.T) {
+		client, err := NewClient(
+			WithCAFile(writeCAFile(t, srv)),
+			WithAuthTokens(AuthTokens{"example.com": testToken}),
+		)
+		require.NoError(t, err)
+
+		resp, err := client.Get(srv.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("no token over plain HTTP", func(t *testing.T) {
+		plainSrv := httptest.NewServer(requireToken(t))
+		defer plainSrv.Close()
+		plainURL, err := url.Parse(plainSrv.URL)
+		require.NoError(t, err)
+
+		client, err := NewClient(WithAuthTokens(AuthTokens{plainURL.Hostname(): testToken}))
+		require.NoError(t, err)
+
+		resp, err := client.Get(plainSrv.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("no token after a redirect to another host", func(t *testing.T) {
+		var authorization []string
+		storage := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization = append(authorization, r.Header.Get("Authorization"))
+			fmt.Fprint(w, "genesis")
+		}))
+		defer storage.Close()
+		redirect := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization = append(authorization, r.Header.Get("Authorization"))
+			http.Redirect(w, r, storage.URL, http.StatusFound)
+		}))
+		defer redirect.Close()
+		redirectURL, err := url.Parse(redirect.URL)
+		require.NoError(t, err)
+
+		// both servers share the certificate of the httptest package
+		client, err := NewClient(
+			WithCAFile(writeCAFile(t, redirect)),
+			WithAuthTokens(AuthTokens{redirectURL.Host: testToken}),
+		)
+		require.NoError(t, err)
+
+		body, err := get(t, client, redirect.URL)
+		require.NoError(t, err)
+		require.Equal(t, "genesis", body)
+		require.Equal(t, []string{"Bearer " + testToken, ""}, authorization)
+	})
+}
+
+func TestAuthTransportGitHubAsset(t *testing.T) {
+	var sent *http.Request
+	transport := authTransport{
+		base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			sent = req
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}),
+		tokens: AuthTokens{"api.github.com": testToken},
+	}
+
+	tests := []struct {
+		name          string
+		url           string
+		wantAccept    string
+		wantAuthorize string
+	}{
+		{
+			name:          "release asset",
+			url:           "https://api.github.com/repos/ignite/genesis/releases/assets/42",
+			wantAccept:    "application/octet-stream",
+			wantAuthorize: "Bearer " + testToken,
+		},
+		{
+			name:          "release description",
+			url:           "https://api.github.com/repos/ignite/genesis/releases/42",
+			wantAuthorize: "Bearer " + testToken,
+		},
+		{
+			name: "release download",
+			url:  "https://github.com/ignite/genesis/releases/download/v1/genesis.json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, tt.url, nil)
+			require.NoError(t, err)
+
+			_, err = transport.RoundTrip(req)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantAccept, sent.Header.Get("Accept"))
+			require.Equal(t, tt.wantAuthorize, sent.Header.Get("Authorization"))
+			require.Empty(t, req.Header, "the request must not be modified")
+		})
+	}
+}
+
+func TestAuthTokensFromEnv(t *testing.T) {
+	t.Run("hosts and GitHub token", func(t *testing.T) {
+		t.Setenv(EnvAuthTokens, "Genesis.example.com=a, localhost:8443=b")
+		t.Setenv(EnvGitHubToken, "c")
+
+		tokens, err := AuthTokensFromEnv()
+		require.NoError(t, err)
+		require.Equal(t, AuthTokens{
+			"genesis.example.com": "a",
+			"localhost:8443":      "b",
+			"api.github.com":      "c",
+		}, tokens)
+	})
+
+	t.Run("GitHub token set for the host", func(t *testing.T) {
+		t.Setenv(EnvAuthTokens, "api.github.com=a")
+		t.Setenv(EnvGitHubToken, "c")
+
+		tokens, err := AuthTokensFromEnv()
+		require.NoError(t, err)
+		require.Equal(t, AuthTokens{"api.github.com": "a"}, tokens)
+	})
+
+	t.Run("invalid entry", func(t *testing.T) {
+		t.Setenv(EnvAuthTokens, "example.com=a,"+testToken)
+		t.Setenv(EnvGitHubToken, "")
+
+		_, err := AuthTokensFromEnv()
+		require.EqualError(t, err, "invalid entry 2 in IGNITE_AUTH_TOKENS, host=token is expected")
+	})
+
+	t.Run("tokens are not printed", func(t *testing.T) {
+		require.NotContains(t, fmt.Sprint(AuthTokens{"example.com": testToken}), testToken)
+	})
+}
@@ -0,0 +1,95 @@
+package xhttp
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+type clientOptions struct {
+	timeout    time.Duration
+	caFile     string
+	proxyURL   string
+	authTokens AuthTokens
+}
+
+// ClientOption configures an HTTP client.
+type ClientOption func(*clientOptions)
+
+// WithTimeout sets the time limit of the requests made by the client, zero means no timeout.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithCAFile adds the PEM encoded certificates of the file to the system certificates
+// trusted by the client, it allows to connect through proxies intercepting TLS.
+func WithCAFile(path string) ClientOption {
+	return func(o *clientOptions) {
+		o.caFile = path
+	}
+}
+
+// WithProxyURL sets the proxy used by the client.
+// By default, the proxy is read from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+func WithProxyURL(proxyURL string) ClientOption {
+	return func(o *clientOptions) {
+		o.proxyURL = proxyURL
+	}
+}
+
+// NewClient creates a new HTTP client, without options the client behaves like http.DefaultClient.
+func NewClient(options ...ClientOption) (*http.Client, error) {
+	var o clientOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if o.proxyURL != "" {
+		proxyURL, err := url.Parse(o.proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy url %s: %w", o.proxyURL, err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if o.caFile != "" {
+		pool, err := certPool(o.caFile)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	return &http.Client{
+		Transport: NewAuthTransport(transport, o.authTokens),
+		Timeout:   o.timeout,
+	}, nil
+}
+
+// certPool returns the system certificate pool with the PEM encoded certificates of the file.
+func certPool(caFile string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the CA file: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificate found in the CA file %s", caFile)
+	}
+	return pool, nil
+}
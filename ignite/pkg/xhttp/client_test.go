@@ -0,0 +1,92 @@
+package xhttp
+
+import (
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// writeCAFile writes the certificate of the TLS server into a PEM file.
+func writeCAFile(t *testing.T, srv *httptest.Server) string {
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	block := &pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(block), 0o644))
+	return path
+}
+
+func get(t *testing.T, client *http.Client, url string) (string, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body), nil
+}
+
+func TestNewClient(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "genesis")
+	}))
+	defer srv.Close()
+
+	t.Run("default client rejects unknown certificates", func(t *testing.T) {
+		client, err := NewClient()
+		require.NoError(t, err)
+
+		_, err = get(t, client, srv.URL)
+		require.ErrorContains(t, err, "certificate")
+	})
+
+	t.Run("custom CA", func(t *testing.T) {
+		client, err := NewClient(WithCAFile(writeCAFile(t, srv)))
+		require.NoError(t, err)
+
+		body, err := get(t, client, srv.URL)
+		require.NoError(t, err)
+		require.Equal(t, "genesis", body)
+	})
+
+	t.Run("invalid CA file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, os.WriteFile(path, []byte("invalid"), 0o644))
+
+		_, err := NewClient(WithCAFile(path))
+		require.EqualError(t, err, "no certificate found in the CA file "+path)
+
+		_, err = NewClient(WithCAFile(filepath.Join(t.TempDir(), "missing.pem")))
+		require.ErrorContains(t, err, "cannot read the CA file")
+	})
+
+	t.Run("proxy", func(t *testing.T) {
+		var proxied string
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			proxied = r.URL.String()
+			fmt.Fprint(w, "proxied")
+		}))
+		defer proxy.Close()
+
+		client, err := NewClient(WithProxyURL(proxy.URL))
+		require.NoError(t, err)
+
+		body, err := get(t, client, "http://genesis.example/genesis.json")
+		require.NoError(t, err)
+		require.Equal(t, "proxied", body)
+		require.Equal(t, "http://genesis.example/genesis.json", proxied)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		client, err := NewClient(WithTimeout(time.Second))
+		require.NoError(t, err)
+		require.Equal(t, time.Second, client.Timeout)
+	})
+}
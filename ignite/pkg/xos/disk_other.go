@@ -0,0 +1,15 @@
+//go:build !windows
+// +build !windows
+
+package xos
+
+import "syscall"
+
+// FreeSpace returns the number of bytes available to the user on the filesystem of the path.
+func FreeSpace(path string) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
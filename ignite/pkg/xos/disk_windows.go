@@ -0,0 +1,19 @@
+//go:build windows
+// +build windows
+
+package xos
+
+import "golang.org/x/sys/windows"
+
+// FreeSpace returns the number of bytes available to the user on the filesystem of the path.
+func FreeSpace(path string) (uint64, error) {
+	dir, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, err
+	}
+	var available uint64
+	if err := windows.GetDiskFreeSpaceEx(dir, &available, nil, nil); err != nil {
+		return 0, err
+	}
+	return available, nil
+}
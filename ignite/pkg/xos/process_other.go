@@ -0,0 +1,31 @@
+//go:build !windows
+// +build !windows
+
+package xos
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// IsFileInUse checks if the error is returned because a file is used by another process.
+// Files in use can always be removed on Unix systems.
+func IsFileInUse(error) bool {
+	return false
+}
+
+// IsFileLocked checks if the error is returned because a file is locked by another process.
+func IsFileLocked(err error) bool {
+	return errors.Is(err, syscall.EWOULDBLOCK)
+}
+
+// IsProcessRunning checks if a process with the pid is running.
+func IsProcessRunning(pid int) bool {
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	err = p.Signal(syscall.Signal(0))
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
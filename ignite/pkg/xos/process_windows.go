@@ -0,0 +1,36 @@
+//go:build windows
+// +build windows
+
+package xos
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+const (
+	errorSharingViolation syscall.Errno = 32
+	errorLockViolation    syscall.Errno = 33
+)
+
+// IsFileInUse checks if the error is returned because a file is used by another process.
+func IsFileInUse(err error) bool {
+	return errors.Is(err, errorSharingViolation) || errors.Is(err, errorLockViolation)
+}
+
+// IsFileLocked checks if the error is returned because a file is locked by another process.
+func IsFileLocked(err error) bool {
+	return IsFileInUse(err)
+}
+
+// IsProcessRunning checks if a process with the pid is running.
+func IsProcessRunning(pid int) bool {
+	// on Windows, finding a process opens a handle to it which fails when the process doesn't exist
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	_ = p.Release()
+	return true
+}
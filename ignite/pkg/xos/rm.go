@@ -3,6 +3,7 @@ package xos
 import (
 	"os"
 	"path/filepath"
+	"time"
 )
 
 func RemoveAllUnderHome(path string) error {
@@ -12,3 +13,22 @@ func RemoveAllUnderHome(path string) error {
 	}
 	return os.RemoveAll(filepath.Join(home, path))
 }
+
+// RemoveAllWithRetry removes path and any children it contains like os.RemoveAll.
+// When files are still in use by another process, which happens on Windows when a process
+// didn't release its files yet, the removal is retried up to attempts times with an
+// exponential backoff starting at backoff. The delays are waited with after, like time.After.
+func RemoveAllWithRetry(
+	path string,
+	attempts int,
+	backoff time.Duration,
+	after func(time.Duration) <-chan time.Time,
+) (err error) {
+	for i := 0; i < attempts; i++ {
+		if err = os.RemoveAll(path); err == nil || !IsFileInUse(err) {
+			return err
+		}
+		<-after(backoff << i)
+	}
+	return err
+}
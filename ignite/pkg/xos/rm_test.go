@@ -0,0 +1,29 @@
+package xos_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/xos"
+)
+
+func TestRemoveAllWithRetry(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "home")
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "config"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config", "genesis.json"), []byte("{}"), 0o644))
+
+	// the removal doesn't wait when the files are not in use
+	after := func(time.Duration) <-chan time.Time {
+		t.Fatal("unexpected retry")
+		return nil
+	}
+	require.NoError(t, xos.RemoveAllWithRetry(dir, 3, time.Second, after))
+	require.NoDirExists(t, dir)
+
+	// a missing path is not an error
+	require.NoError(t, xos.RemoveAllWithRetry(dir, 3, time.Second, after))
+}
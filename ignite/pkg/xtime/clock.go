@@ -1,10 +1,15 @@
 package xtime
 
-import "time"
+import (
+	"context"
+	"sync"
+	"time"
+)
 
 // Clock represents a clock that can retrieve current time
 type Clock interface {
 	Now() time.Time
+	After(d time.Duration) <-chan time.Time
 	Add(duration time.Duration)
 }
 
@@ -21,29 +26,111 @@ func (ClockSystem) Now() time.Time {
 	return time.Now()
 }
 
+// After waits for the duration to elapse and then sends the current time on the returned channel
+func (ClockSystem) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
 // Add implements Clock
 func (ClockSystem) Add(_ time.Duration) {
 	panic("Add can't be called for ClockSystem")
 }
 
-// ClockMock is a clock mocking time with an internal counter
+// ClockMock is a clock mocking time with an internal counter, the time only changes when added
 type ClockMock struct {
-	t time.Time
+	mu      sync.Mutex
+	t       time.Time
+	waiters []clockWaiter
+	added   chan struct{}
+}
+
+// clockWaiter is a wait of ClockMock.After until the time of the clock
+type clockWaiter struct {
+	until time.Time
+	c     chan time.Time
 }
 
 // NewClockMock returns a new ClockMock
 func NewClockMock(originalTime time.Time) *ClockMock {
 	return &ClockMock{
-		t: originalTime,
+		t:     originalTime,
+		added: make(chan struct{}, 1),
 	}
 }
 
 // Now implements Clock
-func (c ClockMock) Now() time.Time {
+func (c *ClockMock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.t
 }
 
-// Add implements Clock
+// After implements Clock, the time of the clock is sent on the returned channel once the clock
+// is added the duration, the time of the clock is not changed
+func (c *ClockMock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.t
+		return ch
+	}
+	c.waiters = append(c.waiters, clockWaiter{until: c.t.Add(d), c: ch})
+
+	select {
+	case c.added <- struct{}{}:
+	default:
+	}
+	return ch
+}
+
+// Add implements Clock, the waits elapsed with the duration complete
 func (c *ClockMock) Add(duration time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.t = c.t.Add(duration)
+	waiters := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.until.After(c.t) {
+			waiters = append(waiters, w)
+			continue
+		}
+		w.c <- c.t
+	}
+	c.waiters = waiters
+}
+
+// AdvanceWaits adds the duration remaining before each pending wait elapses to the clock until the context
+// is done, the code waiting on the clock runs as if the time passed. It's run in its own goroutine.
+func (c *ClockMock) AdvanceWaits(ctx context.Context) {
+	for {
+		if d, ok := c.nextWait(); ok {
+			c.Add(d)
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.added:
+		}
+	}
+}
+
+// nextWait returns the duration remaining before the first pending wait elapses.
+func (c *ClockMock) nextWait() (time.Duration, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.waiters) == 0 {
+		return 0, false
+	}
+	next := c.waiters[0].until
+	for _, w := range c.waiters[1:] {
+		if w.until.Before(next) {
+			next = w.until
+		}
+	}
+	return next.Sub(c.t), true
 }
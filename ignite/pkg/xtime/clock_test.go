@@ -1,6 +1,7 @@
 package xtime_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -13,6 +14,9 @@ func TestClockSystem(t *testing.T) {
 	c := xtime.NewClockSystem()
 	require.False(t, c.Now().IsZero())
 	require.Panics(t, func() { c.Add(time.Second) })
+
+	start := c.Now()
+	require.False(t, (<-c.After(time.Millisecond)).Before(start.Add(time.Millisecond)))
 }
 
 func TestClockMock(t *testing.T) {
@@ -21,4 +25,27 @@ func TestClockMock(t *testing.T) {
 	require.True(t, c.Now().Equal(timeSample))
 	c.Add(time.Second)
 	require.True(t, c.Now().Equal(timeSample.Add(time.Second)))
+
+	// the waits complete once the clock is added their duration
+	wait := c.After(time.Minute)
+	require.True(t, c.Now().Equal(timeSample.Add(time.Second)))
+	c.Add(30 * time.Second)
+	require.Empty(t, wait)
+	c.Add(30 * time.Second)
+	require.True(t, (<-wait).Equal(timeSample.Add(time.Second+time.Minute)))
+
+	// a wait without duration completes immediately
+	require.True(t, (<-c.After(0)).Equal(timeSample.Add(time.Second+time.Minute)))
+}
+
+func TestClockMockAdvanceWaits(t *testing.T) {
+	timeSample := time.Now()
+	c := xtime.NewClockMock(timeSample)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.AdvanceWaits(ctx)
+
+	require.True(t, (<-c.After(time.Minute)).Equal(timeSample.Add(time.Minute)))
+	require.True(t, (<-c.After(time.Hour)).Equal(timeSample.Add(time.Hour+time.Minute)))
 }
@@ -86,6 +86,51 @@ func (c *Chain) build(
 	return gocmd.BuildPath(ctx, output, binary, path, buildFlags)
 }
 
+// BuildCommand is the go build command of the app binary.
+type BuildCommand struct {
+	// Binary is the name of the app binary.
+	Binary string
+
+	// MainPath is the path of the main package relative to the app path.
+	MainPath string
+
+	// Flags are the go build flags.
+	Flags []string
+}
+
+// BuildCommand prepares the app build and returns the go build command of the app binary,
+// it allows to build the binary in another environment than the current one.
+func (c *Chain) BuildCommand(ctx context.Context, cacheStorage cache.Storage) (BuildCommand, error) {
+	if err := c.setup(); err != nil {
+		return BuildCommand{}, err
+	}
+
+	buildFlags, err := c.preBuild(ctx, cacheStorage)
+	if err != nil {
+		return BuildCommand{}, err
+	}
+
+	binary, err := c.Binary()
+	if err != nil {
+		return BuildCommand{}, err
+	}
+
+	path, err := c.discoverMain(c.app.Path)
+	if err != nil {
+		return BuildCommand{}, err
+	}
+	mainPath, err := filepath.Rel(c.app.Path, path)
+	if err != nil {
+		return BuildCommand{}, err
+	}
+
+	return BuildCommand{
+		Binary:   binary,
+		MainPath: mainPath,
+		Flags:    buildFlags,
+	}, nil
+}
+
 // BuildRelease builds binaries for a release. targets is a list
 // of GOOS:GOARCH when provided. It defaults to your system when no targets provided.
 // prefix is used as prefix to tarballs containing each target.
@@ -247,6 +292,14 @@ func (c *Chain) discoverMain(path string) (pkgPath string, err error) {
 		return filepath.Join(c.app.Path, conf.Build.Main), nil
 	}
 
+	// the binary name selects its main package among the main packages of the repository
+	if c.options.binaryName != "" {
+		mainPath := filepath.Join(c.app.Path, "cmd", c.options.binaryName)
+		if info, err := os.Stat(mainPath); err == nil && info.IsDir() {
+			return mainPath, nil
+		}
+	}
+
 	path, err = goanalysis.DiscoverOneMain(path)
 	if err == goanalysis.ErrMultipleMainPackagesFound {
 		return "", errors.Wrap(err, "specify the path to your chain's main package in your config.yml>build.main")
@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-git/go-git/v5"
 	"github.com/gookit/color"
+	"github.com/pkg/errors"
 	"github.com/tendermint/spn/pkg/chainid"
 
 	"github.com/ignite/cli/ignite/chainconfig"
@@ -76,6 +77,15 @@ type chainOptions struct {
 	// homePath of the chain's config dir.
 	homePath string
 
+	// binaryName replaces the name of the app binary.
+	binaryName string
+
+	// binaryPath is the path of the app binary run by the commands.
+	binaryPath string
+
+	// defaultHome replaces the default home of the app binary.
+	defaultHome string
+
 	// keyring backend used by commands if not specified in configuration
 	keyringBackend chaincmd.KeyringBackend
 
@@ -87,6 +97,15 @@ type chainOptions struct {
 	// been modified since they were downloaded.
 	checkDependencies bool
 
+	// initFlags are extra flags provided to the init command.
+	initFlags []string
+
+	// gentxFlags are extra flags provided to the gentx command.
+	gentxFlags []string
+
+	// collectGentxsFlags are extra flags provided to the collect-gentxs command.
+	collectGentxsFlags []string
+
 	// path of a custom config file
 	ConfigFile string
 }
@@ -115,6 +134,21 @@ func HomePath(path string) Option {
 	}
 }
 
+// BinaryName replaces the name of the app binary, including the one set in the config.
+func BinaryName(name string) Option {
+	return func(c *Chain) {
+		c.options.binaryName = name
+	}
+}
+
+// DefaultHome replaces the default home of the app binary, including the one set in the config.
+// The default home is used when no home path is set.
+func DefaultHome(home string) Option {
+	return func(c *Chain) {
+		c.options.defaultHome = home
+	}
+}
+
 // KeyringBackend specifies the keyring backend to use for the chain command
 func KeyringBackend(keyringBackend chaincmd.KeyringBackend) Option {
 	return func(c *Chain) {
@@ -146,6 +180,30 @@ func CheckDependencies() Option {
 	}
 }
 
+// InitFlags provides extra flags to the chain init command.
+// The home and chain ID flags cannot be overridden.
+func InitFlags(flags ...string) Option {
+	return func(c *Chain) {
+		c.options.initFlags = flags
+	}
+}
+
+// GentxFlags provides extra flags to the chain gentx command.
+// The home and chain ID flags cannot be overridden.
+func GentxFlags(flags ...string) Option {
+	return func(c *Chain) {
+		c.options.gentxFlags = flags
+	}
+}
+
+// CollectGentxsFlags provides extra flags to the chain collect-gentxs command.
+// The home and chain ID flags cannot be overridden.
+func CollectGentxsFlags(flags ...string) Option {
+	return func(c *Chain) {
+		c.options.collectGentxsFlags = flags
+	}
+}
+
 // New initializes a new Chain with options that its source lives at path.
 func New(path string, options ...Option) (*Chain, error) {
 	app, err := NewAppAt(path)
@@ -171,6 +229,16 @@ func New(path string, options ...Option) (*Chain, error) {
 		c.stderr = os.Stderr
 	}
 
+	if err := chaincmd.CheckExtraFlags(c.options.initFlags); err != nil {
+		return nil, errors.Wrap(err, "invalid init flags")
+	}
+	if err := chaincmd.CheckExtraFlags(c.options.gentxFlags); err != nil {
+		return nil, errors.Wrap(err, "invalid gentx flags")
+	}
+	if err := chaincmd.CheckExtraFlags(c.options.collectGentxsFlags); err != nil {
+		return nil, errors.Wrap(err, "invalid collect-gentxs flags")
+	}
+
 	c.sourceVersion, err = c.appVersion()
 	if err != nil && err != git.ErrRepositoryNotExists {
 		return nil, err
@@ -272,6 +340,10 @@ func (c *Chain) Name() string {
 
 // Binary returns the name of app's default (appd) binary.
 func (c *Chain) Binary() (string, error) {
+	if c.options.binaryName != "" {
+		return c.options.binaryName, nil
+	}
+
 	conf, err := c.Config()
 	if err != nil {
 		return "", err
@@ -289,6 +361,12 @@ func (c *Chain) SetHome(home string) {
 	c.options.homePath = home
 }
 
+// SetBinaryPath sets the path of the app binary run by the commands,
+// the binary is looked up in the PATH when no path is set.
+func (c *Chain) SetBinaryPath(path string) {
+	c.options.binaryPath = path
+}
+
 // Home returns the blockchain node's home dir.
 func (c *Chain) Home() (string, error) {
 	// check if home is explicitly defined for the app
@@ -311,6 +389,10 @@ func (c *Chain) Home() (string, error) {
 
 // DefaultHome returns the blockchain node's default home dir when not specified in the app
 func (c *Chain) DefaultHome() (string, error) {
+	if c.options.defaultHome != "" {
+		return c.options.defaultHome, nil
+	}
+
 	// check if home is defined in config
 	config, err := c.Config()
 	if err != nil {
@@ -439,6 +521,9 @@ func (c *Chain) Commands(ctx context.Context) (chaincmdrunner.Runner, error) {
 	if err != nil {
 		return chaincmdrunner.Runner{}, err
 	}
+	if c.options.binaryPath != "" {
+		binary = c.options.binaryPath
+	}
 
 	backend, err := c.KeyringBackend()
 	if err != nil {
@@ -461,6 +546,9 @@ func (c *Chain) Commands(ctx context.Context) (chaincmdrunner.Runner, error) {
 		chaincmd.WithVersion(c.Version),
 		chaincmd.WithNodeAddress(nodeAddr),
 		chaincmd.WithKeyringBackend(backend),
+		chaincmd.WithInitFlags(c.options.initFlags...),
+		chaincmd.WithGentxFlags(c.options.gentxFlags...),
+		chaincmd.WithCollectGentxsFlags(c.options.collectGentxsFlags...),
 	}
 
 	cc := chaincmd.New(binary, chainCommandOptions...)
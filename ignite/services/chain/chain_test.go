@@ -8,6 +8,8 @@ import (
 	"github.com/docker/docker/pkg/archive"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/goanalysis"
 )
 
 func TestSourceVersion(t *testing.T) {
@@ -43,3 +45,42 @@ func tempSource(t *testing.T, tarPath string) (path string) {
 
 	return filepath.Join(dir, dirs[0].Name())
 }
+
+func TestBinaryOverrides(t *testing.T) {
+	c, err := New(
+		tempSource(t, "testdata/version/mars.v0.2.tar.gz"),
+		BinaryName("marsappd"),
+		DefaultHome("/home/ignite/.marsapp"),
+	)
+	require.NoError(t, err)
+
+	binary, err := c.Binary()
+	require.NoError(t, err)
+	assert.Equal(t, "marsappd", binary)
+
+	home, err := c.Home()
+	require.NoError(t, err)
+	assert.Equal(t, "/home/ignite/.marsapp", home)
+}
+
+func TestDiscoverMainBinaryName(t *testing.T) {
+	path := tempSource(t, "testdata/version/mars.v0.2.tar.gz")
+	for _, name := range []string{"marsd", "marsctl"} {
+		dir := filepath.Join(path, "cmd", name)
+		require.NoError(t, os.MkdirAll(dir, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0o644))
+	}
+
+	// the binary name selects its main package
+	c, err := New(path, BinaryName("marsctl"))
+	require.NoError(t, err)
+	mainPath, err := c.discoverMain(path)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(path, "cmd", "marsctl"), mainPath)
+
+	// the main package can't be guessed without binary name
+	c, err = New(path)
+	require.NoError(t, err)
+	_, err = c.discoverMain(path)
+	require.ErrorIs(t, err, goanalysis.ErrMultipleMainPackagesFound)
+}
@@ -108,15 +108,7 @@ func (c *Chain) InitChain(ctx context.Context) error {
 	}
 
 	for _, ac := range appconfigs {
-		cf := confile.New(ac.ec, ac.path)
-		var conf map[string]interface{}
-		if err := cf.Load(&conf); err != nil {
-			return err
-		}
-		if err := mergo.Merge(&conf, ac.changes, mergo.WithOverride); err != nil {
-			return err
-		}
-		if err := cf.Save(conf); err != nil {
+		if err := OverrideConfig(ac.ec, ac.path, ac.changes); err != nil {
 			return err
 		}
 	}
@@ -124,6 +116,19 @@ func (c *Chain) InitChain(ctx context.Context) error {
 	return nil
 }
 
+// OverrideConfig merges the changes into the config file at path, the changes override the existing keys.
+func OverrideConfig(ec confile.EncodingCreator, path string, changes map[string]interface{}) error {
+	cf := confile.New(ec, path)
+	var conf map[string]interface{}
+	if err := cf.Load(&conf); err != nil {
+		return err
+	}
+	if err := mergo.Merge(&conf, changes, mergo.WithOverride); err != nil {
+		return err
+	}
+	return cf.Save(conf)
+}
+
 // InitAccounts initializes the chain accounts and creates validator gentxs
 func (c *Chain) InitAccounts(ctx context.Context, conf chainconfig.Config) error {
 	commands, err := c.Commands(ctx)
@@ -0,0 +1,261 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ignite/cli/ignite/pkg/cosmoserror"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/numbers"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+// batchConcurrency is the maximum number of launches processed at the same time by a batch operation.
+const batchConcurrency = 5
+
+type (
+	// LaunchSet selects the launches a batch operation is applied to.
+	LaunchSet func(ctx context.Context, n Network) ([]uint64, error)
+
+	// BatchResult is the result of a batch operation, the error of the operation by launch ID.
+	// The error of a launch is nil when the operation succeeded.
+	BatchResult map[uint64]error
+
+	// RequestPolicy tells if a pending request is approved by a batch approval.
+	RequestPolicy func(request networktypes.Request) bool
+)
+
+// LaunchIDs selects the launches with the IDs.
+func LaunchIDs(launchIDs ...uint64) LaunchSet {
+	return func(context.Context, Network) ([]uint64, error) {
+		return launchIDs, nil
+	}
+}
+
+// AllCoordinatedBy selects all the launches coordinated by the address.
+func AllCoordinatedBy(address string) LaunchSet {
+	return func(ctx context.Context, n Network) ([]uint64, error) {
+		coordinatorID, err := n.CoordinatorIDByAddress(ctx, address)
+		if err != nil {
+			return nil, err
+		}
+		chains, err := n.coordinatorChains(ctx, coordinatorID)
+		if err != nil {
+			return nil, err
+		}
+		launchIDs := make([]uint64, len(chains))
+		for i, chain := range chains {
+			launchIDs[i] = chain.LaunchID
+		}
+		return launchIDs, nil
+	}
+}
+
+// CampaignLaunches selects all the launches of the campaign.
+func CampaignLaunches(campaignID uint64) LaunchSet {
+	return func(ctx context.Context, n Network) ([]uint64, error) {
+		res, err := n.campaignQuery.CampaignChains(ctx, &campaigntypes.QueryGetCampaignChainsRequest{
+			CampaignID: campaignID,
+		})
+		if cosmoserror.Unwrap(err) == cosmoserror.ErrNotFound {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		return res.CampaignChains.Chains, nil
+	}
+}
+
+// Failed returns the IDs of the launches the operation failed for, sorted.
+func (r BatchResult) Failed() []uint64 {
+	var launchIDs []uint64
+	for launchID, err := range r {
+		if err != nil {
+			launchIDs = append(launchIDs, launchID)
+		}
+	}
+	sort.Slice(launchIDs, func(i, j int) bool {
+		return launchIDs[i] < launchIDs[j]
+	})
+	return launchIDs
+}
+
+// ApproveAll is the policy approving all the pending requests.
+func ApproveAll(networktypes.Request) bool {
+	return true
+}
+
+// ApproveRequestTypes is the policy approving the pending requests with one of the types,
+// like networktypes.RequestTypeAddValidator.
+func ApproveRequestTypes(types ...string) RequestPolicy {
+	return func(request networktypes.Request) bool {
+		for _, t := range types {
+			if networktypes.RequestType(request.Content) == t {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// BatchTriggerLaunch triggers the launch of each selected chain as a coordinator.
+// A launch that can't be triggered doesn't abort the others, its error is reported in the result.
+func (n Network) BatchTriggerLaunch(
+	ctx context.Context,
+	launches LaunchSet,
+	launchTime time.Time,
+	options ...TriggerLaunchOption,
+) (BatchResult, error) {
+	return n.batch(ctx, launches, func(ctx context.Context, n Network, launchID uint64) error {
+		return n.TriggerLaunch(ctx, launchID, launchTime, options...)
+	})
+}
+
+// LateRequestPolicy tells a batch approval what to do with the pending requests created after the request deadline.
+type LateRequestPolicy int
+
+const (
+	// SkipLateRequests leaves the late requests pending and reports them, the coordinator reviews them explicitly.
+	SkipLateRequests LateRequestPolicy = iota
+
+	// RejectLateRequests rejects the late requests matching the request policy.
+	RejectLateRequests
+
+	// ApproveLateRequests approves the late requests matching the request policy like the other requests.
+	ApproveLateRequests
+)
+
+// BatchApproveOption configures a batch approval.
+type BatchApproveOption func(*batchApproveOptions)
+
+type batchApproveOptions struct {
+	lateRequests LateRequestPolicy
+}
+
+// WithLateRequestPolicy sets what a batch approval does with the late requests, they are skipped by default.
+func WithLateRequestPolicy(policy LateRequestPolicy) BatchApproveOption {
+	return func(o *batchApproveOptions) {
+		o.lateRequests = policy
+	}
+}
+
+// BatchApproveRequests approves the pending requests matching the policy of each selected chain as a coordinator.
+// A chain whose requests can't be approved doesn't abort the others, its error is reported in the result.
+// The requests created after the request deadline of the chain are handled with the late request policy.
+func (n Network) BatchApproveRequests(
+	ctx context.Context,
+	launches LaunchSet,
+	policy RequestPolicy,
+	options ...BatchApproveOption,
+) (BatchResult, error) {
+	var o batchApproveOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	return n.batch(ctx, launches, func(ctx context.Context, n Network, launchID uint64) error {
+		launch, err := n.ChainLaunch(ctx, launchID)
+		if err != nil {
+			return err
+		}
+		requests, err := n.Requests(ctx, launchID)
+		if err != nil {
+			return err
+		}
+
+		var (
+			deadline  = launch.RequestDeadline()
+			reviewals []Reviewal
+			late      []uint64
+		)
+		for _, request := range requests {
+			if request.Status != launchtypes.Request_PENDING.String() || !policy(request) {
+				continue
+			}
+			if !request.IsLate(deadline) {
+				reviewals = append(reviewals, ApproveRequest(request.RequestID))
+				continue
+			}
+
+			late = append(late, request.RequestID)
+			switch o.lateRequests {
+			case RejectLateRequests:
+				reason := fmt.Sprintf("created after the request deadline %s", deadline.UTC().Format(time.RFC3339))
+				reviewals = append(reviewals, RejectRequest(request.RequestID, reason))
+			case ApproveLateRequests:
+				reviewals = append(reviewals, ApproveRequest(request.RequestID))
+			}
+		}
+
+		if len(late) > 0 {
+			action := "left pending"
+			switch o.lateRequests {
+			case RejectLateRequests:
+				action = "rejected"
+			case ApproveLateRequests:
+				action = "approved"
+			}
+			n.ev.Send(events.NewWarning(fmt.Sprintf(
+				"Request(s) %s created after the request deadline %s are %s",
+				numbers.List(late, "#"),
+				deadline.UTC().Format(time.RFC3339),
+				action,
+			)))
+		}
+		if len(reviewals) == 0 {
+			n.ev.Send(events.New(events.StatusNeutral, "No pending request to approve"))
+			return nil
+		}
+		return n.SubmitRequest(ctx, launchID, reviewals...)
+	})
+}
+
+// batch applies the operation to the selected launches with bounded concurrency.
+// The events of the operation are tagged with the launch ID so the interleaved output stays attributable.
+// The queries of the operations run concurrently, their broadcasts are serialized since they share the account.
+func (n Network) batch(
+	ctx context.Context,
+	launches LaunchSet,
+	op func(ctx context.Context, n Network, launchID uint64) error,
+) (BatchResult, error) {
+	launchIDs, err := launches(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result      = make(BatchResult, len(launchIDs))
+		g           errgroup.Group
+		mu          sync.Mutex
+		broadcastMu sync.Mutex
+	)
+	g.SetLimit(batchConcurrency)
+	for _, launchID := range launchIDs {
+		launchID := launchID
+		g.Go(func() error {
+			launchNetwork := n
+			launchNetwork.ev = n.ev.WithTag(fmt.Sprintf("launch %d", launchID))
+			launchNetwork.broadcastMu = &broadcastMu
+
+			err := op(ctx, launchNetwork, launchID)
+			if err != nil {
+				launchNetwork.ev.Send(events.NewFailed(err.Error()))
+			}
+
+			mu.Lock()
+			result[launchID] = err
+			mu.Unlock()
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return result, nil
+}
@@ -0,0 +1,220 @@
+package network
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+func TestBatchTriggerLaunch(t *testing.T) {
+	sim, network, launchID := newLaunchSimulator(t)
+	first, _ := sim.Chain(launchID)
+	var (
+		bus                = events.NewBus()
+		otherCoordinatorID = sim.AddCoordinator("spn1other")
+		secondLaunchID     = sim.AddChain(launchtypes.Chain{CoordinatorID: first.CoordinatorID})
+		otherLaunchID      = sim.AddChain(launchtypes.Chain{CoordinatorID: otherCoordinatorID})
+		launchTime         = sampleTime.Add(TestMaxRemainingTime)
+	)
+	CollectEvents(bus)(&network)
+
+	result, err := network.BatchTriggerLaunch(
+		context.Background(),
+		LaunchIDs(launchID, secondLaunchID, otherLaunchID),
+		launchTime,
+	)
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+	require.NoError(t, result[launchID])
+	require.NoError(t, result[secondLaunchID])
+	require.Error(t, result[otherLaunchID], "the chain isn't coordinated by the account")
+	require.Equal(t, []uint64{otherLaunchID}, result.Failed())
+
+	for _, id := range []uint64{launchID, secondLaunchID} {
+		chain, _ := sim.Chain(id)
+		require.True(t, chain.LaunchTriggered)
+	}
+	chain, _ := sim.Chain(otherLaunchID)
+	require.False(t, chain.LaunchTriggered)
+
+	// the events of each launch are attributable
+	bus.Shutdown(context.Background())
+	tags := make(map[string]bool)
+	var failure events.Event
+	for ev := range bus.Events() {
+		tags[ev.Tag] = true
+		if ev.Status == events.StatusFailed {
+			failure = ev
+		}
+	}
+	require.True(t, tags["launch 1"])
+	require.True(t, tags["launch 2"])
+	require.Equal(t, "launch 3", failure.Tag)
+}
+
+// serialClient records the maximum number of broadcasts in flight at the same time.
+type serialClient struct {
+	*testutil.Simulator
+	inFlight, max int32
+}
+
+func (c *serialClient) BroadcastTx(ctx context.Context, account cosmosaccount.Account, msgs ...sdk.Msg) (cosmosclient.Response, error) {
+	n := atomic.AddInt32(&c.inFlight, 1)
+	defer atomic.AddInt32(&c.inFlight, -1)
+	for {
+		max := atomic.LoadInt32(&c.max)
+		if n <= max || atomic.CompareAndSwapInt32(&c.max, max, n) {
+			break
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+	return c.Simulator.BroadcastTx(ctx, account, msgs...)
+}
+
+func TestBatchSerializedBroadcasts(t *testing.T) {
+	sim, network, launchID := newLaunchSimulator(t)
+	first, _ := sim.Chain(launchID)
+	launchIDs := []uint64{launchID}
+	for i := 0; i < 3; i++ {
+		launchIDs = append(launchIDs, sim.AddChain(launchtypes.Chain{CoordinatorID: first.CoordinatorID}))
+	}
+	client := &serialClient{Simulator: sim}
+	network.cosmos = client
+
+	result, err := network.BatchTriggerLaunch(
+		context.Background(),
+		LaunchIDs(launchIDs...),
+		sampleTime.Add(TestMaxRemainingTime),
+	)
+	require.NoError(t, err)
+	require.Empty(t, result.Failed())
+
+	// the txs are signed with the same account, they are broadcasted one at a time
+	require.EqualValues(t, 1, client.max)
+}
+
+func TestBatchApproveRequests(t *testing.T) {
+	sim, network, launchID := newLaunchSimulator(t)
+	first, _ := sim.Chain(launchID)
+	var (
+		coordinatorID     = first.CoordinatorID
+		secondLaunchID    = sim.AddChain(launchtypes.Chain{CoordinatorID: coordinatorID})
+		triggeredLaunchID = sim.AddChain(launchtypes.Chain{CoordinatorID: coordinatorID, LaunchTriggered: true})
+		amount            = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+		addRequest        = func(launchID uint64, content launchtypes.RequestContent) uint64 {
+			return sim.AddRequest(launchtypes.Request{
+				LaunchID: launchID,
+				Creator:  "spn1foo",
+				Content:  content,
+				Status:   launchtypes.Request_PENDING,
+			})
+		}
+		accountRequest   = addRequest(launchID, launchtypes.NewGenesisAccount(launchID, "spn1foo", amount))
+		removalRequest   = addRequest(launchID, launchtypes.NewAccountRemoval("spn1bar"))
+		secondRequest    = addRequest(secondLaunchID, launchtypes.NewGenesisAccount(secondLaunchID, "spn1foo", amount))
+		triggeredRequest = addRequest(triggeredLaunchID, launchtypes.NewGenesisAccount(triggeredLaunchID, "spn1foo", amount))
+	)
+	addr, err := network.account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	result, err := network.BatchApproveRequests(
+		context.Background(),
+		AllCoordinatedBy(addr),
+		ApproveRequestTypes(networktypes.RequestTypeAddAccount),
+	)
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+	require.NoError(t, result[launchID])
+	require.NoError(t, result[secondLaunchID])
+	require.ErrorIs(t, result[triggeredLaunchID], launchtypes.ErrTriggeredLaunch)
+	require.Equal(t, []uint64{triggeredLaunchID}, result.Failed())
+
+	requireStatus := func(launchID, requestID uint64, status launchtypes.Request_Status) {
+		request, _ := sim.Request(launchID, requestID)
+		require.Equal(t, status, request.Status)
+	}
+	requireStatus(launchID, accountRequest, launchtypes.Request_APPROVED)
+	requireStatus(launchID, removalRequest, launchtypes.Request_PENDING)
+	requireStatus(secondLaunchID, secondRequest, launchtypes.Request_APPROVED)
+	requireStatus(triggeredLaunchID, triggeredRequest, launchtypes.Request_PENDING)
+}
+
+func TestBatchApproveLateRequests(t *testing.T) {
+	deadline := sampleTime.Add(-time.Hour).Truncate(time.Second)
+	metadata, err := networktypes.SetRequestDeadline(nil, deadline)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		options []BatchApproveOption
+		late    launchtypes.Request_Status
+	}{
+		{
+			name: "late requests left pending",
+			late: launchtypes.Request_PENDING,
+		},
+		{
+			name:    "late requests rejected",
+			options: []BatchApproveOption{WithLateRequestPolicy(RejectLateRequests)},
+			late:    launchtypes.Request_REJECTED,
+		},
+		{
+			name:    "late requests approved",
+			options: []BatchApproveOption{WithLateRequestPolicy(ApproveLateRequests)},
+			late:    launchtypes.Request_APPROVED,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sim, network, _ := newLaunchSimulator(t)
+			first, _ := sim.Chain(1)
+			var (
+				bus        = events.NewBus(events.WithCustomBufferSize(10))
+				launchID   = sim.AddChain(launchtypes.Chain{CoordinatorID: first.CoordinatorID, Metadata: metadata})
+				addRequest = func(createdAt time.Time, address string) uint64 {
+					return sim.AddRequest(launchtypes.Request{
+						LaunchID:  launchID,
+						CreatedAt: createdAt.Unix(),
+						Content:   launchtypes.NewGenesisAccount(launchID, address, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))),
+						Status:    launchtypes.Request_PENDING,
+					})
+				}
+				// the request created in the block of the deadline is on time, the request of the next block is late
+				onTimeRequest = addRequest(deadline, "spn1foo")
+				lateRequest   = addRequest(deadline.Add(time.Second), "spn1bar")
+			)
+			CollectEvents(bus)(&network)
+
+			result, err := network.BatchApproveRequests(context.Background(), LaunchIDs(launchID), ApproveAll, tt.options...)
+			require.NoError(t, err)
+			require.NoError(t, result[launchID])
+
+			request, _ := sim.Request(launchID, onTimeRequest)
+			require.Equal(t, launchtypes.Request_APPROVED, request.Status)
+			request, _ = sim.Request(launchID, lateRequest)
+			require.Equal(t, tt.late, request.Status)
+
+			// the late requests are flagged
+			bus.Shutdown(context.Background())
+			var warnings []string
+			for e := range bus.Events() {
+				if e.Status == events.StatusWarning {
+					warnings = append(warnings, e.Description)
+				}
+			}
+			require.Len(t, warnings, 1)
+			require.Contains(t, warnings[0], "Request(s) #2 created after the request deadline")
+		})
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
 	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
 
 	"github.com/ignite/cli/ignite/pkg/cosmoserror"
 	"github.com/ignite/cli/ignite/pkg/events"
@@ -80,8 +82,12 @@ func (n Network) Campaigns(ctx context.Context) ([]networktypes.Campaign, error)
 
 // CreateCampaign creates a campaign in Network
 func (n Network) CreateCampaign(ctx context.Context, name, metadata string, totalSupply sdk.Coins) (uint64, error) {
+	if err := validateTotalSupply(totalSupply); err != nil {
+		return 0, err
+	}
+
 	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Creating campaign %s", name)))
-	addr, err := n.account.Address(networktypes.SPN)
+	addr, err := n.accountAddress()
 	if err != nil {
 		return 0, err
 	}
@@ -91,7 +97,7 @@ func (n Network) CreateCampaign(ctx context.Context, name, metadata string, tota
 		totalSupply,
 		[]byte(metadata),
 	)
-	res, err := n.cosmos.BroadcastTx(ctx, n.account, msgCreateCampaign)
+	res, err := n.broadcastTx(ctx, msgCreateCampaign)
 	if err != nil {
 		return 0, err
 	}
@@ -104,6 +110,66 @@ func (n Network) CreateCampaign(ctx context.Context, name, metadata string, tota
 	return createCampaignRes.CampaignID, nil
 }
 
+// AddChainToCampaign attaches a chain without campaign to a campaign.
+// The chain and the campaign must be coordinated by the network account.
+func (n Network) AddChainToCampaign(ctx context.Context, campaignID, launchID uint64) error {
+	addr, err := n.accountAddress()
+	if err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Adding the chain %d to the campaign %d", launchID, campaignID)))
+
+	coordinatorID, err := n.CoordinatorIDByAddress(ctx, addr)
+	if errors.Is(err, ErrObjectNotFound) {
+		return fmt.Errorf("the account %s is not a coordinator", addr)
+	} else if err != nil {
+		return err
+	}
+
+	res, err := n.launchQuery.Chain(ctx, &launchtypes.QueryGetChainRequest{
+		LaunchID: launchID,
+	})
+	if cosmoserror.Unwrap(err) == cosmoserror.ErrNotFound {
+		return ErrObjectNotFound
+	} else if err != nil {
+		return err
+	}
+	if res.Chain.CoordinatorID != coordinatorID {
+		return fmt.Errorf("the account %s doesn't coordinate the chain %d", addr, launchID)
+	}
+	if res.Chain.HasCampaign {
+		return fmt.Errorf("the chain %d is already attached to the campaign %d", launchID, res.Chain.CampaignID)
+	}
+
+	campaign, err := n.Campaign(ctx, campaignID)
+	if err != nil {
+		return err
+	}
+	if campaign.CoordinatorID != coordinatorID {
+		return fmt.Errorf("the account %s doesn't coordinate the campaign %d", addr, campaignID)
+	}
+
+	msg := launchtypes.NewMsgEditChain(addr, launchID, true, campaignID, nil)
+	if _, err := n.broadcastTx(ctx, msg); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusDone, fmt.Sprintf("Chain %d added to the campaign %d", launchID, campaignID)))
+	return nil
+}
+
+// validateTotalSupply checks the total supply of a campaign is sorted with positive amounts and valid denoms.
+func validateTotalSupply(totalSupply sdk.Coins) error {
+	if err := totalSupply.Validate(); err != nil {
+		return errors.Wrap(err, "invalid total supply")
+	}
+	if err := networktypes.ValidateCoinsDenom(totalSupply); err != nil {
+		return errors.Wrap(err, "invalid total supply")
+	}
+	return nil
+}
+
 // InitializeMainnet Initialize the mainnet of the campaign.
 func (n Network) InitializeMainnet(
 	ctx context.Context,
@@ -113,7 +179,7 @@ func (n Network) InitializeMainnet(
 	mainnetChainID string,
 ) (uint64, error) {
 	n.ev.Send(events.New(events.StatusOngoing, "Initializing the mainnet campaign"))
-	addr, err := n.account.Address(networktypes.SPN)
+	addr, err := n.accountAddress()
 	if err != nil {
 		return 0, err
 	}
@@ -126,7 +192,7 @@ func (n Network) InitializeMainnet(
 		mainnetChainID,
 	)
 
-	res, err := n.cosmos.BroadcastTx(ctx, n.account, msg)
+	res, err := n.broadcastTx(ctx, msg)
 	if err != nil {
 		return 0, err
 	}
@@ -153,8 +219,12 @@ func (n Network) UpdateCampaign(
 		apply(&p)
 	}
 
+	if err := validateTotalSupply(p.totalSupply); err != nil {
+		return err
+	}
+
 	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Updating the campaign %d", id)))
-	account, err := n.account.Address(networktypes.SPN)
+	account, err := n.accountAddress()
 	if err != nil {
 		return err
 	}
@@ -175,7 +245,7 @@ func (n Network) UpdateCampaign(
 		))
 	}
 
-	if _, err := n.cosmos.BroadcastTx(ctx, n.account, msgs...); err != nil {
+	if _, err := n.broadcastTx(ctx, msgs...); err != nil {
 		return err
 	}
 	n.ev.Send(events.New(events.StatusDone, fmt.Sprintf(
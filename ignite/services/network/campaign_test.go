@@ -0,0 +1,186 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+const (
+	testCampaignID    = uint64(3)
+	testCoordinatorID = uint64(1)
+)
+
+func TestCreateCampaign(t *testing.T) {
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	t.Run("successfully create a campaign", func(t *testing.T) {
+		var (
+			suite, network = newSuite(account)
+			totalSupply    = sdk.NewCoins(sdk.NewInt64Coin("foo", 100), sdk.NewInt64Coin("stake", 1000))
+		)
+
+		suite.CosmosClientMock.
+			On(
+				"BroadcastTx",
+				context.Background(),
+				account,
+				campaigntypes.NewMsgCreateCampaign(addr, testutil.ChainName, totalSupply, []byte("metadata")),
+			).
+			Return(testutil.NewResponse(&campaigntypes.MsgCreateCampaignResponse{
+				CampaignID: testCampaignID,
+			}), nil).
+			Once()
+
+		campaignID, err := network.CreateCampaign(context.Background(), testutil.ChainName, "metadata", totalSupply)
+		require.NoError(t, err)
+		require.Equal(t, testCampaignID, campaignID)
+		suite.AssertAllMocks(t)
+	})
+
+	tests := []struct {
+		name        string
+		totalSupply sdk.Coins
+		err         string
+	}{
+		{
+			name: "unsorted total supply",
+			totalSupply: sdk.Coins{
+				sdk.NewInt64Coin("stake", 1000),
+				sdk.NewInt64Coin("foo", 100),
+			},
+			err: "invalid total supply: denomination foo is not sorted",
+		},
+		{
+			name:        "non positive total supply",
+			totalSupply: sdk.Coins{sdk.NewInt64Coin("stake", 0)},
+			err:         "invalid total supply: coin 0stake amount is not positive",
+		},
+		{
+			name:        "invalid total supply denom",
+			totalSupply: sdk.Coins{sdk.Coin{Denom: "s", Amount: sdkmath.NewInt(10)}},
+			err:         "invalid total supply",
+		},
+	}
+	for _, tt := range tests {
+		t.Run("failed to create a campaign, "+tt.name, func(t *testing.T) {
+			suite, network := newSuite(account)
+
+			_, err := network.CreateCampaign(context.Background(), testutil.ChainName, "", tt.totalSupply)
+			require.ErrorContains(t, err, tt.err)
+			suite.AssertAllMocks(t)
+		})
+	}
+}
+
+func TestAddChainToCampaign(t *testing.T) {
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	mockQueries := func(suite testutil.Suite, chain launchtypes.Chain, campaignCoordinatorID uint64) {
+		suite.ProfileQueryMock.
+			On(
+				"CoordinatorByAddress",
+				context.Background(),
+				&profiletypes.QueryGetCoordinatorByAddressRequest{Address: addr},
+			).
+			Return(&profiletypes.QueryGetCoordinatorByAddressResponse{
+				CoordinatorByAddress: profiletypes.CoordinatorByAddress{
+					Address:       addr,
+					CoordinatorID: testCoordinatorID,
+				},
+			}, nil).
+			Once()
+		suite.LaunchQueryMock.
+			On("Chain", context.Background(), &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}).
+			Return(&launchtypes.QueryGetChainResponse{Chain: chain}, nil).
+			Once()
+		if campaignCoordinatorID == 0 {
+			return
+		}
+		suite.CampaignQueryMock.
+			On("Campaign", context.Background(), &campaigntypes.QueryGetCampaignRequest{CampaignID: testCampaignID}).
+			Return(&campaigntypes.QueryGetCampaignResponse{
+				Campaign: campaigntypes.Campaign{
+					CampaignID:    testCampaignID,
+					CoordinatorID: campaignCoordinatorID,
+				},
+			}, nil).
+			Once()
+	}
+
+	t.Run("successfully add a chain to a campaign", func(t *testing.T) {
+		suite, network := newSuite(account)
+
+		mockQueries(suite, launchtypes.Chain{
+			LaunchID:      testutil.LaunchID,
+			CoordinatorID: testCoordinatorID,
+		}, testCoordinatorID)
+		suite.CosmosClientMock.
+			On(
+				"BroadcastTx",
+				context.Background(),
+				account,
+				launchtypes.NewMsgEditChain(addr, testutil.LaunchID, true, testCampaignID, nil),
+			).
+			Return(testutil.NewResponse(&launchtypes.MsgEditChainResponse{}), nil).
+			Once()
+
+		err := network.AddChainToCampaign(context.Background(), testCampaignID, testutil.LaunchID)
+		require.NoError(t, err)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to add a chain to a campaign, the chain is not coordinated by the account", func(t *testing.T) {
+		suite, network := newSuite(account)
+
+		mockQueries(suite, launchtypes.Chain{
+			LaunchID:      testutil.LaunchID,
+			CoordinatorID: testCoordinatorID + 1,
+		}, 0)
+
+		err := network.AddChainToCampaign(context.Background(), testCampaignID, testutil.LaunchID)
+		require.EqualError(t, err, "the account "+addr+" doesn't coordinate the chain 1")
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to add a chain to a campaign, the chain already has a campaign", func(t *testing.T) {
+		suite, network := newSuite(account)
+
+		mockQueries(suite, launchtypes.Chain{
+			LaunchID:      testutil.LaunchID,
+			CoordinatorID: testCoordinatorID,
+			HasCampaign:   true,
+			CampaignID:    testCampaignID + 1,
+		}, 0)
+
+		err := network.AddChainToCampaign(context.Background(), testCampaignID, testutil.LaunchID)
+		require.EqualError(t, err, "the chain 1 is already attached to the campaign 4")
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to add a chain to a campaign, the campaign is not coordinated by the account", func(t *testing.T) {
+		suite, network := newSuite(account)
+
+		mockQueries(suite, launchtypes.Chain{
+			LaunchID:      testutil.LaunchID,
+			CoordinatorID: testCoordinatorID,
+		}, testCoordinatorID+1)
+
+		err := network.AddChainToCampaign(context.Background(), testCampaignID, testutil.LaunchID)
+		require.EqualError(t, err, "the account "+addr+" doesn't coordinate the campaign 3")
+		suite.AssertAllMocks(t)
+	})
+}
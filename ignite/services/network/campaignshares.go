@@ -0,0 +1,162 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmoserror"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+// ErrCampaignMainnetLaunched is returned when the shares of a campaign with a launched mainnet are updated.
+var ErrCampaignMainnetLaunched = errors.New("the mainnet of the campaign is launched, the campaign shares are immutable")
+
+// ErrTotalSharesReached is returned when an operation allocates more shares than the total shares of the campaign.
+var ErrTotalSharesReached = errors.New("the allocated shares of the campaign exceed the total shares")
+
+// UpdateSpecialAllocations replaces the special allocations of the campaign by the genesis distribution
+// and the claimable airdrop shares. The new special allocations and the allocated shares of the campaign
+// must not exceed the total shares.
+func (n Network) UpdateSpecialAllocations(
+	ctx context.Context,
+	campaignID uint64,
+	genesisDistribution,
+	claimable campaigntypes.Shares,
+) error {
+	addr, err := n.accountAddress()
+	if err != nil {
+		return err
+	}
+
+	specialAllocations := campaigntypes.NewSpecialAllocations(genesisDistribution, claimable)
+	if err := specialAllocations.Validate(); err != nil {
+		return errors.Wrap(err, "invalid special allocations")
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Updating the special allocations of the campaign %d", campaignID)))
+
+	campaign, err := n.mutableCampaign(ctx, campaignID)
+	if err != nil {
+		return err
+	}
+
+	// the current special allocations are part of the allocated shares and are replaced by the new ones
+	allocated, err := campaigntypes.DecreaseShares(campaign.AllocatedShares, campaign.SpecialAllocations.TotalShares())
+	if err != nil {
+		return errors.Wrap(err, "invalid allocated shares")
+	}
+	if _, err := n.checkTotalShares(ctx, campaigntypes.IncreaseShares(allocated, specialAllocations.TotalShares())); err != nil {
+		return err
+	}
+
+	msg := campaigntypes.NewMsgUpdateSpecialAllocations(addr, campaignID, specialAllocations)
+	res, err := n.broadcastTx(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	var updateRes campaigntypes.MsgUpdateSpecialAllocationsResponse
+	if err := res.Decode(&updateRes); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusDone, fmt.Sprintf("Special allocations of the campaign %d updated", campaignID)))
+	return nil
+}
+
+// MintVouchers mints the vouchers of the campaign shares and returns the minted vouchers.
+// The minted shares and the allocated shares of the campaign must not exceed the total shares.
+func (n Network) MintVouchers(ctx context.Context, campaignID uint64, shares campaigntypes.Shares) (sdk.Coins, error) {
+	addr, err := n.accountAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	if shares.Empty() {
+		return nil, errors.New("no shares to mint")
+	}
+	vouchers, err := campaigntypes.SharesToVouchers(shares, campaignID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid shares")
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Minting vouchers for the campaign %d", campaignID)))
+
+	campaign, err := n.mutableCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	totalShares, err := n.checkTotalShares(ctx, campaigntypes.IncreaseShares(campaign.AllocatedShares, shares))
+	if err != nil {
+		return nil, err
+	}
+
+	msg := campaigntypes.NewMsgMintVouchers(addr, campaignID, shares)
+	res, err := n.broadcastTx(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var mintRes campaigntypes.MsgMintVouchersResponse
+	if err := res.Decode(&mintRes); err != nil {
+		return nil, err
+	}
+
+	n.ev.Send(events.New(events.StatusDone, fmt.Sprintf(
+		"Vouchers %s minted for the campaign %d, %s of the total shares",
+		vouchers,
+		campaignID,
+		networktypes.FormatShares(shares, totalShares),
+	)))
+	return vouchers, nil
+}
+
+// mutableCampaign fetches the campaign and returns ErrCampaignMainnetLaunched if its mainnet is launched.
+func (n Network) mutableCampaign(ctx context.Context, campaignID uint64) (campaigntypes.Campaign, error) {
+	res, err := n.campaignQuery.Campaign(ctx, &campaigntypes.QueryGetCampaignRequest{
+		CampaignID: campaignID,
+	})
+	if cosmoserror.Unwrap(err) == cosmoserror.ErrNotFound {
+		return campaigntypes.Campaign{}, ErrObjectNotFound
+	} else if err != nil {
+		return campaigntypes.Campaign{}, err
+	}
+
+	campaign := res.Campaign
+	if !campaign.MainnetInitialized {
+		return campaign, nil
+	}
+
+	chainRes, err := n.launchQuery.Chain(ctx, &launchtypes.QueryGetChainRequest{
+		LaunchID: campaign.MainnetID,
+	})
+	if err != nil {
+		return campaigntypes.Campaign{}, errors.Wrapf(err, "cannot fetch the mainnet %d of the campaign", campaign.MainnetID)
+	}
+	if chainRes.Chain.LaunchTriggered {
+		return campaigntypes.Campaign{}, errors.Wrapf(ErrCampaignMainnetLaunched, "campaign %d", campaignID)
+	}
+	return campaign, nil
+}
+
+// checkTotalShares checks the shares don't exceed the total shares of the campaigns and returns the total shares.
+func (n Network) checkTotalShares(ctx context.Context, shares campaigntypes.Shares) (uint64, error) {
+	res, err := n.campaignQuery.TotalShares(ctx, &campaigntypes.QueryTotalSharesRequest{})
+	if err != nil {
+		return 0, err
+	}
+	reached, err := campaigntypes.IsTotalSharesReached(shares, res.TotalShares)
+	if err != nil {
+		return 0, err
+	}
+	if reached {
+		return 0, errors.Wrapf(ErrTotalSharesReached, "%s over %d", shares, res.TotalShares)
+	}
+	return res.TotalShares, nil
+}
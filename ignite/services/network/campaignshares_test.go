@@ -0,0 +1,188 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+const testTotalShares = uint64(1000)
+
+func TestUpdateSpecialAllocations(t *testing.T) {
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	var (
+		current             = campaigntypes.NewSharesFromCoins(sdk.NewCoins(sdk.NewInt64Coin("foo", 300)))
+		genesisDistribution = campaigntypes.NewSharesFromCoins(sdk.NewCoins(sdk.NewInt64Coin("foo", 400)))
+		claimable           = campaigntypes.NewSharesFromCoins(sdk.NewCoins(sdk.NewInt64Coin("foo", 200)))
+	)
+
+	mockQueries := func(suite testutil.Suite, campaign campaigntypes.Campaign) {
+		suite.CampaignQueryMock.
+			On("Campaign", context.Background(), &campaigntypes.QueryGetCampaignRequest{CampaignID: testCampaignID}).
+			Return(&campaigntypes.QueryGetCampaignResponse{Campaign: campaign}, nil).
+			Once()
+		suite.CampaignQueryMock.
+			On("TotalShares", context.Background(), &campaigntypes.QueryTotalSharesRequest{}).
+			Return(&campaigntypes.QueryTotalSharesResponse{TotalShares: testTotalShares}, nil).
+			Once()
+	}
+
+	t.Run("successfully update the special allocations", func(t *testing.T) {
+		suite, network := newSuite(account)
+
+		// the current special allocations are replaced, 500 + 600 - 300 doesn't exceed the total shares
+		mockQueries(suite, campaigntypes.Campaign{
+			CampaignID:         testCampaignID,
+			AllocatedShares:    campaigntypes.NewSharesFromCoins(sdk.NewCoins(sdk.NewInt64Coin("foo", 500))),
+			SpecialAllocations: campaigntypes.NewSpecialAllocations(current, campaigntypes.EmptyShares()),
+		})
+		suite.CosmosClientMock.
+			On(
+				"BroadcastTx",
+				context.Background(),
+				account,
+				campaigntypes.NewMsgUpdateSpecialAllocations(
+					addr,
+					testCampaignID,
+					campaigntypes.NewSpecialAllocations(genesisDistribution, claimable),
+				),
+			).
+			Return(testutil.NewResponse(&campaigntypes.MsgUpdateSpecialAllocationsResponse{}), nil).
+			Once()
+
+		err := network.UpdateSpecialAllocations(context.Background(), testCampaignID, genesisDistribution, claimable)
+		require.NoError(t, err)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to update the special allocations, total shares exceeded", func(t *testing.T) {
+		suite, network := newSuite(account)
+
+		mockQueries(suite, campaigntypes.Campaign{
+			CampaignID:         testCampaignID,
+			AllocatedShares:    campaigntypes.NewSharesFromCoins(sdk.NewCoins(sdk.NewInt64Coin("foo", 800))),
+			SpecialAllocations: campaigntypes.NewSpecialAllocations(current, campaigntypes.EmptyShares()),
+		})
+
+		err := network.UpdateSpecialAllocations(context.Background(), testCampaignID, genesisDistribution, claimable)
+		require.ErrorIs(t, err, ErrTotalSharesReached)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to update the special allocations, mainnet launched", func(t *testing.T) {
+		suite, network := newSuite(account)
+
+		suite.CampaignQueryMock.
+			On("Campaign", context.Background(), &campaigntypes.QueryGetCampaignRequest{CampaignID: testCampaignID}).
+			Return(&campaigntypes.QueryGetCampaignResponse{Campaign: campaigntypes.Campaign{
+				CampaignID:         testCampaignID,
+				MainnetInitialized: true,
+				MainnetID:          testutil.LaunchID,
+			}}, nil).
+			Once()
+		suite.LaunchQueryMock.
+			On("Chain", context.Background(), &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}).
+			Return(&launchtypes.QueryGetChainResponse{Chain: launchtypes.Chain{
+				LaunchID:        testutil.LaunchID,
+				LaunchTriggered: true,
+			}}, nil).
+			Once()
+
+		err := network.UpdateSpecialAllocations(context.Background(), testCampaignID, genesisDistribution, claimable)
+		require.ErrorIs(t, err, ErrCampaignMainnetLaunched)
+		suite.AssertAllMocks(t)
+	})
+}
+
+func TestMintVouchers(t *testing.T) {
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	shares := campaigntypes.NewSharesFromCoins(sdk.NewCoins(sdk.NewInt64Coin("foo", 400)))
+
+	t.Run("successfully mint vouchers", func(t *testing.T) {
+		var (
+			suite, network = newSuite(account)
+			ev             = events.NewBus(events.WithCustomBufferSize(10))
+		)
+		CollectEvents(ev)(&network)
+
+		suite.CampaignQueryMock.
+			On("Campaign", context.Background(), &campaigntypes.QueryGetCampaignRequest{CampaignID: testCampaignID}).
+			Return(&campaigntypes.QueryGetCampaignResponse{Campaign: campaigntypes.Campaign{
+				CampaignID:      testCampaignID,
+				AllocatedShares: campaigntypes.NewSharesFromCoins(sdk.NewCoins(sdk.NewInt64Coin("foo", 600))),
+			}}, nil).
+			Once()
+		suite.CampaignQueryMock.
+			On("TotalShares", context.Background(), &campaigntypes.QueryTotalSharesRequest{}).
+			Return(&campaigntypes.QueryTotalSharesResponse{TotalShares: testTotalShares}, nil).
+			Once()
+		suite.CosmosClientMock.
+			On("BroadcastTx", context.Background(), account, campaigntypes.NewMsgMintVouchers(addr, testCampaignID, shares)).
+			Return(testutil.NewResponse(&campaigntypes.MsgMintVouchersResponse{}), nil).
+			Once()
+
+		vouchers, err := network.MintVouchers(context.Background(), testCampaignID, shares)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("v/3/foo", 400)), vouchers)
+		suite.AssertAllMocks(t)
+		require.Contains(t, collectDescriptions(ev), "Vouchers 400v/3/foo minted for the campaign 3, 40% foo of the total shares")
+	})
+
+	t.Run("failed to mint vouchers, total shares exceeded", func(t *testing.T) {
+		suite, network := newSuite(account)
+
+		suite.CampaignQueryMock.
+			On("Campaign", context.Background(), &campaigntypes.QueryGetCampaignRequest{CampaignID: testCampaignID}).
+			Return(&campaigntypes.QueryGetCampaignResponse{Campaign: campaigntypes.Campaign{
+				CampaignID:      testCampaignID,
+				AllocatedShares: campaigntypes.NewSharesFromCoins(sdk.NewCoins(sdk.NewInt64Coin("foo", 601))),
+			}}, nil).
+			Once()
+		suite.CampaignQueryMock.
+			On("TotalShares", context.Background(), &campaigntypes.QueryTotalSharesRequest{}).
+			Return(&campaigntypes.QueryTotalSharesResponse{TotalShares: testTotalShares}, nil).
+			Once()
+
+		_, err := network.MintVouchers(context.Background(), testCampaignID, shares)
+		require.ErrorIs(t, err, ErrTotalSharesReached)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to mint vouchers, mainnet launched", func(t *testing.T) {
+		suite, network := newSuite(account)
+
+		suite.CampaignQueryMock.
+			On("Campaign", context.Background(), &campaigntypes.QueryGetCampaignRequest{CampaignID: testCampaignID}).
+			Return(&campaigntypes.QueryGetCampaignResponse{Campaign: campaigntypes.Campaign{
+				CampaignID:         testCampaignID,
+				MainnetInitialized: true,
+				MainnetID:          testutil.LaunchID,
+			}}, nil).
+			Once()
+		suite.LaunchQueryMock.
+			On("Chain", context.Background(), &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}).
+			Return(&launchtypes.QueryGetChainResponse{Chain: launchtypes.Chain{
+				LaunchID:        testutil.LaunchID,
+				LaunchTriggered: true,
+			}}, nil).
+			Once()
+
+		_, err := network.MintVouchers(context.Background(), testCampaignID, shares)
+		require.ErrorIs(t, err, ErrCampaignMainnetLaunched)
+		suite.AssertAllMocks(t)
+	})
+}
@@ -17,7 +17,7 @@ func (n Network) CreateClient(
 	unbondingTime int64,
 	rewardsInfo networktypes.Reward,
 ) (string, error) {
-	addr, err := n.account.Address(networktypes.SPN)
+	addr, err := n.accountAddress()
 	if err != nil {
 		return "", err
 	}
@@ -31,7 +31,7 @@ func (n Network) CreateClient(
 		rewardsInfo.RevisionHeight,
 	)
 
-	res, err := n.cosmos.BroadcastTx(ctx, n.account, msgCreateClient)
+	res, err := n.broadcastTx(ctx, msgCreateClient)
 	if err != nil {
 		return "", err
 	}
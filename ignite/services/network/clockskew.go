@@ -0,0 +1,121 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+const (
+	// DefaultClockSkewThreshold is the clock skew with the SPN node above which a warning is reported.
+	DefaultClockSkewThreshold = 30 * time.Second
+
+	// spnBlockInterval is the expected interval between two blocks of SPN.
+	// The latest block time is at most one interval behind the time of the node.
+	spnBlockInterval = 6 * time.Second
+
+	// clockSkewCacheDuration is the duration a measured clock skew is reused before being measured again.
+	clockSkewCacheDuration = 3 * time.Minute
+)
+
+// clockSkewCache caches the clock skew measured with the SPN node, it is shared by the copies of a Network.
+type clockSkewCache struct {
+	mu         sync.Mutex
+	skew       time.Duration
+	measuredAt time.Time
+}
+
+// WithClockSkewThreshold sets the clock skew with the SPN node above which a warning is reported.
+func WithClockSkewThreshold(threshold time.Duration) Option {
+	return func(n *Network) {
+		n.clockSkewThreshold = threshold
+	}
+}
+
+// AdjustClockSkew corrects the local time with the clock skew measured with the SPN node when the skew
+// is above the threshold, the launch times are then computed from the time of the SPN node.
+func AdjustClockSkew() Option {
+	return func(n *Network) {
+		n.adjustClockSkew = true
+	}
+}
+
+// ClockSkew returns the skew of the local clock with the SPN node, a positive skew when the local clock is ahead.
+// The skew is measured from the time of the latest block of the node, the skews smaller than the block interval
+// can't be measured and are zero. A warning is sent when the skew is above the threshold.
+// The measured skew is cached for a few minutes.
+func (n Network) ClockSkew(ctx context.Context) (time.Duration, error) {
+	n.clockSkew.mu.Lock()
+	defer n.clockSkew.mu.Unlock()
+
+	now := n.clock.Now()
+	if !n.clockSkew.measuredAt.IsZero() && now.Sub(n.clockSkew.measuredAt) < clockSkewCacheDuration {
+		return n.clockSkew.skew, nil
+	}
+
+	status, err := n.cosmos.Status(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot fetch the latest block time")
+	}
+
+	skew := measureClockSkew(now, status.SyncInfo.LatestBlockTime)
+	n.clockSkew.skew = skew
+	n.clockSkew.measuredAt = now
+
+	if isClockSkewed(skew, n.clockSkewThreshold) {
+		direction := "ahead of"
+		if skew < 0 {
+			direction = "behind"
+		}
+		n.ev.Send(events.NewWarning(fmt.Sprintf(
+			"your system clock is %s %s the SPN node, the launch times may be wrong, synchronize your clock",
+			absDuration(skew).Round(time.Second),
+			direction,
+		)))
+	}
+	return skew, nil
+}
+
+// now returns the local time, corrected with the clock skew with the SPN node when the adjustment is enabled.
+// The time is not corrected when the skew can't be measured.
+func (n Network) now(ctx context.Context) time.Time {
+	now := n.clock.Now()
+	skew, err := n.ClockSkew(ctx)
+	if err != nil {
+		n.ev.Send(events.NewDebug(fmt.Sprintf("Cannot check the clock skew: %s", err)))
+		return now
+	}
+	if n.adjustClockSkew && isClockSkewed(skew, n.clockSkewThreshold) {
+		return now.Add(-skew)
+	}
+	return now
+}
+
+// measureClockSkew returns the skew of the local time with the time of the latest block.
+// The node time is between the block time and the next block time, a local time in this range has no skew.
+func measureClockSkew(now, blockTime time.Time) time.Duration {
+	switch {
+	case now.Before(blockTime):
+		return now.Sub(blockTime)
+	case now.After(blockTime.Add(spnBlockInterval)):
+		return now.Sub(blockTime.Add(spnBlockInterval))
+	default:
+		return 0
+	}
+}
+
+func isClockSkewed(skew, threshold time.Duration) bool {
+	return absDuration(skew) > threshold
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,170 @@
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/xtime"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+// mockBlockTime mocks the status of the SPN node with the time of its latest block.
+func mockBlockTime(suite testutil.Suite, blockTime time.Time) {
+	suite.CosmosClientMock.
+		On("Status", mock.Anything).
+		Return(&ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockTime: blockTime}}, nil).
+		Once()
+}
+
+// mockSyncedNode mocks the SPN node with a clock in sync with the clock.
+func mockSyncedNode(suite testutil.Suite, clock xtime.Clock) {
+	suite.CosmosClientMock.
+		On("Status", mock.Anything).
+		Return(func(context.Context) *ctypes.ResultStatus {
+			return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockTime: clock.Now()}}
+		}, nil)
+}
+
+func TestClockSkew(t *testing.T) {
+	tests := []struct {
+		name      string
+		blockTime time.Time
+		skew      time.Duration
+		warning   string
+	}{
+		{
+			name:      "clock in sync",
+			blockTime: sampleTime.Add(-2 * time.Second),
+		},
+		{
+			name:      "clock ahead below the threshold",
+			blockTime: sampleTime.Add(-spnBlockInterval - 10*time.Second),
+			skew:      10 * time.Second,
+		},
+		{
+			name:      "clock ahead",
+			blockTime: sampleTime.Add(-spnBlockInterval - 5*time.Minute),
+			skew:      5 * time.Minute,
+			warning:   "your system clock is 5m0s ahead of the SPN node, the launch times may be wrong, synchronize your clock",
+		},
+		{
+			name:      "clock behind",
+			blockTime: sampleTime.Add(2 * time.Minute),
+			skew:      -2 * time.Minute,
+			warning:   "your system clock is 2m0s behind the SPN node, the launch times may be wrong, synchronize your clock",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+				suite, network = newSuite(account)
+				ev             = events.NewBus(events.WithCustomBufferSize(10))
+			)
+			CollectEvents(ev)(&network)
+			mockBlockTime(suite, tt.blockTime)
+
+			skew, err := network.ClockSkew(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tt.skew, skew)
+			suite.AssertAllMocks(t)
+
+			ev.Shutdown(context.Background())
+			var warnings []string
+			for e := range ev.Events() {
+				require.Equal(t, events.StatusWarning, e.Status)
+				warnings = append(warnings, e.Description)
+			}
+			if tt.warning == "" {
+				require.Empty(t, warnings)
+			} else {
+				require.Equal(t, []string{tt.warning}, warnings)
+			}
+		})
+	}
+}
+
+func TestClockSkewCache(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+		clock          = xtime.NewClockMock(sampleTime)
+	)
+	WithCustomClock(clock)(&network)
+
+	// the skew is measured once for the copies of the network
+	mockBlockTime(suite, sampleTime.Add(time.Minute))
+	skew, err := network.ClockSkew(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, -time.Minute, skew)
+
+	copied := network
+	clock.Add(clockSkewCacheDuration - time.Second)
+	skew, err = copied.ClockSkew(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, -time.Minute, skew)
+	suite.AssertAllMocks(t)
+
+	// the skew is measured again once the cache expired
+	clock.Add(time.Second)
+	mockBlockTime(suite, clock.Now())
+	skew, err = network.ClockSkew(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, skew)
+	suite.AssertAllMocks(t)
+}
+
+func TestTriggerLaunchAdjustClockSkew(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+		nodeTime       = sampleTime.Add(-time.Hour)
+		launchTime     = nodeTime.Add(TestMinRemainingTime).Add(MinLaunchTimeOffset)
+	)
+
+	mockCoordinator(t, suite, account)
+	AdjustClockSkew()(&network)
+
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	// the local clock is one hour ahead, the launch time range is computed from the time of the node
+	mockBlockTime(suite, nodeTime.Add(-spnBlockInterval))
+	suite.LaunchQueryMock.
+		On("Params", context.Background(), &launchtypes.QueryParamsRequest{}).
+		Return(&launchtypes.QueryParamsResponse{
+			Params: launchtypes.NewParams(
+				TestMinRemainingTime,
+				TestMaxRemainingTime,
+				TestRevertDelay,
+				sdk.Coins(nil),
+				sdk.Coins(nil),
+			),
+		}, nil).
+		Once()
+	mockPendingRequests(suite)
+	suite.CosmosClientMock.
+		On("BroadcastTx",
+			context.Background(),
+			account,
+			&launchtypes.MsgTriggerLaunch{
+				Coordinator: addr,
+				LaunchID:    testutil.LaunchID,
+				LaunchTime:  launchTime,
+			}).
+		Return(testutil.NewResponse(&launchtypes.MsgTriggerLaunchResponse{}), nil).
+		Once()
+
+	err = network.TriggerLaunch(context.Background(), testutil.LaunchID, launchTime)
+	require.NoError(t, err)
+	suite.AssertAllMocks(t)
+}
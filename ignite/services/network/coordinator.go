@@ -0,0 +1,87 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmoserror"
+	"github.com/ignite/cli/ignite/services/network/networkchain"
+)
+
+// the launch bundles are verified against the coordinator of the chain resolved by the network
+var _ networkchain.ChainCoordinator = Network{}
+
+// ErrNotCoordinator is returned when a coordinator-only operation is performed with an account
+// that doesn't coordinate the chain.
+type ErrNotCoordinator struct {
+	// Expected is the address of the coordinator of the chain.
+	Expected string
+
+	// Actual is the address of the account of the network.
+	Actual string
+}
+
+// Error implements error.
+func (e ErrNotCoordinator) Error() string {
+	return fmt.Sprintf("the account %s is not the coordinator of the chain, the coordinator is %s", e.Actual, e.Expected)
+}
+
+// SkipCoordinatorCheck doesn't check the account is the coordinator of the chain before broadcasting
+// the coordinator-only messages, it's used when the messages are signed by another account like a multisig.
+func SkipCoordinatorCheck() Option {
+	return func(n *Network) {
+		n.skipCoordinatorCheck = true
+	}
+}
+
+// checkCoordinator checks the account of the network coordinates the chain, SPN rejects the coordinator-only
+// messages of other accounts only once the fees are paid.
+func (n Network) checkCoordinator(ctx context.Context, launchID uint64) error {
+	if n.skipCoordinatorCheck {
+		return nil
+	}
+
+	addr, err := n.accountAddress()
+	if err != nil {
+		return err
+	}
+
+	coordinator, err := n.ChainCoordinatorAddress(ctx, launchID)
+	if err != nil {
+		return err
+	}
+
+	if coordinator != addr {
+		return ErrNotCoordinator{
+			Expected: coordinator,
+			Actual:   addr,
+		}
+	}
+	return nil
+}
+
+// ChainCoordinatorAddress returns the address of the coordinator of the chain,
+// the address the coordinator signs the launch bundles of the chain with.
+func (n Network) ChainCoordinatorAddress(ctx context.Context, launchID uint64) (string, error) {
+	chainRes, err := n.launchQuery.Chain(ctx, &launchtypes.QueryGetChainRequest{
+		LaunchID: launchID,
+	})
+	if cosmoserror.Unwrap(err) == cosmoserror.ErrNotFound {
+		return "", ErrObjectNotFound
+	} else if err != nil {
+		return "", err
+	}
+
+	coordinatorRes, err := n.profileQuery.Coordinator(ctx, &profiletypes.QueryGetCoordinatorRequest{
+		CoordinatorID: chainRes.Chain.CoordinatorID,
+	})
+	if cosmoserror.Unwrap(err) == cosmoserror.ErrNotFound {
+		return "", ErrObjectNotFound
+	} else if err != nil {
+		return "", err
+	}
+	return coordinatorRes.Coordinator.Address, nil
+}
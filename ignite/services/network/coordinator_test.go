@@ -0,0 +1,89 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+// mockCoordinator mocks the queries of the coordinator of the chain, the account is the coordinator.
+func mockCoordinator(t *testing.T, suite testutil.Suite, account cosmosaccount.Account) {
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	suite.LaunchQueryMock.
+		On("Chain", context.Background(), &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}).
+		Return(&launchtypes.QueryGetChainResponse{
+			Chain: launchtypes.Chain{
+				LaunchID:      testutil.LaunchID,
+				CoordinatorID: testCoordinatorID,
+			},
+		}, nil).
+		Once()
+	suite.ProfileQueryMock.
+		On("Coordinator", context.Background(), &profiletypes.QueryGetCoordinatorRequest{CoordinatorID: testCoordinatorID}).
+		Return(&profiletypes.QueryGetCoordinatorResponse{
+			Coordinator: profiletypes.Coordinator{
+				CoordinatorID: testCoordinatorID,
+				Address:       addr,
+				Active:        true,
+			},
+		}, nil).
+		Once()
+}
+
+func TestCheckCoordinator(t *testing.T) {
+	t.Run("the account is the coordinator", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+		)
+
+		mockCoordinator(t, suite, account)
+
+		require.NoError(t, network.checkCoordinator(context.Background(), testutil.LaunchID))
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("the account is not the coordinator", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			coordinator    = testutil.NewTestAccount(t, "coordinator")
+			suite, network = newSuite(account)
+		)
+
+		addr, err := account.Address(networktypes.SPN)
+		require.NoError(t, err)
+		coordinatorAddr, err := coordinator.Address(networktypes.SPN)
+		require.NoError(t, err)
+
+		mockCoordinator(t, suite, coordinator)
+
+		err = network.TriggerLaunch(context.Background(), testutil.LaunchID, sampleTime)
+		require.Equal(t, ErrNotCoordinator{Expected: coordinatorAddr, Actual: addr}, err)
+		require.EqualError(t, err, "the account "+addr+" is not the coordinator of the chain, the coordinator is "+coordinatorAddr)
+
+		// no message is broadcasted
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("the coordinator check is skipped", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+		)
+
+		SkipCoordinatorCheck()(&network)
+
+		// no query is performed
+		require.NoError(t, network.checkCoordinator(context.Background(), testutil.LaunchID))
+		suite.AssertAllMocks(t)
+	})
+}
@@ -0,0 +1,225 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+const (
+	// StaleRequestAge is the age of a pending request from which it is reported as stale in the overview.
+	StaleRequestAge = 7 * 24 * time.Hour
+
+	// overviewConcurrency is the maximum number of chains queried at the same time for the overview.
+	overviewConcurrency = 5
+)
+
+type (
+	// CoordinatorOverview contains the actionable items of the chains coordinated by an account.
+	CoordinatorOverview struct {
+		Coordinator   string `json:"coordinator"`
+		CoordinatorID uint64 `json:"coordinator_id"`
+
+		// Height is the block height of SPN all the queries of the overview are pinned to.
+		Height int64 `json:"height"`
+
+		Chains []ChainOverview `json:"chains"`
+	}
+
+	// ChainOverview is the state of a coordinated chain.
+	ChainOverview struct {
+		LaunchID        uint64    `json:"launch_id"`
+		ChainID         string    `json:"chain_id"`
+		LaunchTriggered bool      `json:"launch_triggered"`
+		LaunchTime      time.Time `json:"launch_time,omitempty"`
+
+		// TimeToLaunch is the time remaining before the launch time, zero when the launch isn't triggered
+		// or the launch time is reached.
+		TimeToLaunch time.Duration `json:"time_to_launch"`
+
+		PendingRequests   int `json:"pending_requests"`
+		GenesisValidators int `json:"genesis_validators"`
+
+		// StaleRequests are the IDs of the pending requests older than StaleRequestAge.
+		StaleRequests []uint64 `json:"stale_requests,omitempty"`
+
+		Readiness ChainReadiness `json:"readiness"`
+
+		// Error is the error of the queries of the chain, the other fields are then incomplete.
+		Error string `json:"error,omitempty"`
+	}
+
+	// ChainReadiness tells if a chain can be launched by the coordinator.
+	ChainReadiness struct {
+		Ready bool `json:"ready"`
+
+		// Reasons are the reasons the chain can't be launched yet.
+		Reasons []string `json:"reasons,omitempty"`
+	}
+)
+
+// CoordinatorOverview returns the actionable items of all the chains coordinated by the network account.
+// The queries are pinned to the same block height and the chains are queried concurrently,
+// a chain that can't be queried is reported with its error without failing the overview.
+func (n Network) CoordinatorOverview(ctx context.Context) (CoordinatorOverview, error) {
+	addr, err := n.accountAddress()
+	if err != nil {
+		return CoordinatorOverview{}, err
+	}
+
+	coordinatorID, err := n.CoordinatorIDByAddress(ctx, addr)
+	if err != nil {
+		return CoordinatorOverview{}, err
+	}
+
+	overview := CoordinatorOverview{
+		Coordinator:   addr,
+		CoordinatorID: coordinatorID,
+	}
+
+	// the overview is always a consistent view of SPN
+	pinned := n
+	pinned.pinHeight = true
+	err = pinned.withPinnedHeight(ctx, func(ctx context.Context, height int64) error {
+		chains, err := n.coordinatorChains(ctx, coordinatorID)
+		if err != nil {
+			return err
+		}
+
+		overview.Height = height
+		overview.Chains = make([]ChainOverview, len(chains))
+
+		var (
+			g  errgroup.Group
+			mu sync.Mutex
+		)
+		g.SetLimit(overviewConcurrency)
+		for i, chain := range chains {
+			i, chain := i, chain
+			g.Go(func() error {
+				chainOverview, err := n.chainOverview(ctx, chain)
+				if err != nil {
+					return err
+				}
+				mu.Lock()
+				overview.Chains[i] = chainOverview
+				mu.Unlock()
+				return nil
+			})
+		}
+		return g.Wait()
+	})
+	if err != nil {
+		return CoordinatorOverview{}, err
+	}
+	return overview, nil
+}
+
+// coordinatorChains returns the chains of the coordinator sorted by launch ID.
+func (n Network) coordinatorChains(ctx context.Context, coordinatorID uint64) ([]launchtypes.Chain, error) {
+	var (
+		chains     []launchtypes.Chain
+		pagination *query.PageRequest
+	)
+	for {
+		observe := n.observeLatency("chains", MetricsKindQuery)
+		res, err := n.launchQuery.ChainAll(ctx, &launchtypes.QueryAllChainRequest{Pagination: pagination})
+		observe()
+		if err != nil {
+			return nil, err
+		}
+		for _, chain := range res.Chain {
+			if chain.CoordinatorID == coordinatorID {
+				chains = append(chains, chain)
+			}
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pagination = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+
+	sort.Slice(chains, func(i, j int) bool {
+		return chains[i].LaunchID < chains[j].LaunchID
+	})
+	return chains, nil
+}
+
+// chainOverview returns the overview of the chain, the errors are reported in the overview.
+// The error of a pruned height is returned to retry the overview at a newer height.
+func (n Network) chainOverview(ctx context.Context, chain launchtypes.Chain) (ChainOverview, error) {
+	launch := networktypes.ToChainLaunch(chain)
+	overview := ChainOverview{
+		LaunchID:        launch.ID,
+		ChainID:         launch.ChainID,
+		LaunchTriggered: launch.LaunchTriggered,
+		LaunchTime:      launch.LaunchTime,
+	}
+
+	now := n.clock.Now()
+	if launch.LaunchTriggered && launch.LaunchTime.After(now) {
+		overview.TimeToLaunch = launch.LaunchTime.Sub(now)
+	}
+
+	observe := n.observeLatency("requests", MetricsKindQuery)
+	requests, err := n.allRequests(ctx, launch.ID)
+	observe()
+	if isHeightPruned(err) {
+		return ChainOverview{}, err
+	}
+	if err != nil {
+		overview.Error = fmt.Sprintf("cannot fetch the requests: %s", err)
+		return overview, nil
+	}
+	for _, request := range requests {
+		if request.Status != launchtypes.Request_PENDING {
+			continue
+		}
+		overview.PendingRequests++
+		if now.Sub(time.Unix(request.CreatedAt, 0)) >= StaleRequestAge {
+			overview.StaleRequests = append(overview.StaleRequests, request.RequestID)
+		}
+	}
+	n.metrics.SetPendingRequests(launch.ID, overview.PendingRequests)
+
+	observe = n.observeLatency("genesis_validators", MetricsKindQuery)
+	validators, err := n.genesisValidatorCount(ctx, launch.ID)
+	observe()
+	if isHeightPruned(err) {
+		return ChainOverview{}, err
+	}
+	if err != nil {
+		overview.Error = fmt.Sprintf("cannot fetch the genesis validators: %s", err)
+		return overview, nil
+	}
+	overview.GenesisValidators = int(validators)
+
+	overview.Readiness = chainReadiness(overview)
+	return overview, nil
+}
+
+// chainReadiness evaluates if the chain can be launched from its overview.
+func chainReadiness(overview ChainOverview) ChainReadiness {
+	var reasons []string
+	if overview.LaunchTriggered {
+		reasons = append(reasons, "the launch is already triggered")
+	}
+	if overview.GenesisValidators == 0 {
+		reasons = append(reasons, "the chain has no genesis validator")
+	}
+	if overview.PendingRequests > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d requests are pending", overview.PendingRequests))
+	}
+	return ChainReadiness{
+		Ready:   len(reasons) == 0,
+		Reasons: reasons,
+	}
+}
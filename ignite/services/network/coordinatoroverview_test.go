@@ -0,0 +1,178 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+
+	"github.com/ignite/cli/ignite/pkg/xtime"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+func TestCoordinatorOverview(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+		now            = sampleTime.Add(10 * 24 * time.Hour)
+		launchTime     = now.Add(time.Hour)
+	)
+	WithCustomClock(xtime.NewClockMock(now))(&network)
+
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	suite.ProfileQueryMock.
+		On(
+			"CoordinatorByAddress",
+			context.Background(),
+			&profiletypes.QueryGetCoordinatorByAddressRequest{Address: addr},
+		).
+		Return(&profiletypes.QueryGetCoordinatorByAddressResponse{
+			CoordinatorByAddress: profiletypes.CoordinatorByAddress{
+				Address:       addr,
+				CoordinatorID: testCoordinatorID,
+			},
+		}, nil).
+		Once()
+	suite.CosmosClientMock.
+		On("Status", mock.Anything).
+		Return(newStatus(42), nil).
+		Once()
+	suite.LaunchQueryMock.
+		On("ChainAll", mock.Anything, &launchtypes.QueryAllChainRequest{}).
+		Return(&launchtypes.QueryAllChainResponse{
+			Chain: []launchtypes.Chain{
+				{LaunchID: 3, GenesisChainID: "bar-1", CoordinatorID: testCoordinatorID},
+				{LaunchID: 2, GenesisChainID: "other-1", CoordinatorID: testCoordinatorID + 1},
+				{
+					LaunchID:        1,
+					GenesisChainID:  "foo-1",
+					CoordinatorID:   testCoordinatorID,
+					LaunchTriggered: true,
+					LaunchTime:      launchTime,
+				},
+			},
+		}, nil).
+		Once()
+	suite.LaunchQueryMock.
+		On("RequestAll", mock.Anything, &launchtypes.QueryAllRequestRequest{LaunchID: 1}).
+		Return(&launchtypes.QueryAllRequestResponse{
+			Request: []launchtypes.Request{
+				{RequestID: 1, Status: launchtypes.Request_APPROVED, CreatedAt: sampleTime.Unix()},
+				{RequestID: 2, Status: launchtypes.Request_PENDING, CreatedAt: sampleTime.Unix()},
+				{RequestID: 3, Status: launchtypes.Request_PENDING, CreatedAt: now.Add(-time.Hour).Unix()},
+			},
+		}, nil).
+		Once()
+	suite.LaunchQueryMock.
+		On("GenesisValidatorAll", mock.Anything, &launchtypes.QueryAllGenesisValidatorRequest{
+			LaunchID:   1,
+			Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+		}).
+		Return(&launchtypes.QueryAllGenesisValidatorResponse{
+			GenesisValidator: []launchtypes.GenesisValidator{{LaunchID: 1}},
+			Pagination:       &query.PageResponse{Total: 3},
+		}, nil).
+		Once()
+	suite.LaunchQueryMock.
+		On("RequestAll", mock.Anything, &launchtypes.QueryAllRequestRequest{LaunchID: 3}).
+		Return(nil, errors.New("unavailable")).
+		Once()
+
+	overview, err := network.CoordinatorOverview(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, CoordinatorOverview{
+		Coordinator:   addr,
+		CoordinatorID: testCoordinatorID,
+		Height:        42,
+		Chains: []ChainOverview{
+			{
+				LaunchID:          1,
+				ChainID:           "foo-1",
+				LaunchTriggered:   true,
+				LaunchTime:        launchTime,
+				TimeToLaunch:      time.Hour,
+				PendingRequests:   2,
+				GenesisValidators: 3,
+				StaleRequests:     []uint64{2},
+				Readiness: ChainReadiness{
+					Reasons: []string{
+						"the launch is already triggered",
+						"2 requests are pending",
+					},
+				},
+			},
+			{
+				LaunchID: 3,
+				ChainID:  "bar-1",
+				Error:    "cannot fetch the requests: unavailable",
+			},
+		},
+	}, overview)
+	suite.AssertAllMocks(t)
+}
+
+func TestCoordinatorOverviewPrunedHeight(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+	)
+	WithCustomClock(xtime.NewClockMock(sampleTime))(&network)
+
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	suite.ProfileQueryMock.
+		On("CoordinatorByAddress", context.Background(), &profiletypes.QueryGetCoordinatorByAddressRequest{Address: addr}).
+		Return(&profiletypes.QueryGetCoordinatorByAddressResponse{
+			CoordinatorByAddress: profiletypes.CoordinatorByAddress{Address: addr, CoordinatorID: testCoordinatorID},
+		}, nil).
+		Once()
+	suite.CosmosClientMock.On("Status", mock.Anything).Return(newStatus(42), nil).Once()
+	suite.CosmosClientMock.On("Status", mock.Anything).Return(newStatus(43), nil).Once()
+	suite.LaunchQueryMock.
+		On("ChainAll", mock.Anything, &launchtypes.QueryAllChainRequest{}).
+		Return(&launchtypes.QueryAllChainResponse{
+			Chain: []launchtypes.Chain{{LaunchID: 1, GenesisChainID: "foo-1", CoordinatorID: testCoordinatorID}},
+		}, nil).
+		Twice()
+
+	// the height is pruned while the chain is queried, the overview is fetched again at a newer height
+	suite.LaunchQueryMock.
+		On("RequestAll", mock.Anything, &launchtypes.QueryAllRequestRequest{LaunchID: 1}).
+		Return(nil, errors.New("version does not exist")).
+		Once()
+	suite.LaunchQueryMock.
+		On("RequestAll", mock.Anything, &launchtypes.QueryAllRequestRequest{LaunchID: 1}).
+		Return(&launchtypes.QueryAllRequestResponse{}, nil).
+		Once()
+	suite.LaunchQueryMock.
+		On("GenesisValidatorAll", mock.Anything, &launchtypes.QueryAllGenesisValidatorRequest{
+			LaunchID:   1,
+			Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+		}).
+		Return(&launchtypes.QueryAllGenesisValidatorResponse{}, nil).
+		Once()
+
+	overview, err := network.CoordinatorOverview(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(43), overview.Height)
+	require.Equal(t, []ChainOverview{
+		{
+			LaunchID: 1,
+			ChainID:  "foo-1",
+			Readiness: ChainReadiness{
+				Reasons: []string{"the chain has no genesis validator"},
+			},
+		},
+	}, overview.Chains)
+	suite.AssertAllMocks(t)
+}
@@ -0,0 +1,22 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+// denomUnits fetches the denoms metadata of SPN to display the coins of the messages.
+// The coins are displayed raw when the metadata can't be fetched.
+func (n Network) denomUnits(ctx context.Context) networktypes.DenomUnits {
+	res, err := n.bankQuery.DenomsMetadata(ctx, &banktypes.QueryDenomsMetadataRequest{})
+	if err != nil {
+		n.ev.Send(events.NewDebug(fmt.Sprintf("Cannot fetch the denoms metadata: %s", err)))
+		return nil
+	}
+	return networktypes.NewDenomUnits(res.Metadatas...)
+}
@@ -0,0 +1,69 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+// testDenomMetadata displays the amounts of TestDenom in mstake.
+var testDenomMetadata = banktypes.Metadata{
+	Base:    TestDenom,
+	Display: "mstake",
+	DenomUnits: []*banktypes.DenomUnit{
+		{Denom: TestDenom, Exponent: 0},
+		{Denom: "mstake", Exponent: 3},
+	},
+}
+
+// mockDenomsMetadata mocks the query of the denoms metadata of SPN.
+func mockDenomsMetadata(suite testutil.Suite, metadata ...banktypes.Metadata) {
+	suite.BankClient.
+		On("DenomsMetadata", context.Background(), &banktypes.QueryDenomsMetadataRequest{}).
+		Return(&banktypes.QueryDenomsMetadataResponse{Metadatas: metadata}, nil).
+		Once()
+}
+
+// collectDescriptions returns the descriptions of the events sent to the bus.
+func collectDescriptions(ev events.Bus) []string {
+	ev.Shutdown(context.Background())
+	var descriptions []string
+	for e := range ev.Events() {
+		descriptions = append(descriptions, e.Description)
+	}
+	return descriptions
+}
+
+func TestDenomUnits(t *testing.T) {
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+
+	t.Run("fetch the denoms metadata", func(t *testing.T) {
+		suite, network := newSuite(account)
+		mockDenomsMetadata(suite, testDenomMetadata)
+
+		units := network.denomUnits(context.Background())
+		require.Equal(t, networktypes.DenomUnits{TestDenom: {Display: "mstake", Exponent: 3}}, units)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("display the coins raw without metadata", func(t *testing.T) {
+		suite, network := newSuite(account)
+		suite.BankClient.
+			On("DenomsMetadata", context.Background(), &banktypes.QueryDenomsMetadataRequest{}).
+			Return(nil, errors.New("unavailable")).
+			Once()
+
+		units := network.denomUnits(context.Background())
+		require.Empty(t, units)
+		require.Equal(t, "1000stake", networktypes.FormatCoin(sdk.NewInt64Coin(TestDenom, 1000), units))
+		suite.AssertAllMocks(t)
+	})
+}
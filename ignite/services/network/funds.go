@@ -0,0 +1,169 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+const (
+	// minFundsPollInterval is the interval before the first poll of the balance while waiting for funds.
+	minFundsPollInterval = 2 * time.Second
+
+	// maxFundsPollInterval is the maximum interval between two polls of the balance while waiting for funds.
+	maxFundsPollInterval = 30 * time.Second
+)
+
+// ErrInsufficientFunds is returned when the balance of the account doesn't reach the fees required
+// by the broadcasted messages before the timeout.
+type ErrInsufficientFunds struct {
+	// Required are the fees required by the messages.
+	Required sdk.Coins
+
+	// Missing are the funds still missing on the account when the wait timed out.
+	Missing sdk.Coins
+
+	// Timeout is the time waited for the funds.
+	Timeout time.Duration
+}
+
+// Error implements error.
+func (e ErrInsufficientFunds) Error() string {
+	return fmt.Sprintf(
+		"insufficient funds after waiting %s: the account balance is missing %s to pay the %s fee",
+		e.Timeout,
+		e.Missing,
+		e.Required,
+	)
+}
+
+// WithFundsWait waits up to the timeout for the balance of the account to pay the fees required by the messages
+// before broadcasting them, the funds of a faucet can arrive after the operation started.
+func WithFundsWait(timeout time.Duration) Option {
+	return func(n *Network) {
+		n.fundsTimeout = timeout
+	}
+}
+
+// requiredFees returns the fees charged by SPN for the messages, the transaction fees are not included.
+func (n Network) requiredFees(ctx context.Context, msgs ...sdk.Msg) (sdk.Coins, error) {
+	var (
+		fees           sdk.Coins
+		launchParams   *launchtypes.Params
+		campaignParams *campaigntypes.Params
+	)
+	fetchLaunchParams := func() error {
+		if launchParams != nil {
+			return nil
+		}
+		params, err := n.LaunchParams(ctx)
+		launchParams = &params
+		return err
+	}
+	for _, msg := range msgs {
+		switch msg.(type) {
+		case *launchtypes.MsgCreateChain:
+			if err := fetchLaunchParams(); err != nil {
+				return nil, err
+			}
+			fees = fees.Add(launchParams.ChainCreationFee...)
+		case *launchtypes.MsgSendRequest:
+			if err := fetchLaunchParams(); err != nil {
+				return nil, err
+			}
+			fees = fees.Add(launchParams.RequestFee...)
+		case *campaigntypes.MsgCreateCampaign:
+			if campaignParams == nil {
+				observe := n.observeLatency("campaign_params", MetricsKindQuery)
+				res, err := n.campaignQuery.Params(ctx, &campaigntypes.QueryParamsRequest{})
+				observe()
+				if err != nil {
+					return nil, err
+				}
+				campaignParams = &res.Params
+			}
+			fees = fees.Add(campaignParams.CampaignCreationFee...)
+		}
+	}
+	return fees, nil
+}
+
+// waitForFunds waits for the balance of the account to pay the fees required by the messages.
+// The balance is polled with a backoff until the timeout, the wait is skipped when the messages have no fee.
+func (n Network) waitForFunds(ctx context.Context, msgs ...sdk.Msg) error {
+	if n.fundsTimeout <= 0 {
+		return nil
+	}
+
+	addr, err := n.accountAddress()
+	if err != nil {
+		return err
+	}
+
+	required, err := n.requiredFees(ctx, msgs...)
+	if err != nil {
+		return err
+	}
+	if required.IsZero() {
+		return nil
+	}
+
+	var (
+		deadline = n.clock.Now().Add(n.fundsTimeout)
+		interval = minFundsPollInterval
+	)
+	for {
+		observe := n.observeLatency("balances", MetricsKindQuery)
+		res, err := n.bankQuery.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{Address: addr})
+		observe()
+		if err != nil {
+			return err
+		}
+
+		missing := missingFunds(res.Balances, required)
+		if missing.IsZero() {
+			return nil
+		}
+
+		remaining := deadline.Sub(n.clock.Now())
+		if remaining <= 0 {
+			return ErrInsufficientFunds{Required: required, Missing: missing, Timeout: n.fundsTimeout}
+		}
+		n.ev.Send(events.NewOngoing(fmt.Sprintf(
+			"Waiting for %s on the account %s (%s left)",
+			missing,
+			addr,
+			remaining.Round(time.Second),
+		)))
+
+		if interval > remaining {
+			interval = remaining
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-n.clock.After(interval):
+		}
+		if interval *= 2; interval > maxFundsPollInterval {
+			interval = maxFundsPollInterval
+		}
+	}
+}
+
+// missingFunds returns the part of the required coins the balance doesn't cover.
+func missingFunds(balance, required sdk.Coins) sdk.Coins {
+	var missing sdk.Coins
+	for _, coin := range required {
+		if diff := coin.Amount.Sub(balance.AmountOf(coin.Denom)); diff.IsPositive() {
+			missing = missing.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+	return missing
+}
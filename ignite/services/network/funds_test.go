@@ -0,0 +1,74 @@
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+func TestWaitForFunds(t *testing.T) {
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	var (
+		fee = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+		msg = launchtypes.NewMsgSendRequest(addr, testutil.LaunchID, launchtypes.NewAccountRemoval("spn1foo"))
+	)
+	mockFee := func(suite testutil.Suite) {
+		suite.LaunchQueryMock.
+			On("Params", context.Background(), &launchtypes.QueryParamsRequest{}).
+			Return(&launchtypes.QueryParamsResponse{Params: launchtypes.Params{RequestFee: fee}}, nil).
+			Once()
+	}
+	mockBalance := func(suite testutil.Suite, balance sdk.Coins, times int) {
+		suite.BankClient.
+			On("AllBalances", context.Background(), &banktypes.QueryAllBalancesRequest{Address: addr}).
+			Return(&banktypes.QueryAllBalancesResponse{Balances: balance}, nil).
+			Times(times)
+	}
+
+	t.Run("funds received after two polls", func(t *testing.T) {
+		suite, network := newSuite(account)
+		advanceClock(t, network)
+		WithFundsWait(time.Minute)(&network)
+
+		mockFee(suite)
+		mockBalance(suite, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), 2)
+		mockBalance(suite, fee, 1)
+		suite.CosmosClientMock.
+			On("BroadcastTx", context.Background(), account, msg).
+			Return(testutil.NewResponse(&launchtypes.MsgSendRequestResponse{}), nil).
+			Once()
+
+		_, err := network.broadcastTx(context.Background(), msg)
+		require.NoError(t, err)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("funds never received", func(t *testing.T) {
+		suite, network := newSuite(account)
+		advanceClock(t, network)
+		WithFundsWait(10 * time.Second)(&network)
+
+		mockFee(suite)
+		mockBalance(suite, sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), 4)
+
+		_, err := network.broadcastTx(context.Background(), msg)
+		require.Equal(t, ErrInsufficientFunds{
+			Required: fee,
+			Missing:  sdk.NewCoins(sdk.NewInt64Coin("stake", 60)),
+			Timeout:  10 * time.Second,
+		}, err)
+		require.EqualError(t, err, "insufficient funds after waiting 10s: the account balance is missing 60stake to pay the 100stake fee")
+		suite.AssertAllMocks(t)
+	})
+}
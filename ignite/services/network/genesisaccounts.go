@@ -0,0 +1,364 @@
+package network
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmoserror"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+// GenesisAccountsBatchSize is the maximum number of genesis account requests sent in a single tx,
+// the batches are smaller when their messages exceed the maximum size of a tx.
+const GenesisAccountsBatchSize = 50
+
+// genesisAccountsColumns are the columns of a genesis accounts CSV file, the vesting columns are optional.
+var genesisAccountsColumns = []string{"address", "amount", "vesting_amount", "vesting_end_time"}
+
+type (
+	// GenesisAccountRow is a row of a genesis accounts file maintained by the coordinator.
+	// The account is a vesting account when the vesting amount is set, the amount is then its total balance.
+	GenesisAccountRow struct {
+		// Row is the number of the row in the file, starting at 1 for the first account.
+		Row int `json:"-"`
+
+		Address string `json:"address"`
+		Amount  string `json:"amount"`
+
+		VestingAmount string `json:"vesting_amount,omitempty"`
+
+		// VestingEndTime is the end of the vesting as a RFC3339 time or a unix timestamp.
+		VestingEndTime string `json:"vesting_end_time,omitempty"`
+	}
+
+	// GenesisAccountResult is the result of the import of a row, either its request ID or its error.
+	GenesisAccountResult struct {
+		Row       int    `json:"row"`
+		Address   string `json:"address"`
+		RequestID uint64 `json:"request_id,omitempty"`
+		Error     string `json:"error,omitempty"`
+	}
+
+	// GenesisAccountsImport is the result of the import of a genesis accounts file.
+	GenesisAccountsImport struct {
+		LaunchID uint64                 `json:"launch_id"`
+		Results  []GenesisAccountResult `json:"results"`
+	}
+
+	// ErrInvalidGenesisAccounts is returned by a strict import when rows of the file are invalid.
+	ErrInvalidGenesisAccounts struct {
+		Rows []int
+	}
+
+	// ImportGenesisAccountsOption configures the import of genesis accounts.
+	ImportGenesisAccountsOption func(*importGenesisAccountsOptions)
+
+	importGenesisAccountsOptions struct {
+		strict bool
+	}
+)
+
+// Error implements error.
+func (e ErrInvalidGenesisAccounts) Error() string {
+	rows := make([]string, len(e.Rows))
+	for i, row := range e.Rows {
+		rows[i] = strconv.Itoa(row)
+	}
+	return fmt.Sprintf("invalid genesis accounts at rows %s, no request sent", strings.Join(rows, ", "))
+}
+
+// WithStrictImport sends no request when a row of the file is invalid.
+func WithStrictImport() ImportGenesisAccountsOption {
+	return func(o *importGenesisAccountsOptions) {
+		o.strict = true
+	}
+}
+
+// ParseGenesisAccountsCSV parses a genesis accounts CSV file.
+// The header of the file names the columns: address, amount and the optional vesting_amount and vesting_end_time.
+func ParseGenesisAccountsCSV(r io.Reader) ([]GenesisAccountRow, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, errors.New("empty genesis accounts file")
+	} else if err != nil {
+		return nil, err
+	}
+	index := make(map[string]int)
+	for i, column := range header {
+		index[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, column := range genesisAccountsColumns[:2] {
+		if _, ok := index[column]; !ok {
+			return nil, fmt.Errorf("missing column %s in the genesis accounts file", column)
+		}
+	}
+	field := func(record []string, column string) string {
+		i, ok := index[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var rows []GenesisAccountRow
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		rows = append(rows, GenesisAccountRow{
+			Row:            len(rows) + 1,
+			Address:        field(record, "address"),
+			Amount:         field(record, "amount"),
+			VestingAmount:  field(record, "vesting_amount"),
+			VestingEndTime: field(record, "vesting_end_time"),
+		})
+	}
+	return rows, nil
+}
+
+// ParseGenesisAccountsJSON parses a genesis accounts JSON file, a list of objects with the columns
+// of the CSV file as fields.
+func ParseGenesisAccountsJSON(r io.Reader) ([]GenesisAccountRow, error) {
+	var rows []GenesisAccountRow
+	if err := json.NewDecoder(r).Decode(&rows); err != nil {
+		return nil, errors.Wrap(err, "invalid genesis accounts file")
+	}
+	for i := range rows {
+		rows[i].Row = i + 1
+	}
+	return rows, nil
+}
+
+// WriteJSON writes the results of the import as an indented JSON object.
+func (i GenesisAccountsImport) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(i)
+}
+
+// Failed returns the results of the rows without request.
+func (i GenesisAccountsImport) Failed() []GenesisAccountResult {
+	var failed []GenesisAccountResult
+	for _, result := range i.Results {
+		if result.Error != "" {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// ImportGenesisAccounts validates the rows of a genesis accounts file and sends a genesis or vesting account
+// request for each valid row, in txs of at most GenesisAccountsBatchSize requests within the maximum size of a tx.
+// The invalid rows are reported in the results without blocking the valid rows unless WithStrictImport is used.
+// The rows are invalid when the address isn't bech32, the amounts can't be parsed, the address is a duplicate
+// of a previous row or the accounts exceed the supply of the campaign of the chain.
+func (n Network) ImportGenesisAccounts(
+	ctx context.Context,
+	launchID uint64,
+	rows []GenesisAccountRow,
+	options ...ImportGenesisAccountsOption,
+) (GenesisAccountsImport, error) {
+	var o importGenesisAccountsOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	addr, err := n.accountAddress()
+	if err != nil {
+		return GenesisAccountsImport{}, err
+	}
+
+	supply, err := n.chainCampaignSupply(ctx, launchID)
+	if err != nil {
+		return GenesisAccountsImport{}, err
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Validating %d genesis accounts", len(rows))))
+	var (
+		result = GenesisAccountsImport{
+			LaunchID: launchID,
+			Results:  make([]GenesisAccountResult, len(rows)),
+		}
+		seen     = make(map[string]int)
+		total    sdk.Coins
+		valid    []int
+		invalid  []int
+		contents []launchtypes.RequestContent
+	)
+	for i, row := range rows {
+		result.Results[i] = GenesisAccountResult{Row: row.Row, Address: row.Address}
+
+		content, amount, err := genesisAccountContent(launchID, row)
+		if err == nil {
+			address := genesisAccountAddress(content)
+			if previous, ok := seen[address]; ok {
+				err = fmt.Errorf("duplicate of the account of row %d", previous)
+			} else if supply != nil && !total.Add(amount...).IsAllLTE(supply) {
+				err = fmt.Errorf("the accounts exceed the campaign supply %s", supply)
+			} else {
+				seen[address] = row.Row
+				total = total.Add(amount...)
+			}
+		}
+		if err != nil {
+			result.Results[i].Error = err.Error()
+			invalid = append(invalid, row.Row)
+			continue
+		}
+		valid = append(valid, i)
+		contents = append(contents, content)
+	}
+	if len(invalid) > 0 {
+		if o.strict {
+			return result, ErrInvalidGenesisAccounts{Rows: invalid}
+		}
+		n.ev.Send(events.NewWarning(fmt.Sprintf("%d invalid genesis accounts are skipped", len(invalid))))
+	}
+
+	messages := make([]sdk.Msg, len(contents))
+	for i, content := range contents {
+		messages[i] = launchtypes.NewMsgSendRequest(addr, launchID, content)
+	}
+	maxBytes, err := n.maxMsgsBytes(ctx)
+	if err != nil {
+		return result, err
+	}
+	batches, err := splitMsgs(messages, maxBytes, GenesisAccountsBatchSize)
+	if err != nil {
+		return result, err
+	}
+
+	progress := n.ev.StartProgress("Sending genesis account requests", len(contents))
+	start := 0
+	for _, messages := range batches {
+		var (
+			responses = make([]launchtypes.MsgSendRequestResponse, len(messages))
+			decoded   = make([]proto.Message, len(messages))
+		)
+		for i := range messages {
+			decoded[i] = &responses[i]
+		}
+
+		res, err := n.broadcastTx(ctx, messages...)
+		if err == nil {
+			err = res.DecodeAll(decoded...)
+		}
+		if err != nil {
+			// the rows of the failed and following batches have no request
+			for _, i := range valid[start:] {
+				result.Results[i].Error = fmt.Sprintf("not sent: %s", err)
+			}
+			return result, err
+		}
+		for i, res := range responses {
+			result.Results[valid[start+i]].RequestID = res.RequestID
+		}
+		start += len(messages)
+		progress.Add(len(messages))
+	}
+	progress.Finish("Genesis account requests sent")
+
+	return result, nil
+}
+
+// chainCampaignSupply returns the total supply of the campaign of the chain, nil when the chain has no campaign.
+func (n Network) chainCampaignSupply(ctx context.Context, launchID uint64) (sdk.Coins, error) {
+	res, err := n.launchQuery.Chain(ctx, &launchtypes.QueryGetChainRequest{LaunchID: launchID})
+	if cosmoserror.Unwrap(err) == cosmoserror.ErrNotFound {
+		return nil, ErrObjectNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	if !res.Chain.HasCampaign {
+		return nil, nil
+	}
+
+	campaign, err := n.Campaign(ctx, res.Chain.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+	return campaign.TotalSupply, nil
+}
+
+// genesisAccountContent returns the request content of the row and the amount of the account.
+func genesisAccountContent(launchID uint64, row GenesisAccountRow) (launchtypes.RequestContent, sdk.Coins, error) {
+	if row.Address == "" {
+		return launchtypes.RequestContent{}, nil, errors.New("empty address")
+	}
+	address, err := cosmosutil.ChangeAddressPrefix(row.Address, networktypes.SPN)
+	if err != nil {
+		return launchtypes.RequestContent{}, nil, fmt.Errorf("invalid address %s: %w", row.Address, err)
+	}
+
+	amount, err := sdk.ParseCoinsNormalized(row.Amount)
+	if err != nil {
+		return launchtypes.RequestContent{}, nil, fmt.Errorf("invalid amount %s: %w", row.Amount, err)
+	}
+	if amount.Empty() {
+		return launchtypes.RequestContent{}, nil, errors.New("empty amount")
+	}
+
+	if row.VestingAmount == "" && row.VestingEndTime == "" {
+		return launchtypes.NewGenesisAccount(launchID, address, amount), amount, nil
+	}
+
+	vesting, err := sdk.ParseCoinsNormalized(row.VestingAmount)
+	if err != nil {
+		return launchtypes.RequestContent{}, nil, fmt.Errorf("invalid vesting amount %s: %w", row.VestingAmount, err)
+	}
+	endTime, err := parseVestingEndTime(row.VestingEndTime)
+	if err != nil {
+		return launchtypes.RequestContent{}, nil, err
+	}
+	options := *launchtypes.NewDelayedVesting(amount, vesting, endTime)
+	if err := options.Validate(); err != nil {
+		return launchtypes.RequestContent{}, nil, fmt.Errorf("invalid vesting: %w", err)
+	}
+	return launchtypes.NewVestingAccount(launchID, address, options), amount, nil
+}
+
+// genesisAccountAddress returns the address of the account of a genesis or vesting account request content.
+func genesisAccountAddress(content launchtypes.RequestContent) string {
+	if account := content.GetGenesisAccount(); account != nil {
+		return account.Address
+	}
+	if account := content.GetVestingAccount(); account != nil {
+		return account.Address
+	}
+	return ""
+}
+
+// parseVestingEndTime parses a vesting end time, either a RFC3339 time or a unix timestamp.
+func parseVestingEndTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, errors.New("empty vesting end time")
+	}
+	if timestamp, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(timestamp, 0).UTC(), nil
+	}
+	endTime, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid vesting end time %s, expected a RFC3339 time or a unix timestamp", value)
+	}
+	return endTime, nil
+}
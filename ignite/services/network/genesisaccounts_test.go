@@ -0,0 +1,148 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+const (
+	testGenesisAccount        = "spn1quyqjzstpsxsurcszyfpx9q4zct3sxg65w7slm"
+	testGenesisVestingAccount = "spn1pc83qygjzv2p29shrqv35xcur50p7gppztr6hf"
+)
+
+// newGenesisAccountsSimulator returns a simulator with a chain of a campaign with the total supply.
+func newGenesisAccountsSimulator(t *testing.T, totalSupply sdk.Coins) (*testutil.Simulator, Network, uint64) {
+	sim, network, launchID := newLaunchSimulator(t)
+	chain, _ := sim.Chain(launchID)
+	campaignID := sim.AddCampaign(campaigntypes.Campaign{CoordinatorID: chain.CoordinatorID, TotalSupply: totalSupply})
+	launchID = sim.AddChain(launchtypes.Chain{
+		CoordinatorID: chain.CoordinatorID,
+		HasCampaign:   true,
+		CampaignID:    campaignID,
+	})
+	return sim, network, launchID
+}
+
+func parseGenesisAccountsFixture(t *testing.T) []GenesisAccountRow {
+	f, err := os.Open("testdata/genesis_accounts.csv")
+	require.NoError(t, err)
+	defer f.Close()
+
+	rows, err := ParseGenesisAccountsCSV(f)
+	require.NoError(t, err)
+	return rows
+}
+
+func TestParseGenesisAccountsCSV(t *testing.T) {
+	rows := parseGenesisAccountsFixture(t)
+	require.Len(t, rows, 5)
+	require.Equal(t, GenesisAccountRow{
+		Row:            2,
+		Address:        "cosmos1pc83qygjzv2p29shrqv35xcur50p7gpp7hmuen",
+		Amount:         "2000stake",
+		VestingAmount:  "500stake",
+		VestingEndTime: "2030-01-01T00:00:00Z",
+	}, rows[1])
+
+	_, err := ParseGenesisAccountsCSV(strings.NewReader("address,balance\n"))
+	require.EqualError(t, err, "missing column amount in the genesis accounts file")
+}
+
+func TestImportGenesisAccounts(t *testing.T) {
+	t.Run("invalid rows are skipped", func(t *testing.T) {
+		sim, network, launchID := newGenesisAccountsSimulator(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10000)))
+
+		result, err := network.ImportGenesisAccounts(context.Background(), launchID, parseGenesisAccountsFixture(t))
+		require.NoError(t, err)
+		require.Len(t, result.Results, 5)
+		require.Equal(t, GenesisAccountResult{Row: 1, Address: testGenesisAccount, RequestID: 1}, result.Results[0])
+		require.Equal(t, uint64(2), result.Results[1].RequestID)
+		require.Equal(t, "duplicate of the account of row 1", result.Results[2].Error)
+		require.Contains(t, result.Results[3].Error, "invalid amount stake1000")
+		require.Contains(t, result.Results[4].Error, "invalid address notanaddress")
+		require.Len(t, result.Failed(), 3)
+
+		request, _ := sim.Request(launchID, 1)
+		require.Equal(t, launchtypes.NewGenesisAccount(launchID, testGenesisAccount, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))), request.Content)
+		request, _ = sim.Request(launchID, 2)
+		require.Equal(t, launchtypes.NewVestingAccount(launchID, testGenesisVestingAccount, *launchtypes.NewDelayedVesting(
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 2000)),
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+			time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		)), request.Content)
+		_, ok := sim.Request(launchID, 3)
+		require.False(t, ok)
+
+		buf := &bytes.Buffer{}
+		require.NoError(t, result.WriteJSON(buf))
+		var written GenesisAccountsImport
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &written))
+		require.Equal(t, result, written)
+	})
+
+	t.Run("strict import", func(t *testing.T) {
+		sim, network, launchID := newGenesisAccountsSimulator(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10000)))
+
+		_, err := network.ImportGenesisAccounts(
+			context.Background(),
+			launchID,
+			parseGenesisAccountsFixture(t),
+			WithStrictImport(),
+		)
+		require.Equal(t, ErrInvalidGenesisAccounts{Rows: []int{3, 4, 5}}, err)
+		_, ok := sim.Request(launchID, 1)
+		require.False(t, ok, "no request must be sent")
+	})
+
+	t.Run("accounts over the campaign supply", func(t *testing.T) {
+		_, network, launchID := newGenesisAccountsSimulator(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1500)))
+		rows, err := ParseGenesisAccountsJSON(strings.NewReader(fmt.Sprintf(`[
+			{"address": %q, "amount": "1000stake"},
+			{"address": %q, "amount": "1000stake"},
+			{"address": %q, "amount": "10uatom"}
+		]`, testGenesisAccount, testGenesisVestingAccount, testGenesisVestingAccount)))
+		require.NoError(t, err)
+
+		result, err := network.ImportGenesisAccounts(context.Background(), launchID, rows)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), result.Results[0].RequestID)
+		require.Equal(t, "the accounts exceed the campaign supply 1500stake", result.Results[1].Error)
+		require.Equal(t, "the accounts exceed the campaign supply 1500stake", result.Results[2].Error)
+	})
+
+	t.Run("batched requests", func(t *testing.T) {
+		sim, network, launchID := newLaunchSimulator(t)
+		var rows []GenesisAccountRow
+		for i := 0; i < GenesisAccountsBatchSize+10; i++ {
+			addr := make([]byte, 20)
+			addr[0] = byte(i)
+			rows = append(rows, GenesisAccountRow{
+				Row:     i + 1,
+				Address: sdk.AccAddress(addr).String(),
+				Amount:  "10stake",
+			})
+		}
+
+		result, err := network.ImportGenesisAccounts(context.Background(), launchID, rows)
+		require.NoError(t, err)
+		require.Empty(t, result.Failed())
+		for i, res := range result.Results {
+			require.Equal(t, uint64(i+1), res.RequestID)
+		}
+		_, ok := sim.Request(launchID, uint64(len(rows)))
+		require.True(t, ok)
+	})
+}
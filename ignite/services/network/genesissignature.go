@@ -0,0 +1,112 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/pkg/errors"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmoserror"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+// SignGenesisHash signs the published genesis hash of the chain with the network account and writes the detached
+// signature to the path, the coordinator publishes it next to the genesis at networktypes.GenesisSignatureURL.
+// The genesis is marked as signed in the chain metadata, the other metadata keys are preserved.
+func (n Network) SignGenesisHash(ctx context.Context, launchID uint64, signaturePath string) error {
+	addr, err := n.accountAddress()
+	if err != nil {
+		return err
+	}
+
+	res, err := n.launchQuery.Chain(ctx, &launchtypes.QueryGetChainRequest{
+		LaunchID: launchID,
+	})
+	if cosmoserror.Unwrap(err) == cosmoserror.ErrNotFound {
+		return ErrObjectNotFound
+	} else if err != nil {
+		return err
+	}
+
+	genesisURL := res.Chain.InitialGenesis.GetGenesisURL()
+	if genesisURL == nil {
+		return fmt.Errorf("the chain %d has no genesis URL, only the hash of a genesis URL can be signed", launchID)
+	}
+
+	chainMetadata, err := networktypes.ParseChainMetadata(res.Chain.Metadata)
+	if err != nil {
+		return err
+	}
+	genesis := networktypes.GenesisMetadata{}
+	if chainMetadata.Genesis != nil {
+		genesis = *chainMetadata.Genesis
+	}
+	genesis.Signed = true
+	metadata, err := networktypes.SetGenesisMetadata(res.Chain.Metadata, genesis)
+	if err != nil {
+		return err
+	}
+
+	if err := n.writeGenesisSignature(genesisURL.Hash, signaturePath); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, "Marking the genesis as signed"))
+
+	msg := launchtypes.NewMsgEditChain(addr, launchID, false, 0, metadata)
+	if _, err := n.broadcastTx(ctx, msg); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusDone, fmt.Sprintf(
+		"Genesis hash of the chain %d signed by %s, publish %s at %s",
+		launchID,
+		addr,
+		signaturePath,
+		networktypes.GenesisSignatureURL(genesisURL.Url),
+	)))
+	return nil
+}
+
+// writeGenesisSignature signs the genesis hash with the key of the network account and writes the detached
+// signature to the path.
+func (n Network) writeGenesisSignature(hash, path string) error {
+	signature, err := n.signGenesisHash(hash)
+	if err != nil {
+		return err
+	}
+	bz, err := json.MarshalIndent(signature, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, bz, 0o644); err != nil {
+		return errors.Wrap(err, "cannot write the genesis signature")
+	}
+	return nil
+}
+
+// signGenesisHash signs the genesis hash with the key of the network account.
+func (n Network) signGenesisHash(hash string) (networktypes.GenesisSignature, error) {
+	if !n.CanSign() {
+		return networktypes.GenesisSignature{}, ErrNoSigningAccount
+	}
+
+	signature, pubKey, err := n.account.Sign(networktypes.GenesisHashSignBytes(hash))
+	if err != nil {
+		return networktypes.GenesisSignature{}, errors.Wrap(err, "cannot sign the genesis hash")
+	}
+	if _, ok := pubKey.(*secp256k1.PubKey); !ok {
+		return networktypes.GenesisSignature{}, fmt.Errorf("cannot sign the genesis hash with a %s key, a secp256k1 key is required", pubKey.Type())
+	}
+
+	return networktypes.GenesisSignature{
+		Hash:      hash,
+		Signature: signature,
+		PubKey:    pubKey.Bytes(),
+	}, nil
+}
@@ -0,0 +1,77 @@
+package network
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+func TestSignGenesisHash(t *testing.T) {
+	const hash = "4ea5c508a6566e76240543f8feb06fd457777be39549c4016436afda65d2330e"
+
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	t.Run("signed by the network account", func(t *testing.T) {
+		_, network := newSuite(account)
+
+		path := filepath.Join(t.TempDir(), "genesis.json.sig")
+		require.NoError(t, network.writeGenesisSignature(hash, path))
+
+		bz, err := os.ReadFile(path)
+		require.NoError(t, err)
+		signature, err := networktypes.ParseGenesisSignature(bz)
+		require.NoError(t, err)
+		require.Equal(t, hash, signature.Hash)
+		require.NoError(t, signature.Verify(hash, addr))
+	})
+
+	t.Run("no signing account", func(t *testing.T) {
+		suite := testutil.NewSuite()
+		network := NewQueryOnly(suite.CosmosClientMock)
+
+		_, err := network.signGenesisHash(hash)
+		require.ErrorIs(t, err, ErrNoSigningAccount)
+	})
+}
+
+func TestChainLaunchSignedGenesis(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+	)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	suite.LaunchQueryMock.
+		On("Chain", context.Background(), &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}).
+		Return(&launchtypes.QueryGetChainResponse{
+			Chain: launchtypes.Chain{
+				LaunchID:      testutil.LaunchID,
+				CoordinatorID: testCoordinatorID,
+				Metadata:      []byte(`{"genesis":{"signed":true}}`),
+			},
+		}, nil).
+		Once()
+	suite.ProfileQueryMock.
+		On("Coordinator", context.Background(), &profiletypes.QueryGetCoordinatorRequest{CoordinatorID: testCoordinatorID}).
+		Return(&profiletypes.QueryGetCoordinatorResponse{
+			Coordinator: profiletypes.Coordinator{CoordinatorID: testCoordinatorID, Address: addr},
+		}, nil).
+		Once()
+
+	// the signer of the genesis is verified against the coordinator
+	launch, err := network.ChainLaunch(context.Background(), testutil.LaunchID)
+	require.NoError(t, err)
+	require.Equal(t, addr, launch.CoordinatorAddress)
+	suite.AssertAllMocks(t)
+}
@@ -0,0 +1,125 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmoserror"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+// GentxAllocation returns the self-delegation the account can use in the gentx of a chain of the campaign.
+// The allocation is the sum of the mainnet account shares and of the vouchers held by the account, converted
+// into coins of the campaign total supply. The denom is the stake denom of the chain when the campaign
+// supplies it, the single denom of the campaign supply otherwise.
+func (n Network) GentxAllocation(
+	ctx context.Context,
+	campaignID uint64,
+	stakeDenom string,
+) (networktypes.GentxAllocation, error) {
+	addr, err := n.accountAddress()
+	if err != nil {
+		return networktypes.GentxAllocation{}, err
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Fetching the allocation of the campaign %d", campaignID)))
+
+	campaignRes, err := n.campaignQuery.Campaign(ctx, &campaigntypes.QueryGetCampaignRequest{
+		CampaignID: campaignID,
+	})
+	if cosmoserror.Unwrap(err) == cosmoserror.ErrNotFound {
+		return networktypes.GentxAllocation{}, ErrObjectNotFound
+	} else if err != nil {
+		return networktypes.GentxAllocation{}, err
+	}
+
+	denom, err := selfDelegationDenom(campaignRes.Campaign.TotalSupply, stakeDenom)
+	if err != nil {
+		return networktypes.GentxAllocation{}, errors.Wrapf(err, "campaign %d", campaignID)
+	}
+
+	shares := campaigntypes.EmptyShares()
+	accRes, err := n.campaignQuery.MainnetAccount(ctx, &campaigntypes.QueryGetMainnetAccountRequest{
+		CampaignID: campaignID,
+		Address:    addr,
+	})
+	if err != nil && cosmoserror.Unwrap(err) != cosmoserror.ErrNotFound {
+		return networktypes.GentxAllocation{}, err
+	}
+	if err == nil {
+		shares = campaigntypes.IncreaseShares(shares, accRes.MainnetAccount.Shares)
+	}
+
+	balancesRes, err := n.bankQuery.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{Address: addr})
+	if err != nil {
+		return networktypes.GentxAllocation{}, err
+	}
+	var (
+		voucherPrefix = campaigntypes.VoucherDenom(campaignID, "")
+		vouchers      sdk.Coins
+	)
+	for _, coin := range balancesRes.Balances {
+		if strings.HasPrefix(coin.Denom, voucherPrefix) {
+			vouchers = append(vouchers, coin)
+		}
+	}
+	if !vouchers.Empty() {
+		voucherShares, err := campaigntypes.VouchersToShares(vouchers, campaignID)
+		if err != nil {
+			return networktypes.GentxAllocation{}, errors.Wrap(err, "invalid vouchers")
+		}
+		shares = campaigntypes.IncreaseShares(shares, voucherShares)
+	}
+
+	totalSharesRes, err := n.campaignQuery.TotalShares(ctx, &campaigntypes.QueryTotalSharesRequest{})
+	if err != nil {
+		return networktypes.GentxAllocation{}, err
+	}
+	coins, err := shares.CoinsFromTotalSupply(campaignRes.Campaign.TotalSupply, totalSharesRes.TotalShares)
+	if err != nil {
+		return networktypes.GentxAllocation{}, errors.Wrap(err, "invalid shares")
+	}
+
+	allocation := networktypes.GentxAllocation{
+		CampaignID: campaignID,
+		Denom:      denom,
+		Max:        coins.AmountOf(denom),
+	}
+	if !allocation.Max.IsPositive() {
+		return networktypes.GentxAllocation{}, fmt.Errorf(
+			"the account %s has no %s allocation in the campaign %d",
+			addr,
+			denom,
+			campaignID,
+		)
+	}
+
+	n.ev.Send(events.New(events.StatusDone, fmt.Sprintf(
+		"Allocation of %s from the campaign %d",
+		allocation.DefaultSelfDelegation(),
+		campaignID,
+	)))
+	return allocation, nil
+}
+
+// selfDelegationDenom derives the denom of the self-delegation from the total supply of the campaign.
+func selfDelegationDenom(totalSupply sdk.Coins, stakeDenom string) (string, error) {
+	if stakeDenom != "" && totalSupply.AmountOf(stakeDenom).IsPositive() {
+		return stakeDenom, nil
+	}
+	if len(totalSupply) == 1 {
+		return totalSupply[0].Denom, nil
+	}
+	return "", fmt.Errorf(
+		"cannot derive the self-delegation denom, the total supply %s doesn't contain the stake denom %s",
+		totalSupply,
+		stakeDenom,
+	)
+}
@@ -0,0 +1,177 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmoserror"
+	"github.com/ignite/cli/ignite/services/chain"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+func TestGentxAllocation(t *testing.T) {
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	mockCampaign := func(suite testutil.Suite, totalSupply sdk.Coins) {
+		suite.CampaignQueryMock.
+			On("Campaign", mock.Anything, &campaigntypes.QueryGetCampaignRequest{CampaignID: testCampaignID}).
+			Return(&campaigntypes.QueryGetCampaignResponse{
+				Campaign: campaigntypes.Campaign{CampaignID: testCampaignID, TotalSupply: totalSupply},
+			}, nil).
+			Once()
+	}
+	mockAllocation := func(suite testutil.Suite, shares string, balances sdk.Coins) {
+		mainnetAccount := suite.CampaignQueryMock.
+			On("MainnetAccount", mock.Anything, &campaigntypes.QueryGetMainnetAccountRequest{
+				CampaignID: testCampaignID,
+				Address:    addr,
+			})
+		if shares == "" {
+			mainnetAccount.Return(nil, cosmoserror.ErrNotFound).Once()
+		} else {
+			accountShares, err := campaigntypes.NewShares(shares)
+			require.NoError(t, err)
+			mainnetAccount.Return(&campaigntypes.QueryGetMainnetAccountResponse{
+				MainnetAccount: campaigntypes.MainnetAccount{Shares: accountShares},
+			}, nil).Once()
+		}
+		suite.BankClient.
+			On("AllBalances", mock.Anything, &banktypes.QueryAllBalancesRequest{Address: addr}).
+			Return(&banktypes.QueryAllBalancesResponse{Balances: balances}, nil).
+			Once()
+		suite.CampaignQueryMock.
+			On("TotalShares", mock.Anything, &campaigntypes.QueryTotalSharesRequest{}).
+			Return(&campaigntypes.QueryTotalSharesResponse{TotalShares: testTotalShares}, nil).
+			Once()
+	}
+
+	t.Run("allocation from shares and vouchers", func(t *testing.T) {
+		suite, network := newSuite(account)
+		mockCampaign(suite, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000000), sdk.NewInt64Coin("uatom", 1000)))
+		mockAllocation(suite, "50stake", sdk.NewCoins(
+			sdk.NewInt64Coin(campaigntypes.VoucherDenom(testCampaignID, "stake"), 50),
+			// vouchers of another campaign and other coins are not part of the allocation
+			sdk.NewInt64Coin(campaigntypes.VoucherDenom(testCampaignID+1, "stake"), 500),
+			sdk.NewInt64Coin("uspn", 1000),
+		))
+
+		allocation, err := network.GentxAllocation(context.Background(), testCampaignID, "stake")
+		require.NoError(t, err)
+		require.Equal(t, networktypes.GentxAllocation{
+			CampaignID: testCampaignID,
+			Denom:      "stake",
+			Max:        sdkmath.NewInt(100000),
+		}, allocation)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("denom derived from the campaign supply", func(t *testing.T) {
+		suite, network := newSuite(account)
+		mockCampaign(suite, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)))
+		mockAllocation(suite, "100uatom", nil)
+
+		allocation, err := network.GentxAllocation(context.Background(), testCampaignID, "stake")
+		require.NoError(t, err)
+		require.Equal(t, "uatom", allocation.Denom)
+		require.Equal(t, sdkmath.NewInt(100), allocation.Max)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("denom can't be derived", func(t *testing.T) {
+		suite, network := newSuite(account)
+		mockCampaign(suite, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000), sdk.NewInt64Coin("uosmo", 1000)))
+
+		_, err := network.GentxAllocation(context.Background(), testCampaignID, "stake")
+		require.ErrorContains(t, err, "cannot derive the self-delegation denom")
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("no allocation", func(t *testing.T) {
+		suite, network := newSuite(account)
+		mockCampaign(suite, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000000)))
+		mockAllocation(suite, "", nil)
+
+		_, err := network.GentxAllocation(context.Background(), testCampaignID, "stake")
+		require.ErrorContains(t, err, "has no stake allocation in the campaign")
+		suite.AssertAllMocks(t)
+	})
+}
+
+func TestJoinOverAllocation(t *testing.T) {
+	var (
+		account    = testutil.NewTestAccount(t, testutil.TestAccountName)
+		allocation = networktypes.GentxAllocation{
+			CampaignID: testCampaignID,
+			Denom:      TestDenom,
+			Max:        sdkmath.NewInt(TestAmountInt - 1),
+		}
+		overAllocation = networktypes.ErrSelfDelegationOverAllocation{
+			SelfDelegation: sdk.NewInt64Coin(TestDenom, TestAmountInt),
+			Allocation:     allocation,
+		}
+	)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+	gentx := testutil.NewGentx(addr, TestDenom, TestAmountString, "", testutil.PeerAddress)
+
+	t.Run("staking amount over the allocation", func(t *testing.T) {
+		var (
+			suite, network = newSuite(account)
+			c              = &fakeGentxChain{Chain: suite.ChainMock, t: t, gentx: gentx}
+		)
+
+		err := network.JoinWithValidator(
+			context.Background(),
+			c,
+			testutil.LaunchID,
+			chain.Validator{Name: testutil.TestAccountName, StakingAmount: TestAmountString + TestDenom},
+			WithGentxAllocation(allocation),
+		)
+		require.Equal(t, overAllocation, err)
+		require.EqualError(t, err, "the self-delegation 95000000stake exceeds the allocation of 94999999stake from the campaign 3")
+		require.Empty(t, c.validators, "the gentx must not be issued over the allocation")
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("gentx over the allocation", func(t *testing.T) {
+		suite, network := newSuite(account)
+		gentxPath := gentx.SaveTo(t, t.TempDir())
+
+		err := network.Join(
+			context.Background(),
+			suite.ChainMock,
+			testutil.LaunchID,
+			gentxPath,
+			WithGentxAllocation(allocation),
+		)
+		require.ErrorAs(t, err, &networktypes.ErrSelfDelegationOverAllocation{})
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("gentx in another denom", func(t *testing.T) {
+		suite, network := newSuite(account)
+		gentxPath := gentx.SaveTo(t, t.TempDir())
+		uatomAllocation := allocation
+		uatomAllocation.Denom = "uatom"
+
+		err := network.Join(
+			context.Background(),
+			suite.ChainMock,
+			testutil.LaunchID,
+			gentxPath,
+			WithGentxAllocation(uatomAllocation),
+		)
+		require.ErrorContains(t, err, "the self-delegation 95000000stake must be in uatom, the denom of the campaign 3")
+		suite.AssertAllMocks(t)
+	})
+}
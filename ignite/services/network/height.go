@@ -0,0 +1,67 @@
+package network
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+// WithPinnedHeight pins all the queries issued by a service call to the same block height,
+// this ensures the combined view returned by calls issuing several queries is consistent.
+func WithPinnedHeight() Option {
+	return func(n *Network) {
+		n.pinHeight = true
+	}
+}
+
+// withPinnedHeight runs the queries with a context pinned to the latest block height
+// when the height pinning is enabled, the height is zero otherwise.
+// If the node pruned the pinned height while querying, the queries are retried once
+// at a newer height.
+func (n Network) withPinnedHeight(ctx context.Context, queries func(ctx context.Context, height int64) error) error {
+	if !n.pinHeight {
+		return queries(ctx, 0)
+	}
+
+	var err error
+	for i := 0; i < 2; i++ {
+		var (
+			pinnedCtx context.Context
+			height    int64
+		)
+		pinnedCtx, height, err = n.pinnedHeightContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err = queries(pinnedCtx, height); !isHeightPruned(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// pinnedHeightContext returns a context that pins the gRPC queries to the latest block height.
+func (n Network) pinnedHeightContext(ctx context.Context) (context.Context, int64, error) {
+	status, err := n.cosmos.Status(ctx)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "cannot fetch the latest block height")
+	}
+
+	height := status.SyncInfo.LatestBlockHeight
+	ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+	return ctx, height, nil
+}
+
+// isHeightPruned checks if the error is returned because the queried height is no longer available on the node.
+func isHeightPruned(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "version does not exist") || strings.Contains(msg, "is not available")
+}
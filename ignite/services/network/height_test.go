@@ -0,0 +1,190 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+// launchQueryServer is a fake launch query server returning empty genesis information.
+type launchQueryServer struct {
+	launchtypes.UnimplementedQueryServer
+}
+
+func (launchQueryServer) GenesisAccountAll(
+	context.Context,
+	*launchtypes.QueryAllGenesisAccountRequest,
+) (*launchtypes.QueryAllGenesisAccountResponse, error) {
+	return &launchtypes.QueryAllGenesisAccountResponse{}, nil
+}
+
+func (launchQueryServer) VestingAccountAll(
+	context.Context,
+	*launchtypes.QueryAllVestingAccountRequest,
+) (*launchtypes.QueryAllVestingAccountResponse, error) {
+	return &launchtypes.QueryAllVestingAccountResponse{}, nil
+}
+
+func (launchQueryServer) GenesisValidatorAll(
+	context.Context,
+	*launchtypes.QueryAllGenesisValidatorRequest,
+) (*launchtypes.QueryAllGenesisValidatorResponse, error) {
+	return &launchtypes.QueryAllGenesisValidatorResponse{}, nil
+}
+
+// heightRecorder is a gRPC interceptor recording the height header of the queries.
+// The queries at a pruned height fail like on a pruned node.
+type heightRecorder struct {
+	mu      sync.Mutex
+	heights []string
+	pruned  map[string]bool
+}
+
+func (r *heightRecorder) intercept(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	var height string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(grpctypes.GRPCBlockHeightHeader); len(values) > 0 {
+			height = values[0]
+		}
+	}
+
+	r.mu.Lock()
+	r.heights = append(r.heights, height)
+	r.mu.Unlock()
+
+	if r.pruned[height] {
+		return nil, errors.New("failed to load state at height " + height + "; version does not exist")
+	}
+	return handler(ctx, req)
+}
+
+func newLaunchQueryClient(t *testing.T, recorder *heightRecorder) launchtypes.QueryClient {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(grpc.UnaryInterceptor(recorder.intercept))
+	launchtypes.RegisterQueryServer(server, &launchQueryServer{})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return launchtypes.NewQueryClient(conn)
+}
+
+func newStatus(height int64) *ctypes.ResultStatus {
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: height}}
+}
+
+func TestGenesisInformationPinnedHeight(t *testing.T) {
+	t.Run("queries without height pinning", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+			recorder       = &heightRecorder{}
+		)
+		WithLaunchQueryClient(newLaunchQueryClient(t, recorder))(&network)
+
+		gi, err := network.GenesisInformation(context.Background(), testutil.LaunchID)
+		require.NoError(t, err)
+		require.Equal(t, networktypes.GenesisInformation{}, gi)
+		require.Equal(t, []string{"", "", ""}, recorder.heights)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("queries pinned to the latest height", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+			recorder       = &heightRecorder{}
+		)
+		WithLaunchQueryClient(newLaunchQueryClient(t, recorder))(&network)
+		WithPinnedHeight()(&network)
+
+		suite.CosmosClientMock.
+			On("Status", mock.Anything).
+			Return(newStatus(42), nil).
+			Once()
+
+		gi, err := network.GenesisInformation(context.Background(), testutil.LaunchID)
+		require.NoError(t, err)
+		require.EqualValues(t, 42, gi.Height)
+		require.Equal(t, []string{"42", "42", "42"}, recorder.heights)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("queries retried at a newer height when pruned", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+			recorder       = &heightRecorder{pruned: map[string]bool{"42": true}}
+		)
+		WithLaunchQueryClient(newLaunchQueryClient(t, recorder))(&network)
+		WithPinnedHeight()(&network)
+
+		suite.CosmosClientMock.
+			On("Status", mock.Anything).
+			Return(newStatus(42), nil).
+			Once()
+		suite.CosmosClientMock.
+			On("Status", mock.Anything).
+			Return(newStatus(50), nil).
+			Once()
+
+		gi, err := network.GenesisInformation(context.Background(), testutil.LaunchID)
+		require.NoError(t, err)
+		require.EqualValues(t, 50, gi.Height)
+		require.Equal(t, []string{"42", "50", "50", "50"}, recorder.heights)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("queries retried only once", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+			recorder       = &heightRecorder{pruned: map[string]bool{"42": true, "50": true}}
+		)
+		WithLaunchQueryClient(newLaunchQueryClient(t, recorder))(&network)
+		WithPinnedHeight()(&network)
+
+		suite.CosmosClientMock.
+			On("Status", mock.Anything).
+			Return(newStatus(42), nil).
+			Once()
+		suite.CosmosClientMock.
+			On("Status", mock.Anything).
+			Return(newStatus(50), nil).
+			Once()
+
+		_, err := network.GenesisInformation(context.Background(), testutil.LaunchID)
+		require.ErrorContains(t, err, "version does not exist")
+		require.Equal(t, []string{"42", "50"}, recorder.heights)
+		suite.AssertAllMocks(t)
+	})
+}
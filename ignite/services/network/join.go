@@ -5,18 +5,22 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
 
 	"github.com/ignite/cli/ignite/pkg/cosmosutil"
 	"github.com/ignite/cli/ignite/pkg/events"
 	"github.com/ignite/cli/ignite/pkg/xurl"
+	"github.com/ignite/cli/ignite/services/chain"
 	"github.com/ignite/cli/ignite/services/network/networkchain"
 	"github.com/ignite/cli/ignite/services/network/networktypes"
 )
 
 type joinOptions struct {
-	accountAmount sdk.Coins
-	publicAddress string
+	accountAmount    sdk.Coins
+	publicAddress    string
+	validatorKeyType networktypes.ValidatorKeyType
+	gentxAllocation  *networktypes.GentxAllocation
 }
 
 type JoinOption func(*joinOptions)
@@ -35,6 +39,133 @@ func WithPublicAddress(addr string) JoinOption {
 	}
 }
 
+// WithValidatorKeyType checks the consensus key of the gentx has the type required by the chain
+func WithValidatorKeyType(keyType networktypes.ValidatorKeyType) JoinOption {
+	return func(o *joinOptions) {
+		o.validatorKeyType = keyType
+	}
+}
+
+// WithGentxAllocation checks the self-delegation of the gentx against the allocation of the campaign of the chain
+func WithGentxAllocation(allocation networktypes.GentxAllocation) JoinOption {
+	return func(o *joinOptions) {
+		o.gentxAllocation = &allocation
+	}
+}
+
+// GentxChain is a chain able to issue the gentx of its validator.
+type GentxChain interface {
+	Chain
+	IssueGentx(ctx context.Context, v chain.Validator) (string, error)
+}
+
+// JoinWithValidator issues the gentx of the validator from the initialized chain home and joins the network with it.
+func (n Network) JoinWithValidator(
+	ctx context.Context,
+	c GentxChain,
+	launchID uint64,
+	v chain.Validator,
+	options ...JoinOption,
+) error {
+	if !n.CanSign() {
+		return ErrNoSigningAccount
+	}
+
+	o := joinOptions{}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	// check the validator before running the chain binary to report a clear error
+	if err := validateValidator(v); err != nil {
+		return err
+	}
+	stakingAmount, _ := sdk.ParseCoinNormalized(v.StakingAmount)
+	if o.gentxAllocation != nil {
+		if err := o.gentxAllocation.CheckSelfDelegation(stakingAmount); err != nil {
+			return err
+		}
+	}
+
+	n.ev.Send(events.NewOngoing(events.Message(networktypes.MsgGeneratingGentx, nil)))
+
+	gentxPath, err := c.IssueGentx(ctx, v)
+	if err != nil {
+		return errors.Wrap(err, "cannot generate the gentx")
+	}
+
+	n.ev.Send(events.NewDone(events.Message(networktypes.MsgGentxGenerated, nil), ""))
+	n.ev.Send(events.NewOngoing(events.Message(networktypes.MsgVerifyingGentx, nil)))
+
+	gentxInfo, _, err := cosmosutil.GentxFromPath(gentxPath)
+	if err != nil {
+		return errors.Wrap(err, "invalid generated gentx")
+	}
+	if selfDelegation := gentxInfo.SelfDelegation; selfDelegation.Denom != stakingAmount.Denom ||
+		!selfDelegation.IsEqual(stakingAmount) {
+		return fmt.Errorf(
+			"invalid generated gentx: the self delegation is %s, expected %s",
+			selfDelegation,
+			stakingAmount,
+		)
+	}
+
+	n.ev.Send(events.NewDone(events.Message(networktypes.MsgGentxVerified, nil), ""))
+
+	return n.Join(ctx, c, launchID, gentxPath, options...)
+}
+
+// validateValidator checks the staking amount and the commission rates of the validator.
+// Commission rates not set are ignored as the chain defaults are used.
+func validateValidator(v chain.Validator) error {
+	if _, err := sdk.ParseCoinNormalized(v.StakingAmount); err != nil {
+		return errors.Wrapf(err, "invalid staking amount %s", v.StakingAmount)
+	}
+
+	rate, err := parseCommissionRate("commission rate", v.CommissionRate)
+	if err != nil {
+		return err
+	}
+	maxRate, err := parseCommissionRate("commission max rate", v.CommissionMaxRate)
+	if err != nil {
+		return err
+	}
+	maxChangeRate, err := parseCommissionRate("commission max change rate", v.CommissionMaxChangeRate)
+	if err != nil {
+		return err
+	}
+
+	if maxRate == nil {
+		return nil
+	}
+	if rate != nil && rate.GT(*maxRate) {
+		return fmt.Errorf("the commission rate %s cannot be greater than the commission max rate %s", rate, maxRate)
+	}
+	if maxChangeRate != nil && maxChangeRate.GT(*maxRate) {
+		return fmt.Errorf(
+			"the commission max change rate %s cannot be greater than the commission max rate %s",
+			maxChangeRate,
+			maxRate,
+		)
+	}
+	return nil
+}
+
+// parseCommissionRate parses a commission rate of the validator, nil is returned if the rate is not set.
+func parseCommissionRate(name, value string) (*sdk.Dec, error) {
+	if value == "" {
+		return nil, nil
+	}
+	rate, err := sdk.NewDecFromStr(value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s %s", name, value)
+	}
+	if rate.IsNegative() || rate.GT(sdk.OneDec()) {
+		return nil, fmt.Errorf("the %s %s must be between 0 and 1", name, value)
+	}
+	return &rate, nil
+}
+
 // Join to the network.
 func (n Network) Join(
 	ctx context.Context,
@@ -43,6 +174,10 @@ func (n Network) Join(
 	gentxPath string,
 	options ...JoinOption,
 ) error {
+	if !n.CanSign() {
+		return ErrNoSigningAccount
+	}
+
 	o := joinOptions{}
 	for _, apply := range options {
 		apply(&o)
@@ -60,6 +195,23 @@ func (n Network) Join(
 		return err
 	}
 
+	// the chain rejects the gentxs signed with another consensus key type
+	if o.validatorKeyType != "" && gentxInfo.PubKeyType != o.validatorKeyType.PubKeyType() {
+		return fmt.Errorf(
+			"invalid gentx consensus key type: expected %s (%s), actual %s",
+			o.validatorKeyType,
+			o.validatorKeyType.PubKeyType(),
+			gentxInfo.PubKeyType,
+		)
+	}
+
+	// the self-delegation of a campaign-backed chain is limited by the allocation of the validator
+	if o.gentxAllocation != nil {
+		if err := o.gentxAllocation.CheckSelfDelegation(gentxInfo.SelfDelegation); err != nil {
+			return errors.Wrap(err, "invalid gentx")
+		}
+	}
+
 	// get the peer address
 	if o.publicAddress != "" {
 		if nodeID, err = c.NodeID(ctx); err != nil {
@@ -84,6 +236,16 @@ func (n Network) Join(
 		return err
 	}
 
+	// SPN charges a fee for each request, the join fails before sending the first request if the
+	// account can't pay all of them
+	requestCount := 1
+	if !o.accountAmount.IsZero() {
+		requestCount++
+	}
+	if err := n.checkRequestQuota(ctx, launchID, requestCount); err != nil {
+		return err
+	}
+
 	if !o.accountAmount.IsZero() {
 		if err := n.sendAccountRequest(ctx, launchID, accountAddress, o.accountAmount); err != nil {
 			return err
@@ -102,30 +264,38 @@ func (n Network) sendValidatorRequest(
 	gentx []byte,
 	gentxInfo cosmosutil.GentxInfo,
 ) error {
-	addr, err := n.account.Address(networktypes.SPN)
+	addr, err := n.accountAddress()
 	if err != nil {
 		return err
 	}
 
-	msg := launchtypes.NewMsgSendRequest(
-		addr,
+	content := launchtypes.NewGenesisValidator(
 		launchID,
-		launchtypes.NewGenesisValidator(
-			launchID,
-			valAddress,
-			gentx,
-			gentxInfo.PubKey,
-			gentxInfo.SelfDelegation,
-			peer,
-		),
+		valAddress,
+		gentx,
+		gentxInfo.PubKey,
+		gentxInfo.SelfDelegation,
+		peer,
 	)
+	if err := networktypes.CheckRequestContentLimits(content); err != nil {
+		return err
+	}
 
-	n.ev.Send(events.New(events.StatusOngoing, "Broadcasting validator transaction"))
-
-	res, err := n.cosmos.BroadcastTx(ctx, n.account, msg)
+	msg := launchtypes.NewMsgSendRequest(addr, launchID, content)
+	maxBytes, err := n.maxMsgsBytes(ctx)
 	if err != nil {
 		return err
 	}
+	if err := checkMsgSize(msg, maxBytes, "the validator request"); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, events.Message(networktypes.MsgBroadcastingValidator, nil)))
+
+	res, err := n.broadcastTx(ctx, msg)
+	if err != nil {
+		return n.requestBroadcastError(ctx, launchID, 1, err)
+	}
 
 	var requestRes launchtypes.MsgSendRequestResponse
 	if err := res.Decode(&requestRes); err != nil {
@@ -133,12 +303,11 @@ func (n Network) sendValidatorRequest(
 	}
 
 	if requestRes.AutoApproved {
-		n.ev.Send(events.New(events.StatusDone, "Validator added to the network by the coordinator!"))
+		n.ev.Send(events.New(events.StatusDone, events.Message(networktypes.MsgValidatorAdded, nil)))
 	} else {
-		n.ev.Send(events.New(events.StatusDone,
-			fmt.Sprintf("Request %d to join the network as a validator has been submitted!",
-				requestRes.RequestID),
-		))
+		n.ev.Send(events.New(events.StatusDone, events.Message(networktypes.MsgJoinRequestSubmitted, events.Params{
+			"RequestID": requestRes.RequestID,
+		})))
 	}
 	return nil
 }
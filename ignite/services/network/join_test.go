@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
 
+	"github.com/ignite/cli/ignite/services/chain"
 	"github.com/ignite/cli/ignite/services/network/networktypes"
 	"github.com/ignite/cli/ignite/services/network/testutil"
 )
@@ -40,6 +41,7 @@ func TestJoin(t *testing.T) {
 		suite, network := newSuite(account)
 
 		suite.ChainMock.On("NodeID", context.Background()).Return(testutil.NodeID, nil).Once()
+		mockRequestQuota(suite, addr, nil, nil)
 		suite.CosmosClientMock.
 			On(
 				"BroadcastTx",
@@ -94,6 +96,7 @@ func TestJoin(t *testing.T) {
 		gentxPath := gentx.SaveTo(t, tmp)
 		suite, network := newSuite(account)
 
+		mockRequestQuota(suite, addr, nil, nil)
 		suite.CosmosClientMock.
 			On(
 				"BroadcastTx",
@@ -145,6 +148,7 @@ func TestJoin(t *testing.T) {
 		expectedError := errors.New("failed to add validator")
 
 		suite.ChainMock.On("NodeID", context.Background()).Return(testutil.NodeID, nil).Once()
+		mockRequestQuota(suite, addr, nil, nil)
 		suite.CosmosClientMock.
 			On(
 				"BroadcastTx",
@@ -202,6 +206,7 @@ func TestJoin(t *testing.T) {
 		suite, network := newSuite(account)
 
 		suite.ChainMock.On("NodeID", context.Background()).Return(testutil.NodeID, nil).Once()
+		mockRequestQuota(suite, addr, nil, nil)
 		suite.CosmosClientMock.
 			On(
 				"BroadcastTx",
@@ -310,3 +315,236 @@ func TestJoin(t *testing.T) {
 		suite.AssertAllMocks(t)
 	})
 }
+
+func TestJoinValidatorKeyType(t *testing.T) {
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	for _, keyType := range []networktypes.ValidatorKeyType{
+		networktypes.ValidatorKeyEd25519,
+		networktypes.ValidatorKeySecp256k1,
+		networktypes.ValidatorKeyBLS,
+	} {
+		keyType := keyType
+
+		t.Run("successfully send join request with a "+string(keyType)+" key", func(t *testing.T) {
+			gentx := testutil.
+				NewGentx(addr, TestDenom, TestAmountString, "", testutil.PeerAddress).
+				WithPubKeyType(keyType.PubKeyType())
+			gentxPath := gentx.SaveTo(t, t.TempDir())
+			suite, network := newSuite(account)
+
+			mockRequestQuota(suite, addr, nil, nil)
+			suite.CosmosClientMock.
+				On(
+					"BroadcastTx",
+					context.Background(),
+					account,
+					launchtypes.NewMsgSendRequest(
+						addr,
+						testutil.LaunchID,
+						launchtypes.NewGenesisValidator(
+							testutil.LaunchID,
+							addr,
+							gentx.JSON(t),
+							[]byte{},
+							sdk.NewCoin(TestDenom, sdkmath.NewInt(TestAmountInt)),
+							launchtypes.Peer{
+								Id: testutil.NodeID,
+								Connection: &launchtypes.Peer_TcpAddress{
+									TcpAddress: testutil.TCPAddress,
+								},
+							}),
+					),
+				).
+				Return(testutil.NewResponse(&launchtypes.MsgSendRequestResponse{
+					RequestID: TestGenesisValidatorRequestID,
+				}), nil).
+				Once()
+
+			err := network.Join(
+				context.Background(),
+				suite.ChainMock,
+				testutil.LaunchID,
+				gentxPath,
+				WithValidatorKeyType(keyType),
+			)
+			require.NoError(t, err)
+			suite.AssertAllMocks(t)
+		})
+	}
+
+	t.Run("failed to send join request, invalid gentx key type", func(t *testing.T) {
+		gentxPath := testutil.
+			NewGentx(addr, TestDenom, TestAmountString, "", testutil.PeerAddress).
+			WithPubKeyType(networktypes.ValidatorKeyEd25519.PubKeyType()).
+			SaveTo(t, t.TempDir())
+		suite, network := newSuite(account)
+
+		err := network.Join(
+			context.Background(),
+			suite.ChainMock,
+			testutil.LaunchID,
+			gentxPath,
+			WithValidatorKeyType(networktypes.ValidatorKeySecp256k1),
+		)
+		require.EqualError(t, err, "invalid gentx consensus key type: expected secp256k1 "+
+			"(/cosmos.crypto.secp256k1.PubKey), actual /cosmos.crypto.ed25519.PubKey")
+		suite.AssertAllMocks(t)
+	})
+}
+
+// fakeGentxChain is a chain recording the validators of the issued gentxs.
+type fakeGentxChain struct {
+	Chain
+	t          *testing.T
+	gentx      *testutil.Gentx
+	validators []chain.Validator
+}
+
+func (c *fakeGentxChain) IssueGentx(_ context.Context, v chain.Validator) (string, error) {
+	c.validators = append(c.validators, v)
+	return c.gentx.SaveTo(c.t, c.t.TempDir()), nil
+}
+
+func TestJoinWithValidator(t *testing.T) {
+	var (
+		account   = testutil.NewTestAccount(t, testutil.TestAccountName)
+		validator = chain.Validator{
+			Name:                    testutil.TestAccountName,
+			Moniker:                 "moniker",
+			StakingAmount:           TestAmountString + TestDenom,
+			CommissionRate:          "0.10",
+			CommissionMaxRate:       "0.20",
+			CommissionMaxChangeRate: "0.01",
+			Details:                 "details",
+		}
+	)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+	gentx := testutil.NewGentx(addr, TestDenom, TestAmountString, "", testutil.PeerAddress)
+
+	t.Run("successfully issue the gentx and send join request", func(t *testing.T) {
+		var (
+			suite, network = newSuite(account)
+			c              = &fakeGentxChain{Chain: suite.ChainMock, t: t, gentx: gentx}
+		)
+
+		mockRequestQuota(suite, addr, nil, nil)
+		suite.CosmosClientMock.
+			On(
+				"BroadcastTx",
+				context.Background(),
+				account,
+				launchtypes.NewMsgSendRequest(
+					addr,
+					testutil.LaunchID,
+					launchtypes.NewGenesisValidator(
+						testutil.LaunchID,
+						addr,
+						gentx.JSON(t),
+						[]byte{},
+						sdk.NewCoin(TestDenom, sdkmath.NewInt(TestAmountInt)),
+						launchtypes.Peer{
+							Id: testutil.NodeID,
+							Connection: &launchtypes.Peer_TcpAddress{
+								TcpAddress: testutil.TCPAddress,
+							},
+						},
+					),
+				),
+			).
+			Return(testutil.NewResponse(&launchtypes.MsgSendRequestResponse{
+				RequestID: TestGenesisValidatorRequestID,
+			}), nil).
+			Once()
+
+		err := network.JoinWithValidator(context.Background(), c, testutil.LaunchID, validator)
+		require.NoError(t, err)
+		require.Equal(t, []chain.Validator{validator}, c.validators)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("staking amount not in the canonical coin format", func(t *testing.T) {
+		var (
+			suite, network = newSuite(account)
+			c              = &fakeGentxChain{Chain: suite.ChainMock, t: t, gentx: gentx}
+			v              = validator
+		)
+		v.StakingAmount = TestAmountString + ".0" + TestDenom
+
+		mockRequestQuota(suite, addr, nil, nil)
+		suite.CosmosClientMock.
+			On("BroadcastTx", context.Background(), account, mock.Anything).
+			Return(testutil.NewResponse(&launchtypes.MsgSendRequestResponse{
+				RequestID: TestGenesisValidatorRequestID,
+			}), nil).
+			Once()
+
+		err := network.JoinWithValidator(context.Background(), c, testutil.LaunchID, v)
+		require.NoError(t, err)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to join, generated gentx doesn't match the validator", func(t *testing.T) {
+		var (
+			suite, network = newSuite(account)
+			c              = &fakeGentxChain{Chain: suite.ChainMock, t: t, gentx: gentx}
+			v              = validator
+		)
+		v.StakingAmount = "10" + TestDenom
+
+		err := network.JoinWithValidator(context.Background(), c, testutil.LaunchID, v)
+		require.EqualError(t, err, "invalid generated gentx: the self delegation is 95000000stake, expected 10stake")
+		require.Len(t, c.validators, 1)
+		suite.AssertAllMocks(t)
+	})
+
+	tests := []struct {
+		name   string
+		update func(*chain.Validator)
+		err    string
+	}{
+		{
+			name:   "invalid staking amount",
+			update: func(v *chain.Validator) { v.StakingAmount = "foo" },
+			err:    "invalid staking amount foo",
+		},
+		{
+			name:   "invalid commission rate",
+			update: func(v *chain.Validator) { v.CommissionRate = "foo" },
+			err:    "invalid commission rate foo",
+		},
+		{
+			name:   "commission rate out of range",
+			update: func(v *chain.Validator) { v.CommissionMaxRate = "1.5" },
+			err:    "the commission max rate 1.5 must be between 0 and 1",
+		},
+		{
+			name:   "commission rate greater than max rate",
+			update: func(v *chain.Validator) { v.CommissionRate = "0.3" },
+			err:    "the commission rate 0.300000000000000000 cannot be greater than the commission max rate 0.200000000000000000",
+		},
+		{
+			name:   "commission max change rate greater than max rate",
+			update: func(v *chain.Validator) { v.CommissionMaxChangeRate = "0.25" },
+			err:    "the commission max change rate 0.250000000000000000 cannot be greater than the commission max rate 0.200000000000000000",
+		},
+	}
+	for _, tt := range tests {
+		t.Run("failed to join, "+tt.name, func(t *testing.T) {
+			var (
+				suite, network = newSuite(account)
+				c              = &fakeGentxChain{Chain: suite.ChainMock, t: t, gentx: gentx}
+				v              = validator
+			)
+			tt.update(&v)
+
+			err := network.JoinWithValidator(context.Background(), c, testutil.LaunchID, v)
+			require.ErrorContains(t, err, tt.err)
+			require.Empty(t, c.validators, "the gentx must not be issued for an invalid validator")
+			suite.AssertAllMocks(t)
+		})
+	}
+}
@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
 
+	"github.com/ignite/cli/ignite/pkg/cosmoserror"
 	"github.com/ignite/cli/ignite/pkg/events"
 	"github.com/ignite/cli/ignite/services/network/networktypes"
 )
@@ -17,54 +20,197 @@ import (
 // to ensure the minimum duration is reached
 const MinLaunchTimeOffset = time.Second * 30
 
+// ErrInvalidLaunchTime is returned when the launch time is outside the launch time range allowed by SPN.
+type ErrInvalidLaunchTime struct {
+	LaunchTime    time.Time
+	MinLaunchTime time.Time
+	MaxLaunchTime time.Time
+
+	// Suggestion is the nearest valid launch time.
+	Suggestion time.Time
+}
+
+// Error implements error.
+func (e ErrInvalidLaunchTime) Error() string {
+	if e.LaunchTime.Before(e.MinLaunchTime) {
+		return fmt.Sprintf("launch time %s lower than minimum %s", e.LaunchTime, e.MinLaunchTime)
+	}
+	return fmt.Sprintf("launch time %s bigger than maximum %s", e.LaunchTime, e.MaxLaunchTime)
+}
+
+// LaunchTimeRange returns the range of the valid launch times from the launch params.
+// The minimum launch time includes the MinLaunchTimeOffset, it's only the offset when the params have no
+// minimum launch time. The maximum launch time is zero when the params have no maximum launch time:
+// some SPN deployments return zero launch time params, a zero maximum would make every launch time invalid.
+func LaunchTimeRange(params launchtypes.Params, now time.Time) (minLaunchTime, maxLaunchTime time.Time) {
+	minLaunchTime = now.Add(MinLaunchTimeOffset)
+	if params.LaunchTimeRange.MinLaunchTime > 0 {
+		minLaunchTime = minLaunchTime.Add(params.LaunchTimeRange.MinLaunchTime)
+	}
+	if params.LaunchTimeRange.MaxLaunchTime > 0 {
+		maxLaunchTime = now.Add(params.LaunchTimeRange.MaxLaunchTime)
+	}
+	return minLaunchTime, maxLaunchTime
+}
+
+// launchParamsWarnings returns the warnings about the launch time params not set by SPN.
+func launchParamsWarnings(params launchtypes.Params) (warnings []string) {
+	if params.LaunchTimeRange.MinLaunchTime <= 0 {
+		warnings = append(warnings, events.Message(networktypes.MsgLaunchNoMinTime, events.Params{
+			"Offset": MinLaunchTimeOffset,
+		}))
+	}
+	if params.LaunchTimeRange.MaxLaunchTime <= 0 {
+		warnings = append(warnings, events.Message(networktypes.MsgLaunchNoMaxTime, nil))
+	}
+	return warnings
+}
+
+// SuggestLaunchTime returns the nearest valid launch time from the desired launch time.
+// The minimum launch time is suggested when the range is empty because of the offset.
+func SuggestLaunchTime(params launchtypes.Params, desired, now time.Time) time.Time {
+	minLaunchTime, maxLaunchTime := LaunchTimeRange(params, now)
+	switch {
+	case desired.Before(minLaunchTime), !maxLaunchTime.IsZero() && maxLaunchTime.Before(minLaunchTime):
+		return minLaunchTime
+	case !maxLaunchTime.IsZero() && desired.After(maxLaunchTime):
+		return maxLaunchTime
+	default:
+		return desired
+	}
+}
+
+// checkLaunchTime returns an ErrInvalidLaunchTime error when the launch time is outside the launch time range.
+func checkLaunchTime(params launchtypes.Params, launchTime, now time.Time) error {
+	minLaunchTime, maxLaunchTime := LaunchTimeRange(params, now)
+	if launchTime.Before(minLaunchTime) || (!maxLaunchTime.IsZero() && launchTime.After(maxLaunchTime)) {
+		return ErrInvalidLaunchTime{
+			LaunchTime:    launchTime,
+			MinLaunchTime: minLaunchTime,
+			MaxLaunchTime: maxLaunchTime,
+			Suggestion:    SuggestLaunchTime(params, launchTime, now),
+		}
+	}
+	return nil
+}
+
 // LaunchParams fetches the chain launch module params from SPN
 func (n Network) LaunchParams(ctx context.Context) (launchtypes.Params, error) {
-	res, err := n.launchQuery.Params(ctx, &launchtypes.QueryParamsRequest{})
+	defer n.observeLatency("launch_params", MetricsKindQuery)()
+
+	res, err := cachedQuery(ctx, n, "launch_params", func(ctx context.Context) (*launchtypes.QueryParamsResponse, error) {
+		return n.launchQuery.Params(ctx, &launchtypes.QueryParamsRequest{})
+	})
 	if err != nil {
 		return launchtypes.Params{}, err
 	}
 	return res.GetParams(), nil
 }
 
-// TriggerLaunch launches a chain as a coordinator
-func (n Network) TriggerLaunch(ctx context.Context, launchID uint64, launchTime time.Time) error {
-	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Launching chain %d", launchID)))
-	params, err := n.LaunchParams(ctx)
+// triggerLaunchOptions holds the options of a launch trigger.
+type triggerLaunchOptions struct {
+	ignorePendingRequests bool
+	nearestLaunchTime     bool
+
+	// recheckLaunchTime returns the launch time checked again right before the broadcast.
+	recheckLaunchTime func(ctx context.Context) (time.Time, error)
+}
+
+// TriggerLaunchOption configures a launch trigger.
+type TriggerLaunchOption func(*triggerLaunchOptions)
+
+// WithIgnorePendingRequests launches the chain even when requests are still pending, a warning with
+// the number of pending requests is emitted instead of an ErrPendingRequests error.
+func WithIgnorePendingRequests() TriggerLaunchOption {
+	return func(o *triggerLaunchOptions) {
+		o.ignorePendingRequests = true
+	}
+}
+
+// WithNearestLaunchTime launches the chain at the nearest valid launch time from the launch time instead of
+// returning an ErrInvalidLaunchTime error. The nearest launch time is computed with the time of the node when
+// the launch is triggered, a suggestion computed earlier can be out of the range by then.
+func WithNearestLaunchTime() TriggerLaunchOption {
+	return func(o *triggerLaunchOptions) {
+		o.nearestLaunchTime = true
+	}
+}
+
+// TriggerLaunch launches a chain as a coordinator.
+// An ErrPendingRequests error is returned when requests of the chain are still pending.
+func (n Network) TriggerLaunch(ctx context.Context, launchID uint64, launchTime time.Time, options ...TriggerLaunchOption) error {
+	var o triggerLaunchOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	address, err := n.accountAddress()
 	if err != nil {
 		return err
 	}
 
-	var (
-		minLaunchTime = n.clock.Now().Add(params.LaunchTimeRange.MinLaunchTime).Add(MinLaunchTimeOffset)
-		maxLaunchTime = n.clock.Now().Add(params.LaunchTimeRange.MaxLaunchTime)
-	)
-	address, err := n.account.Address(networktypes.SPN)
+	n.ev.Send(events.New(events.StatusOngoing, events.Message(networktypes.MsgLaunchingChain, events.Params{
+		"LaunchID": launchID,
+	})))
+	if err := n.checkCoordinator(ctx, launchID); err != nil {
+		return err
+	}
+
+	params, err := n.LaunchParams(ctx)
 	if err != nil {
 		return err
 	}
+	for _, warning := range launchParamsWarnings(params) {
+		n.ev.Send(events.NewWarning(warning))
+	}
 
-	if launchTime.IsZero() {
+	// the launch time range is checked by SPN with the time of the node
+	now := n.now(ctx)
+	minLaunchTime, _ := LaunchTimeRange(params, now)
+	switch {
+	case launchTime.IsZero():
 		// Use minimum launch time by default
 		launchTime = minLaunchTime
-	} else {
-		// check launch time is in range
-		switch {
-		case launchTime.Before(minLaunchTime):
-			return fmt.Errorf("launch time %s lower than minimum %s",
-				launchTime.String(),
-				minLaunchTime.String(),
-			)
-		case launchTime.After(maxLaunchTime):
-			return fmt.Errorf("launch time %s bigger than maximum %s",
-				launchTime.String(),
-				maxLaunchTime.String(),
-			)
+	case o.nearestLaunchTime:
+		launchTime = SuggestLaunchTime(params, launchTime, now)
+	default:
+		if err := checkLaunchTime(params, launchTime, now); err != nil {
+			return err
+		}
+	}
+
+	count, requestIDs, err := n.pendingRequests(ctx, launchID)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		if !o.ignorePendingRequests {
+			return ErrPendingRequests{
+				LaunchID:   launchID,
+				Count:      count,
+				RequestIDs: requestIDs,
+			}
+		}
+		n.ev.Send(events.NewWarning(events.Message(networktypes.MsgLaunchPendingRequests, events.Params{
+			"LaunchID": launchID,
+			"Count":    count,
+		})))
+	}
+
+	if o.recheckLaunchTime != nil {
+		if launchTime, err = o.recheckLaunchTime(ctx); err != nil {
+			return err
+		}
+		if o.nearestLaunchTime {
+			launchTime = SuggestLaunchTime(params, launchTime, now)
+		} else if err := checkLaunchTime(params, launchTime, now); err != nil {
+			return err
 		}
 	}
 
 	msg := launchtypes.NewMsgTriggerLaunch(address, launchID, launchTime)
-	n.ev.Send(events.New(events.StatusOngoing, "Setting launch time"))
-	res, err := n.cosmos.BroadcastTx(ctx, n.account, msg)
+	n.ev.Send(events.New(events.StatusOngoing, events.Message(networktypes.MsgLaunchSettingTime, nil)))
+	res, err := n.broadcastTx(ctx, msg)
 	if err != nil {
 		return err
 	}
@@ -74,35 +220,158 @@ func (n Network) TriggerLaunch(ctx context.Context, launchID uint64, launchTime
 		return err
 	}
 
-	n.ev.Send(events.New(events.StatusDone,
-		fmt.Sprintf("Chain %d will be launched on %s", launchID, launchTime.String()),
-	))
+	n.ev.Send(events.New(events.StatusDone, events.Message(networktypes.MsgLaunchScheduled, events.Params{
+		"LaunchID":     launchID,
+		"LaunchTime":   launchTime,
+		"Announcement": networktypes.NewLaunchTimeAnnouncement(launchTime, now, n.location),
+	})))
 	return nil
 }
 
+// ErrChainIDBumpWithValidators is returned when the chain ID epoch is bumped while the chain has approved genesis
+// validators: the gentxs are signed for the chain ID, the relaunched chain couldn't start with them.
+type ErrChainIDBumpWithValidators struct {
+	LaunchID   uint64
+	ChainID    string
+	Validators uint64
+}
+
+// Error implements error.
+func (e ErrChainIDBumpWithValidators) Error() string {
+	return fmt.Sprintf(
+		"the chain ID epoch of the chain %d can't be bumped: its %d approved genesis validator(s) signed their gentxs "+
+			"for the chain ID %s, they must re-submit their gentxs for the new chain ID once removed. "+
+			"Revert the launch without bumping the epoch or remove the genesis validators first",
+		e.LaunchID,
+		e.Validators,
+		e.ChainID,
+	)
+}
+
+// revertLaunchOptions holds the options of a launch revert.
+type revertLaunchOptions struct {
+	bumpChainIDEpoch bool
+}
+
+// RevertLaunchOption configures a launch revert.
+type RevertLaunchOption func(*revertLaunchOptions)
+
+// BumpChainIDEpoch increments the epoch of the chain ID when the launch is reverted, the validators can't confuse
+// the relaunched chain with the reverted one. The chain ID is set in the genesis and the chain metadata.
+// The revert fails with ErrChainIDBumpWithValidators when the chain has approved genesis validators.
+func BumpChainIDEpoch() RevertLaunchOption {
+	return func(o *revertLaunchOptions) {
+		o.bumpChainIDEpoch = true
+	}
+}
+
 // RevertLaunch reverts a launched chain as a coordinator
-func (n Network) RevertLaunch(ctx context.Context, launchID uint64, chain Chain) error {
-	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Reverting launched chain %d", launchID)))
+func (n Network) RevertLaunch(ctx context.Context, launchID uint64, chain Chain, options ...RevertLaunchOption) error {
+	var o revertLaunchOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, events.Message(networktypes.MsgRevertingLaunch, events.Params{
+		"LaunchID": launchID,
+	})))
 
-	address, err := n.account.Address(networktypes.SPN)
+	address, err := n.accountAddress()
 	if err != nil {
 		return err
 	}
 
-	msg := launchtypes.NewMsgRevertLaunch(address, launchID)
-	_, err = n.cosmos.BroadcastTx(ctx, n.account, msg)
-	if err != nil {
+	if err := n.checkCoordinator(ctx, launchID); err != nil {
 		return err
 	}
 
-	n.ev.Send(events.New(events.StatusDone,
-		fmt.Sprintf("Chain %d launch was reverted", launchID),
-	))
+	msgs := []sdk.Msg{launchtypes.NewMsgRevertLaunch(address, launchID)}
 
-	n.ev.Send(events.New(events.StatusOngoing, "Resetting the genesis time"))
+	// the chain ID is bumped in the same transaction as the revert
+	var chainID, bumpedChainID string
+	if o.bumpChainIDEpoch {
+		if chainID, err = chain.ChainID(); err != nil {
+			return err
+		}
+		if bumpedChainID, err = networktypes.BumpChainIDEpoch(chainID); err != nil {
+			return err
+		}
+		if err := n.checkNoGenesisValidators(ctx, launchID, chainID); err != nil {
+			return err
+		}
+
+		res, err := n.launchQuery.Chain(ctx, &launchtypes.QueryGetChainRequest{
+			LaunchID: launchID,
+		})
+		if cosmoserror.Unwrap(err) == cosmoserror.ErrNotFound {
+			return ErrObjectNotFound
+		} else if err != nil {
+			return err
+		}
+		metadata, err := networktypes.SetChainID(res.Chain.Metadata, bumpedChainID)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, launchtypes.NewMsgEditChain(address, launchID, false, 0, metadata))
+	}
+
+	if _, err := n.broadcastTx(ctx, msgs...); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusDone, events.Message(networktypes.MsgLaunchReverted, events.Params{
+		"LaunchID": launchID,
+	})))
+
+	n.ev.Send(events.New(events.StatusOngoing, events.Message(networktypes.MsgResettingGenesisTime, nil)))
 	if err := chain.ResetGenesisTime(); err != nil {
 		return err
 	}
-	n.ev.Send(events.New(events.StatusDone, "Genesis time was reset"))
+	n.ev.Send(events.New(events.StatusDone, events.Message(networktypes.MsgGenesisTimeReset, nil)))
+
+	if o.bumpChainIDEpoch {
+		if err := chain.SetChainID(bumpedChainID); err != nil {
+			return err
+		}
+		n.ev.Send(events.New(events.StatusDone, events.Message(networktypes.MsgChainIDBumped, events.Params{
+			"ChainID":       chainID,
+			"BumpedChainID": bumpedChainID,
+		})))
+	}
+	return nil
+}
+
+// genesisValidatorCount returns the number of approved genesis validators of the chain.
+// The validators are counted by SPN, a single page of one validator is fetched.
+func (n Network) genesisValidatorCount(ctx context.Context, launchID uint64) (uint64, error) {
+	res, err := n.launchQuery.GenesisValidatorAll(ctx, &launchtypes.QueryAllGenesisValidatorRequest{
+		LaunchID:   launchID,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	count := uint64(len(res.GenesisValidator))
+	if res.Pagination != nil && res.Pagination.Total > count {
+		count = res.Pagination.Total
+	}
+	return count, nil
+}
+
+// checkNoGenesisValidators checks the chain has no approved genesis validator before its chain ID is changed,
+// the gentxs of the validators are only valid for the chain ID they were signed for.
+func (n Network) checkNoGenesisValidators(ctx context.Context, launchID uint64, chainID string) error {
+	count, err := n.genesisValidatorCount(ctx, launchID)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrChainIDBumpWithValidators{
+			LaunchID:   launchID,
+			ChainID:    chainID,
+			Validators: count,
+		}
+	}
 	return nil
 }
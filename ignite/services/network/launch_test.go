@@ -3,13 +3,17 @@ package network
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
 
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/xtime"
 	"github.com/ignite/cli/ignite/services/network/networktypes"
 	"github.com/ignite/cli/ignite/services/network/testutil"
 )
@@ -20,105 +24,186 @@ const (
 	TestRevertDelay      = time.Second * 3600
 )
 
+// newLaunchSimulator creates a network connected to a SPN simulator with a chain of the account of the network.
+func newLaunchSimulator(t *testing.T) (*testutil.Simulator, Network, uint64) {
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	sim := testutil.NewSimulator(
+		testutil.WithSimulatorBlockTime(sampleTime),
+		testutil.WithSimulatorParams(launchtypes.NewParams(
+			TestMinRemainingTime,
+			TestMaxRemainingTime,
+			TestRevertDelay,
+			sdk.Coins(nil),
+			sdk.Coins(nil),
+		)),
+	)
+	coordinatorID := sim.AddCoordinator(addr)
+	launchID := sim.AddChain(launchtypes.Chain{CoordinatorID: coordinatorID})
+
+	network := New(
+		sim,
+		account,
+		WithLaunchQueryClient(sim.LaunchQueryClient()),
+		WithCampaignQueryClient(sim.CampaignQueryClient()),
+		WithProfileQueryClient(sim.ProfileQueryClient()),
+		WithCustomClock(xtime.NewClockMock(sampleTime)),
+	)
+	return sim, network, launchID
+}
+
 func TestTriggerLaunch(t *testing.T) {
 	t.Run("successfully launch a chain", func(t *testing.T) {
-		var (
-			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
-			suite, network = newSuite(account)
-		)
+		sim, network, launchID := newLaunchSimulator(t)
 
-		addr, err := account.Address(networktypes.SPN)
+		err := network.TriggerLaunch(context.Background(), launchID, sampleTime.Add(TestMaxRemainingTime))
 		require.NoError(t, err)
 
-		suite.LaunchQueryMock.
-			On("Params", context.Background(), &launchtypes.QueryParamsRequest{}).
-			Return(&launchtypes.QueryParamsResponse{
-				Params: launchtypes.NewParams(
-					TestMinRemainingTime,
-					TestMaxRemainingTime,
-					TestRevertDelay,
-					sdk.Coins(nil),
-					sdk.Coins(nil),
-				),
-			}, nil).
-			Once()
-		suite.CosmosClientMock.
-			On("BroadcastTx",
-				context.Background(),
-				account,
-				&launchtypes.MsgTriggerLaunch{
-					Coordinator: addr,
-					LaunchID:    testutil.LaunchID,
-					LaunchTime:  sampleTime.Add(TestMaxRemainingTime),
-				}).
-			Return(testutil.NewResponse(&launchtypes.MsgTriggerLaunchResponse{}), nil).
-			Once()
+		chain, _ := sim.Chain(launchID)
+		require.True(t, chain.LaunchTriggered)
+		require.True(t, chain.LaunchTime.Equal(sampleTime.Add(TestMaxRemainingTime)))
+	})
 
-		launchError := network.TriggerLaunch(context.Background(), testutil.LaunchID, sampleTime.Add(TestMaxRemainingTime))
-		require.NoError(t, launchError)
-		suite.AssertAllMocks(t)
+	t.Run("announce the launch time in the local zone", func(t *testing.T) {
+		_, network, launchID := newLaunchSimulator(t)
+		ev := events.NewBus(events.WithCustomBufferSize(10))
+		CollectEvents(ev)(&network)
+		WithLocation(time.FixedZone("CEST", 2*60*60))(&network)
+
+		err := network.TriggerLaunch(context.Background(), launchID, sampleTime.Add(TestMaxRemainingTime))
+		require.NoError(t, err)
+
+		ev.Shutdown(context.Background())
+		var descriptions []string
+		for e := range ev.Events() {
+			descriptions = append(descriptions, e.Description)
+		}
+		require.Contains(t, descriptions, fmt.Sprintf(
+			"Chain %d will be launched on 1970-01-02 00:16:40 UTC (1970-01-02 02:16:40 CEST, in 24h, unix 87400)",
+			launchID,
+		))
+	})
+
+	t.Run("successfully launch a chain at the minimum launch time", func(t *testing.T) {
+		sim, network, launchID := newLaunchSimulator(t)
+
+		err := network.TriggerLaunch(context.Background(), launchID, time.Time{})
+		require.NoError(t, err)
+
+		chain, _ := sim.Chain(launchID)
+		require.True(t, chain.LaunchTime.Equal(sampleTime.Add(TestMinRemainingTime).Add(MinLaunchTimeOffset)))
 	})
 
 	t.Run("failed to launch a chain, remaining time is lower than allowed", func(t *testing.T) {
 		var (
-			account                       = testutil.NewTestAccount(t, testutil.TestAccountName)
-			suite, network                = newSuite(account)
+			sim, network, launchID        = newLaunchSimulator(t)
 			remainingTimeLowerThanMinimum = sampleTime
 		)
 
-		suite.LaunchQueryMock.
-			On("Params", context.Background(), &launchtypes.QueryParamsRequest{}).
-			Return(&launchtypes.QueryParamsResponse{
-				Params: launchtypes.NewParams(
-					TestMinRemainingTime,
-					TestMaxRemainingTime,
-					TestRevertDelay,
-					sdk.Coins(nil),
-					sdk.Coins(nil),
-				),
-			}, nil).
-			Once()
-
-		launchError := network.TriggerLaunch(context.Background(), testutil.LaunchID, remainingTimeLowerThanMinimum)
-		require.Errorf(
-			t,
-			launchError,
-			"remaining time %s lower than minimum %s",
-			remainingTimeLowerThanMinimum.String(),
-			sampleTime.Add(TestMinRemainingTime).Add(MinLaunchTimeOffset).String(),
-		)
-		suite.AssertAllMocks(t)
+		launchError := network.TriggerLaunch(context.Background(), launchID, remainingTimeLowerThanMinimum)
+		minLaunchTime := sampleTime.Add(TestMinRemainingTime).Add(MinLaunchTimeOffset)
+		require.Equal(t, ErrInvalidLaunchTime{
+			LaunchTime:    remainingTimeLowerThanMinimum,
+			MinLaunchTime: minLaunchTime,
+			MaxLaunchTime: sampleTime.Add(TestMaxRemainingTime),
+			Suggestion:    minLaunchTime,
+		}, launchError)
+		require.EqualError(t, launchError, fmt.Sprintf(
+			"launch time %s lower than minimum %s",
+			remainingTimeLowerThanMinimum,
+			minLaunchTime,
+		))
+
+		chain, _ := sim.Chain(launchID)
+		require.False(t, chain.LaunchTriggered)
 	})
 
 	t.Run("failed to launch a chain, remaining time is greater than allowed", func(t *testing.T) {
 		var (
-			account                         = testutil.NewTestAccount(t, testutil.TestAccountName)
-			suite, network                  = newSuite(account)
+			sim, network, launchID          = newLaunchSimulator(t)
 			remainingTimeGreaterThanMaximum = sampleTime.Add(TestMaxRemainingTime).Add(time.Second)
 		)
 
-		suite.LaunchQueryMock.
-			On("Params", context.Background(), &launchtypes.QueryParamsRequest{}).
-			Return(&launchtypes.QueryParamsResponse{
-				Params: launchtypes.NewParams(
-					TestMinRemainingTime,
-					TestMaxRemainingTime,
-					TestRevertDelay,
-					sdk.Coins(nil),
-					sdk.Coins(nil),
-				),
-			}, nil).
-			Once()
+		launchError := network.TriggerLaunch(context.Background(), launchID, remainingTimeGreaterThanMaximum)
+		maxLaunchTime := sampleTime.Add(TestMaxRemainingTime)
+		require.Equal(t, ErrInvalidLaunchTime{
+			LaunchTime:    remainingTimeGreaterThanMaximum,
+			MinLaunchTime: sampleTime.Add(TestMinRemainingTime).Add(MinLaunchTimeOffset),
+			MaxLaunchTime: maxLaunchTime,
+			Suggestion:    maxLaunchTime,
+		}, launchError)
+		require.EqualError(t, launchError, fmt.Sprintf(
+			"launch time %s bigger than maximum %s",
+			remainingTimeGreaterThanMaximum,
+			maxLaunchTime,
+		))
+
+		chain, _ := sim.Chain(launchID)
+		require.False(t, chain.LaunchTriggered)
+	})
 
-		launchError := network.TriggerLaunch(context.Background(), testutil.LaunchID, remainingTimeGreaterThanMaximum)
-		require.Errorf(
-			t,
-			launchError,
-			"remaining time %s greater than maximum %s",
-			remainingTimeGreaterThanMaximum.String(),
-			sampleTime.Add(TestMaxRemainingTime).String(),
-		)
-		suite.AssertAllMocks(t)
+	t.Run("nearest launch time computed when the launch is triggered", func(t *testing.T) {
+		sim, network, launchID := newLaunchSimulator(t)
+
+		err := network.TriggerLaunch(context.Background(), launchID, sampleTime)
+		var errLaunchTime ErrInvalidLaunchTime
+		require.ErrorAs(t, err, &errLaunchTime)
+
+		// the suggestion is too early once the user confirmed it
+		network.clock.(*xtime.ClockMock).Add(time.Minute)
+		sim.SetBlockTime(sampleTime.Add(time.Minute))
+		err = network.TriggerLaunch(context.Background(), launchID, errLaunchTime.Suggestion)
+		require.ErrorAs(t, err, &ErrInvalidLaunchTime{})
+
+		require.NoError(t, network.TriggerLaunch(context.Background(), launchID, sampleTime, WithNearestLaunchTime()))
+		chain, _ := sim.Chain(launchID)
+		require.True(t, chain.LaunchTime.Equal(errLaunchTime.Suggestion.Add(time.Minute)))
+	})
+
+	t.Run("launch time validated against the updated params", func(t *testing.T) {
+		sim, network, launchID := newLaunchSimulator(t)
+		launchTime := sampleTime.Add(TestMaxRemainingTime)
+
+		// the maximum launch time is lowered on SPN after the launch params are fetched by another launch
+		_, err := network.LaunchParams(context.Background())
+		require.NoError(t, err)
+		sim.SetParams(launchtypes.NewParams(TestMinRemainingTime, TestMaxRemainingTime/2, TestRevertDelay, nil, nil))
+
+		err = network.TriggerLaunch(context.Background(), launchID, launchTime)
+		maxLaunchTime := sampleTime.Add(TestMaxRemainingTime / 2)
+		require.Equal(t, ErrInvalidLaunchTime{
+			LaunchTime:    launchTime,
+			MinLaunchTime: sampleTime.Add(TestMinRemainingTime).Add(MinLaunchTimeOffset),
+			MaxLaunchTime: maxLaunchTime,
+			Suggestion:    maxLaunchTime,
+		}, err)
+
+		require.NoError(t, network.TriggerLaunch(context.Background(), launchID, maxLaunchTime))
+		chain, _ := sim.Chain(launchID)
+		require.True(t, chain.LaunchTime.Equal(maxLaunchTime))
+	})
+
+	t.Run("failed to launch a chain, launch time rejected by SPN", func(t *testing.T) {
+		sim, network, launchID := newLaunchSimulator(t)
+
+		// the system clock is ahead of SPN, the launch time is valid for the local clock only
+		sim.SetBlockTime(sampleTime.Add(-time.Hour))
+
+		err := network.TriggerLaunch(context.Background(), launchID, sampleTime.Add(TestMaxRemainingTime))
+		require.ErrorIs(t, err, launchtypes.ErrLaunchTimeTooHigh)
+
+		chain, _ := sim.Chain(launchID)
+		require.False(t, chain.LaunchTriggered)
+	})
+
+	t.Run("failed to launch a chain, launch already triggered", func(t *testing.T) {
+		_, network, launchID := newLaunchSimulator(t)
+
+		require.NoError(t, network.TriggerLaunch(context.Background(), launchID, time.Time{}))
+		err := network.TriggerLaunch(context.Background(), launchID, time.Time{})
+		require.ErrorIs(t, err, launchtypes.ErrTriggeredLaunch)
 	})
 
 	t.Run("failed to launch a chain, failed to broadcast the launch tx", func(t *testing.T) {
@@ -128,9 +213,12 @@ func TestTriggerLaunch(t *testing.T) {
 			expectedError  = errors.New("Failed to fetch")
 		)
 
+		mockCoordinator(t, suite, account)
+
 		addr, err := account.Address(networktypes.SPN)
 		require.NoError(t, err)
 
+		mockBlockTime(suite, sampleTime)
 		suite.LaunchQueryMock.
 			On("Params", context.Background(), &launchtypes.QueryParamsRequest{}).
 			Return(&launchtypes.QueryParamsResponse{
@@ -143,6 +231,7 @@ func TestTriggerLaunch(t *testing.T) {
 				),
 			}, nil).
 			Once()
+		mockPendingRequests(suite)
 		suite.CosmosClientMock.
 			On("BroadcastTx",
 				context.Background(),
@@ -168,9 +257,12 @@ func TestTriggerLaunch(t *testing.T) {
 			expectedError  = errors.New("failed to fetch")
 		)
 
+		mockCoordinator(t, suite, account)
+
 		addr, err := account.Address(networktypes.SPN)
 		require.NoError(t, err)
 
+		mockBlockTime(suite, sampleTime)
 		suite.LaunchQueryMock.
 			On("Params", context.Background(), &launchtypes.QueryParamsRequest{}).
 			Return(&launchtypes.QueryParamsResponse{
@@ -183,6 +275,7 @@ func TestTriggerLaunch(t *testing.T) {
 				),
 			}, nil).
 			Once()
+		mockPendingRequests(suite)
 		suite.CosmosClientMock.
 			On("BroadcastTx",
 				context.Background(),
@@ -208,6 +301,8 @@ func TestTriggerLaunch(t *testing.T) {
 			expectedError  = errors.New("failed to fetch")
 		)
 
+		mockCoordinator(t, suite, account)
+
 		suite.LaunchQueryMock.
 			On("Params", context.Background(), &launchtypes.QueryParamsRequest{}).
 			Return(&launchtypes.QueryParamsResponse{
@@ -235,6 +330,8 @@ func TestRevertLaunch(t *testing.T) {
 			suite, network = newSuite(account)
 		)
 
+		mockCoordinator(t, suite, account)
+
 		addr, err := account.Address(networktypes.SPN)
 		require.NoError(t, err)
 
@@ -262,6 +359,8 @@ func TestRevertLaunch(t *testing.T) {
 			expectedError  = errors.New("failed to revert launch")
 		)
 
+		mockCoordinator(t, suite, account)
+
 		addr, err := account.Address(networktypes.SPN)
 		require.NoError(t, err)
 
@@ -292,6 +391,8 @@ func TestRevertLaunch(t *testing.T) {
 			expectedError  = errors.New("failed to reset genesis time")
 		)
 
+		mockCoordinator(t, suite, account)
+
 		addr, err := account.Address(networktypes.SPN)
 		require.NoError(t, err)
 
@@ -315,4 +416,216 @@ func TestRevertLaunch(t *testing.T) {
 		require.Equal(t, expectedError, revertError)
 		suite.AssertAllMocks(t)
 	})
+
+	t.Run("revert launch and bump the chain ID epoch", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+		)
+
+		mockCoordinator(t, suite, account)
+
+		addr, err := account.Address(networktypes.SPN)
+		require.NoError(t, err)
+
+		suite.ChainMock.On("ChainID").Return("mars-9", nil).Once()
+		mockGenesisValidatorCount(suite, 0)
+		suite.LaunchQueryMock.
+			On("Chain", context.Background(), &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}).
+			Return(&launchtypes.QueryGetChainResponse{
+				Chain: launchtypes.Chain{
+					LaunchID: testutil.LaunchID,
+					Metadata: []byte(`{"version":1,"explorer_url":"https://e.io"}`),
+				},
+			}, nil).
+			Once()
+		suite.CosmosClientMock.
+			On("BroadcastTx",
+				context.Background(),
+				account,
+				&launchtypes.MsgRevertLaunch{
+					Coordinator: addr,
+					LaunchID:    testutil.LaunchID,
+				},
+				&launchtypes.MsgEditChain{
+					Coordinator: addr,
+					LaunchID:    testutil.LaunchID,
+					Metadata:    []byte(`{"version":1,"explorer_url":"https://e.io","chain_id":"mars-10"}`),
+				}).
+			Return(testutil.NewResponse(&launchtypes.MsgRevertLaunchResponse{}), nil).
+			Once()
+		suite.ChainMock.On("ResetGenesisTime").Return(nil).Once()
+		suite.ChainMock.On("SetChainID", "mars-10").Return(nil).Once()
+
+		revertError := network.RevertLaunch(context.Background(), testutil.LaunchID, suite.ChainMock, BumpChainIDEpoch())
+		require.NoError(t, revertError)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to bump the chain ID epoch with approved genesis validators", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+		)
+
+		mockCoordinator(t, suite, account)
+		suite.ChainMock.On("ChainID").Return("mars-9", nil).Once()
+		mockGenesisValidatorCount(suite, 3)
+
+		// nothing is broadcasted and the genesis is not changed
+		revertError := network.RevertLaunch(context.Background(), testutil.LaunchID, suite.ChainMock, BumpChainIDEpoch())
+		require.Equal(t, ErrChainIDBumpWithValidators{
+			LaunchID:   testutil.LaunchID,
+			ChainID:    "mars-9",
+			Validators: 3,
+		}, revertError)
+		suite.AssertAllMocks(t)
+	})
+}
+
+// mockGenesisValidatorCount mocks the count of the genesis validators of the launch.
+func mockGenesisValidatorCount(suite testutil.Suite, count uint64) {
+	var validators []launchtypes.GenesisValidator
+	if count > 0 {
+		validators = []launchtypes.GenesisValidator{{LaunchID: testutil.LaunchID}}
+	}
+	suite.LaunchQueryMock.
+		On("GenesisValidatorAll", context.Background(), &launchtypes.QueryAllGenesisValidatorRequest{
+			LaunchID:   testutil.LaunchID,
+			Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+		}).
+		Return(&launchtypes.QueryAllGenesisValidatorResponse{
+			GenesisValidator: validators,
+			Pagination:       &query.PageResponse{Total: count},
+		}, nil).
+		Once()
+}
+
+func TestSuggestLaunchTime(t *testing.T) {
+	var (
+		params = launchtypes.NewParams(
+			TestMinRemainingTime,
+			TestMaxRemainingTime,
+			TestRevertDelay,
+			sdk.Coins(nil),
+			sdk.Coins(nil),
+		)
+		minLaunchTime = sampleTime.Add(TestMinRemainingTime).Add(MinLaunchTimeOffset)
+		maxLaunchTime = sampleTime.Add(TestMaxRemainingTime)
+	)
+
+	tests := []struct {
+		name    string
+		params  launchtypes.Params
+		desired time.Time
+		want    time.Time
+	}{
+		{
+			name:    "desired time in range",
+			params:  params,
+			desired: minLaunchTime.Add(time.Hour),
+			want:    minLaunchTime.Add(time.Hour),
+		},
+		{
+			name:    "desired time lower than minimum",
+			params:  params,
+			desired: minLaunchTime.Add(-time.Second),
+			want:    minLaunchTime,
+		},
+		{
+			name:    "desired time in the past",
+			params:  params,
+			desired: sampleTime.Add(-24 * time.Hour),
+			want:    minLaunchTime,
+		},
+		{
+			name:    "desired time bigger than maximum",
+			params:  params,
+			desired: maxLaunchTime.Add(time.Hour),
+			want:    maxLaunchTime,
+		},
+		{
+			name: "range in the past of the desired time because of the offset",
+			params: launchtypes.NewParams(
+				TestMinRemainingTime,
+				TestMinRemainingTime,
+				TestRevertDelay,
+				sdk.Coins(nil),
+				sdk.Coins(nil),
+			),
+			desired: sampleTime.Add(TestMaxRemainingTime),
+			want:    minLaunchTime,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, SuggestLaunchTime(tt.params, tt.desired, sampleTime))
+		})
+	}
+}
+
+func TestLaunchTimeRangeZeroParams(t *testing.T) {
+	newParams := func(minLaunchTime, maxLaunchTime time.Duration) launchtypes.Params {
+		return launchtypes.NewParams(minLaunchTime, maxLaunchTime, TestRevertDelay, sdk.Coins(nil), sdk.Coins(nil))
+	}
+
+	tests := []struct {
+		name             string
+		params           launchtypes.Params
+		wantMin, wantMax time.Time
+		warnings         int
+		valid, invalid   []time.Time
+	}{
+		{
+			name:     "no minimum launch time",
+			params:   newParams(0, TestMaxRemainingTime),
+			wantMin:  sampleTime.Add(MinLaunchTimeOffset),
+			wantMax:  sampleTime.Add(TestMaxRemainingTime),
+			warnings: 1,
+			valid:    []time.Time{sampleTime.Add(MinLaunchTimeOffset), sampleTime.Add(TestMaxRemainingTime)},
+			invalid:  []time.Time{sampleTime, sampleTime.Add(TestMaxRemainingTime).Add(time.Second)},
+		},
+		{
+			name:     "no maximum launch time",
+			params:   newParams(TestMinRemainingTime, 0),
+			wantMin:  sampleTime.Add(TestMinRemainingTime).Add(MinLaunchTimeOffset),
+			warnings: 1,
+			valid:    []time.Time{sampleTime.Add(TestMinRemainingTime).Add(MinLaunchTimeOffset), sampleTime.Add(365 * 24 * time.Hour)},
+			invalid:  []time.Time{sampleTime.Add(TestMinRemainingTime)},
+		},
+		{
+			name:     "no launch time range",
+			params:   newParams(0, 0),
+			wantMin:  sampleTime.Add(MinLaunchTimeOffset),
+			warnings: 2,
+			valid:    []time.Time{sampleTime.Add(MinLaunchTimeOffset), sampleTime.Add(365 * 24 * time.Hour)},
+			invalid:  []time.Time{sampleTime, sampleTime.Add(MinLaunchTimeOffset).Add(-time.Second)},
+		},
+		{
+			name:    "standard launch time range",
+			params:  newParams(TestMinRemainingTime, TestMaxRemainingTime),
+			wantMin: sampleTime.Add(TestMinRemainingTime).Add(MinLaunchTimeOffset),
+			wantMax: sampleTime.Add(TestMaxRemainingTime),
+			valid:   []time.Time{sampleTime.Add(TestMinRemainingTime).Add(MinLaunchTimeOffset)},
+			invalid: []time.Time{sampleTime.Add(TestMaxRemainingTime).Add(time.Second)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minLaunchTime, maxLaunchTime := LaunchTimeRange(tt.params, sampleTime)
+			require.Equal(t, tt.wantMin, minLaunchTime)
+			require.Equal(t, tt.wantMax, maxLaunchTime)
+			require.Len(t, launchParamsWarnings(tt.params), tt.warnings)
+
+			for _, launchTime := range tt.valid {
+				require.NoError(t, checkLaunchTime(tt.params, launchTime, sampleTime))
+				require.Equal(t, launchTime, SuggestLaunchTime(tt.params, launchTime, sampleTime))
+			}
+			for _, launchTime := range tt.invalid {
+				err := checkLaunchTime(tt.params, launchTime, sampleTime)
+				require.ErrorAs(t, err, &ErrInvalidLaunchTime{})
+				require.NoError(t, checkLaunchTime(tt.params, SuggestLaunchTime(tt.params, launchTime, sampleTime), sampleTime))
+			}
+		})
+	}
 }
@@ -0,0 +1,184 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+// launchHeightWindow is the number of the latest SPN blocks used to measure the average block time.
+const launchHeightWindow = 100
+
+type (
+	// BlockSample is the time of a block.
+	BlockSample struct {
+		Height int64
+		Time   time.Time
+	}
+
+	// HeightTimeEstimate is the estimated time of a future block.
+	HeightTimeEstimate struct {
+		// Height is the height of the future block.
+		Height int64
+
+		// LatestHeight is the height of the latest block the estimate is computed from.
+		LatestHeight int64
+
+		// AverageBlockTime is the average block time measured over the block history.
+		AverageBlockTime time.Duration
+
+		// Time is the estimated time of the block.
+		Time time.Time
+
+		// ErrorBand is the uncertainty of the estimate, the block is expected within Time ± ErrorBand.
+		ErrorBand time.Duration
+	}
+)
+
+// EstimateHeightTime estimates the time of the block at the height from a history of the latest blocks.
+// The time is extrapolated from the latest block with the average block time of the history. The error band
+// is one standard deviation of the block times accumulated over the remaining blocks, plus the error of the
+// average itself, it widens with the variability of the block times and the distance to the height.
+func EstimateHeightTime(history []BlockSample, height int64) (HeightTimeEstimate, error) {
+	if len(history) < 2 {
+		return HeightTimeEstimate{}, errors.New("at least two blocks are needed to measure the block time")
+	}
+	history = append([]BlockSample(nil), history...)
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].Height < history[j].Height
+	})
+
+	var (
+		first  = history[0]
+		latest = history[len(history)-1]
+		blocks = latest.Height - first.Height
+	)
+	if height <= latest.Height {
+		return HeightTimeEstimate{}, fmt.Errorf("the height %d is not after the latest height %d", height, latest.Height)
+	}
+	if blocks <= 0 || !latest.Time.After(first.Time) {
+		return HeightTimeEstimate{}, errors.New("the block history doesn't span any time")
+	}
+	average := float64(latest.Time.Sub(first.Time)) / float64(blocks)
+
+	// the variance of the block time, the samples spanning several blocks are weighted by their block count
+	var variance float64
+	for i := 1; i < len(history); i++ {
+		span := history[i].Height - history[i-1].Height
+		if span <= 0 {
+			return HeightTimeEstimate{}, fmt.Errorf("duplicate block %d in the history", history[i].Height)
+		}
+		blockTime := float64(history[i].Time.Sub(history[i-1].Time)) / float64(span)
+		variance += float64(span) * (blockTime - average) * (blockTime - average)
+	}
+	deviation := math.Sqrt(variance / float64(blocks))
+
+	remaining := float64(height - latest.Height)
+	band := deviation*math.Sqrt(remaining) + remaining*deviation/math.Sqrt(float64(blocks))
+
+	return HeightTimeEstimate{
+		Height:           height,
+		LatestHeight:     latest.Height,
+		AverageBlockTime: time.Duration(average),
+		Time:             latest.Time.Add(time.Duration(average * remaining)),
+		ErrorBand:        time.Duration(band),
+	}, nil
+}
+
+// EstimateSPNHeightTime estimates the time of the SPN block at the height from the latest SPN blocks.
+func (n Network) EstimateSPNHeightTime(ctx context.Context, height int64) (HeightTimeEstimate, error) {
+	status, err := n.cosmos.Status(ctx)
+	if err != nil {
+		return HeightTimeEstimate{}, errors.Wrap(err, "cannot fetch the latest block of SPN")
+	}
+	latestHeight := status.SyncInfo.LatestBlockHeight
+	if height <= latestHeight {
+		return HeightTimeEstimate{}, fmt.Errorf("the height %d is not after the latest SPN height %d", height, latestHeight)
+	}
+
+	start := latestHeight - launchHeightWindow
+	if start < 1 {
+		start = 1
+	}
+	heights := make([]int64, 0, latestHeight-start)
+	for h := start; h < latestHeight; h++ {
+		heights = append(heights, h)
+	}
+	blockTimes, err := n.cosmos.BlockTimes(ctx, heights...)
+	if err != nil {
+		return HeightTimeEstimate{}, errors.Wrap(err, "cannot fetch the SPN block times")
+	}
+
+	history := []BlockSample{{Height: latestHeight, Time: status.SyncInfo.LatestBlockTime}}
+	for h, t := range blockTimes {
+		history = append(history, BlockSample{Height: h, Time: t})
+	}
+	return EstimateHeightTime(history, height)
+}
+
+// TriggerLaunchAtHeight launches a chain as a coordinator at the estimated time of the SPN block at the height.
+// The estimate is computed again right before the broadcast, a warning is emitted when its error band
+// crosses the launch time range allowed by SPN. The estimate used for the launch is returned.
+func (n Network) TriggerLaunchAtHeight(
+	ctx context.Context,
+	launchID uint64,
+	height int64,
+	options ...TriggerLaunchOption,
+) (HeightTimeEstimate, error) {
+	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Estimating the time of the SPN block %d", height)))
+	estimate, err := n.EstimateSPNHeightTime(ctx, height)
+	if err != nil {
+		return HeightTimeEstimate{}, err
+	}
+
+	params, err := n.LaunchParams(ctx)
+	if err != nil {
+		return HeightTimeEstimate{}, err
+	}
+	n.warnLaunchEstimate(estimate, params, n.now(ctx))
+
+	options = append(options, func(o *triggerLaunchOptions) {
+		o.recheckLaunchTime = func(ctx context.Context) (time.Time, error) {
+			estimate, err = n.EstimateSPNHeightTime(ctx, height)
+			if err != nil {
+				return time.Time{}, err
+			}
+			return estimate.Time, nil
+		}
+	})
+	if err := n.TriggerLaunch(ctx, launchID, estimate.Time, options...); err != nil {
+		return HeightTimeEstimate{}, err
+	}
+	return estimate, nil
+}
+
+// warnLaunchEstimate warns when the error band of the estimate crosses the launch time range.
+func (n Network) warnLaunchEstimate(estimate HeightTimeEstimate, params launchtypes.Params, now time.Time) {
+	minLaunchTime, maxLaunchTime := LaunchTimeRange(params, now)
+	earliest := estimate.Time.Add(-estimate.ErrorBand)
+	latest := estimate.Time.Add(estimate.ErrorBand)
+
+	if earliest.Before(minLaunchTime) {
+		n.ev.Send(events.NewWarning(fmt.Sprintf(
+			"The SPN block %d may be produced at %s, before the minimum launch time %s",
+			estimate.Height,
+			earliest.UTC().Format(time.RFC3339),
+			minLaunchTime.UTC().Format(time.RFC3339),
+		)))
+	}
+	if !maxLaunchTime.IsZero() && latest.After(maxLaunchTime) {
+		n.ev.Send(events.NewWarning(fmt.Sprintf(
+			"The SPN block %d may be produced at %s, after the maximum launch time %s",
+			estimate.Height,
+			latest.UTC().Format(time.RFC3339),
+			maxLaunchTime.UTC().Format(time.RFC3339),
+		)))
+	}
+}
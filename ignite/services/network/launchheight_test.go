@@ -0,0 +1,213 @@
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+// blockHistory returns the history of blocks produced after each interval from the block 1 at start.
+func blockHistory(start time.Time, intervals ...time.Duration) []BlockSample {
+	history := []BlockSample{{Height: 1, Time: start}}
+	for i, interval := range intervals {
+		start = start.Add(interval)
+		history = append(history, BlockSample{Height: int64(i + 2), Time: start})
+	}
+	return history
+}
+
+// repeatIntervals returns the intervals repeated count times.
+func repeatIntervals(count int, intervals ...time.Duration) []time.Duration {
+	var repeated []time.Duration
+	for i := 0; i < count; i++ {
+		repeated = append(repeated, intervals...)
+	}
+	return repeated
+}
+
+func TestEstimateHeightTime(t *testing.T) {
+	start := time.Unix(1000, 0)
+
+	tests := []struct {
+		name        string
+		history     []BlockSample
+		height      int64
+		wantAverage time.Duration
+		wantTime    time.Time
+		wantBand    time.Duration
+		err         string
+	}{
+		{
+			name:        "constant block time",
+			history:     blockHistory(start, repeatIntervals(100, 6*time.Second)...),
+			height:      201,
+			wantAverage: 6 * time.Second,
+			wantTime:    start.Add(200 * 6 * time.Second),
+		},
+		{
+			name:        "alternating block times",
+			history:     blockHistory(start, repeatIntervals(50, 4*time.Second, 8*time.Second)...),
+			height:      501,
+			wantAverage: 6 * time.Second,
+			wantTime:    start.Add(500 * 6 * time.Second),
+			// 2s deviation: 2s × √400 + 400 × 2s / √100
+			wantBand: 120 * time.Second,
+		},
+		{
+			name:        "slowing down chain",
+			history:     blockHistory(start, append(repeatIntervals(50, 5*time.Second), repeatIntervals(50, 10*time.Second)...)...),
+			height:      201,
+			wantAverage: 7500 * time.Millisecond,
+			wantTime:    start.Add(750 * time.Second).Add(100 * 7500 * time.Millisecond),
+			// 2.5s deviation: 2.5s × √100 + 100 × 2.5s / √100
+			wantBand: 50 * time.Second,
+		},
+		{
+			name: "sparse and unordered history",
+			history: []BlockSample{
+				{Height: 51, Time: start.Add(300 * time.Second)},
+				{Height: 1, Time: start},
+				{Height: 101, Time: start.Add(600 * time.Second)},
+			},
+			height:      111,
+			wantAverage: 6 * time.Second,
+			wantTime:    start.Add(660 * time.Second),
+		},
+		{
+			name:    "single block",
+			history: blockHistory(start),
+			height:  10,
+			err:     "at least two blocks are needed to measure the block time",
+		},
+		{
+			name:    "past height",
+			history: blockHistory(start, 6*time.Second),
+			height:  2,
+			err:     "the height 2 is not after the latest height 2",
+		},
+		{
+			name:    "duplicate block",
+			history: append(blockHistory(start, 6*time.Second), BlockSample{Height: 2, Time: start.Add(6 * time.Second)}),
+			height:  10,
+			err:     "duplicate block 2 in the history",
+		},
+		{
+			name:    "history without time span",
+			history: []BlockSample{{Height: 1, Time: start}, {Height: 2, Time: start}},
+			height:  10,
+			err:     "the block history doesn't span any time",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimate, err := EstimateHeightTime(tt.history, tt.height)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.height, estimate.Height)
+			require.Equal(t, tt.wantAverage, estimate.AverageBlockTime)
+			require.True(t, tt.wantTime.Equal(estimate.Time), "estimated %s, want %s", estimate.Time, tt.wantTime)
+			require.InDelta(t, tt.wantBand, estimate.ErrorBand, float64(time.Millisecond))
+		})
+	}
+}
+
+func TestTriggerLaunchAtHeight(t *testing.T) {
+	// the SPN blocks are produced every 4 or 8 seconds until the sample time at height 101
+	newHeightSimulator := func(t *testing.T) (Network, uint64, func(uint64) time.Time, events.Bus) {
+		sim, network, launchID := newLaunchSimulator(t)
+		sim.SetBlockTime(sampleTime.Add(-600 * time.Second))
+		sim.AddBlocks(repeatIntervals(50, 4*time.Second, 8*time.Second)...)
+
+		bus := events.NewBus()
+		CollectEvents(bus)(&network)
+		launchTime := func(launchID uint64) time.Time {
+			chain, _ := sim.Chain(launchID)
+			require.True(t, chain.LaunchTriggered)
+			return chain.LaunchTime
+		}
+		return network, launchID, launchTime, bus
+	}
+	warnings := func(bus events.Bus) (warnings []string) {
+		bus.Shutdown(context.Background())
+		for ev := range bus.Events() {
+			if ev.Status == events.StatusWarning {
+				warnings = append(warnings, ev.Description)
+			}
+		}
+		return warnings
+	}
+
+	t.Run("launch at the estimated time", func(t *testing.T) {
+		network, launchID, launchTime, bus := newHeightSimulator(t)
+
+		estimate, err := network.TriggerLaunchAtHeight(context.Background(), launchID, 1301)
+		require.NoError(t, err)
+		require.Equal(t, int64(101), estimate.LatestHeight)
+		require.True(t, sampleTime.Add(2*time.Hour).Equal(estimate.Time))
+		require.True(t, estimate.Time.Equal(launchTime(launchID)))
+		require.Empty(t, warnings(bus))
+	})
+
+	t.Run("error band crossing the minimum launch time", func(t *testing.T) {
+		network, launchID, _, bus := newHeightSimulator(t)
+
+		// the block is estimated 90 seconds after the minimum launch time with an error band of about 3 minutes
+		_, err := network.TriggerLaunchAtHeight(context.Background(), launchID, 721)
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"The SPN block 721 may be produced at 1970-01-01T01:15:46Z, before the minimum launch time 1970-01-01T01:17:10Z",
+		}, warnings(bus))
+	})
+
+	t.Run("height before the launch time range", func(t *testing.T) {
+		network, launchID, _, _ := newHeightSimulator(t)
+
+		_, err := network.TriggerLaunchAtHeight(context.Background(), launchID, 201)
+		require.ErrorAs(t, err, &ErrInvalidLaunchTime{})
+	})
+
+	t.Run("past height", func(t *testing.T) {
+		network, launchID, _, _ := newHeightSimulator(t)
+
+		_, err := network.TriggerLaunchAtHeight(context.Background(), launchID, 100)
+		require.EqualError(t, err, "the height 100 is not after the latest SPN height 101")
+	})
+}
+
+func TestTriggerLaunchRecheck(t *testing.T) {
+	launchTime := sampleTime.Add(2 * time.Hour)
+
+	t.Run("rechecked launch time", func(t *testing.T) {
+		sim, network, launchID := newLaunchSimulator(t)
+		recheckedTime := launchTime.Add(time.Minute)
+
+		err := network.TriggerLaunch(context.Background(), launchID, launchTime, func(o *triggerLaunchOptions) {
+			o.recheckLaunchTime = func(context.Context) (time.Time, error) {
+				return recheckedTime, nil
+			}
+		})
+		require.NoError(t, err)
+		chain, _ := sim.Chain(launchID)
+		require.True(t, recheckedTime.Equal(chain.LaunchTime))
+	})
+
+	t.Run("rechecked launch time out of range", func(t *testing.T) {
+		sim, network, launchID := newLaunchSimulator(t)
+
+		err := network.TriggerLaunch(context.Background(), launchID, launchTime, func(o *triggerLaunchOptions) {
+			o.recheckLaunchTime = func(context.Context) (time.Time, error) {
+				return sampleTime.Add(time.Minute), nil
+			}
+		})
+		require.ErrorAs(t, err, &ErrInvalidLaunchTime{})
+		chain, _ := sim.Chain(launchID)
+		require.False(t, chain.LaunchTriggered)
+	})
+}
@@ -0,0 +1,73 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmoserror"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+// LaunchNoticeOption configures a launch notice.
+type LaunchNoticeOption func(*networktypes.LaunchNotice)
+
+// BlockingNotice asks the validators to hold off starting their node until the notice is cleared.
+func BlockingNotice() LaunchNoticeOption {
+	return func(n *networktypes.LaunchNotice) {
+		n.Blocking = true
+	}
+}
+
+// SetLaunchNotice publishes a notice to the validators of the chain as a coordinator, an empty notice clears it.
+// The notice is stored in the chain metadata, the other metadata keys are preserved.
+func (n Network) SetLaunchNotice(ctx context.Context, launchID uint64, notice string, options ...LaunchNoticeOption) error {
+	addr, err := n.accountAddress()
+	if err != nil {
+		return err
+	}
+
+	res, err := n.launchQuery.Chain(ctx, &launchtypes.QueryGetChainRequest{
+		LaunchID: launchID,
+	})
+	if cosmoserror.Unwrap(err) == cosmoserror.ErrNotFound {
+		return ErrObjectNotFound
+	} else if err != nil {
+		return err
+	}
+
+	var launchNotice *networktypes.LaunchNotice
+	if notice != "" {
+		launchNotice = &networktypes.LaunchNotice{Message: notice}
+		for _, apply := range options {
+			apply(launchNotice)
+		}
+	}
+
+	metadata, err := networktypes.SetLaunchNotice(res.Chain.Metadata, launchNotice)
+	if err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, "Updating the launch notice"))
+
+	msg := launchtypes.NewMsgEditChain(addr, launchID, false, 0, metadata)
+	if _, err := n.broadcastTx(ctx, msg); err != nil {
+		return err
+	}
+
+	switch {
+	case launchNotice == nil:
+		n.ev.Send(events.New(events.StatusDone, fmt.Sprintf("Launch notice of the chain %d cleared", launchID)))
+	case launchNotice.Blocking:
+		n.ev.Send(events.New(events.StatusDone, fmt.Sprintf(
+			"Blocking launch notice published, the validators of the chain %d hold off starting their node",
+			launchID,
+		)))
+	default:
+		n.ev.Send(events.New(events.StatusDone, fmt.Sprintf("Launch notice published for the chain %d", launchID)))
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmoserror"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+func TestSetLaunchNotice(t *testing.T) {
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	var (
+		metadata  = []byte(`{"launch_notice":{"message":"old"},"x":1}`)
+		mockChain = func(suite testutil.Suite) {
+			suite.LaunchQueryMock.
+				On("Chain", context.Background(), &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}).
+				Return(&launchtypes.QueryGetChainResponse{Chain: launchtypes.Chain{
+					LaunchID: testutil.LaunchID,
+					Metadata: metadata,
+				}}, nil).
+				Once()
+		}
+		mockEdit = func(suite testutil.Suite, metadata string) {
+			suite.CosmosClientMock.
+				On(
+					"BroadcastTx",
+					context.Background(),
+					account,
+					launchtypes.NewMsgEditChain(addr, testutil.LaunchID, false, 0, []byte(metadata)),
+				).
+				Return(testutil.NewResponse(&launchtypes.MsgEditChainResponse{}), nil).
+				Once()
+		}
+	)
+
+	t.Run("publish a blocking notice", func(t *testing.T) {
+		suite, network := newSuite(account)
+		mockChain(suite)
+		mockEdit(suite, `{"launch_notice":{"message":"hold off","blocking":true},"x":1}`)
+
+		err := network.SetLaunchNotice(context.Background(), testutil.LaunchID, "hold off", BlockingNotice())
+		require.NoError(t, err)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("clear the notice", func(t *testing.T) {
+		suite, network := newSuite(account)
+		mockChain(suite)
+		mockEdit(suite, `{"x":1}`)
+
+		err := network.SetLaunchNotice(context.Background(), testutil.LaunchID, "")
+		require.NoError(t, err)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to publish a notice, chain not found", func(t *testing.T) {
+		suite, network := newSuite(account)
+		suite.LaunchQueryMock.
+			On("Chain", context.Background(), &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}).
+			Return(nil, cosmoserror.ErrNotFound).
+			Once()
+
+		err := network.SetLaunchNotice(context.Background(), testutil.LaunchID, "hold off")
+		require.Equal(t, ErrObjectNotFound, err)
+		suite.AssertAllMocks(t)
+	})
+}
@@ -0,0 +1,414 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/chain"
+	"github.com/ignite/cli/ignite/services/network/networkchain"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/version"
+)
+
+// LaunchTemplateVersion is the version of the launch template format.
+// It must be incremented when fields are added to the template, the former versions of Ignite CLI
+// can't apply a template with fields they don't know.
+const LaunchTemplateVersion = 1
+
+type (
+	// LaunchTemplate contains the configuration of the publish and join flows of a chain,
+	// the same testnet can be launched again by applying the template.
+	LaunchTemplate struct {
+		// Version is the version of the template format.
+		Version int `yaml:"version"`
+
+		// CLIVersion is the version of Ignite CLI that saved the template.
+		CLIVersion string `yaml:"cli_version,omitempty"`
+
+		// Fees are the fees of SPN when the template was saved, their changes are reported when the template is applied.
+		Fees LaunchTemplateFees `yaml:"fees,omitempty"`
+
+		Publish PublishTemplate `yaml:"publish"`
+		Join    *JoinTemplate   `yaml:"join,omitempty"`
+	}
+
+	// LaunchTemplateFees contains the fees of SPN.
+	LaunchTemplateFees struct {
+		ChainCreation string `yaml:"chain_creation,omitempty"`
+		Request       string `yaml:"request,omitempty"`
+	}
+
+	// PublishTemplate contains the configuration of the chain publication, the coins and the shares
+	// are written like the flags of the publish command.
+	PublishTemplate struct {
+		ChainID          string                        `yaml:"chain_id,omitempty"`
+		GenesisURL       string                        `yaml:"genesis_url,omitempty"`
+		CampaignID       uint64                        `yaml:"campaign_id,omitempty"`
+		CampaignMetadata string                        `yaml:"campaign_metadata,omitempty"`
+		TotalSupply      string                        `yaml:"total_supply,omitempty"`
+		Shares           string                        `yaml:"shares,omitempty"`
+		AccountBalance   string                        `yaml:"account_balance,omitempty"`
+		Mainnet          bool                          `yaml:"mainnet,omitempty"`
+		NoCheck          bool                          `yaml:"no_check,omitempty"`
+		BinaryName       string                        `yaml:"binary_name,omitempty"`
+		DefaultHome      string                        `yaml:"default_home,omitempty"`
+		ValidatorKeyType networktypes.ValidatorKeyType `yaml:"validator_key_type,omitempty"`
+		FaucetURL        string                        `yaml:"faucet_url,omitempty"`
+		ExplorerURL      string                        `yaml:"explorer_url,omitempty"`
+
+		// Accounts are the genesis accounts requested by the coordinator once the chain is published.
+		Accounts []TemplateAccount `yaml:"accounts,omitempty"`
+
+		// Reward is the reward of the validators, the chain has no reward when nil.
+		Reward *TemplateReward `yaml:"reward,omitempty"`
+	}
+
+	// TemplateAccount is a genesis account of a template, the account of the coordinator when the address is empty.
+	TemplateAccount struct {
+		Address string `yaml:"address,omitempty"`
+		Coins   string `yaml:"coins"`
+	}
+
+	// TemplateReward is the reward of the validators of a template.
+	TemplateReward struct {
+		Coins  string `yaml:"coins"`
+		Height int64  `yaml:"height"`
+	}
+
+	// JoinTemplate contains the configuration of the validators joining the chain.
+	JoinTemplate struct {
+		AccountAmount string            `yaml:"account_amount,omitempty"`
+		PublicAddress string            `yaml:"public_address,omitempty"`
+		Validator     ValidatorTemplate `yaml:"validator"`
+	}
+
+	// ValidatorTemplate contains the gentx configuration of a validator.
+	ValidatorTemplate struct {
+		Moniker                 string `yaml:"moniker,omitempty"`
+		StakingAmount           string `yaml:"staking_amount"`
+		CommissionRate          string `yaml:"commission_rate,omitempty"`
+		CommissionMaxRate       string `yaml:"commission_max_rate,omitempty"`
+		CommissionMaxChangeRate string `yaml:"commission_max_change_rate,omitempty"`
+		MinSelfDelegation       string `yaml:"min_self_delegation,omitempty"`
+		GasPrices               string `yaml:"gas_prices,omitempty"`
+		Details                 string `yaml:"details,omitempty"`
+		Identity                string `yaml:"identity,omitempty"`
+		Website                 string `yaml:"website,omitempty"`
+		SecurityContact         string `yaml:"security_contact,omitempty"`
+	}
+
+	// ErrNewerTemplate is returned when a template saved by a newer version of Ignite CLI has unknown fields.
+	ErrNewerTemplate struct {
+		Version    int
+		CLIVersion string
+	}
+)
+
+// Error implements error.
+func (e ErrNewerTemplate) Error() string {
+	return fmt.Sprintf(
+		"the launch template version %d is not supported by this version of Ignite CLI, Ignite CLI %s or newer is required",
+		e.Version,
+		e.CLIVersion,
+	)
+}
+
+// ChainOptions returns the options to initialize the chain checked before the publication.
+func (t PublishTemplate) ChainOptions() []networkchain.Option {
+	var options []networkchain.Option
+	if t.GenesisURL != "" {
+		options = append(options, networkchain.WithGenesisFromURL(t.GenesisURL))
+	}
+	if t.BinaryName != "" || t.DefaultHome != "" {
+		options = append(options, networkchain.WithBinaryName(t.BinaryName), networkchain.WithDefaultHome(t.DefaultHome))
+	}
+	return options
+}
+
+// PublishOptions returns the options to publish the chain.
+func (t PublishTemplate) PublishOptions() ([]PublishOption, error) {
+	options := []PublishOption{WithMetadata(t.CampaignMetadata)}
+
+	if t.GenesisURL != "" {
+		options = append(options, WithCustomGenesis(t.GenesisURL))
+	}
+	if t.ChainID != "" {
+		if err := networktypes.ValidateChainID(t.ChainID); err != nil {
+			return nil, err
+		}
+		options = append(options, WithChainID(t.ChainID))
+	}
+	if t.CampaignID != 0 {
+		options = append(options, WithCampaign(t.CampaignID))
+	}
+	if t.NoCheck {
+		options = append(options, WithNoCheck())
+	}
+	if t.Mainnet {
+		options = append(options, Mainnet())
+	}
+
+	totalSupply, err := sdk.ParseCoinsNormalized(t.TotalSupply)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid total supply")
+	}
+	if !totalSupply.Empty() {
+		options = append(options, WithTotalSupply(totalSupply))
+	}
+
+	accountBalance, err := sdk.ParseCoinsNormalized(t.AccountBalance)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid account balance")
+	}
+	if !accountBalance.IsZero() {
+		options = append(options, WithAccountBalance(accountBalance))
+	}
+
+	if t.Shares != "" {
+		shares, err := ParseSharePercents(t.Shares)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, WithPercentageShares(shares))
+	}
+
+	if t.ValidatorKeyType != "" {
+		if _, err := networktypes.ParseValidatorKeyType(string(t.ValidatorKeyType)); err != nil {
+			return nil, err
+		}
+	}
+	options = append(options, WithChainMetadata(networktypes.ChainMetadata{
+		BinaryName:       t.BinaryName,
+		DefaultHome:      t.DefaultHome,
+		ValidatorKeyType: t.ValidatorKeyType,
+		FaucetURL:        t.FaucetURL,
+		ExplorerURL:      t.ExplorerURL,
+	}))
+
+	return options, nil
+}
+
+// ChainValidator returns the validator of the join template.
+func (t ValidatorTemplate) ChainValidator() chain.Validator {
+	return chain.Validator{
+		Moniker:                 t.Moniker,
+		StakingAmount:           t.StakingAmount,
+		CommissionRate:          t.CommissionRate,
+		CommissionMaxRate:       t.CommissionMaxRate,
+		CommissionMaxChangeRate: t.CommissionMaxChangeRate,
+		MinSelfDelegation:       t.MinSelfDelegation,
+		GasPrices:               t.GasPrices,
+		Details:                 t.Details,
+		Identity:                t.Identity,
+		Website:                 t.Website,
+		SecurityContact:         t.SecurityContact,
+	}
+}
+
+// JoinOptions returns the options to join the chain.
+func (t JoinTemplate) JoinOptions(validatorKeyType networktypes.ValidatorKeyType) ([]JoinOption, error) {
+	var options []JoinOption
+
+	accountAmount, err := sdk.ParseCoinsNormalized(t.AccountAmount)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid account amount")
+	}
+	if !accountAmount.IsZero() {
+		options = append(options, WithAccountRequest(accountAmount))
+	}
+	if t.PublicAddress != "" {
+		options = append(options, WithPublicAddress(t.PublicAddress))
+	}
+	if validatorKeyType != "" {
+		options = append(options, WithValidatorKeyType(validatorKeyType))
+	}
+	return options, nil
+}
+
+// LoadTemplate reads the launch template at path.
+// A template with unknown fields saved by a newer version of Ignite CLI returns an ErrNewerTemplate error.
+func LoadTemplate(path string) (LaunchTemplate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return LaunchTemplate{}, err
+	}
+
+	// the version is read first, the fields of a newer template are unknown to this version
+	var header struct {
+		Version    int    `yaml:"version"`
+		CLIVersion string `yaml:"cli_version"`
+	}
+	if err := yaml.Unmarshal(data, &header); err != nil {
+		return LaunchTemplate{}, errors.Wrap(err, "invalid launch template")
+	}
+	if header.Version < 1 {
+		return LaunchTemplate{}, fmt.Errorf("invalid launch template version %d", header.Version)
+	}
+
+	var t LaunchTemplate
+	if err := yaml.UnmarshalWithOptions(data, &t, yaml.Strict()); err != nil {
+		if header.Version > LaunchTemplateVersion {
+			return LaunchTemplate{}, ErrNewerTemplate{
+				Version:    header.Version,
+				CLIVersion: header.CLIVersion,
+			}
+		}
+		return LaunchTemplate{}, errors.Wrap(err, "invalid launch template")
+	}
+	return t, nil
+}
+
+// SaveTemplate writes the launch template at path with the current fees of SPN.
+func (n Network) SaveTemplate(ctx context.Context, path string, t LaunchTemplate) error {
+	params, err := n.WithoutQueryCache().LaunchParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	t.Version = LaunchTemplateVersion
+	t.CLIVersion = version.Version
+	t.Fees = LaunchTemplateFees{
+		ChainCreation: params.ChainCreationFee.String(),
+		Request:       params.RequestFee.String(),
+	}
+
+	data, err := yaml.Marshal(t)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.NewDone(fmt.Sprintf("Launch template saved to %s", path), ""))
+	return nil
+}
+
+// ApplyTemplate publishes the chain with the publish configuration of the launch template at path,
+// the genesis accounts and the reward of the template are then set.
+// The chain must be initialized with the ChainOptions of the template unless the template skips the checks.
+// When the accounts or the reward fail to be set, the IDs of the published chain are returned with the error.
+func (n Network) ApplyTemplate(ctx context.Context, path string, c Chain) (launchID, campaignID uint64, err error) {
+	t, err := LoadTemplate(path)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// the options are built before any transaction, an invalid template publishes nothing
+	options, err := t.Publish.PublishOptions()
+	if err != nil {
+		return 0, 0, err
+	}
+	accounts := make([]sdk.Coins, len(t.Publish.Accounts))
+	for i, account := range t.Publish.Accounts {
+		if accounts[i], err = sdk.ParseCoinsNormalized(account.Coins); err != nil {
+			return 0, 0, errors.Wrapf(err, "invalid coins for the template account %d", i)
+		}
+	}
+	var rewardCoins sdk.Coins
+	if t.Publish.Reward != nil {
+		if rewardCoins, err = sdk.ParseCoinsNormalized(t.Publish.Reward.Coins); err != nil {
+			return 0, 0, errors.Wrap(err, "invalid reward coins")
+		}
+		if rewardCoins.IsZero() || t.Publish.Reward.Height <= 0 {
+			return 0, 0, errors.New("the reward must have coins and a positive height")
+		}
+	}
+
+	if err := n.checkTemplateFees(ctx, t.Fees); err != nil {
+		return 0, 0, err
+	}
+
+	launchID, campaignID, err = n.Publish(ctx, c, options...)
+	if err != nil {
+		return launchID, campaignID, err
+	}
+
+	if len(t.Publish.Accounts) > 0 {
+		if err := n.checkRequestQuota(ctx, launchID, len(t.Publish.Accounts)); err != nil {
+			return launchID, campaignID, err
+		}
+	}
+	for i, account := range t.Publish.Accounts {
+		address := account.Address
+		if address == "" {
+			if address, err = n.accountAddress(); err != nil {
+				return launchID, campaignID, err
+			}
+		}
+		if err := n.sendAccountRequest(ctx, launchID, address, accounts[i]); err != nil {
+			return launchID, campaignID, err
+		}
+	}
+
+	if t.Publish.Reward != nil {
+		if err := n.SetReward(ctx, launchID, t.Publish.Reward.Height, rewardCoins); err != nil {
+			return launchID, campaignID, err
+		}
+	}
+
+	return launchID, campaignID, nil
+}
+
+// ApplyJoinTemplate joins the chain with the validator of the join configuration of the launch template at path.
+func (n Network) ApplyJoinTemplate(ctx context.Context, path string, c GentxChain, launchID uint64) error {
+	t, err := LoadTemplate(path)
+	if err != nil {
+		return err
+	}
+	if t.Join == nil {
+		return fmt.Errorf("the launch template %s has no join configuration", path)
+	}
+
+	// the key type published with the chain is preferred, the chain may have been published without the template
+	validatorKeyType := t.Publish.ValidatorKeyType
+	launch, err := n.ChainLaunch(ctx, launchID)
+	if err != nil {
+		return err
+	}
+	if launch.ValidatorKeyType != "" {
+		validatorKeyType = launch.ValidatorKeyType
+	}
+
+	options, err := t.Join.JoinOptions(validatorKeyType)
+	if err != nil {
+		return err
+	}
+
+	if err := n.checkTemplateFees(ctx, t.Fees); err != nil {
+		return err
+	}
+
+	return n.JoinWithValidator(ctx, c, launchID, t.Join.Validator.ChainValidator(), options...)
+}
+
+// checkTemplateFees reports the fees of SPN that changed since the template was saved,
+// the fees of a template written by hand are not checked.
+func (n Network) checkTemplateFees(ctx context.Context, fees LaunchTemplateFees) error {
+	params, err := n.LaunchParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, fee := range []struct {
+		name           string
+		saved, current string
+	}{
+		{"chain creation fee", fees.ChainCreation, params.ChainCreationFee.String()},
+		{"request fee", fees.Request, params.RequestFee.String()},
+	} {
+		if fee.saved == "" || fee.saved == fee.current {
+			continue
+		}
+		n.ev.Send(events.NewWarning(
+			fmt.Sprintf("the %s of SPN changed since the template was saved: %s, was %s", fee.name, fee.current, fee.saved),
+		))
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package network
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+	"github.com/ignite/cli/ignite/version"
+)
+
+func TestSaveTemplate(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+		path           = filepath.Join(t.TempDir(), "template.yml")
+		template       = LaunchTemplate{
+			Publish: PublishTemplate{
+				ChainID:          "earth-1",
+				TotalSupply:      "1000000stake",
+				Shares:           "10%foo,20%staking",
+				AccountBalance:   "1000stake",
+				ValidatorKeyType: networktypes.ValidatorKeySecp256k1,
+				Accounts: []TemplateAccount{
+					{Coins: "1000stake"},
+					{Address: testutil.TestAccountName, Coins: "500stake"},
+				},
+				Reward: &TemplateReward{Coins: "100foo", Height: 50},
+			},
+			Join: &JoinTemplate{
+				AccountAmount: "1000stake",
+				Validator: ValidatorTemplate{
+					StakingAmount:  "100000stake",
+					CommissionRate: "0.1",
+				},
+			},
+		}
+	)
+
+	suite.LaunchQueryMock.
+		On("Params", context.Background(), &launchtypes.QueryParamsRequest{}).
+		Return(&launchtypes.QueryParamsResponse{
+			Params: launchtypes.Params{
+				ChainCreationFee: sdk.NewCoins(sdk.NewInt64Coin("uspn", 100)),
+				RequestFee:       sdk.NewCoins(sdk.NewInt64Coin("uspn", 10)),
+			},
+		}, nil).
+		Once()
+
+	require.NoError(t, network.SaveTemplate(context.Background(), path, template))
+	suite.AssertAllMocks(t)
+
+	got, err := LoadTemplate(path)
+	require.NoError(t, err)
+
+	template.Version = LaunchTemplateVersion
+	template.CLIVersion = version.Version
+	template.Fees = LaunchTemplateFees{ChainCreation: "100uspn", Request: "10uspn"}
+	require.Equal(t, template, got)
+
+	options, err := got.Publish.PublishOptions()
+	require.NoError(t, err)
+	var o publishOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+	require.Equal(t, "earth-1", o.chainID)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000000)), o.totalSupply)
+	require.Len(t, o.sharePercentages, 2)
+	require.Equal(t, networktypes.ValidatorKeySecp256k1, o.chainMetadata.ValidatorKeyType)
+}
+
+func TestLoadTemplate(t *testing.T) {
+	writeTemplate := func(t *testing.T, content string) string {
+		path := filepath.Join(t.TempDir(), "template.yml")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+		return path
+	}
+
+	t.Run("newer template with unknown fields", func(t *testing.T) {
+		path := writeTemplate(t, `version: 2
+cli_version: v0.26.0
+publish:
+  chain_id: earth-1
+  genesis_patches:
+  - app_state.staking.params.unbonding_time: 60s
+`)
+		_, err := LoadTemplate(path)
+		require.Equal(t, ErrNewerTemplate{Version: 2, CLIVersion: "v0.26.0"}, err)
+		require.EqualError(
+			t,
+			err,
+			"the launch template version 2 is not supported by this version of Ignite CLI, Ignite CLI v0.26.0 or newer is required",
+		)
+	})
+
+	t.Run("newer template with known fields", func(t *testing.T) {
+		path := writeTemplate(t, "version: 2\ncli_version: v0.26.0\npublish:\n  chain_id: earth-1\n")
+		got, err := LoadTemplate(path)
+		require.NoError(t, err)
+		require.Equal(t, "earth-1", got.Publish.ChainID)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		path := writeTemplate(t, "version: 1\npublish:\n  chain: earth-1\n")
+		_, err := LoadTemplate(path)
+		require.ErrorContains(t, err, "invalid launch template")
+	})
+
+	t.Run("template without version", func(t *testing.T) {
+		path := writeTemplate(t, "publish:\n  chain_id: earth-1\n")
+		_, err := LoadTemplate(path)
+		require.EqualError(t, err, "invalid launch template version 0")
+	})
+}
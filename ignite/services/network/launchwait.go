@@ -0,0 +1,176 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmoserror"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+const (
+	// minLaunchPollInterval is the interval between two polls of the chain launch close to the launch time.
+	minLaunchPollInterval = 2 * time.Second
+
+	// maxLaunchPollInterval is the interval between two polls of the chain launch while the launch
+	// is not triggered or far from the launch time.
+	maxLaunchPollInterval = time.Minute
+
+	// maxLaunchPollBackoff is the maximum interval between two polls of the chain launch after query errors.
+	maxLaunchPollBackoff = 5 * time.Minute
+
+	// launchPollDivisor divides the time remaining before the launch to get the poll interval,
+	// the interval shrinks as the launch time approaches.
+	launchPollDivisor = 10
+
+	// launchPollJitter is the maximum fraction of the poll interval randomly added or removed,
+	// the validators waiting for the same launch don't poll SPN at the same time.
+	launchPollJitter = 0.2
+)
+
+// launchPollInterval returns the interval before the next poll of the chain launch, without jitter.
+// The interval is a tenth of the time remaining before the launch, between the minimum and maximum intervals,
+// and the maximum interval while the launch is not triggered or once the launch time is reached.
+// The interval is doubled for each successive query error, up to the maximum backoff.
+func launchPollInterval(triggered bool, remaining time.Duration, failures int) time.Duration {
+	interval := maxLaunchPollInterval
+	if triggered && remaining > 0 {
+		interval = remaining / launchPollDivisor
+		switch {
+		case interval < minLaunchPollInterval:
+			interval = minLaunchPollInterval
+		case interval > maxLaunchPollInterval:
+			interval = maxLaunchPollInterval
+		}
+	}
+
+	for i := 0; i < failures && interval < maxLaunchPollBackoff; i++ {
+		interval *= 2
+	}
+	if failures > 0 && interval > maxLaunchPollBackoff {
+		interval = maxLaunchPollBackoff
+	}
+	return interval
+}
+
+// newRandom returns a goroutine safe generator of random numbers in [0, 1) seeded with the current time,
+// the global source is not seeded and would give the same jitter to all the validators.
+func newRandom() func() float64 {
+	var (
+		mu sync.Mutex
+		r  = rand.New(rand.NewSource(time.Now().UnixNano()))
+	)
+	return func() float64 {
+		mu.Lock()
+		defer mu.Unlock()
+		return r.Float64()
+	}
+}
+
+// jitterInterval adds the jitter to the interval from a random number in [0, 1), 0.5 adds no jitter.
+func jitterInterval(interval time.Duration, random float64) time.Duration {
+	return interval + time.Duration(float64(interval)*launchPollJitter*(2*random-1))
+}
+
+// WatchLaunch polls the launch of the chain and calls the handler with the fetched launch until the handler
+// returns true. The launch is polled slowly while it's far and more frequently as the launch time approaches,
+// the polls are slowed down after query errors. The launch transitions are delivered to the launch webhook
+// before calling the handler.
+func (n Network) WatchLaunch(ctx context.Context, launchID uint64, handler func(networktypes.ChainLaunch) bool) error {
+	var (
+		launch   networktypes.ChainLaunch
+		failures int
+		notifier = n.newLaunchNotifier()
+	)
+	for {
+		res, err := n.launchQuery.Chain(ctx, &launchtypes.QueryGetChainRequest{
+			LaunchID: launchID,
+		})
+		switch {
+		case cosmoserror.Unwrap(err) == cosmoserror.ErrNotFound:
+			return ErrObjectNotFound
+		case err != nil:
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			failures++
+			n.ev.Send(events.NewDebug(fmt.Sprintf("Cannot fetch the launch of the chain %d: %s", launchID, err)))
+		default:
+			failures = 0
+			launch = networktypes.ToChainLaunch(res.Chain)
+			notifier.observe(ctx, launch)
+			if handler(launch) {
+				return nil
+			}
+		}
+
+		remaining := launch.LaunchTime.Sub(n.now(ctx))
+		interval := jitterInterval(launchPollInterval(launch.LaunchTriggered, remaining, failures), n.random())
+
+		// the launch is polled again at the launch time
+		if launch.LaunchTriggered && remaining > 0 && interval > remaining {
+			interval = remaining
+		}
+		n.ev.Send(events.NewDebug(fmt.Sprintf(
+			"Polling the launch of the chain %d again in %s",
+			launchID,
+			interval.Round(time.Millisecond),
+		)))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-n.clock.After(interval):
+		}
+	}
+}
+
+// WaitLaunch waits for the launch of the chain to be triggered by the coordinator and its launch time reached,
+// it returns the launched chain. A launch reverted before the launch time is waited again.
+// The launch time is compared with the local time corrected with the clock skew with the SPN node.
+// The notices of the coordinator are shown and a blocking notice holds the launch until it's cleared.
+func (n Network) WaitLaunch(ctx context.Context, launchID uint64) (networktypes.ChainLaunch, error) {
+	var (
+		launched networktypes.ChainLaunch
+		notice   string
+	)
+	err := n.WatchLaunch(ctx, launchID, func(launch networktypes.ChainLaunch) bool {
+		launched = launch
+		if launch.LaunchNotice.IsActive() && launch.LaunchNotice.Message != notice {
+			n.ev.Send(events.NewNeutral(fmt.Sprintf("Notice of the coordinator: %s", launch.LaunchNotice.Message)))
+		}
+		notice = ""
+		if launch.LaunchNotice.IsActive() {
+			notice = launch.LaunchNotice.Message
+		}
+		if !launch.LaunchTriggered {
+			return false
+		}
+
+		now := n.now(ctx)
+		if !now.Before(launch.LaunchTime) {
+			if launch.LaunchNotice.IsBlocking() {
+				n.ev.Send(events.NewOngoing(fmt.Sprintf(
+					"Chain %d is launched but the coordinator asks to hold off starting the node",
+					launchID,
+				)))
+				return false
+			}
+			return true
+		}
+
+		n.ev.Send(events.NewOngoing(fmt.Sprintf(
+			"Chain %d will be launched on %s",
+			launchID,
+			networktypes.NewLaunchTimeAnnouncement(launch.LaunchTime, now, n.location),
+		)))
+		return false
+	})
+	return launched, err
+}
@@ -0,0 +1,331 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmoserror"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/xtime"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+func TestLaunchPollInterval(t *testing.T) {
+	tests := []struct {
+		name      string
+		triggered bool
+		remaining time.Duration
+		failures  int
+		want      time.Duration
+	}{
+		{
+			name: "launch not triggered",
+			want: maxLaunchPollInterval,
+		},
+		{
+			name:      "launch far",
+			triggered: true,
+			remaining: 24 * time.Hour,
+			want:      maxLaunchPollInterval,
+		},
+		{
+			name:      "launch approaching",
+			triggered: true,
+			remaining: 5 * time.Minute,
+			want:      30 * time.Second,
+		},
+		{
+			name:      "launch close",
+			triggered: true,
+			remaining: 10 * time.Second,
+			want:      minLaunchPollInterval,
+		},
+		{
+			name:      "launch time reached",
+			triggered: true,
+			remaining: -time.Second,
+			want:      maxLaunchPollInterval,
+		},
+		{
+			name:      "query error",
+			triggered: true,
+			remaining: 5 * time.Minute,
+			failures:  1,
+			want:      time.Minute,
+		},
+		{
+			name:      "successive query errors",
+			triggered: true,
+			remaining: 5 * time.Minute,
+			failures:  3,
+			want:      4 * time.Minute,
+		},
+		{
+			name:     "query errors up to the maximum backoff",
+			failures: 10,
+			want:     maxLaunchPollBackoff,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, launchPollInterval(tt.triggered, tt.remaining, tt.failures))
+		})
+	}
+}
+
+func TestJitterInterval(t *testing.T) {
+	require.Equal(t, 10*time.Second, jitterInterval(10*time.Second, 0.5))
+	require.Equal(t, 8*time.Second, jitterInterval(10*time.Second, 0))
+	require.Equal(t, 11*time.Second, jitterInterval(10*time.Second, 0.75))
+}
+
+// pollIntervals returns the intervals of the debug events of the polls.
+func pollIntervals(t *testing.T, ev events.Bus) []time.Duration {
+	ev.Shutdown(context.Background())
+	var intervals []time.Duration
+	for e := range ev.Events() {
+		i := strings.Index(e.Description, "again in ")
+		if i < 0 {
+			continue
+		}
+		interval, err := time.ParseDuration(e.Description[i+len("again in "):])
+		require.NoError(t, err)
+		intervals = append(intervals, interval)
+	}
+	return intervals
+}
+
+func TestWaitLaunch(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+		clock          = xtime.NewClockMock(sampleTime)
+		ev             = events.NewBus(events.WithCustomBufferSize(100))
+		launchTime     = sampleTime.Add(10 * time.Minute)
+	)
+	WithCustomClock(clock)(&network)
+	CollectEvents(ev)(&network)
+	advanceClock(t, network)
+	mockSyncedNode(suite, clock)
+	network.random = func() float64 { return 0.5 }
+
+	suite.LaunchQueryMock.
+		On("Chain", context.Background(), &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}).
+		Return(&launchtypes.QueryGetChainResponse{
+			Chain: launchtypes.Chain{
+				LaunchID:        testutil.LaunchID,
+				LaunchTriggered: true,
+				LaunchTime:      launchTime,
+			},
+		}, nil)
+
+	launch, err := network.WaitLaunch(context.Background(), testutil.LaunchID)
+	require.NoError(t, err)
+	require.True(t, launch.LaunchTriggered)
+	require.False(t, clock.Now().Before(launch.LaunchTime))
+
+	// the intervals shrink as the launch time approaches, down to the minimum interval
+	intervals := pollIntervals(t, ev)
+	require.Equal(t, maxLaunchPollInterval, intervals[0])
+	for i := 1; i < len(intervals); i++ {
+		require.LessOrEqual(t, intervals[i], intervals[i-1])
+	}
+	require.Contains(t, intervals, minLaunchPollInterval)
+	require.LessOrEqual(t, intervals[len(intervals)-1], minLaunchPollInterval)
+}
+
+func TestWaitLaunchCountdown(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+		ev             = events.NewBus(events.WithCustomBufferSize(100))
+	)
+	WithLocation(time.FixedZone("EDT", -4*60*60))(&network)
+	CollectEvents(ev)(&network)
+	advanceClock(t, network)
+	mockSyncedNode(suite, network.clock)
+	network.random = func() float64 { return 0.5 }
+
+	suite.LaunchQueryMock.
+		On("Chain", context.Background(), &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}).
+		Return(&launchtypes.QueryGetChainResponse{
+			Chain: launchtypes.Chain{
+				LaunchID:        testutil.LaunchID,
+				LaunchTriggered: true,
+				LaunchTime:      sampleTime.Add(3 * time.Minute),
+			},
+		}, nil)
+
+	_, err := network.WaitLaunch(context.Background(), testutil.LaunchID)
+	require.NoError(t, err)
+
+	ev.Shutdown(context.Background())
+	var countdown []string
+	for e := range ev.Events() {
+		if strings.HasPrefix(e.Description, "Chain 1 will be launched on") {
+			countdown = append(countdown, e.Description)
+		}
+	}
+	// the countdown is announced at each poll until the launch time
+	require.Equal(
+		t,
+		"Chain 1 will be launched on 1970-01-01 00:19:40 UTC (1969-12-31 20:19:40 EDT, in 3m, unix 1180)",
+		countdown[0],
+	)
+	require.Equal(
+		t,
+		"Chain 1 will be launched on 1970-01-01 00:19:40 UTC (1969-12-31 20:19:40 EDT, in 2s, unix 1180)",
+		countdown[len(countdown)-1],
+	)
+}
+
+func TestWaitLaunchClockSkew(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+		clock          = xtime.NewClockMock(sampleTime)
+	)
+	WithCustomClock(clock)(&network)
+	AdjustClockSkew()(&network)
+
+	// the local clock is one hour behind the SPN node, the launch time is already reached
+	mockBlockTime(suite, sampleTime.Add(time.Hour))
+	suite.LaunchQueryMock.
+		On("Chain", context.Background(), &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}).
+		Return(&launchtypes.QueryGetChainResponse{
+			Chain: launchtypes.Chain{
+				LaunchID:        testutil.LaunchID,
+				LaunchTriggered: true,
+				LaunchTime:      sampleTime.Add(10 * time.Minute),
+			},
+		}, nil).
+		Once()
+
+	launch, err := network.WaitLaunch(context.Background(), testutil.LaunchID)
+	require.NoError(t, err)
+	require.True(t, launch.LaunchTriggered)
+	require.Equal(t, sampleTime, clock.Now())
+	suite.AssertAllMocks(t)
+}
+
+func TestWaitLaunchNotice(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+		ev             = events.NewBus(events.WithCustomBufferSize(100))
+		chainRequest   = &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}
+	)
+	CollectEvents(ev)(&network)
+	advanceClock(t, network)
+	mockSyncedNode(suite, network.clock)
+	network.random = func() float64 { return 0.5 }
+
+	chain := func(notice *networktypes.LaunchNotice) *launchtypes.QueryGetChainResponse {
+		metadata, err := networktypes.SetLaunchNotice(nil, notice)
+		require.NoError(t, err)
+		return &launchtypes.QueryGetChainResponse{Chain: launchtypes.Chain{
+			LaunchID:        testutil.LaunchID,
+			LaunchTriggered: true,
+			LaunchTime:      sampleTime,
+			Metadata:        metadata,
+		}}
+	}
+
+	// the blocking notice holds the launch until it's cleared
+	suite.LaunchQueryMock.
+		On("Chain", context.Background(), chainRequest).
+		Return(chain(&networktypes.LaunchNotice{Message: "upgrade the binary", Blocking: true}), nil).
+		Twice()
+	suite.LaunchQueryMock.
+		On("Chain", context.Background(), chainRequest).
+		Return(chain(&networktypes.LaunchNotice{Message: "the binary is upgraded"}), nil).
+		Once()
+
+	launch, err := network.WaitLaunch(context.Background(), testutil.LaunchID)
+	require.NoError(t, err)
+	require.False(t, launch.LaunchNotice.IsBlocking())
+	suite.AssertAllMocks(t)
+
+	ev.Shutdown(context.Background())
+	var notices, holds int
+	for e := range ev.Events() {
+		switch {
+		case strings.HasPrefix(e.Description, "Notice of the coordinator:"):
+			notices++
+		case strings.HasSuffix(e.Description, "the coordinator asks to hold off starting the node"):
+			holds++
+		}
+	}
+	// each notice is shown once
+	require.Equal(t, 2, notices)
+	require.Equal(t, 2, holds)
+}
+
+func TestWaitLaunchBackoff(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+		ev             = events.NewBus(events.WithCustomBufferSize(100))
+	)
+	CollectEvents(ev)(&network)
+	advanceClock(t, network)
+	mockSyncedNode(suite, network.clock)
+	network.random = func() float64 { return 0.5 }
+
+	chainRequest := &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}
+	suite.LaunchQueryMock.
+		On("Chain", context.Background(), chainRequest).
+		Return(&launchtypes.QueryGetChainResponse{Chain: launchtypes.Chain{LaunchID: testutil.LaunchID}}, nil).
+		Once()
+	suite.LaunchQueryMock.
+		On("Chain", context.Background(), chainRequest).
+		Return(nil, errors.New("unavailable")).
+		Times(3)
+	suite.LaunchQueryMock.
+		On("Chain", context.Background(), chainRequest).
+		Return(&launchtypes.QueryGetChainResponse{
+			Chain: launchtypes.Chain{
+				LaunchID:        testutil.LaunchID,
+				LaunchTriggered: true,
+				LaunchTime:      sampleTime,
+			},
+		}, nil).
+		Once()
+
+	_, err := network.WaitLaunch(context.Background(), testutil.LaunchID)
+	require.NoError(t, err)
+	suite.AssertAllMocks(t)
+
+	// the polls are slowed down after each error
+	require.Equal(t, []time.Duration{
+		maxLaunchPollInterval,
+		2 * maxLaunchPollInterval,
+		4 * maxLaunchPollInterval,
+		maxLaunchPollBackoff,
+	}, pollIntervals(t, ev))
+}
+
+func TestWatchLaunchNotFound(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+	)
+
+	suite.LaunchQueryMock.
+		On("Chain", context.Background(), &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}).
+		Return(nil, cosmoserror.ErrNotFound).
+		Once()
+
+	err := network.WatchLaunch(context.Background(), testutil.LaunchID, func(networktypes.ChainLaunch) bool {
+		return true
+	})
+	require.ErrorIs(t, err, ErrObjectNotFound)
+	suite.AssertAllMocks(t)
+}
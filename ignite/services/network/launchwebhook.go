@@ -0,0 +1,222 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+const (
+	// WebhookSignatureHeader is the header of the HMAC-SHA256 signature of the webhook body,
+	// the signature is hex encoded with the sha256= prefix.
+	WebhookSignatureHeader = "X-Ignite-Signature"
+
+	// DefaultWebhookRetries is the number of retries of a webhook delivery after a server error.
+	DefaultWebhookRetries = 3
+
+	webhookSignaturePrefix = "sha256="
+
+	// webhookRetryBackoff is the interval before the first retry of a delivery, doubled for each retry.
+	webhookRetryBackoff = time.Second
+)
+
+// LaunchTransition is a change of the launch state of a chain notified to the webhook.
+type LaunchTransition string
+
+const (
+	// LaunchTransitionTriggered is notified when the launch is triggered, or triggered again with another time.
+	LaunchTransitionTriggered LaunchTransition = "launch_triggered"
+
+	// LaunchTransitionReverted is notified when a triggered launch is reverted.
+	LaunchTransitionReverted LaunchTransition = "launch_reverted"
+
+	// LaunchTransitionTimeReached is notified when the launch time of a triggered launch is reached.
+	LaunchTransitionTimeReached LaunchTransition = "launch_time_reached"
+)
+
+// LaunchWebhook posts the launch transitions of the watched chains to a URL.
+type LaunchWebhook struct {
+	// URL receives the transitions as LaunchWebhookPayload JSON bodies.
+	URL string
+
+	// Secret is the key of the HMAC signature of the bodies, the bodies are not signed when empty.
+	Secret string
+
+	// Client sends the requests, http.DefaultClient when nil.
+	Client *http.Client
+
+	// MaxRetries is the number of retries after a server error, DefaultWebhookRetries when zero.
+	MaxRetries int
+}
+
+// LaunchWebhookPayload is the body of the webhook requests.
+type LaunchWebhookPayload struct {
+	LaunchID   uint64           `json:"launch_id"`
+	ChainID    string           `json:"chain_id"`
+	Transition LaunchTransition `json:"transition"`
+	LaunchTime time.Time        `json:"launch_time"`
+}
+
+// WithLaunchWebhook notifies the webhook of the launch transitions observed while watching a launch.
+// Each transition is delivered at most once per watch, a delivery failing after the retries is reported
+// as a warning and not delivered again.
+func WithLaunchWebhook(webhook LaunchWebhook) Option {
+	return func(n *Network) {
+		n.launchWebhook = &webhook
+	}
+}
+
+// SignWebhookBody returns the value of the signature header of the webhook body.
+func SignWebhookBody(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return webhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature checks the signature header of a webhook body received with the secret,
+// the receivers use it to authenticate the payloads.
+func VerifyWebhookSignature(secret string, body []byte, signature string) bool {
+	if !strings.HasPrefix(signature, webhookSignaturePrefix) {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(SignWebhookBody(secret, body)))
+}
+
+// launchNotifier detects the transitions of a watched launch and delivers them to the webhook.
+type launchNotifier struct {
+	n        Network
+	previous *networktypes.ChainLaunch
+
+	// delivered contains the transitions already delivered, by transition and launch time
+	delivered map[string]bool
+}
+
+func (n Network) newLaunchNotifier() *launchNotifier {
+	return &launchNotifier{
+		n:         n,
+		delivered: make(map[string]bool),
+	}
+}
+
+// observe delivers the transitions between the previous launch and the fetched launch.
+func (l *launchNotifier) observe(ctx context.Context, launch networktypes.ChainLaunch) {
+	if l.n.launchWebhook == nil {
+		return
+	}
+	previous := l.previous
+	l.previous = &launch
+
+	var transitions []LaunchTransition
+	switch {
+	case launch.LaunchTriggered && (previous == nil || !previous.LaunchTriggered):
+		transitions = append(transitions, LaunchTransitionTriggered)
+	case launch.LaunchTriggered && !previous.LaunchTime.Equal(launch.LaunchTime):
+		transitions = append(transitions, LaunchTransitionTriggered)
+	case !launch.LaunchTriggered && previous != nil && previous.LaunchTriggered:
+		transitions = append(transitions, LaunchTransitionReverted)
+	}
+	if launch.LaunchTriggered && !l.n.clock.Now().Before(launch.LaunchTime) {
+		transitions = append(transitions, LaunchTransitionTimeReached)
+	}
+
+	for _, transition := range transitions {
+		launchTime := launch.LaunchTime
+		if transition == LaunchTransitionReverted {
+			launchTime = previous.LaunchTime
+		}
+		key := fmt.Sprintf("%s/%d", transition, launchTime.Unix())
+		if l.delivered[key] {
+			continue
+		}
+		l.delivered[key] = true
+
+		payload := LaunchWebhookPayload{
+			LaunchID:   launch.ID,
+			ChainID:    launch.ChainID,
+			Transition: transition,
+			LaunchTime: launchTime.UTC(),
+		}
+		if err := l.n.deliverWebhook(ctx, payload); err != nil {
+			l.n.ev.Send(events.NewWarning(fmt.Sprintf(
+				"The %s transition of the chain %d can't be delivered to the webhook: %s",
+				transition,
+				launch.ID,
+				err,
+			)))
+		}
+	}
+}
+
+// deliverWebhook posts the payload to the webhook, the delivery is retried with backoff after server errors.
+func (n Network) deliverWebhook(ctx context.Context, payload LaunchWebhookPayload) error {
+	webhook := n.launchWebhook
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	client := webhook.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	retries := webhook.MaxRetries
+	if retries == 0 {
+		retries = DefaultWebhookRetries
+	}
+
+	backoff := webhookRetryBackoff
+	for attempt := 0; ; attempt++ {
+		retry, err := postWebhook(ctx, client, webhook, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt == retries {
+			return err
+		}
+
+		n.ev.Send(events.NewDebug(fmt.Sprintf("Webhook delivery failed, retrying in %s: %s", backoff, err)))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-n.clock.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// postWebhook posts the body to the webhook, it returns whether the delivery can be retried on error.
+func postWebhook(ctx context.Context, client *http.Client, webhook *LaunchWebhook, body []byte) (retry bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if webhook.Secret != "" {
+		req.Header.Set(WebhookSignatureHeader, SignWebhookBody(webhook.Secret, body))
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	switch {
+	case res.StatusCode >= http.StatusInternalServerError:
+		return true, fmt.Errorf("webhook responded with status %s", res.Status)
+	case res.StatusCode >= http.StatusMultipleChoices:
+		return false, fmt.Errorf("webhook responded with status %s", res.Status)
+	}
+	return false, nil
+}
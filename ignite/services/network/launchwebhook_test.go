@@ -0,0 +1,121 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+func TestWebhookSignature(t *testing.T) {
+	body := []byte(`{"launch_id":1}`)
+	signature := SignWebhookBody("secret", body)
+
+	require.True(t, VerifyWebhookSignature("secret", body, signature))
+	require.False(t, VerifyWebhookSignature("other", body, signature))
+	require.False(t, VerifyWebhookSignature("secret", []byte(`{"launch_id":2}`), signature))
+	require.False(t, VerifyWebhookSignature("secret", body, signature[len("sha256="):]))
+}
+
+func TestLaunchWebhook(t *testing.T) {
+	const secret = "webhook-secret"
+
+	var (
+		mu       sync.Mutex
+		attempts int
+		received []LaunchWebhookPayload
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		if !VerifyWebhookSignature(secret, body, r.Header.Get(WebhookSignatureHeader)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		// the first delivery fails and is retried
+		if attempts == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		var payload LaunchWebhookPayload
+		require.NoError(t, json.Unmarshal(body, &payload))
+		received = append(received, payload)
+	}))
+	defer server.Close()
+
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+		ev             = events.NewBus(events.WithCustomBufferSize(100))
+		firstLaunch    = sampleTime.Add(time.Hour)
+		secondLaunch   = sampleTime.Add(2 * time.Hour)
+		chainRequest   = &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}
+	)
+	CollectEvents(ev)(&network)
+	advanceClock(t, network)
+	mockSyncedNode(suite, network.clock)
+	WithLaunchWebhook(LaunchWebhook{URL: server.URL, Secret: secret})(&network)
+	network.random = func() float64 { return 0.5 }
+
+	chain := func(triggered bool, launchTime time.Time) *launchtypes.QueryGetChainResponse {
+		return &launchtypes.QueryGetChainResponse{Chain: launchtypes.Chain{
+			LaunchID:        testutil.LaunchID,
+			GenesisChainID:  "spn-1",
+			LaunchTriggered: triggered,
+			LaunchTime:      launchTime,
+		}}
+	}
+	suite.LaunchQueryMock.On("Chain", context.Background(), chainRequest).Return(chain(false, time.Time{}), nil).Once()
+	suite.LaunchQueryMock.On("Chain", context.Background(), chainRequest).Return(chain(true, firstLaunch), nil).Twice()
+	suite.LaunchQueryMock.On("Chain", context.Background(), chainRequest).Return(chain(false, time.Time{}), nil).Once()
+	suite.LaunchQueryMock.On("Chain", context.Background(), chainRequest).Return(chain(true, secondLaunch), nil)
+
+	_, err := network.WaitLaunch(context.Background(), testutil.LaunchID)
+	require.NoError(t, err)
+
+	// each transition is delivered once, the first delivery after a retry
+	require.Equal(t, []LaunchWebhookPayload{
+		{LaunchID: testutil.LaunchID, ChainID: "spn-1", Transition: LaunchTransitionTriggered, LaunchTime: firstLaunch.UTC()},
+		{LaunchID: testutil.LaunchID, ChainID: "spn-1", Transition: LaunchTransitionReverted, LaunchTime: firstLaunch.UTC()},
+		{LaunchID: testutil.LaunchID, ChainID: "spn-1", Transition: LaunchTransitionTriggered, LaunchTime: secondLaunch.UTC()},
+		{LaunchID: testutil.LaunchID, ChainID: "spn-1", Transition: LaunchTransitionTimeReached, LaunchTime: secondLaunch.UTC()},
+	}, received)
+	require.Equal(t, 5, attempts)
+}
+
+func TestLaunchWebhookClientError(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	var (
+		account    = testutil.NewTestAccount(t, testutil.TestAccountName)
+		_, network = newSuite(account)
+		ev         = events.NewBus(events.WithCustomBufferSize(10))
+	)
+	CollectEvents(ev)(&network)
+	WithLaunchWebhook(LaunchWebhook{URL: server.URL})(&network)
+
+	err := network.deliverWebhook(context.Background(), LaunchWebhookPayload{LaunchID: testutil.LaunchID})
+	require.EqualError(t, err, "webhook responded with status 400 Bad Request")
+
+	// the client errors are not retried
+	require.Equal(t, 1, attempts)
+}
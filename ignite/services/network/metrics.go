@@ -0,0 +1,80 @@
+package network
+
+import (
+	"strings"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iancoleman/strcase"
+)
+
+const (
+	// MetricsKindQuery is the kind of the latency metrics recorded for queries.
+	MetricsKindQuery = "query"
+
+	// MetricsKindBroadcast is the kind of the latency metrics recorded for broadcasts.
+	MetricsKindBroadcast = "broadcast"
+
+	// MetricsResultSuccess is the result of a successful broadcast.
+	MetricsResultSuccess = "success"
+
+	// MetricsResultFailure is the result of a failed broadcast.
+	MetricsResultFailure = "failure"
+)
+
+// MetricsRecorder records metrics about the network operations.
+// The networkmetrics package provides a Prometheus implementation.
+type MetricsRecorder interface {
+	// IncBroadcasts increments the count of broadcasts of the operation for the result.
+	IncBroadcasts(operation, result string)
+
+	// ObserveLatency records the latency of a query or a broadcast of the operation.
+	ObserveLatency(operation, kind string, latency time.Duration)
+
+	// SetPendingRequests sets the number of pending requests observed for a chain launch.
+	SetPendingRequests(launchID uint64, count int)
+}
+
+// noopMetrics is the metrics recorder used when no recorder is provided.
+type noopMetrics struct{}
+
+func (noopMetrics) IncBroadcasts(string, string)                 {}
+func (noopMetrics) ObserveLatency(string, string, time.Duration) {}
+func (noopMetrics) SetPendingRequests(uint64, int)               {}
+
+// WithMetrics records metrics about the network operations with the recorder.
+func WithMetrics(recorder MetricsRecorder) Option {
+	return func(n *Network) {
+		n.metrics = recorder
+	}
+}
+
+// observeLatency returns a function that records the latency of the operation since observeLatency was called.
+func (n Network) observeLatency(operation, kind string) func() {
+	start := time.Now()
+	return func() {
+		n.metrics.ObserveLatency(operation, kind, time.Since(start))
+	}
+}
+
+// observeBroadcast records the result and the latency of a broadcast started at start.
+func (n Network) observeBroadcast(start time.Time, err error, msgs ...sdktypes.Msg) {
+	operation := msgsOperation(msgs...)
+	result := MetricsResultSuccess
+	if err != nil {
+		result = MetricsResultFailure
+	}
+	n.metrics.IncBroadcasts(operation, result)
+	n.metrics.ObserveLatency(operation, MetricsKindBroadcast, time.Since(start))
+}
+
+// msgsOperation returns the name of the operation performed by the msgs from the type of the first msg,
+// e.g. trigger_launch for MsgTriggerLaunch.
+func msgsOperation(msgs ...sdktypes.Msg) string {
+	if len(msgs) == 0 {
+		return "unknown"
+	}
+	typeURL := sdktypes.MsgTypeURL(msgs[0])
+	name := typeURL[strings.LastIndex(typeURL, ".")+1:]
+	return strcase.ToSnake(strings.TrimPrefix(name, "Msg"))
+}
@@ -0,0 +1,155 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+// fakeMetrics records the metrics labels without values.
+type fakeMetrics struct {
+	mu              sync.Mutex
+	broadcasts      []string
+	latencies       []string
+	pendingRequests map[uint64]int
+}
+
+func (m *fakeMetrics) IncBroadcasts(operation, result string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.broadcasts = append(m.broadcasts, operation+":"+result)
+}
+
+func (m *fakeMetrics) ObserveLatency(operation, kind string, _ time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.latencies = append(m.latencies, operation+":"+kind)
+}
+
+func (m *fakeMetrics) SetPendingRequests(launchID uint64, count int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.pendingRequests == nil {
+		m.pendingRequests = make(map[uint64]int)
+	}
+	m.pendingRequests[launchID] = count
+}
+
+func TestMetrics(t *testing.T) {
+	t.Run("trigger launch", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+			metrics        = &fakeMetrics{}
+		)
+
+		mockCoordinator(t, suite, account)
+		WithMetrics(metrics)(&network)
+
+		mockBlockTime(suite, sampleTime)
+		suite.LaunchQueryMock.
+			On("Params", context.Background(), &launchtypes.QueryParamsRequest{}).
+			Return(&launchtypes.QueryParamsResponse{
+				Params: launchtypes.NewParams(
+					TestMinRemainingTime,
+					TestMaxRemainingTime,
+					TestRevertDelay,
+					sdk.Coins(nil),
+					sdk.Coins(nil),
+				),
+			}, nil).
+			Once()
+		mockPendingRequests(suite)
+		suite.CosmosClientMock.
+			On("BroadcastTx", context.Background(), account, mock.Anything).
+			Return(testutil.NewResponse(&launchtypes.MsgTriggerLaunchResponse{}), nil).
+			Once()
+
+		err := network.TriggerLaunch(context.Background(), testutil.LaunchID, sampleTime.Add(TestMaxRemainingTime))
+		require.NoError(t, err)
+		require.Equal(t, []string{"trigger_launch:success"}, metrics.broadcasts)
+		require.Equal(t, []string{"launch_params:query", "requests:query", "trigger_launch:broadcast"}, metrics.latencies)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("request settlement", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+			metrics        = &fakeMetrics{}
+		)
+
+		mockCoordinator(t, suite, account)
+		WithMetrics(metrics)(&network)
+
+		suite.LaunchQueryMock.
+			On("RequestAll", context.Background(), &launchtypes.QueryAllRequestRequest{LaunchID: testutil.LaunchID}).
+			Return(&launchtypes.QueryAllRequestResponse{
+				Request: []launchtypes.Request{
+					{RequestID: 1, Status: launchtypes.Request_PENDING},
+					{RequestID: 2, Status: launchtypes.Request_APPROVED},
+					{RequestID: 3, Status: launchtypes.Request_PENDING},
+				},
+			}, nil).
+			Once()
+		suite.CosmosClientMock.
+			On("BroadcastTx", context.Background(), account, mock.Anything).
+			Return(testutil.NewResponse(&launchtypes.MsgSettleRequestResponse{}), nil).
+			Once()
+		suite.CosmosClientMock.
+			On("BroadcastTxWithMemo", context.Background(), account, "rejection reason: invalid gentx", mock.Anything).
+			Return(testutil.NewResponse(&launchtypes.MsgSettleRequestResponse{}), nil).
+			Once()
+
+		_, err := network.Requests(context.Background(), testutil.LaunchID)
+		require.NoError(t, err)
+		err = network.SubmitRequest(context.Background(), testutil.LaunchID, ApproveRequest(1), RejectRequest(3, "invalid gentx"))
+		require.NoError(t, err)
+
+		require.Equal(t, map[uint64]int{testutil.LaunchID: 2}, metrics.pendingRequests)
+		require.Equal(t, []string{"settle_request:success", "settle_request:success"}, metrics.broadcasts)
+		require.Equal(t, []string{"requests:query", "settle_request:broadcast", "settle_request:broadcast"}, metrics.latencies)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed join", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+			metrics        = &fakeMetrics{}
+		)
+		WithMetrics(metrics)(&network)
+
+		addr, err := account.Address(networktypes.SPN)
+		require.NoError(t, err)
+		gentxPath := testutil.NewGentx(
+			addr,
+			TestDenom,
+			TestAmountString,
+			"",
+			testutil.PeerAddress,
+		).SaveTo(t, t.TempDir())
+
+		mockRequestQuota(suite, addr, nil, nil)
+		suite.CosmosClientMock.
+			On("BroadcastTx", context.Background(), account, mock.Anything).
+			Return(testutil.NewResponse(&launchtypes.MsgSendRequestResponse{}), errors.New("failed to broadcast")).
+			Once()
+
+		err = network.Join(context.Background(), suite.ChainMock, testutil.LaunchID, gentxPath)
+		require.Error(t, err)
+		require.Equal(t, []string{"send_request:failure"}, metrics.broadcasts)
+		require.Equal(t, []string{"launch_params:query", "send_request:broadcast"}, metrics.latencies)
+		suite.AssertAllMocks(t)
+	})
+}
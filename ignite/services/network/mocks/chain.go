@@ -223,6 +223,20 @@ func (_m *Chain) ResetGenesisTime() error {
 	return r0
 }
 
+// SetChainID provides a mock function with given fields: chainID
+func (_m *Chain) SetChainID(chainID string) error {
+	ret := _m.Called(chainID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SourceHash provides a mock function with given fields:
 func (_m *Chain) SourceHash() string {
 	ret := _m.Called()
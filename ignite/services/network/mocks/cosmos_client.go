@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	time "time"
+
 	client "github.com/cosmos/cosmos-sdk/client"
 
 	coretypes "github.com/tendermint/tendermint/rpc/core/types"
@@ -23,6 +25,36 @@ type CosmosClient struct {
 	mock.Mock
 }
 
+// BlockTimes provides a mock function with given fields: ctx, heights
+func (_m *CosmosClient) BlockTimes(ctx context.Context, heights ...int64) (map[int64]time.Time, error) {
+	_va := make([]interface{}, len(heights))
+	for _i := range heights {
+		_va[_i] = heights[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 map[int64]time.Time
+	if rf, ok := ret.Get(0).(func(context.Context, ...int64) map[int64]time.Time); ok {
+		r0 = rf(ctx, heights...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]time.Time)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...int64) error); ok {
+		r1 = rf(ctx, heights...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BroadcastTx provides a mock function with given fields: ctx, account, msgs
 func (_m *CosmosClient) BroadcastTx(ctx context.Context, account cosmosaccount.Account, msgs ...types.Msg) (cosmosclient.Response, error) {
 	_va := make([]interface{}, len(msgs))
@@ -51,6 +83,34 @@ func (_m *CosmosClient) BroadcastTx(ctx context.Context, account cosmosaccount.A
 	return r0, r1
 }
 
+// BroadcastTxWithMemo provides a mock function with given fields: ctx, account, memo, msgs
+func (_m *CosmosClient) BroadcastTxWithMemo(ctx context.Context, account cosmosaccount.Account, memo string, msgs ...types.Msg) (cosmosclient.Response, error) {
+	_va := make([]interface{}, len(msgs))
+	for _i := range msgs {
+		_va[_i] = msgs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, account, memo)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 cosmosclient.Response
+	if rf, ok := ret.Get(0).(func(context.Context, cosmosaccount.Account, string, ...types.Msg) cosmosclient.Response); ok {
+		r0 = rf(ctx, account, memo, msgs...)
+	} else {
+		r0 = ret.Get(0).(cosmosclient.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, cosmosaccount.Account, string, ...types.Msg) error); ok {
+		r1 = rf(ctx, account, memo, msgs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ConsensusInfo provides a mock function with given fields: ctx, height
 func (_m *CosmosClient) ConsensusInfo(ctx context.Context, height int64) (cosmosclient.ConsensusInfo, error) {
 	ret := _m.Called(ctx, height)
@@ -86,6 +146,50 @@ func (_m *CosmosClient) Context() client.Context {
 	return r0
 }
 
+// MaxTxBytes provides a mock function with given fields: ctx
+func (_m *CosmosClient) MaxTxBytes(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchTxs provides a mock function with given fields: ctx, query
+func (_m *CosmosClient) SearchTxs(ctx context.Context, query string) ([]*coretypes.ResultTx, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 []*coretypes.ResultTx
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*coretypes.ResultTx); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*coretypes.ResultTx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Status provides a mock function with given fields: ctx
 func (_m *CosmosClient) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
 	ret := _m.Called(ctx)
@@ -0,0 +1,322 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ibcchanneltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/relayer"
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+// monitoringRetryDelay is the delay before a failed step of the monitoring connection is retried.
+const monitoringRetryDelay = 5 * time.Second
+
+// MonitoringStep is a step of the handshake of the monitoring connection between SPN and a launched chain.
+type MonitoringStep string
+
+const (
+	// MonitoringStepClient creates the client of the launched chain on SPN.
+	MonitoringStepClient MonitoringStep = "client"
+
+	// MonitoringStepLink creates the connection and the channel between the monitoring modules.
+	MonitoringStepLink MonitoringStep = "link"
+
+	// MonitoringStepVerify checks the channel is open on both chains.
+	MonitoringStepVerify MonitoringStep = "verify"
+)
+
+// MonitoringStepConfig configures the timeout and the retries of a step of the monitoring connection.
+type MonitoringStepConfig struct {
+	// Timeout is the timeout of each attempt of the step, no timeout when zero.
+	Timeout time.Duration
+
+	// Retries is the number of times the step is retried after a failure.
+	Retries int
+}
+
+// defaultMonitoringSteps are the default configs of the steps of the monitoring connection.
+var defaultMonitoringSteps = map[MonitoringStep]MonitoringStepConfig{
+	MonitoringStepClient: {Timeout: time.Minute, Retries: 3},
+	MonitoringStepLink:   {Timeout: 5 * time.Minute, Retries: 1},
+	MonitoringStepVerify: {Timeout: 30 * time.Second, Retries: 5},
+}
+
+// ErrMonitoringStep is returned when a step of the monitoring connection fails after all its retries.
+type ErrMonitoringStep struct {
+	Step     MonitoringStep
+	Attempts int
+	Err      error
+}
+
+// Error implements error
+func (e ErrMonitoringStep) Error() string {
+	return fmt.Sprintf("monitoring %s step failed after %d attempts: %s", e.Step, e.Attempts, e.Err)
+}
+
+// Unwrap returns the error of the last attempt of the step.
+func (e ErrMonitoringStep) Unwrap() error {
+	return e.Err
+}
+
+//go:generate mockery --name MonitoringRelayer --case underscore
+
+// MonitoringRelayer relays the handshake of the monitoring connection, relayer.Relayer implements it.
+type MonitoringRelayer interface {
+	Link(ctx context.Context, conf relayerconf.Config, pathID string) (relayerconf.Config, error)
+	ChannelState(
+		ctx context.Context,
+		conf relayerconf.Config,
+		chainID,
+		portID,
+		channelID string,
+	) (ibcchanneltypes.State, error)
+}
+
+// MonitoringChain is a launched chain to connect to the monitoring module of SPN.
+type MonitoringChain struct {
+	// Node is the node of the launched chain.
+	Node Node
+
+	// Relayer is the relayer config of the launched chain.
+	Relayer relayerconf.Chain
+}
+
+// MonitoringConnection is the monitoring connection established between SPN and a launched chain.
+type MonitoringConnection struct {
+	PathID string
+	SPN    networktypes.RewardIBCInfo
+	Chain  networktypes.RewardIBCInfo
+
+	// Config is the relayer config of the connection, used to relay the monitoring packets.
+	Config relayerconf.Config
+
+	// Steps are the steps run to establish the connection with their attempts, in order.
+	Steps []MonitoringStepReport
+}
+
+// MonitoringStepReport is the report of a step of the monitoring connection.
+type MonitoringStepReport struct {
+	Step     MonitoringStep
+	Attempts int
+}
+
+type monitoringOptions struct {
+	steps map[MonitoringStep]MonitoringStepConfig
+}
+
+// MonitoringOption configures the monitoring connection.
+type MonitoringOption func(*monitoringOptions)
+
+// WithMonitoringStepTimeout sets the timeout of each attempt of the step.
+func WithMonitoringStepTimeout(step MonitoringStep, timeout time.Duration) MonitoringOption {
+	return func(o *monitoringOptions) {
+		config := o.steps[step]
+		config.Timeout = timeout
+		o.steps[step] = config
+	}
+}
+
+// WithMonitoringStepRetries sets the number of times the step is retried after a failure.
+func WithMonitoringStepRetries(step MonitoringStep, retries int) MonitoringOption {
+	return func(o *monitoringOptions) {
+		config := o.steps[step]
+		config.Retries = retries
+		o.steps[step] = config
+	}
+}
+
+// ConnectMonitoring establishes the IBC connection between the monitoring modules of SPN and the launched chain
+// required by the reward distribution. The client of the chain is created on SPN, the connection and the channel
+// are created with the relayer and the channel must be open on both chains. The existing client and channel
+// are reused when the connection is partially established. The relayer accounts must be funded on both chains.
+func (n Network) ConnectMonitoring(
+	ctx context.Context,
+	launchID uint64,
+	r MonitoringRelayer,
+	spn relayerconf.Chain,
+	chain MonitoringChain,
+	options ...MonitoringOption,
+) (MonitoringConnection, error) {
+	o := monitoringOptions{steps: make(map[MonitoringStep]MonitoringStepConfig)}
+	for step, config := range defaultMonitoringSteps {
+		o.steps[step] = config
+	}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	conn := MonitoringConnection{
+		PathID: relayer.PathID(spn.ID, chain.Relayer.ID),
+		SPN:    networktypes.RewardIBCInfo{ChainID: spn.ID},
+		Chain:  networktypes.RewardIBCInfo{ChainID: chain.Relayer.ID},
+	}
+	runStep := func(step MonitoringStep, run func(ctx context.Context) error) error {
+		attempts, err := n.runMonitoringStep(ctx, step, o.steps[step], run)
+		conn.Steps = append(conn.Steps, MonitoringStepReport{Step: step, Attempts: attempts})
+		return err
+	}
+
+	n.ev.Send(events.NewOngoing("Creating the monitoring client"))
+	err := runStep(MonitoringStepClient, func(ctx context.Context) (err error) {
+		conn.Chain.ClientID, err = chain.Node.consumerClientID(ctx)
+		if errors.Is(err, ErrObjectNotFound) {
+			return errors.New("the monitoring client of SPN isn't created yet on the chain")
+		} else if err != nil {
+			return err
+		}
+
+		spnInfo, err := n.RewardIBCInfo(ctx, launchID)
+		if err == nil {
+			conn.SPN.ClientID = spnInfo.ClientID
+			conn.SPN.ConnectionID = spnInfo.ConnectionID
+			conn.SPN.ChannelID = spnInfo.ChannelID
+			return nil
+		} else if !errors.Is(err, ErrObjectNotFound) {
+			return err
+		}
+
+		reward, _, unbondingTime, err := chain.Node.RewardsInfo(ctx)
+		if err != nil {
+			return err
+		}
+		conn.SPN.ClientID, err = n.CreateClient(ctx, launchID, unbondingTime, reward)
+		return err
+	})
+	if err != nil {
+		return MonitoringConnection{}, err
+	}
+	n.ev.Send(events.NewDone(
+		fmt.Sprintf("Monitoring clients: %s on SPN, %s on the chain", conn.SPN.ClientID, conn.Chain.ClientID),
+		"",
+	))
+
+	spn.ClientID = conn.SPN.ClientID
+	chain.Relayer.ClientID = conn.Chain.ClientID
+	conn.Config = relayerconf.Config{
+		Version: relayerconf.SupportVersion,
+		Chains:  []relayerconf.Chain{spn, chain.Relayer},
+		Paths: []relayerconf.Path{
+			{
+				ID:       conn.PathID,
+				Ordering: relayer.OrderingOrdered,
+				Src: relayerconf.PathEnd{
+					ChainID:      spn.ID,
+					PortID:       networktypes.SPNPortID,
+					Version:      networktypes.SPNVersion,
+					ConnectionID: conn.SPN.ConnectionID,
+					ChannelID:    conn.SPN.ChannelID,
+				},
+				Dst: relayerconf.PathEnd{
+					ChainID: chain.Relayer.ID,
+					PortID:  networktypes.ChainPortID,
+					Version: networktypes.SPNVersion,
+				},
+			},
+		},
+	}
+
+	// the channel is created once and only verified when the connection is already established
+	if conn.SPN.ChannelID == "" {
+		n.ev.Send(events.NewOngoing("Creating the monitoring connection and channel"))
+		err = runStep(MonitoringStepLink, func(ctx context.Context) error {
+			conf, err := r.Link(ctx, conn.Config, conn.PathID)
+			if err != nil {
+				return err
+			}
+			conn.Config = conf
+			return nil
+		})
+		if err != nil {
+			return MonitoringConnection{}, err
+		}
+	}
+
+	path, err := conn.Config.PathByID(conn.PathID)
+	if err != nil {
+		return MonitoringConnection{}, err
+	}
+	conn.SPN.ConnectionID, conn.SPN.ChannelID = path.Src.ConnectionID, path.Src.ChannelID
+	conn.Chain.ConnectionID, conn.Chain.ChannelID = path.Dst.ConnectionID, path.Dst.ChannelID
+	if conn.Chain.ChannelID == "" {
+		if conn.Chain.ChannelID, err = chain.Node.connectionChannelID(ctx); err != nil {
+			return MonitoringConnection{}, errors.Wrap(err, "cannot fetch the monitoring channel of the chain")
+		}
+		path.Dst.ChannelID = conn.Chain.ChannelID
+		if err := conn.Config.UpdatePath(path); err != nil {
+			return MonitoringConnection{}, err
+		}
+	}
+
+	n.ev.Send(events.NewOngoing("Verifying the monitoring channel"))
+	err = runStep(MonitoringStepVerify, func(ctx context.Context) error {
+		for _, end := range []relayerconf.PathEnd{path.Src, path.Dst} {
+			state, err := r.ChannelState(ctx, conn.Config, end.ChainID, end.PortID, end.ChannelID)
+			if err != nil {
+				return err
+			}
+			if state != ibcchanneltypes.OPEN {
+				return fmt.Errorf("the channel %s of %s is %s", end.ChannelID, end.ChainID, state)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return MonitoringConnection{}, err
+	}
+	n.ev.Send(events.NewDone(
+		fmt.Sprintf(
+			"Monitoring channel open: %s/%s on SPN, %s/%s on the chain",
+			conn.SPN.ConnectionID,
+			conn.SPN.ChannelID,
+			conn.Chain.ConnectionID,
+			conn.Chain.ChannelID,
+		),
+		"",
+	))
+
+	return conn, nil
+}
+
+// runMonitoringStep runs the step until it succeeds or its retries are exhausted and returns the number of attempts.
+func (n Network) runMonitoringStep(
+	ctx context.Context,
+	step MonitoringStep,
+	config MonitoringStepConfig,
+	run func(ctx context.Context) error,
+) (int, error) {
+	for attempt := 1; ; attempt++ {
+		stepCtx, cancel := ctx, context.CancelFunc(func() {})
+		if config.Timeout > 0 {
+			stepCtx, cancel = context.WithTimeout(ctx, config.Timeout)
+		}
+		err := run(stepCtx)
+		cancel()
+
+		switch {
+		case err == nil:
+			return attempt, nil
+		case ctx.Err() != nil:
+			return attempt, ctx.Err()
+		case attempt > config.Retries:
+			return attempt, ErrMonitoringStep{Step: step, Attempts: attempt, Err: err}
+		}
+
+		n.ev.Send(events.NewDebug(fmt.Sprintf(
+			"Monitoring %s step failed, retrying in %s: %s",
+			step,
+			monitoringRetryDelay,
+			err,
+		)))
+		select {
+		case <-ctx.Done():
+			return attempt, ctx.Err()
+		case <-n.clock.After(monitoringRetryDelay):
+		}
+	}
+}
@@ -0,0 +1,276 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	spntypes "github.com/tendermint/spn/pkg/types"
+	monitoringctypes "github.com/tendermint/spn/x/monitoringc/types"
+	monitoringptypes "github.com/tendermint/spn/x/monitoringp/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmoserror"
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+	"github.com/ignite/cli/ignite/services/network/mocks"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+func TestConnectMonitoring(t *testing.T) {
+	const (
+		spnClientID   = "07-tendermint-1"
+		chainClientID = "07-tendermint-0"
+		pathID        = "spn-1-orbit-1"
+	)
+	var (
+		account  = testutil.NewTestAccount(t, testutil.TestAccountName)
+		spnChain = relayerconf.Chain{ID: "spn-1", Account: "spn", RPCAddress: "http://spn:26657"}
+	)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	type chainMocks struct {
+		cosmos     *mocks.CosmosClient
+		staking    *mocks.StakingClient
+		monitoring *mocks.MonitoringpClient
+	}
+	newMonitoringChain := func(t *testing.T) (MonitoringChain, chainMocks) {
+		m := chainMocks{
+			cosmos:     mocks.NewCosmosClient(t),
+			staking:    mocks.NewStakingClient(t),
+			monitoring: mocks.NewMonitoringpClient(t),
+		}
+		return MonitoringChain{
+			Node: Node{
+				cosmos:                  m.cosmos,
+				stakingQuery:            m.staking,
+				monitoringProviderQuery: m.monitoring,
+			},
+			Relayer: relayerconf.Chain{ID: "orbit-1", Account: "orbit", RPCAddress: "http://orbit:26657"},
+		}, m
+	}
+	mockConsumerClient := func(m chainMocks, err error) {
+		res := &monitoringptypes.QueryGetConsumerClientIDResponse{
+			ConsumerClientID: monitoringptypes.ConsumerClientID{ClientID: chainClientID},
+		}
+		if err != nil {
+			res = nil
+		}
+		m.monitoring.
+			On("ConsumerClientID", mock.Anything, &monitoringptypes.QueryGetConsumerClientIDRequest{}).
+			Return(res, err)
+	}
+	mockCreateClient := func(suite testutil.Suite, m chainMocks) {
+		suite.MonitoringConsumerClient.
+			On(
+				"VerifiedClientIds",
+				mock.Anything,
+				&monitoringctypes.QueryGetVerifiedClientIdsRequest{LaunchID: testutil.LaunchID},
+			).
+			Return(nil, cosmoserror.ErrNotFound).
+			Once()
+		m.cosmos.
+			On("Status", mock.Anything).
+			Return(newStatus(10), nil).
+			Once()
+		m.cosmos.
+			On("ConsensusInfo", mock.Anything, int64(10)).
+			Return(cosmosclient.ConsensusInfo{
+				Timestamp:          "2022-01-01T00:00:00Z",
+				Root:               "root",
+				NextValidatorsHash: "hash",
+				ValidatorSet:       &tmproto.ValidatorSet{},
+			}, nil).
+			Once()
+		m.staking.
+			On("Params", mock.Anything, &stakingtypes.QueryParamsRequest{}).
+			Return(&stakingtypes.QueryParamsResponse{
+				Params: stakingtypes.Params{UnbondingTime: 1000 * time.Second},
+			}, nil).
+			Once()
+		suite.CosmosClientMock.
+			On(
+				"BroadcastTx",
+				mock.Anything,
+				account,
+				monitoringctypes.NewMsgCreateClient(
+					addr,
+					testutil.LaunchID,
+					spntypes.NewConsensusState("2022-01-01T00:00:00Z", "hash", "root"),
+					spntypes.NewValidatorSet([]spntypes.Validator{}...),
+					1000,
+					10,
+				),
+			).
+			Return(testutil.NewResponse(&monitoringctypes.MsgCreateClientResponse{ClientID: spnClientID}), nil).
+			Once()
+	}
+	linkedConfig := func(conf relayerconf.Config) relayerconf.Config {
+		linked := conf
+		linked.Paths = []relayerconf.Path{conf.Paths[0]}
+		linked.Paths[0].Src.ConnectionID, linked.Paths[0].Src.ChannelID = "connection-1", "channel-1"
+		linked.Paths[0].Dst.ConnectionID, linked.Paths[0].Dst.ChannelID = "connection-0", "channel-0"
+		return linked
+	}
+
+	t.Run("connect the monitoring modules", func(t *testing.T) {
+		var (
+			suite, network = newSuite(account)
+			chain, m       = newMonitoringChain(t)
+			relayer        = mocks.NewMonitoringRelayer(t)
+		)
+		advanceClock(t, network)
+
+		mockConsumerClient(m, nil)
+		mockCreateClient(suite, m)
+
+		var conf relayerconf.Config
+		relayer.
+			On("Link", mock.Anything, mock.Anything, pathID).
+			Run(func(args mock.Arguments) {
+				conf = args.Get(1).(relayerconf.Config)
+			}).
+			Return(func(_ context.Context, conf relayerconf.Config, _ string) relayerconf.Config {
+				return linkedConfig(conf)
+			}, nil).
+			Once()
+		relayer.
+			On("ChannelState", mock.Anything, mock.Anything, "spn-1", networktypes.SPNPortID, "channel-1").
+			Return(ibcchanneltypes.TRYOPEN, nil).
+			Once()
+		relayer.
+			On("ChannelState", mock.Anything, mock.Anything, "spn-1", networktypes.SPNPortID, "channel-1").
+			Return(ibcchanneltypes.OPEN, nil).
+			Once()
+		relayer.
+			On("ChannelState", mock.Anything, mock.Anything, "orbit-1", networktypes.ChainPortID, "channel-0").
+			Return(ibcchanneltypes.OPEN, nil).
+			Once()
+
+		conn, err := network.ConnectMonitoring(context.Background(), testutil.LaunchID, relayer, spnChain, chain)
+		require.NoError(t, err)
+		require.Equal(t, pathID, conn.PathID)
+		require.Equal(t, networktypes.RewardIBCInfo{
+			ChainID:      "spn-1",
+			ClientID:     spnClientID,
+			ConnectionID: "connection-1",
+			ChannelID:    "channel-1",
+		}, conn.SPN)
+		require.Equal(t, networktypes.RewardIBCInfo{
+			ChainID:      "orbit-1",
+			ClientID:     chainClientID,
+			ConnectionID: "connection-0",
+			ChannelID:    "channel-0",
+		}, conn.Chain)
+		require.Equal(t, []MonitoringStepReport{
+			{Step: MonitoringStepClient, Attempts: 1},
+			{Step: MonitoringStepLink, Attempts: 1},
+			{Step: MonitoringStepVerify, Attempts: 2},
+		}, conn.Steps)
+
+		// the relayer links the chains with their clients
+		require.Equal(t, spnClientID, conf.Chains[0].ClientID)
+		require.Equal(t, chainClientID, conf.Chains[1].ClientID)
+		require.Equal(t, networktypes.SPNPortID, conf.Paths[0].Src.PortID)
+		require.Equal(t, networktypes.ChainPortID, conf.Paths[0].Dst.PortID)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("client step failure", func(t *testing.T) {
+		var (
+			suite, network = newSuite(account)
+			chain, m       = newMonitoringChain(t)
+			relayer        = mocks.NewMonitoringRelayer(t)
+		)
+		advanceClock(t, network)
+
+		mockConsumerClient(m, cosmoserror.ErrNotFound)
+
+		_, err := network.ConnectMonitoring(
+			context.Background(),
+			testutil.LaunchID,
+			relayer,
+			spnChain,
+			chain,
+			WithMonitoringStepRetries(MonitoringStepClient, 2),
+		)
+		var stepErr ErrMonitoringStep
+		require.ErrorAs(t, err, &stepErr)
+		require.Equal(t, MonitoringStepClient, stepErr.Step)
+		require.Equal(t, 3, stepErr.Attempts)
+		require.EqualError(t, err, "monitoring client step failed after 3 attempts: "+
+			"the monitoring client of SPN isn't created yet on the chain")
+		m.monitoring.AssertNumberOfCalls(t, "ConsumerClientID", 3)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("link step failure", func(t *testing.T) {
+		var (
+			suite, network = newSuite(account)
+			chain, m       = newMonitoringChain(t)
+			relayer        = mocks.NewMonitoringRelayer(t)
+			errLink        = errors.New("connection handshake timed out")
+		)
+		advanceClock(t, network)
+
+		mockConsumerClient(m, nil)
+		mockCreateClient(suite, m)
+		relayer.
+			On("Link", mock.Anything, mock.Anything, pathID).
+			Return(relayerconf.Config{}, errLink).
+			Once()
+
+		_, err := network.ConnectMonitoring(
+			context.Background(),
+			testutil.LaunchID,
+			relayer,
+			spnChain,
+			chain,
+			WithMonitoringStepRetries(MonitoringStepLink, 0),
+			WithMonitoringStepTimeout(MonitoringStepLink, time.Minute),
+		)
+		require.ErrorIs(t, err, errLink)
+		require.EqualError(t, err, "monitoring link step failed after 1 attempts: connection handshake timed out")
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("verify step failure", func(t *testing.T) {
+		var (
+			suite, network = newSuite(account)
+			chain, m       = newMonitoringChain(t)
+			relayer        = mocks.NewMonitoringRelayer(t)
+		)
+		advanceClock(t, network)
+
+		mockConsumerClient(m, nil)
+		mockCreateClient(suite, m)
+		relayer.
+			On("Link", mock.Anything, mock.Anything, pathID).
+			Return(func(_ context.Context, conf relayerconf.Config, _ string) relayerconf.Config {
+				return linkedConfig(conf)
+			}, nil).
+			Once()
+		relayer.
+			On("ChannelState", mock.Anything, mock.Anything, "spn-1", networktypes.SPNPortID, "channel-1").
+			Return(ibcchanneltypes.INIT, nil).
+			Times(2)
+
+		_, err := network.ConnectMonitoring(
+			context.Background(),
+			testutil.LaunchID,
+			relayer,
+			spnChain,
+			chain,
+			WithMonitoringStepRetries(MonitoringStepVerify, 1),
+		)
+		require.EqualError(t, err, "monitoring verify step failed after 2 attempts: the channel channel-1 of spn-1 is STATE_INIT")
+		suite.AssertAllMocks(t)
+	})
+}
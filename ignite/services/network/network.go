@@ -2,7 +2,11 @@ package network
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
@@ -16,18 +20,29 @@ import (
 	rewardtypes "github.com/tendermint/spn/x/reward/types"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
+	"github.com/ignite/cli/ignite/pkg/auditlog"
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite/cli/ignite/pkg/cosmosclient"
 	"github.com/ignite/cli/ignite/pkg/events"
 	"github.com/ignite/cli/ignite/pkg/xtime"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
 )
 
 //go:generate mockery --name CosmosClient --case underscore
 type CosmosClient interface {
 	Context() client.Context
 	BroadcastTx(ctx context.Context, account cosmosaccount.Account, msgs ...sdktypes.Msg) (cosmosclient.Response, error)
+	BroadcastTxWithMemo(
+		ctx context.Context,
+		account cosmosaccount.Account,
+		memo string,
+		msgs ...sdktypes.Msg,
+	) (cosmosclient.Response, error)
 	Status(ctx context.Context) (*ctypes.ResultStatus, error)
 	ConsensusInfo(ctx context.Context, height int64) (cosmosclient.ConsensusInfo, error)
+	SearchTxs(ctx context.Context, query string) ([]*ctypes.ResultTx, error)
+	BlockTimes(ctx context.Context, heights ...int64) (map[int64]time.Time, error)
+	MaxTxBytes(ctx context.Context) (int64, error)
 }
 
 // Network is network builder.
@@ -36,6 +51,7 @@ type Network struct {
 	ev                      events.Bus
 	cosmos                  CosmosClient
 	account                 cosmosaccount.Account
+	accountRegistry         *cosmosaccount.Registry
 	campaignQuery           campaigntypes.QueryClient
 	launchQuery             launchtypes.QueryClient
 	profileQuery            profiletypes.QueryClient
@@ -44,6 +60,26 @@ type Network struct {
 	bankQuery               banktypes.QueryClient
 	monitoringConsumerQuery monitoringctypes.QueryClient
 	clock                   xtime.Clock
+	clockSkew               *clockSkewCache
+	clockSkewThreshold      time.Duration
+	adjustClockSkew         bool
+	skipCoordinatorCheck    bool
+	auditLog                *auditlog.Log
+	pinHeight               bool
+	metrics                 MetricsRecorder
+	fundsTimeout            time.Duration
+	queryCache              *queryCache
+	launchWebhook           *LaunchWebhook
+
+	// broadcastMu serializes the broadcasts of the operations run concurrently with the account,
+	// each tx of the account must be signed with the next sequence.
+	broadcastMu *sync.Mutex
+
+	// random returns a random number in [0, 1) for the jitter of the polls
+	random func() float64
+
+	// location is the zone of the times announced to the user
+	location *time.Location
 }
 
 //go:generate mockery --name Chain --case underscore
@@ -61,6 +97,7 @@ type Chain interface {
 	NodeID(ctx context.Context) (string, error)
 	CacheBinary(launchID uint64) error
 	ResetGenesisTime() error
+	SetChainID(chainID string) error
 }
 
 type Option func(*Network)
@@ -113,10 +150,31 @@ func WithCustomClock(clock xtime.Clock) Option {
 	}
 }
 
-// CollectEvents collects events from the network builder.
+// WithLocation sets the local zone of the launch times announced to the user, the system zone is used by default.
+func WithLocation(loc *time.Location) Option {
+	return func(n *Network) {
+		n.location = loc
+	}
+}
+
+// WithAuditLog records the state-changing operations performed by the network into the audit log.
+func WithAuditLog(log auditlog.Log) Option {
+	return func(n *Network) {
+		n.auditLog = &log
+	}
+}
+
+// WithAccountRegistry sets the registry of the accounts available to sign an operation with WithSigner.
+func WithAccountRegistry(registry cosmosaccount.Registry) Option {
+	return func(n *Network) {
+		n.accountRegistry = &registry
+	}
+}
+
+// CollectEvents collects events from the network builder, the events are sent in the network category.
 func CollectEvents(ev events.Bus) Option {
 	return func(n *Network) {
-		n.ev = ev
+		n.ev = ev.WithCategory(events.CategoryNetwork)
 	}
 }
 
@@ -134,6 +192,11 @@ func New(cosmos CosmosClient, account cosmosaccount.Account, options ...Option)
 		bankQuery:               banktypes.NewQueryClient(cosmos.Context()),
 		monitoringConsumerQuery: monitoringctypes.NewQueryClient(cosmos.Context()),
 		clock:                   xtime.NewClockSystem(),
+		clockSkew:               &clockSkewCache{},
+		clockSkewThreshold:      DefaultClockSkewThreshold,
+		metrics:                 noopMetrics{},
+		random:                  newRandom(),
+		location:                time.Local,
 	}
 	for _, opt := range options {
 		opt(&n)
@@ -141,6 +204,130 @@ func New(cosmos CosmosClient, account cosmosaccount.Account, options ...Option)
 	return n
 }
 
+// NewQueryOnly creates a Builder without signing account.
+// Read operations work as with a regular builder while state-changing operations return ErrNoSigningAccount.
+func NewQueryOnly(cosmos CosmosClient, options ...Option) Network {
+	return New(cosmos, cosmosaccount.Account{}, options...)
+}
+
+// CanSign checks if the network has an account to sign the state-changing operations.
+func (n Network) CanSign() bool {
+	return n.account.Record != nil
+}
+
+// WithSigner returns a copy of the network signing the operations with the key of the account registry,
+// the signature and the address of the messages follow the key. It's used to perform an operation with another
+// account than the default account of the network.
+func (n Network) WithSigner(name string) (Network, error) {
+	if n.accountRegistry == nil {
+		return Network{}, errors.Errorf("cannot sign with the key %s, the network has no account registry", name)
+	}
+	account, err := n.accountRegistry.GetByName(name)
+	if err != nil {
+		return Network{}, errors.Wrapf(err, "cannot sign with the key %s", name)
+	}
+	n.account = account
+	return n, nil
+}
+
+// accountAddress returns the SPN address of the signing account.
+func (n Network) accountAddress() (string, error) {
+	if !n.CanSign() {
+		return "", ErrNoSigningAccount
+	}
+	return n.account.Address(networktypes.SPN)
+}
+
+// broadcastTx broadcasts the messages with the network account and reports the gas used for the tx.
+func (n Network) broadcastTx(ctx context.Context, msgs ...sdktypes.Msg) (cosmosclient.Response, error) {
+	return n.broadcastTxWithMemo(ctx, "", msgs...)
+}
+
+// broadcastTxWithMemo broadcasts the messages like broadcastTx in a tx with the memo, the tx has no memo when empty.
+func (n Network) broadcastTxWithMemo(ctx context.Context, memo string, msgs ...sdktypes.Msg) (cosmosclient.Response, error) {
+	// the messages must not be built from cached query results
+	if n.queryCache != nil {
+		return cosmosclient.Response{}, ErrQueryCacheBroadcast
+	}
+	if !n.CanSign() {
+		return cosmosclient.Response{}, ErrNoSigningAccount
+	}
+
+	addr, err := n.accountAddress()
+	if err != nil {
+		return cosmosclient.Response{}, err
+	}
+	if err := n.waitForFunds(ctx, msgs...); err != nil {
+		return cosmosclient.Response{}, err
+	}
+
+	if n.broadcastMu != nil {
+		n.broadcastMu.Lock()
+		defer n.broadcastMu.Unlock()
+	}
+
+	// the round trip of the broadcast is timed, the wait for the funds is reported on its own
+	timer := n.ev.StartTimer(fmt.Sprintf("Broadcasting the transaction signed by %s (%s)", n.account.Name, addr))
+
+	start := time.Now()
+	var res cosmosclient.Response
+	if memo == "" {
+		res, err = n.cosmos.BroadcastTx(ctx, n.account, msgs...)
+	} else {
+		res, err = n.cosmos.BroadcastTxWithMemo(ctx, n.account, memo, msgs...)
+	}
+	n.observeBroadcast(start, err, msgs...)
+	n.audit(res, err, msgs...)
+	if err != nil {
+		return res, translateTxError(res, err)
+	}
+
+	source := "fallback"
+	if res.GasEstimation.Simulated {
+		source = "simulation"
+	}
+	n.ev.Send(events.NewDebug(fmt.Sprintf("Transaction broadcasted with %d gas from %s", res.GasEstimation.Gas, source)))
+	timer.Done("Transaction broadcasted")
+
+	return res, nil
+}
+
+// audit records the broadcasted messages into the audit log.
+// Errors are reported as debug events only to never fail the broadcasted operation.
+func (n Network) audit(res cosmosclient.Response, broadcastErr error, msgs ...sdktypes.Msg) {
+	if n.auditLog == nil {
+		return
+	}
+
+	// the tx response is not set when the tx failed before being broadcasted
+	var txHash string
+	if res.TxResponse != nil {
+		txHash = res.TxHash
+	}
+
+	for _, msg := range msgs {
+		entry := auditlog.Entry{
+			Time:      n.clock.Now().UTC(),
+			Operation: sdktypes.MsgTypeURL(msg),
+			TxHash:    txHash,
+		}
+		if m, ok := msg.(interface{ GetLaunchID() uint64 }); ok {
+			entry.LaunchID = m.GetLaunchID()
+		}
+		if args, err := json.Marshal(msg); err == nil {
+			entry.Args = args
+		}
+		if broadcastErr != nil {
+			entry.Error = broadcastErr.Error()
+		}
+
+		if err := n.auditLog.Append(entry); err != nil {
+			n.ev.Send(events.NewDebug(fmt.Sprintf("Cannot write the audit log: %s", err)))
+			return
+		}
+	}
+}
+
 func ParseID(id string) (uint64, error) {
 	objID, err := strconv.ParseUint(id, 10, 64)
 	if err != nil {
@@ -1,14 +1,26 @@
 package network
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
 
+	"github.com/ignite/cli/ignite/pkg/auditlog"
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/events"
 	"github.com/ignite/cli/ignite/pkg/xtime"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
 	"github.com/ignite/cli/ignite/services/network/testutil"
 )
 
@@ -30,6 +42,16 @@ func newSuite(account cosmosaccount.Account) (testutil.Suite, Network) {
 	)
 }
 
+// advanceClock completes the waits on the clock of the network as if the time passed, until the test ends.
+func advanceClock(t *testing.T, network Network) {
+	clock, ok := network.clock.(*xtime.ClockMock)
+	require.True(t, ok)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go clock.AdvanceWaits(ctx)
+}
+
 func TestParseID(t *testing.T) {
 	tests := []struct {
 		name string
@@ -72,8 +94,209 @@ func TestParseID(t *testing.T) {
 	}
 }
 
+func TestAuditLog(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+		log            = auditlog.New(filepath.Join(t.TempDir(), "audit.log"))
+	)
+	WithAuditLog(log)(&network)
+
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	var (
+		msgApprove = launchtypes.NewMsgSettleRequest(addr, testutil.LaunchID, 1, true)
+		msgReject  = launchtypes.NewMsgSettleRequest(addr, testutil.LaunchID, 2, false)
+		res        = testutil.NewResponse(&launchtypes.MsgSettleRequestResponse{})
+	)
+	res.TxHash = "ABCD"
+
+	suite.CosmosClientMock.
+		On("BroadcastTx", context.Background(), account, msgApprove).
+		Return(res, nil).
+		Once()
+	suite.CosmosClientMock.
+		On("BroadcastTx", context.Background(), account, msgReject).
+		Return(cosmosclient.Response{}, errors.New("failed to broadcast")).
+		Once()
+
+	_, err = network.broadcastTx(context.Background(), msgApprove)
+	require.NoError(t, err)
+	_, err = network.broadcastTx(context.Background(), msgReject)
+	require.Error(t, err)
+	suite.AssertAllMocks(t)
+
+	argsApprove, err := json.Marshal(msgApprove)
+	require.NoError(t, err)
+	argsReject, err := json.Marshal(msgReject)
+	require.NoError(t, err)
+
+	entries, err := log.Recent(testutil.LaunchID, 0)
+	require.NoError(t, err)
+	require.Equal(t, []auditlog.Entry{
+		{
+			Time:      sampleTime.UTC(),
+			Operation: "/tendermint.spn.launch.MsgSettleRequest",
+			LaunchID:  testutil.LaunchID,
+			Args:      argsApprove,
+			TxHash:    "ABCD",
+		},
+		{
+			Time:      sampleTime.UTC(),
+			Operation: "/tendermint.spn.launch.MsgSettleRequest",
+			LaunchID:  testutil.LaunchID,
+			Args:      argsReject,
+			Error:     "failed to broadcast",
+		},
+	}, entries)
+}
+
+func TestQueryOnly(t *testing.T) {
+	t.Run("network with account can sign", func(t *testing.T) {
+		_, network := newSuite(testutil.NewTestAccount(t, testutil.TestAccountName))
+		require.True(t, network.CanSign())
+	})
+
+	t.Run("query-only network performs read operations", func(t *testing.T) {
+		suite := testutil.NewSuite()
+		network := NewQueryOnly(suite.CosmosClientMock, WithLaunchQueryClient(suite.LaunchQueryMock))
+		require.False(t, network.CanSign())
+
+		params := launchtypes.DefaultParams()
+		suite.LaunchQueryMock.
+			On("Params", context.Background(), &launchtypes.QueryParamsRequest{}).
+			Return(&launchtypes.QueryParamsResponse{Params: params}, nil).
+			Once()
+
+		got, err := network.LaunchParams(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, params, got)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("query-only network rejects state-changing operations", func(t *testing.T) {
+		suite := testutil.NewSuite()
+		network := NewQueryOnly(suite.CosmosClientMock, WithLaunchQueryClient(suite.LaunchQueryMock))
+
+		err := network.TriggerLaunch(context.Background(), testutil.LaunchID, time.Time{})
+		require.ErrorIs(t, err, ErrNoSigningAccount)
+
+		err = network.SubmitRequest(context.Background(), testutil.LaunchID, ApproveRequest(1))
+		require.ErrorIs(t, err, ErrNoSigningAccount)
+
+		_, err = network.broadcastTx(context.Background(), launchtypes.NewMsgSettleRequest("", testutil.LaunchID, 1, true))
+		require.ErrorIs(t, err, ErrNoSigningAccount)
+
+		// no query nor broadcast must be performed
+		suite.AssertAllMocks(t)
+	})
+}
+
 func SampleSharePercent(t *testing.T, denom string, nominator, denominator uint64) SharePercent {
 	sp, err := NewSharePercent(denom, nominator, denominator)
 	require.NoError(t, err)
 	return sp
 }
+
+func TestWithSigner(t *testing.T) {
+	registry, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+	alice, _, err := registry.Create("alice")
+	require.NoError(t, err)
+	bob, _, err := registry.Create("bob")
+	require.NoError(t, err)
+	bobAddr, err := bob.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	totalSupply := sdk.NewCoins(sdk.NewInt64Coin("foo", 1000))
+
+	t.Run("sign with the overridden account", func(t *testing.T) {
+		var (
+			suite, network = newSuite(alice)
+			bus            = events.NewBus(events.WithCustomBufferSize(10))
+		)
+		CollectEvents(bus)(&network)
+		WithAccountRegistry(registry)(&network)
+
+		suite.CosmosClientMock.
+			On(
+				"BroadcastTx",
+				context.Background(),
+				bob,
+				campaigntypes.NewMsgCreateCampaign(bobAddr, "foo", totalSupply, []byte{}),
+			).
+			Return(testutil.NewResponse(&campaigntypes.MsgCreateCampaignResponse{CampaignID: 1}), nil).
+			Once()
+
+		bobNetwork, err := network.WithSigner("bob")
+		require.NoError(t, err)
+		campaignID, err := bobNetwork.CreateCampaign(context.Background(), "foo", "", totalSupply)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), campaignID)
+		suite.AssertAllMocks(t)
+
+		// the default account of the network is unchanged
+		require.Equal(t, alice, network.account)
+
+		bus.Shutdown(context.Background())
+		var descriptions []string
+		for ev := range bus.Events() {
+			descriptions = append(descriptions, ev.Description)
+		}
+		require.Contains(t, descriptions, fmt.Sprintf("Broadcasting the transaction signed by bob (%s)", bobAddr))
+	})
+
+	t.Run("key not found", func(t *testing.T) {
+		_, network := newSuite(alice)
+		WithAccountRegistry(registry)(&network)
+
+		_, err := network.WithSigner("carol")
+		require.ErrorContains(t, err, "cannot sign with the key carol")
+	})
+
+	t.Run("no account registry", func(t *testing.T) {
+		_, network := newSuite(alice)
+
+		_, err := network.WithSigner("bob")
+		require.EqualError(t, err, "cannot sign with the key bob, the network has no account registry")
+	})
+}
+
+func TestAbandonedEventsConsumer(t *testing.T) {
+	var (
+		wg                     sync.WaitGroup
+		sim, network, launchID = newLaunchSimulator(t)
+		bus                    = events.NewBus(events.WithWaitGroup(&wg), events.WithCustomBufferSize(1))
+		consumed               = make(chan events.Event)
+	)
+	CollectEvents(bus)(&network)
+
+	// the consumer handles the first event and stops
+	go func() {
+		ev := <-bus.Events()
+		wg.Done()
+		consumed <- ev
+	}()
+
+	done := make(chan error)
+	go func() {
+		done <- network.TriggerLaunch(context.Background(), launchID, sampleTime.Add(TestMaxRemainingTime))
+	}()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("the operation is blocked by the abandoned consumer")
+	}
+	<-consumed
+
+	chain, _ := sim.Chain(launchID)
+	require.True(t, chain.LaunchTriggered)
+	require.NotZero(t, bus.Dropped())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	bus.Shutdown(ctx)
+}
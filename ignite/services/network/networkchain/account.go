@@ -41,7 +41,11 @@ func (c Chain) InitAccount(ctx context.Context, v chain.Validator, accountName s
 		return "", err
 	}
 
-	// create the gentx.
+	return c.IssueGentx(ctx, v)
+}
+
+// IssueGentx issues a gentx for the validator from the initialized chain home in config/gentx/gentx.json
+func (c Chain) IssueGentx(ctx context.Context, v chain.Validator) (string, error) {
 	issuedGentxPath, err := c.chain.IssueGentx(ctx, v)
 	if err != nil {
 		return "", err
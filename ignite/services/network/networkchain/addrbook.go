@@ -0,0 +1,63 @@
+package networkchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/pex"
+)
+
+// AddrBookFile is the name of the Tendermint address book in the config directory of the chain.
+const AddrBookFile = "addrbook.json"
+
+// writeAddrBook adds the peers, formatted as id@host:port, into the address book of the path.
+// The peers are merged with the addresses of an existing address book, a peer already in the book is not duplicated.
+// The invalid peers are skipped and returned as warnings.
+func writeAddrBook(path string, peers []string) (warnings []string, err error) {
+	// the address book panics when loading a corrupted file
+	if data, err := os.ReadFile(path); err == nil {
+		var existing struct {
+			Key   string            `json:"key"`
+			Addrs []json.RawMessage `json:"addrs"`
+		}
+		if err := json.Unmarshal(data, &existing); err != nil {
+			return nil, fmt.Errorf("invalid address book %s: %w", path, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+
+	// the peers of a launch can be private addresses or tunneled through localhost
+	book := pex.NewAddrBook(path, false)
+	if err := book.Start(); err != nil {
+		return nil, err
+	}
+
+	for _, peer := range peers {
+		addr, err := p2p.NewNetAddressString(peer)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("peer %s skipped from the address book: %s", peer, err))
+			continue
+		}
+		if err := book.AddAddress(addr, addr); err != nil {
+			warnings = append(warnings, fmt.Sprintf("peer %s skipped from the address book: %s", peer, err))
+		}
+	}
+
+	// the address book is saved when stopped, its save routine is awaited to not write the file after returning
+	if err := book.Stop(); err != nil {
+		return nil, err
+	}
+	if w, ok := book.(interface{ Wait() }); ok {
+		w.Wait()
+	}
+
+	return warnings, nil
+}
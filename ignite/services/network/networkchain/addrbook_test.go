@@ -0,0 +1,122 @@
+package networkchain
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/pex"
+)
+
+const (
+	testPeerID1 = "a0e1e7410031f82c10373bd7521aa6d10ff2c2f8"
+	testPeerID2 = "b1f2f8521142093d21484ce8632bb7e21003d3f9"
+)
+
+// addrBookJSON is the schema of the Tendermint address book.
+type addrBookJSON struct {
+	Key   string `json:"key"`
+	Addrs []struct {
+		Addr struct {
+			ID   string `json:"id"`
+			IP   string `json:"ip"`
+			Port uint16 `json:"port"`
+		} `json:"addr"`
+		Src struct {
+			ID string `json:"id"`
+		} `json:"src"`
+		Buckets     []int     `json:"buckets"`
+		Attempts    int32     `json:"attempts"`
+		BucketType  byte      `json:"bucket_type"`
+		LastAttempt time.Time `json:"last_attempt"`
+		LastSuccess time.Time `json:"last_success"`
+		LastBanTime time.Time `json:"last_ban_time"`
+	} `json:"addrs"`
+}
+
+func readAddrBook(t *testing.T, path string) addrBookJSON {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var book addrBookJSON
+	require.NoError(t, json.Unmarshal(data, &book))
+	return book
+}
+
+func TestWriteAddrBook(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config", AddrBookFile)
+
+	warnings, err := writeAddrBook(path, []string{
+		testPeerID1 + "@192.168.0.1:26656",
+		"invalid@192.168.0.2:26656",
+		testPeerID2 + "@127.0.0.1:22001",
+	})
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	require.Contains(t, warnings[0], "peer invalid@192.168.0.2:26656 skipped from the address book")
+
+	book := readAddrBook(t, path)
+	key, err := hex.DecodeString(book.Key)
+	require.NoError(t, err)
+	require.Len(t, key, 12)
+	require.Len(t, book.Addrs, 2)
+
+	ips := make(map[string]string)
+	for _, addr := range book.Addrs {
+		ips[addr.Addr.ID] = addr.Addr.IP
+		require.Equal(t, addr.Addr.ID, addr.Src.ID)
+		require.Len(t, addr.Buckets, 1)
+		require.GreaterOrEqual(t, addr.Buckets[0], 0)
+		require.Less(t, addr.Buckets[0], 256)
+		require.EqualValues(t, 1, addr.BucketType)
+		require.False(t, addr.LastAttempt.IsZero())
+	}
+	require.Equal(t, map[string]string{
+		testPeerID1: "192.168.0.1",
+		testPeerID2: "127.0.0.1",
+	}, ips)
+
+	// the file is loaded by Tendermint
+	loaded := pex.NewAddrBook(path, false)
+	require.NoError(t, loaded.Start())
+	// the address book is saved on stop, wait for the save before the removal of the temp dir
+	defer func() {
+		loaded.Stop() //nolint:errcheck
+		loaded.(interface{ Wait() }).Wait()
+	}()
+	require.Equal(t, 2, loaded.Size())
+	addr, err := p2p.NewNetAddressString(testPeerID1 + "@192.168.0.1:26656")
+	require.NoError(t, err)
+	require.True(t, loaded.HasAddress(addr))
+}
+
+func TestWriteAddrBookMerge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), AddrBookFile)
+
+	_, err := writeAddrBook(path, []string{testPeerID1 + "@192.168.0.1:26656"})
+	require.NoError(t, err)
+	key := readAddrBook(t, path).Key
+
+	_, err = writeAddrBook(path, []string{
+		testPeerID1 + "@192.168.0.1:26656",
+		testPeerID2 + "@192.168.0.2:26656",
+	})
+	require.NoError(t, err)
+
+	book := readAddrBook(t, path)
+	require.Equal(t, key, book.Key)
+	require.Len(t, book.Addrs, 2)
+
+	t.Run("invalid existing address book", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), AddrBookFile)
+		require.NoError(t, os.WriteFile(path, []byte("{"), 0o644))
+
+		_, err := writeAddrBook(path, []string{testPeerID1 + "@192.168.0.1:26656"})
+		require.ErrorContains(t, err, "invalid address book")
+	})
+}
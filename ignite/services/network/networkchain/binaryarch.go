@@ -0,0 +1,251 @@
+package networkchain
+
+import (
+	"bytes"
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+// ErrUnknownBinaryFormat is returned when the binary is not an ELF, Mach-O or PE executable.
+var ErrUnknownBinaryFormat = errors.New("unknown binary format")
+
+// BinaryPlatform is the platform an executable is built for, with the GOOS and GOARCH names.
+type BinaryPlatform struct {
+	OS   string
+	Arch string
+}
+
+// String returns the platform in the os/arch form.
+func (p BinaryPlatform) String() string {
+	return p.OS + "/" + p.Arch
+}
+
+// RuntimePlatform returns the platform of the running host.
+func RuntimePlatform() BinaryPlatform {
+	return BinaryPlatform{OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
+
+// ErrBinaryPlatform is returned when the chain binary can't run on the host platform.
+type ErrBinaryPlatform struct {
+	Path    string
+	Binary  []BinaryPlatform
+	Runtime BinaryPlatform
+}
+
+// Error implements error.
+func (e ErrBinaryPlatform) Error() string {
+	platforms := make([]string, len(e.Binary))
+	for i, p := range e.Binary {
+		platforms[i] = p.String()
+	}
+	return fmt.Sprintf(
+		"the chain binary %s is built for %s and can't run on %s: "+
+			"cross-compile the binary with GOOS=%s GOARCH=%s "+
+			"or build it with the Docker build mode (--docker-build) on a %s host",
+		e.Path,
+		strings.Join(platforms, ", "),
+		e.Runtime,
+		e.Runtime.OS,
+		e.Runtime.Arch,
+		DefaultBuilderPlatform,
+	)
+}
+
+// ReadBinaryPlatforms returns the platforms of the executable from the headers of its format,
+// the binary is never executed. A Mach-O universal binary has a platform per architecture.
+// ErrUnknownBinaryFormat is returned when the file is not an ELF, Mach-O or PE executable.
+func ReadBinaryPlatforms(path string) ([]BinaryPlatform, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	magic := make([]byte, 4)
+	if _, err := io.ReadFull(f, magic); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, ErrUnknownBinaryFormat
+		}
+		return nil, err
+	}
+
+	var platforms []BinaryPlatform
+	switch {
+	case bytes.Equal(magic, []byte(elf.ELFMAG)):
+		platforms, err = elfPlatforms(f)
+	case isMachO(magic):
+		platforms, err = machOPlatforms(f)
+	case bytes.HasPrefix(magic, []byte("MZ")):
+		platforms, err = pePlatforms(f)
+	default:
+		return nil, ErrUnknownBinaryFormat
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the headers of the binary %s: %w", path, err)
+	}
+	return platforms, nil
+}
+
+// CheckBinaryPlatform checks the executable can run on the host platform from its headers.
+// ErrBinaryPlatform is returned when none of the platforms of the binary is the host platform.
+func CheckBinaryPlatform(path string) error {
+	platforms, err := ReadBinaryPlatforms(path)
+	if err != nil {
+		return err
+	}
+	host := RuntimePlatform()
+	for _, p := range platforms {
+		if p == host {
+			return nil
+		}
+	}
+	return ErrBinaryPlatform{
+		Path:    path,
+		Binary:  platforms,
+		Runtime: host,
+	}
+}
+
+// checkBinaryPlatform checks the chain binary can run on the host before it is installed.
+func (c Chain) checkBinaryPlatform(binaryPath string) error {
+	path, err := exec.LookPath(binaryPath)
+	if err != nil {
+		return err
+	}
+
+	err = CheckBinaryPlatform(path)
+	if errors.Is(err, ErrUnknownBinaryFormat) {
+		// the binary can be a wrapper script, its platform can't be known without running it
+		c.ev.Send(events.NewDebug(fmt.Sprintf("The platform of the chain binary %s is unknown, skipping the check", path)))
+		return nil
+	}
+	return err
+}
+
+func isMachO(magic []byte) bool {
+	switch binary.BigEndian.Uint32(magic) {
+	case macho.Magic32, macho.Magic64, macho.MagicFat:
+		return true
+	}
+	switch binary.LittleEndian.Uint32(magic) {
+	case macho.Magic32, macho.Magic64:
+		return true
+	}
+	return false
+}
+
+func elfPlatforms(r io.ReaderAt) ([]BinaryPlatform, error) {
+	f, err := elf.NewFile(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// the Go linker leaves the OS ABI unset for Linux
+	var goos string
+	switch f.OSABI {
+	case elf.ELFOSABI_NONE, elf.ELFOSABI_LINUX:
+		goos = "linux"
+	case elf.ELFOSABI_FREEBSD:
+		goos = "freebsd"
+	case elf.ELFOSABI_NETBSD:
+		goos = "netbsd"
+	case elf.ELFOSABI_OPENBSD:
+		goos = "openbsd"
+	case elf.ELFOSABI_SOLARIS:
+		goos = "solaris"
+	default:
+		goos = strings.ToLower(strings.TrimPrefix(f.OSABI.String(), "ELFOSABI_"))
+	}
+
+	var goarch string
+	switch f.Machine {
+	case elf.EM_X86_64:
+		goarch = "amd64"
+	case elf.EM_386:
+		goarch = "386"
+	case elf.EM_AARCH64:
+		goarch = "arm64"
+	case elf.EM_ARM:
+		goarch = "arm"
+	case elf.EM_RISCV:
+		goarch = "riscv64"
+	case elf.EM_S390:
+		goarch = "s390x"
+	case elf.EM_PPC64:
+		goarch = "ppc64"
+		if f.Data == elf.ELFDATA2LSB {
+			goarch = "ppc64le"
+		}
+	default:
+		goarch = strings.ToLower(strings.TrimPrefix(f.Machine.String(), "EM_"))
+	}
+	return []BinaryPlatform{{OS: goos, Arch: goarch}}, nil
+}
+
+func machOPlatforms(r io.ReaderAt) ([]BinaryPlatform, error) {
+	fat, err := macho.NewFatFile(r)
+	switch {
+	case err == nil:
+		platforms := make([]BinaryPlatform, len(fat.Arches))
+		for i, arch := range fat.Arches {
+			platforms[i] = BinaryPlatform{OS: "darwin", Arch: machOArch(arch.Cpu)}
+		}
+		return platforms, nil
+	case !errors.Is(err, macho.ErrNotFat):
+		return nil, err
+	}
+
+	f, err := macho.NewFile(r)
+	if err != nil {
+		return nil, err
+	}
+	return []BinaryPlatform{{OS: "darwin", Arch: machOArch(f.Cpu)}}, nil
+}
+
+func machOArch(cpu macho.Cpu) string {
+	switch cpu {
+	case macho.CpuAmd64:
+		return "amd64"
+	case macho.Cpu386:
+		return "386"
+	case macho.CpuArm64:
+		return "arm64"
+	case macho.CpuArm:
+		return "arm"
+	default:
+		return strings.ToLower(strings.TrimPrefix(cpu.String(), "Cpu"))
+	}
+}
+
+func pePlatforms(r io.ReaderAt) ([]BinaryPlatform, error) {
+	f, err := pe.NewFile(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var goarch string
+	switch f.Machine {
+	case pe.IMAGE_FILE_MACHINE_AMD64:
+		goarch = "amd64"
+	case pe.IMAGE_FILE_MACHINE_I386:
+		goarch = "386"
+	case pe.IMAGE_FILE_MACHINE_ARM64:
+		goarch = "arm64"
+	case pe.IMAGE_FILE_MACHINE_ARMNT:
+		goarch = "arm"
+	default:
+		goarch = fmt.Sprintf("machine-%#x", f.Machine)
+	}
+	return []BinaryPlatform{{OS: "windows", Arch: goarch}}, nil
+}
@@ -0,0 +1,97 @@
+package networkchain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+func TestReadBinaryPlatforms(t *testing.T) {
+	// the fixtures only contain the headers of the formats
+	tests := []struct {
+		fixture string
+		want    []BinaryPlatform
+	}{
+		{fixture: "linux_amd64.elf", want: []BinaryPlatform{{OS: "linux", Arch: "amd64"}}},
+		{fixture: "linux_arm64.elf", want: []BinaryPlatform{{OS: "linux", Arch: "arm64"}}},
+		{fixture: "freebsd_amd64.elf", want: []BinaryPlatform{{OS: "freebsd", Arch: "amd64"}}},
+		{fixture: "darwin_arm64.macho", want: []BinaryPlatform{{OS: "darwin", Arch: "arm64"}}},
+		{
+			fixture: "darwin_universal.macho",
+			want:    []BinaryPlatform{{OS: "darwin", Arch: "amd64"}, {OS: "darwin", Arch: "arm64"}},
+		},
+		{fixture: "windows_amd64.exe", want: []BinaryPlatform{{OS: "windows", Arch: "amd64"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			platforms, err := ReadBinaryPlatforms(filepath.Join("testdata/binaries", tt.fixture))
+			require.NoError(t, err)
+			require.Equal(t, tt.want, platforms)
+		})
+	}
+
+	t.Run("unknown format", func(t *testing.T) {
+		_, err := ReadBinaryPlatforms("testdata/binaries/script.sh")
+		require.ErrorIs(t, err, ErrUnknownBinaryFormat)
+	})
+}
+
+func TestCheckBinaryPlatform(t *testing.T) {
+	host := RuntimePlatform()
+	for _, fixture := range []string{"linux_amd64.elf", "linux_arm64.elf", "darwin_arm64.macho", "windows_amd64.exe"} {
+		t.Run(fixture, func(t *testing.T) {
+			path := filepath.Join("testdata/binaries", fixture)
+			platforms, err := ReadBinaryPlatforms(path)
+			require.NoError(t, err)
+
+			err = CheckBinaryPlatform(path)
+			if platforms[0] == host {
+				require.NoError(t, err)
+				return
+			}
+			require.Equal(t, ErrBinaryPlatform{Path: path, Binary: platforms, Runtime: host}, err)
+			require.ErrorContains(t, err, "is built for "+platforms[0].String())
+			require.ErrorContains(t, err, "--docker-build")
+		})
+	}
+
+	t.Run("universal binary", func(t *testing.T) {
+		err := CheckBinaryPlatform("testdata/binaries/darwin_universal.macho")
+		if host.OS == "darwin" && (host.Arch == "amd64" || host.Arch == "arm64") {
+			require.NoError(t, err)
+		} else {
+			require.ErrorContains(t, err, "is built for darwin/amd64, darwin/arm64")
+		}
+	})
+}
+
+// installFixture installs the binary fixture as an executable like a built binary.
+func installFixture(t *testing.T, fixture string) string {
+	data, err := os.ReadFile(filepath.Join("testdata/binaries", fixture))
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), fixture)
+	require.NoError(t, os.WriteFile(path, data, 0o755))
+	return path
+}
+
+func TestChainCheckBinaryPlatform(t *testing.T) {
+	foreign := installFixture(t, "windows_amd64.exe")
+	if RuntimePlatform().String() == "windows/amd64" {
+		foreign = installFixture(t, "linux_amd64.elf")
+	}
+	ev := events.NewBus(events.WithCustomBufferSize(10))
+
+	t.Run("binary for another platform", func(t *testing.T) {
+		c := Chain{ev: ev}
+		require.ErrorAs(t, c.checkBinaryPlatform(foreign), &ErrBinaryPlatform{})
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		c := Chain{ev: ev}
+		require.NoError(t, c.checkBinaryPlatform(installFixture(t, "script.sh")))
+	})
+}
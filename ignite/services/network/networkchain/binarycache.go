@@ -1,9 +1,15 @@
 package networkchain
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
 	"github.com/ignite/cli/ignite/chainconfig"
 	"github.com/ignite/cli/ignite/pkg/checksum"
 	"github.com/ignite/cli/ignite/pkg/confile"
+	"github.com/ignite/cli/ignite/pkg/gocmd"
 	"github.com/ignite/cli/ignite/pkg/xfilepath"
 )
 
@@ -11,28 +17,73 @@ const (
 	SPNCacheDirectory    = "spn"
 	BinaryCacheDirectory = "binary-cache"
 	BinaryCacheFilename  = "checksums.yml"
+
+	GenesisDownloadDirectory = "genesis-download"
 )
 
 type BinaryCacheList struct {
 	CachedBinaries []Binary `yaml:"cached_binaries"`
 }
 
-// Binary associates launch id with build hash where build hash is sha256(binary, source)
+// Binary associates launch id with build hash where build hash is sha256(binary, source, toolchain)
 type Binary struct {
 	LaunchID  uint64
 	BuildHash string
+
+	// Toolchain is the key of the toolchain the binary was built with.
+	Toolchain string
 }
 
-func (l *BinaryCacheList) Set(launchID uint64, buildHash string) {
+// Toolchain is the Go environment a chain binary is built with, a binary built with
+// another toolchain can behave differently and must be rebuilt.
+type Toolchain struct {
+	GoVersion string
+	GOOS      string
+	GOARCH    string
+
+	// BuildFlags are the Go environment variables changing the build output.
+	BuildFlags []string
+}
+
+// toolchainEnv are the Go environment variables of the toolchain, the variables following
+// GOARCH are the build flags.
+var toolchainEnv = []string{"GOVERSION", "GOOS", "GOARCH", "CGO_ENABLED", "GOFLAGS", "GOAMD64", "GOARM"}
+
+// CurrentToolchain returns the toolchain of the Go environment.
+func CurrentToolchain(ctx context.Context) (Toolchain, error) {
+	values, err := gocmd.Env(ctx, toolchainEnv)
+	if err != nil {
+		return Toolchain{}, err
+	}
+
+	toolchain := Toolchain{
+		GoVersion: values[0],
+		GOOS:      values[1],
+		GOARCH:    values[2],
+	}
+	for i, name := range toolchainEnv[3:] {
+		toolchain.BuildFlags = append(toolchain.BuildFlags, name+"="+values[i+3])
+	}
+	return toolchain, nil
+}
+
+// Key returns the key of the toolchain in the build hashes.
+func (t Toolchain) Key() string {
+	return checksum.Strings(t.GoVersion, t.GOOS, t.GOARCH, strings.Join(t.BuildFlags, " "))
+}
+
+func (l *BinaryCacheList) Set(launchID uint64, buildHash, toolchain string) {
 	for i, binary := range l.CachedBinaries {
 		if binary.LaunchID == launchID {
 			l.CachedBinaries[i].BuildHash = buildHash
+			l.CachedBinaries[i].Toolchain = toolchain
 			return
 		}
 	}
 	l.CachedBinaries = append(l.CachedBinaries, Binary{
 		LaunchID:  launchID,
 		BuildHash: buildHash,
+		Toolchain: toolchain,
 	})
 }
 
@@ -45,42 +96,114 @@ func (l *BinaryCacheList) Get(launchID uint64) (string, bool) {
 	return "", false
 }
 
-// cacheBinaryForLaunchID caches hash sha256(sha256(binary) + sourcehash) for launch id
-func cacheBinaryForLaunchID(launchID uint64, binaryHash, sourceHash string) error {
-	cachePath, err := getBinaryCacheFilepath()
+// ListBinaryCache returns the cached binaries.
+func ListBinaryCache() ([]Binary, error) {
+	cacheList, err := loadBinaryCache()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	cacheList := BinaryCacheList{}
-	err = confile.New(confile.DefaultYAMLEncodingCreator, cachePath).Load(&cacheList)
+	return cacheList.CachedBinaries, nil
+}
+
+// PurgeBinaryCache removes the cached binaries built with another toolchain than the toolchain
+// and returns the removed binaries.
+func PurgeBinaryCache(toolchain Toolchain) ([]Binary, error) {
+	cacheList, err := loadBinaryCache()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		key    = toolchain.Key()
+		kept   []Binary
+		purged []Binary
+	)
+	for _, binary := range cacheList.CachedBinaries {
+		if binary.Toolchain == key {
+			kept = append(kept, binary)
+		} else {
+			purged = append(purged, binary)
+		}
+	}
+	if len(purged) == 0 {
+		return nil, nil
+	}
+
+	cacheList.CachedBinaries = kept
+	return purged, saveBinaryCache(cacheList)
+}
+
+// buildHash returns the hash sha256(sha256(binary) + sourcehash + toolchain) of a built binary.
+func buildHash(binaryHash, sourceHash string, toolchain Toolchain) string {
+	return checksum.Strings(binaryHash, sourceHash, toolchain.Key())
+}
+
+// cacheBinaryForLaunchID caches the build hash of the binary for launch id
+func cacheBinaryForLaunchID(launchID uint64, binaryHash, sourceHash string, toolchain Toolchain) error {
+	cacheList, err := loadBinaryCache()
 	if err != nil {
 		return err
 	}
-	cacheList.Set(launchID, checksum.Strings(binaryHash, sourceHash))
+	cacheList.Set(launchID, buildHash(binaryHash, sourceHash, toolchain), toolchain.Key())
 
-	return confile.New(confile.DefaultYAMLEncodingCreator, cachePath).Save(cacheList)
+	return saveBinaryCache(cacheList)
 }
 
-// checkBinaryCacheForLaunchID checks if binary for the given launch was already built
-func checkBinaryCacheForLaunchID(launchID uint64, binaryHash, sourceHash string) (bool, error) {
-	cachePath, err := getBinaryCacheFilepath()
+// checkBinaryCacheForLaunchID checks if binary for the given launch was already built with the toolchain
+func checkBinaryCacheForLaunchID(launchID uint64, binaryHash, sourceHash string, toolchain Toolchain) (bool, error) {
+	cacheList, err := loadBinaryCache()
 	if err != nil {
 		return false, err
 	}
+	hash, ok := cacheList.Get(launchID)
+	return ok && hash == buildHash(binaryHash, sourceHash, toolchain), nil
+}
+
+func loadBinaryCache() (BinaryCacheList, error) {
+	cachePath, err := getBinaryCacheFilepath()
+	if err != nil {
+		return BinaryCacheList{}, err
+	}
 	cacheList := BinaryCacheList{}
 	err = confile.New(confile.DefaultYAMLEncodingCreator, cachePath).Load(&cacheList)
+	return cacheList, err
+}
+
+func saveBinaryCache(cacheList BinaryCacheList) error {
+	cachePath, err := getBinaryCacheFilepath()
 	if err != nil {
-		return false, err
+		return err
 	}
-	buildHash, ok := cacheList.Get(launchID)
-	return ok && buildHash == checksum.Strings(binaryHash, sourceHash), nil
+	return confile.New(confile.DefaultYAMLEncodingCreator, cachePath).Save(cacheList)
 }
 
 func getBinaryCacheFilepath() (string, error) {
-	return xfilepath.Join(
-		chainconfig.ConfigDirPath,
-		xfilepath.Path(SPNCacheDirectory),
-		xfilepath.Path(BinaryCacheDirectory),
-		xfilepath.Path(BinaryCacheFilename),
+	return paths.CachePath(
+		xfilepath.Join(
+			chainconfig.ConfigDirPath,
+			xfilepath.Path(SPNCacheDirectory),
+			xfilepath.Path(BinaryCacheDirectory),
+			xfilepath.Path(BinaryCacheFilename),
+		),
+		SPNCacheDirectory,
+		BinaryCacheDirectory,
+		BinaryCacheFilename,
+	)()
+}
+
+// genesisResumeFile returns the file keeping the partial download of the genesis from the URL.
+func genesisResumeFile(genesisURL string) (string, error) {
+	sum := sha256.Sum256([]byte(genesisURL))
+	filename := hex.EncodeToString(sum[:]) + ".json"
+	return paths.CachePath(
+		xfilepath.Join(
+			chainconfig.ConfigDirPath,
+			xfilepath.Path(SPNCacheDirectory),
+			xfilepath.Path(GenesisDownloadDirectory),
+			xfilepath.Path(filename),
+		),
+		SPNCacheDirectory,
+		GenesisDownloadDirectory,
+		filename,
 	)()
 }
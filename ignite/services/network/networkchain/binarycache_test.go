@@ -0,0 +1,104 @@
+package networkchain
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testToolchain = Toolchain{
+	GoVersion:  "go1.18.5",
+	GOOS:       "linux",
+	GOARCH:     "amd64",
+	BuildFlags: []string{"CGO_ENABLED=1", "GOFLAGS=", "GOAMD64=v1", "GOARM="},
+}
+
+func TestBuildHash(t *testing.T) {
+	hash := buildHash("binary", "source", testToolchain)
+	require.Equal(t, hash, buildHash("binary", "source", testToolchain))
+
+	tests := []struct {
+		name   string
+		modify func(*Toolchain)
+	}{
+		{
+			name:   "Go version",
+			modify: func(t *Toolchain) { t.GoVersion = "go1.19" },
+		},
+		{
+			name:   "GOOS",
+			modify: func(t *Toolchain) { t.GOOS = "darwin" },
+		},
+		{
+			name:   "GOARCH",
+			modify: func(t *Toolchain) { t.GOARCH = "arm64" },
+		},
+		{
+			name:   "build flags",
+			modify: func(t *Toolchain) { t.BuildFlags = []string{"CGO_ENABLED=0", "GOFLAGS=", "GOAMD64=v1", "GOARM="} },
+		},
+	}
+	for _, tt := range tests {
+		t.Run("different "+tt.name, func(t *testing.T) {
+			toolchain := testToolchain
+			toolchain.BuildFlags = append([]string{}, testToolchain.BuildFlags...)
+			tt.modify(&toolchain)
+
+			require.NotEqual(t, testToolchain.Key(), toolchain.Key())
+			require.NotEqual(t, hash, buildHash("binary", "source", toolchain))
+		})
+	}
+
+	require.NotEqual(t, hash, buildHash("binary", "other", testToolchain))
+	require.NotEqual(t, hash, buildHash("other", "source", testToolchain))
+}
+
+func TestCurrentToolchain(t *testing.T) {
+	toolchain, err := CurrentToolchain(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, toolchain.GoVersion)
+	require.Equal(t, runtime.GOOS, toolchain.GOOS)
+	require.Len(t, toolchain.BuildFlags, len(toolchainEnv)-3)
+}
+
+func TestBinaryCache(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	upgraded := testToolchain
+	upgraded.GoVersion = "go1.19"
+
+	require.NoError(t, cacheBinaryForLaunchID(1, "binary1", "source1", testToolchain))
+	require.NoError(t, cacheBinaryForLaunchID(2, "binary2", "source2", upgraded))
+
+	match, err := checkBinaryCacheForLaunchID(1, "binary1", "source1", testToolchain)
+	require.NoError(t, err)
+	require.True(t, match)
+
+	// a binary built before a toolchain upgrade is not reused
+	match, err = checkBinaryCacheForLaunchID(1, "binary1", "source1", upgraded)
+	require.NoError(t, err)
+	require.False(t, match)
+
+	binaries, err := ListBinaryCache()
+	require.NoError(t, err)
+	require.Len(t, binaries, 2)
+
+	purged, err := PurgeBinaryCache(upgraded)
+	require.NoError(t, err)
+	require.Equal(t, []Binary{{
+		LaunchID:  1,
+		BuildHash: buildHash("binary1", "source1", testToolchain),
+		Toolchain: testToolchain.Key(),
+	}}, purged)
+
+	binaries, err = ListBinaryCache()
+	require.NoError(t, err)
+	require.Len(t, binaries, 1)
+	require.EqualValues(t, 2, binaries[0].LaunchID)
+
+	purged, err = PurgeBinaryCache(upgraded)
+	require.NoError(t, err)
+	require.Empty(t, purged)
+}
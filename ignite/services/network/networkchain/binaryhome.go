@@ -0,0 +1,77 @@
+package networkchain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/goenv"
+)
+
+// reDefaultHome matches the default value of the home flag in the help of a Cosmos SDK command.
+var reDefaultHome = regexp.MustCompile(`--home string[^\n]*\(default "([^"]+)"\)`)
+
+// WithBinaryName replaces the name of the chain binary guessed from the repository,
+// it's used for the chains building a binary with another name or building several binaries.
+func WithBinaryName(name string) Option {
+	return func(c *Chain) {
+		c.binaryName = name
+	}
+}
+
+// WithDefaultHome replaces the default home of the chain binary guessed from the repository.
+func WithDefaultHome(home string) Option {
+	return func(c *Chain) {
+		c.defaultHome = home
+	}
+}
+
+// checkDefaultHome checks the default home of the built binary is the default home of the chain,
+// a warning is sent when the binary uses another home since the chain files would be written in a home
+// the binary doesn't read without the home flag.
+func (c Chain) checkDefaultHome(ctx context.Context, binaryName string) {
+	ev := c.ev.WithCategory(events.CategoryBuild)
+
+	defaultHome, err := c.chain.DefaultHome()
+	if err != nil {
+		return
+	}
+
+	binaryHome, err := probeDefaultHome(ctx, binaryName)
+	if err != nil {
+		ev.Send(events.NewDebug(fmt.Sprintf("Cannot read the default home of the chain binary: %s", err)))
+		return
+	}
+
+	if filepath.Clean(os.ExpandEnv(defaultHome)) != filepath.Clean(binaryHome) {
+		ev.Send(events.NewNeutral(fmt.Sprintf(
+			"The chain binary %s uses the default home %s instead of %s, set the default home of the chain or run the binary with --home",
+			binaryName,
+			binaryHome,
+			defaultHome,
+		)))
+	}
+}
+
+// probeDefaultHome returns the default home of the chain binary from the help of its init command.
+// The binary is looked up in the PATH, then in the Go bin directory where the chain is installed.
+func probeDefaultHome(ctx context.Context, binary string) (string, error) {
+	if _, err := exec.LookPath(binary); err != nil && !filepath.IsAbs(binary) {
+		binary = filepath.Join(goenv.Bin(), binary)
+	}
+
+	out, err := exec.CommandContext(ctx, binary, "init", "--help").CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", err, out)
+	}
+
+	m := reDefaultHome.FindSubmatch(out)
+	if m == nil {
+		return "", fmt.Errorf("no default home in the help of the init command of %s", binary)
+	}
+	return string(m[1]), nil
+}
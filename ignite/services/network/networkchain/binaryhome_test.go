@@ -0,0 +1,105 @@
+package networkchain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+// fakeBinary writes a script printing the help of an init command with the default home.
+func fakeBinary(t *testing.T, name, defaultHome string) string {
+	help := "Initialize private validator, p2p, genesis, and application configuration files\n\n" +
+		"Flags:\n" +
+		"      --chain-id string   genesis file chain-id\n" +
+		"  -h, --help              help for init\n"
+	if defaultHome != "" {
+		help += `      --home string       The application home directory (default "` + defaultHome + "\")\n"
+	}
+
+	path := filepath.Join(t.TempDir(), name)
+	script := "#!/bin/sh\ncat <<'EOF'\n" + help + "EOF\n"
+	require.NoError(t, os.WriteFile(path, []byte(script), 0o755))
+	return path
+}
+
+// fakeChainSource writes the source of a chain with an empty config.
+func fakeChainSource(t *testing.T) string {
+	path := t.TempDir()
+	gomod := "module github.com/ignite/mars\n\ngo 1.18\n\nrequire github.com/cosmos/cosmos-sdk v0.46.1\n"
+	require.NoError(t, os.WriteFile(filepath.Join(path, "go.mod"), []byte(gomod), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(path, "config.yml"), []byte("accounts: []\n"), 0o644))
+	return path
+}
+
+func TestProbeDefaultHome(t *testing.T) {
+	t.Run("default home of the binary", func(t *testing.T) {
+		home, err := probeDefaultHome(context.Background(), fakeBinary(t, "appd", "/home/ignite/.app"))
+		require.NoError(t, err)
+		require.Equal(t, "/home/ignite/.app", home)
+	})
+
+	t.Run("binary without home flag", func(t *testing.T) {
+		_, err := probeDefaultHome(context.Background(), fakeBinary(t, "appd", ""))
+		require.ErrorContains(t, err, "no default home in the help of the init command")
+	})
+
+	t.Run("failing binary", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "appd")
+		require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\necho unknown command >&2\nexit 1\n"), 0o755))
+
+		_, err := probeDefaultHome(context.Background(), path)
+		require.ErrorContains(t, err, "unknown command")
+	})
+}
+
+func TestCheckDefaultHome(t *testing.T) {
+	tests := []struct {
+		name        string
+		defaultHome string
+		binaryHome  string
+		warning     string
+	}{
+		{
+			name:        "binary with the default home",
+			defaultHome: "/home/ignite/.mars",
+			binaryHome:  "/home/ignite/.mars",
+		},
+		{
+			name:        "binary with another default home",
+			defaultHome: "/home/ignite/.mars",
+			binaryHome:  "/home/ignite/.marsapp",
+			warning:     "uses the default home /home/ignite/.marsapp instead of /home/ignite/.mars",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ch, err := chain.New(fakeChainSource(t), chain.DefaultHome(tt.defaultHome))
+			require.NoError(t, err)
+
+			var (
+				ev     = events.NewBus(events.WithCustomBufferSize(10))
+				c      = Chain{chain: ch, ev: ev}
+				binary = fakeBinary(t, "marsd", tt.binaryHome)
+			)
+			c.checkDefaultHome(context.Background(), binary)
+
+			ev.Shutdown(context.Background())
+			var warnings []string
+			for e := range ev.Events() {
+				warnings = append(warnings, e.Description)
+			}
+			if tt.warning == "" {
+				require.Empty(t, warnings)
+			} else {
+				require.Len(t, warnings, 1)
+				require.Contains(t, warnings[0], tt.warning)
+			}
+		})
+	}
+}
@@ -0,0 +1,461 @@
+package networkchain
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+const (
+	// LaunchBundleVersion is the version of the launch bundle format.
+	// It must be incremented when the bundle content changes in a non backward compatible way.
+	LaunchBundleVersion = 1
+
+	bundleManifestFile  = "manifest.json"
+	bundleSignatureFile = "manifest.sig"
+	bundleGenesisFile   = "genesis.json"
+	bundlePeersFile     = "peers.json"
+	bundleLaunchFile    = "launch.json"
+)
+
+// ErrInvalidBundleSignature is returned when the signature of a launch bundle doesn't match its manifest.
+var ErrInvalidBundleSignature = errors.New("invalid launch bundle signature")
+
+type (
+	// LaunchBundleManifest describes the content of a launch bundle.
+	LaunchBundleManifest struct {
+		// Version is the version of the bundle format.
+		Version int `json:"version"`
+
+		// Checksums contains the sha256 checksum of each file of the bundle.
+		Checksums map[string]string `json:"checksums"`
+	}
+
+	// LaunchBundleMetadata contains the launch information of a launch bundle.
+	LaunchBundleMetadata struct {
+		LaunchID    uint64    `json:"launch_id"`
+		ChainID     string    `json:"chain_id"`
+		SourceURL   string    `json:"source_url"`
+		SourceHash  string    `json:"source_hash"`
+		GenesisHash string    `json:"genesis_hash"`
+		LaunchTime  time.Time `json:"launch_time"`
+	}
+
+	// LaunchBundlePeers contains the peers of the chain to connect to from a launch bundle.
+	LaunchBundlePeers struct {
+		PersistentPeers []string       `json:"persistent_peers"`
+		TunneledPeers   []TunneledPeer `json:"tunneled_peers,omitempty"`
+	}
+
+	// LaunchBundleSignature is the detached signature of the manifest of a launch bundle.
+	// The manifest contains the checksum of every other file of the bundle, the signature covers them all.
+	LaunchBundleSignature struct {
+		Signature []byte `json:"signature"`
+		PubKey    []byte `json:"pub_key"`
+	}
+
+	// ChainCoordinator resolves the address of the coordinator of a chain on SPN,
+	// network.Network implements it with ChainCoordinatorAddress.
+	ChainCoordinator interface {
+		ChainCoordinatorAddress(ctx context.Context, launchID uint64) (string, error)
+	}
+
+	// LaunchBundleOption configures the export and the import of a launch bundle.
+	LaunchBundleOption func(*launchBundleOptions)
+
+	launchBundleOptions struct {
+		signer           *cosmosaccount.Account
+		requireSignature bool
+	}
+
+	// launchBundlePaths contains the paths of the chain home files included in a launch bundle.
+	launchBundlePaths struct {
+		genesis   string
+		config    string
+		spnConfig string
+	}
+)
+
+// SignLaunchBundle signs the manifest of the exported launch bundle with the SPN key of the coordinator.
+func SignLaunchBundle(account cosmosaccount.Account) LaunchBundleOption {
+	return func(o *launchBundleOptions) {
+		o.signer = &account
+	}
+}
+
+// RequireLaunchBundleSignature refuses to import the unsigned launch bundles.
+func RequireLaunchBundleSignature() LaunchBundleOption {
+	return func(o *launchBundleOptions) {
+		o.requireSignature = true
+	}
+}
+
+// LaunchBundleSignBytes returns the bytes signed by the coordinator for the manifest of a launch bundle.
+func LaunchBundleSignBytes(manifest []byte) []byte {
+	return []byte("ignite-launch-bundle:" + sha256Hex(manifest))
+}
+
+// ExportLaunchBundle exports the final genesis and the peers of the prepared chain
+// into a tar.gz bundle that validators can install without preparing the chain.
+// The manifest of the bundle is signed with SignLaunchBundle.
+func (c Chain) ExportLaunchBundle(path string, options ...LaunchBundleOption) error {
+	paths, err := c.launchBundlePaths()
+	if err != nil {
+		return err
+	}
+
+	metadata := LaunchBundleMetadata{
+		LaunchID:   c.launchID,
+		ChainID:    c.id,
+		SourceURL:  c.url,
+		SourceHash: c.hash,
+		LaunchTime: c.launchTime,
+	}
+
+	return exportLaunchBundle(path, metadata, paths, options...)
+}
+
+// ImportLaunchBundle verifies the launch bundle checksums and signature and installs its
+// genesis and peers into the chain home. The signature must be made by the coordinator of the chain
+// of the bundle, resolved with coordinator. The unsigned bundles are imported without RequireLaunchBundleSignature.
+func (c Chain) ImportLaunchBundle(
+	ctx context.Context,
+	path string,
+	coordinator ChainCoordinator,
+	options ...LaunchBundleOption,
+) (LaunchBundleMetadata, error) {
+	paths, err := c.launchBundlePaths()
+	if err != nil {
+		return LaunchBundleMetadata{}, err
+	}
+	return importLaunchBundle(ctx, path, paths, c.launchID, coordinator, options...)
+}
+
+func (c Chain) launchBundlePaths() (paths launchBundlePaths, err error) {
+	if paths.genesis, err = c.GenesisPath(); err != nil {
+		return paths, err
+	}
+	if paths.config, err = c.ConfigTOMLPath(); err != nil {
+		return paths, err
+	}
+	if paths.spnConfig, err = c.SPNConfigPath(); err != nil {
+		return paths, err
+	}
+	return paths, nil
+}
+
+func exportLaunchBundle(path string, metadata LaunchBundleMetadata, paths launchBundlePaths, options ...LaunchBundleOption) error {
+	var o launchBundleOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	genesis, err := os.ReadFile(paths.genesis)
+	if err != nil {
+		return errors.Wrap(err, "cannot read the chain genesis")
+	}
+	metadata.GenesisHash = sha256Hex(genesis)
+
+	var peers LaunchBundlePeers
+	if peers.PersistentPeers, err = persistentPeers(paths.config); err != nil {
+		return errors.Wrap(err, "cannot read the chain peers")
+	}
+	spnConfig, err := GetSPNConfig(paths.spnConfig)
+	if err != nil {
+		return err
+	}
+	peers.TunneledPeers = spnConfig.TunneledPeers
+
+	peersFile, err := json.MarshalIndent(peers, "", "  ")
+	if err != nil {
+		return err
+	}
+	launchFile, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	files := map[string][]byte{
+		bundleGenesisFile: genesis,
+		bundlePeersFile:   peersFile,
+		bundleLaunchFile:  launchFile,
+	}
+
+	manifest := LaunchBundleManifest{
+		Version:   LaunchBundleVersion,
+		Checksums: make(map[string]string),
+	}
+	for name, content := range files {
+		manifest.Checksums[name] = sha256Hex(content)
+	}
+	if files[bundleManifestFile], err = json.MarshalIndent(manifest, "", "  "); err != nil {
+		return err
+	}
+	if o.signer != nil {
+		if files[bundleSignatureFile], err = signLaunchBundle(*o.signer, files[bundleManifestFile]); err != nil {
+			return err
+		}
+	}
+
+	return writeTarGz(path, files)
+}
+
+// signLaunchBundle returns the signature file of the manifest signed with the key of the account.
+func signLaunchBundle(account cosmosaccount.Account, manifest []byte) ([]byte, error) {
+	signature, pubKey, err := account.Sign(LaunchBundleSignBytes(manifest))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot sign the launch bundle")
+	}
+	if _, ok := pubKey.(*secp256k1.PubKey); !ok {
+		return nil, fmt.Errorf("cannot sign the launch bundle with a %s key, a secp256k1 key is required", pubKey.Type())
+	}
+	return json.MarshalIndent(LaunchBundleSignature{
+		Signature: signature,
+		PubKey:    pubKey.Bytes(),
+	}, "", "  ")
+}
+
+// verifyLaunchBundle verifies the signature of the manifest and returns the SPN address of the signer.
+func verifyLaunchBundle(signatureFile, manifest []byte) (signer string, err error) {
+	var signature LaunchBundleSignature
+	if err := json.Unmarshal(signatureFile, &signature); err != nil {
+		return "", errors.Wrap(err, "invalid launch bundle signature file")
+	}
+	if len(signature.PubKey) != secp256k1.PubKeySize {
+		return "", fmt.Errorf("%w: the public key must be a %d bytes secp256k1 key", ErrInvalidBundleSignature, secp256k1.PubKeySize)
+	}
+
+	pubKey := &secp256k1.PubKey{Key: signature.PubKey}
+	if !pubKey.VerifySignature(LaunchBundleSignBytes(manifest), signature.Signature) {
+		return "", ErrInvalidBundleSignature
+	}
+	return sdk.Bech32ifyAddressBytes(networktypes.SPN, pubKey.Address())
+}
+
+// importLaunchBundle verifies and installs the launch bundle, the bundle must be for the launch ID
+// unless the launch ID is zero. Nothing is written before the bundle is verified.
+func importLaunchBundle(
+	ctx context.Context,
+	path string,
+	paths launchBundlePaths,
+	launchID uint64,
+	coordinator ChainCoordinator,
+	options ...LaunchBundleOption,
+) (LaunchBundleMetadata, error) {
+	var o launchBundleOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+	if coordinator == nil {
+		return LaunchBundleMetadata{}, errors.New("the coordinator of the chain is required to verify the launch bundle")
+	}
+
+	files, err := readTarGz(path)
+	if err != nil {
+		return LaunchBundleMetadata{}, errors.Wrap(err, "cannot read the launch bundle")
+	}
+
+	manifestFile, ok := files[bundleManifestFile]
+	if !ok {
+		return LaunchBundleMetadata{}, errors.New("the launch bundle has no manifest")
+	}
+	var manifest LaunchBundleManifest
+	if err := json.Unmarshal(manifestFile, &manifest); err != nil {
+		return LaunchBundleMetadata{}, errors.Wrap(err, "invalid launch bundle manifest")
+	}
+	if manifest.Version > LaunchBundleVersion {
+		return LaunchBundleMetadata{}, fmt.Errorf(
+			"unsupported launch bundle version %d, the maximum supported version is %d",
+			manifest.Version,
+			LaunchBundleVersion,
+		)
+	}
+
+	// the signature covers the manifest, a file swapped with its checksum invalidates it
+	var signer string
+	switch signatureFile, ok := files[bundleSignatureFile]; {
+	case ok:
+		if signer, err = verifyLaunchBundle(signatureFile, manifestFile); err != nil {
+			return LaunchBundleMetadata{}, err
+		}
+	case o.requireSignature:
+		return LaunchBundleMetadata{}, errors.New("the launch bundle is not signed")
+	}
+
+	// all the files required to install the bundle must be present
+	for _, name := range []string{bundleGenesisFile, bundlePeersFile, bundleLaunchFile} {
+		if _, ok := files[name]; !ok {
+			return LaunchBundleMetadata{}, fmt.Errorf("the launch bundle has no %s file", name)
+		}
+	}
+
+	// the manifest must cover every file of the bundle and the files must match their checksum
+	names := make([]string, 0, len(files))
+	for name := range files {
+		if name != bundleManifestFile && name != bundleSignatureFile {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		expected, ok := manifest.Checksums[name]
+		if !ok {
+			return LaunchBundleMetadata{}, fmt.Errorf("the launch bundle manifest has no checksum for %s", name)
+		}
+		if actual := sha256Hex(files[name]); actual != expected {
+			return LaunchBundleMetadata{}, fmt.Errorf(
+				"invalid checksum for %s: expected %s, actual %s",
+				name,
+				expected,
+				actual,
+			)
+		}
+	}
+
+	var (
+		metadata LaunchBundleMetadata
+		peers    LaunchBundlePeers
+	)
+	if err := json.Unmarshal(files[bundleLaunchFile], &metadata); err != nil {
+		return LaunchBundleMetadata{}, errors.Wrap(err, "invalid launch bundle metadata")
+	}
+	if err := json.Unmarshal(files[bundlePeersFile], &peers); err != nil {
+		return LaunchBundleMetadata{}, errors.Wrap(err, "invalid launch bundle peers")
+	}
+	if launchID != 0 && metadata.LaunchID != launchID {
+		return LaunchBundleMetadata{}, fmt.Errorf(
+			"the launch bundle is for the launch %d, expected %d",
+			metadata.LaunchID,
+			launchID,
+		)
+	}
+
+	// the signer must be the coordinator of the chain the bundle is for
+	if signer != "" {
+		coordinatorAddr, err := coordinator.ChainCoordinatorAddress(ctx, metadata.LaunchID)
+		if err != nil {
+			return LaunchBundleMetadata{}, errors.Wrapf(err, "cannot fetch the coordinator of the launch %d", metadata.LaunchID)
+		}
+		if signer != coordinatorAddr {
+			return LaunchBundleMetadata{}, fmt.Errorf(
+				"%w: the launch bundle is signed by %s, not by the coordinator %s",
+				ErrInvalidBundleSignature,
+				signer,
+				coordinatorAddr,
+			)
+		}
+	}
+
+	// install the bundle into the chain home
+	if err := os.MkdirAll(filepath.Dir(paths.genesis), 0o755); err != nil {
+		return LaunchBundleMetadata{}, err
+	}
+	if err := os.WriteFile(paths.genesis, files[bundleGenesisFile], 0o644); err != nil {
+		return LaunchBundleMetadata{}, err
+	}
+	if len(peers.PersistentPeers) > 0 {
+		if err := setPersistentPeers(paths.config, peers.PersistentPeers, len(peers.TunneledPeers) > 0); err != nil {
+			return LaunchBundleMetadata{}, err
+		}
+	}
+	// the disk advice is specific to the chain home it was made for, it's not installed
+	if len(peers.TunneledPeers) > 0 {
+		spnConfig := Config{TunneledPeers: peers.TunneledPeers}
+		if err := SetSPNConfig(spnConfig, paths.spnConfig); err != nil {
+			return LaunchBundleMetadata{}, err
+		}
+	}
+
+	return metadata, nil
+}
+
+// writeTarGz writes the files into a tar.gz archive, files are sorted by name for reproducibility.
+func writeTarGz(path string, files map[string][]byte) error {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for _, name := range names {
+		if err := tw.WriteHeader(&tar.Header{
+			Name: name,
+			Mode: 0o644,
+			Size: int64(len(files[name])),
+		}); err != nil {
+			return err
+		}
+		if _, err := tw.Write(files[name]); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gw.Close(); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+// readTarGz reads all the regular files of a tar.gz archive.
+func readTarGz(path string) (map[string][]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer gr.Close()
+
+	files := make(map[string][]byte)
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		files[filepath.Clean(header.Name)] = content
+	}
+	return files, nil
+}
+
+func sha256Hex(content []byte) string {
+	h := sha256.Sum256(content)
+	return hex.EncodeToString(h[:])
+}
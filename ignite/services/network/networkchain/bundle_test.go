@@ -0,0 +1,246 @@
+package networkchain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+const sampleConfigToml = `[p2p]
+persistent_peers = ""
+`
+
+// chainCoordinators resolves the coordinators of the chains by launch ID.
+type chainCoordinators map[uint64]string
+
+func (c chainCoordinators) ChainCoordinatorAddress(_ context.Context, launchID uint64) (string, error) {
+	addr, ok := c[launchID]
+	if !ok {
+		return "", errors.New("chain not found")
+	}
+	return addr, nil
+}
+
+func newBundleHome(t *testing.T, genesis, configToml string) launchBundlePaths {
+	dir := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	paths := launchBundlePaths{
+		genesis:   filepath.Join(dir, "genesis.json"),
+		config:    filepath.Join(dir, "config.toml"),
+		spnConfig: filepath.Join(dir, SPNConfigFile),
+	}
+	if genesis != "" {
+		require.NoError(t, os.WriteFile(paths.genesis, []byte(genesis), 0o644))
+	}
+	require.NoError(t, os.WriteFile(paths.config, []byte(configToml), 0o644))
+	return paths
+}
+
+func TestLaunchBundle(t *testing.T) {
+	var (
+		genesis  = `{"chain_id":"foo-1","app_state":{}}`
+		metadata = LaunchBundleMetadata{
+			LaunchID:   1,
+			ChainID:    "foo-1",
+			SourceURL:  "https://github.com/foo/foo",
+			SourceHash: "0xaaa",
+			LaunchTime: time.Unix(1000, 0).UTC(),
+		}
+		peers = []string{
+			"9b1f4adbfb0c0b513040d914bfb717303c0eaa71@1.2.3.4:26656",
+			"e6a59e37b2761f26a21c9168f78a7f2b07c120c7@127.0.0.1:22001",
+		}
+		tunneledPeer = TunneledPeer{
+			Name:      HTTPTunnelChisel,
+			Address:   "https://foo.gitpod.io",
+			NodeID:    "e6a59e37b2761f26a21c9168f78a7f2b07c120c7",
+			LocalPort: "22001",
+		}
+	)
+
+	t.Run("export and import a launch bundle", func(t *testing.T) {
+		src := newBundleHome(t, genesis, sampleConfigToml)
+		require.NoError(t, setPersistentPeers(src.config, peers, true))
+		require.NoError(t, SetSPNConfig(Config{TunneledPeers: []TunneledPeer{tunneledPeer}}, src.spnConfig))
+
+		bundle := filepath.Join(t.TempDir(), "bundle.tar.gz")
+		require.NoError(t, exportLaunchBundle(bundle, metadata, src))
+
+		dst := newBundleHome(t, "", sampleConfigToml)
+		imported, err := importLaunchBundle(context.Background(), bundle, dst, 1, chainCoordinators{})
+		require.NoError(t, err)
+
+		srcGenesis, err := os.ReadFile(src.genesis)
+		require.NoError(t, err)
+		dstGenesis, err := os.ReadFile(dst.genesis)
+		require.NoError(t, err)
+		require.Equal(t, sha256Hex(srcGenesis), sha256Hex(dstGenesis))
+
+		expected := metadata
+		expected.GenesisHash = sha256Hex(srcGenesis)
+		require.Equal(t, expected, imported)
+
+		dstPeers, err := persistentPeers(dst.config)
+		require.NoError(t, err)
+		require.Equal(t, peers, dstPeers)
+
+		spnConfig, err := GetSPNConfig(dst.spnConfig)
+		require.NoError(t, err)
+		require.Equal(t, []TunneledPeer{tunneledPeer}, spnConfig.TunneledPeers)
+	})
+
+	t.Run("import a tampered launch bundle", func(t *testing.T) {
+		src := newBundleHome(t, genesis, sampleConfigToml)
+		bundle := filepath.Join(t.TempDir(), "bundle.tar.gz")
+		require.NoError(t, exportLaunchBundle(bundle, metadata, src))
+
+		files, err := readTarGz(bundle)
+		require.NoError(t, err)
+		files[bundleGenesisFile] = []byte(`{"chain_id":"bar-1"}`)
+		require.NoError(t, writeTarGz(bundle, files))
+
+		dst := newBundleHome(t, "", sampleConfigToml)
+		_, err = importLaunchBundle(context.Background(), bundle, dst, 1, chainCoordinators{})
+		require.ErrorContains(t, err, "invalid checksum for genesis.json")
+		require.NoFileExists(t, dst.genesis)
+	})
+
+	t.Run("import a launch bundle of another launch", func(t *testing.T) {
+		src := newBundleHome(t, genesis, sampleConfigToml)
+		bundle := filepath.Join(t.TempDir(), "bundle.tar.gz")
+		require.NoError(t, exportLaunchBundle(bundle, metadata, src))
+
+		// nothing is installed
+		dst := newBundleHome(t, "", sampleConfigToml)
+		_, err := importLaunchBundle(context.Background(), bundle, dst, 2, chainCoordinators{})
+		require.EqualError(t, err, "the launch bundle is for the launch 1, expected 2")
+		require.NoFileExists(t, dst.genesis)
+		require.NoFileExists(t, dst.spnConfig)
+	})
+
+	t.Run("import a launch bundle with an unsupported version", func(t *testing.T) {
+		bundle := filepath.Join(t.TempDir(), "bundle.tar.gz")
+		require.NoError(t, writeTarGz(bundle, map[string][]byte{
+			bundleManifestFile: []byte(`{"version":100}`),
+		}))
+
+		_, err := importLaunchBundle(context.Background(), bundle, newBundleHome(t, "", sampleConfigToml), 1, chainCoordinators{})
+		require.ErrorContains(t, err, "unsupported launch bundle version 100")
+	})
+}
+
+func TestLaunchBundleSignature(t *testing.T) {
+	var (
+		genesis  = `{"chain_id":"foo-1","app_state":{}}`
+		metadata = LaunchBundleMetadata{LaunchID: 1, ChainID: "foo-1"}
+	)
+
+	registry, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+	coordinator, _, err := registry.Create("coordinator")
+	require.NoError(t, err)
+	coordinatorAddr, err := coordinator.Address(networktypes.SPN)
+	require.NoError(t, err)
+	other, _, err := registry.Create("other")
+	require.NoError(t, err)
+	coordinators := chainCoordinators{1: coordinatorAddr}
+
+	exportBundle := func(t *testing.T, options ...LaunchBundleOption) string {
+		bundle := filepath.Join(t.TempDir(), "bundle.tar.gz")
+		src := newBundleHome(t, genesis, sampleConfigToml)
+		require.NoError(t, exportLaunchBundle(bundle, metadata, src, options...))
+		return bundle
+	}
+
+	t.Run("import a launch bundle signed by the coordinator", func(t *testing.T) {
+		bundle := exportBundle(t, SignLaunchBundle(coordinator))
+
+		files, err := readTarGz(bundle)
+		require.NoError(t, err)
+		require.Contains(t, files, bundleSignatureFile)
+
+		dst := newBundleHome(t, "", sampleConfigToml)
+		_, err = importLaunchBundle(context.Background(), bundle, dst, 1, coordinators, RequireLaunchBundleSignature())
+		require.NoError(t, err)
+		require.FileExists(t, dst.genesis)
+	})
+
+	t.Run("import a launch bundle with a swapped file", func(t *testing.T) {
+		bundle := exportBundle(t, SignLaunchBundle(coordinator))
+
+		// the checksum of the swapped genesis is updated in the manifest
+		files, err := readTarGz(bundle)
+		require.NoError(t, err)
+		var manifest LaunchBundleManifest
+		require.NoError(t, json.Unmarshal(files[bundleManifestFile], &manifest))
+		files[bundleGenesisFile] = []byte(`{"chain_id":"bar-1"}`)
+		manifest.Checksums[bundleGenesisFile] = sha256Hex(files[bundleGenesisFile])
+		files[bundleManifestFile], err = json.Marshal(manifest)
+		require.NoError(t, err)
+		require.NoError(t, writeTarGz(bundle, files))
+
+		dst := newBundleHome(t, "", sampleConfigToml)
+		_, err = importLaunchBundle(context.Background(), bundle, dst, 1, coordinators)
+		require.ErrorIs(t, err, ErrInvalidBundleSignature)
+		require.NoFileExists(t, dst.genesis)
+	})
+
+	t.Run("import a launch bundle with a file out of the manifest", func(t *testing.T) {
+		bundle := exportBundle(t, SignLaunchBundle(coordinator))
+
+		files, err := readTarGz(bundle)
+		require.NoError(t, err)
+		files["app.toml"] = []byte(`pruning = "nothing"`)
+		require.NoError(t, writeTarGz(bundle, files))
+
+		_, err = importLaunchBundle(context.Background(), bundle, newBundleHome(t, "", sampleConfigToml), 1, coordinators)
+		require.ErrorContains(t, err, "the launch bundle manifest has no checksum for app.toml")
+	})
+
+	t.Run("import a launch bundle signed by another account", func(t *testing.T) {
+		bundle := exportBundle(t, SignLaunchBundle(other))
+
+		dst := newBundleHome(t, "", sampleConfigToml)
+		_, err := importLaunchBundle(context.Background(), bundle, dst, 1, coordinators)
+		require.ErrorIs(t, err, ErrInvalidBundleSignature)
+		require.ErrorContains(t, err, "not by the coordinator "+coordinatorAddr)
+		require.NoFileExists(t, dst.genesis)
+	})
+
+	t.Run("import a launch bundle of an unknown chain", func(t *testing.T) {
+		bundle := exportBundle(t, SignLaunchBundle(coordinator))
+
+		dst := newBundleHome(t, "", sampleConfigToml)
+		_, err := importLaunchBundle(context.Background(), bundle, dst, 0, chainCoordinators{})
+		require.EqualError(t, err, "cannot fetch the coordinator of the launch 1: chain not found")
+		require.NoFileExists(t, dst.genesis)
+	})
+
+	t.Run("import a launch bundle without coordinator", func(t *testing.T) {
+		bundle := exportBundle(t, SignLaunchBundle(coordinator))
+
+		_, err := importLaunchBundle(context.Background(), bundle, newBundleHome(t, "", sampleConfigToml), 1, nil)
+		require.EqualError(t, err, "the coordinator of the chain is required to verify the launch bundle")
+	})
+
+	t.Run("import an unsigned launch bundle", func(t *testing.T) {
+		bundle := exportBundle(t)
+
+		_, err := importLaunchBundle(context.Background(), bundle, newBundleHome(t, "", sampleConfigToml), 1, coordinators)
+		require.NoError(t, err)
+
+		dst := newBundleHome(t, "", sampleConfigToml)
+		_, err = importLaunchBundle(context.Background(), bundle, dst, 1, coordinators, RequireLaunchBundleSignature())
+		require.ErrorContains(t, err, "the launch bundle is not signed")
+		require.NoFileExists(t, dst.genesis)
+	})
+}
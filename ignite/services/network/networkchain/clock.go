@@ -0,0 +1,38 @@
+package networkchain
+
+import (
+	"context"
+	"time"
+)
+
+// Clock reads the current time and waits for durations.
+// The chain reads the time only through its clock to let the tests control the time.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+// WithClock sets the clock of the chain, the system clock is used by default.
+func WithClock(clock Clock) Option {
+	return func(c *Chain) {
+		c.clock = clock
+	}
+}
+
+// withDeadline returns a copy of ctx canceled once the clock reaches the deadline.
+func withDeadline(ctx context.Context, clock Clock, deadline time.Time) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-clock.After(deadline.Sub(clock.Now())):
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// withTimeout returns a copy of ctx canceled once the timeout elapsed on the clock.
+func withTimeout(ctx context.Context, clock Clock, timeout time.Duration) (context.Context, context.CancelFunc) {
+	return withDeadline(ctx, clock, clock.Now().Add(timeout))
+}
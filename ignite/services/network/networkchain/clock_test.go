@@ -0,0 +1,81 @@
+package networkchain
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/services/network/networkchain/networkchaintest"
+)
+
+// TestNoSystemTime checks the package reads the time only through the clock of the chain.
+func TestNoSystemTime(t *testing.T) {
+	forbidden := map[string][]string{
+		"time":    {"Now", "Since", "Until", "Sleep", "After", "AfterFunc", "Tick", "NewTimer", "NewTicker"},
+		"context": {"WithDeadline", "WithTimeout"},
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	require.NoError(t, err)
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			// the imports can be renamed, the calls are matched by import path
+			imports := make(map[string]string)
+			for _, spec := range file.Imports {
+				path, _ := strconv.Unquote(spec.Path.Value)
+				name := path[strings.LastIndex(path, "/")+1:]
+				if spec.Name != nil {
+					name = spec.Name.Name
+				}
+				imports[name] = path
+			}
+
+			ast.Inspect(file, func(n ast.Node) bool {
+				sel, ok := n.(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+				ident, ok := sel.X.(*ast.Ident)
+				if !ok {
+					return true
+				}
+				for _, name := range forbidden[imports[ident.Name]] {
+					if sel.Sel.Name == name {
+						t.Errorf("%s: %s.%s reads the system time, use the clock of the chain", fset.Position(sel.Pos()), ident.Name, name)
+					}
+				}
+				return true
+			})
+		}
+	}
+}
+
+func TestWithDeadline(t *testing.T) {
+	var (
+		start = time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC)
+		clock = networkchaintest.NewClock(start)
+	)
+
+	ctx, cancel := withTimeout(context.Background(), clock, time.Minute)
+	defer cancel()
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Second)
+	require.NoError(t, ctx.Err())
+
+	clock.Advance(time.Minute)
+	<-ctx.Done()
+	require.Equal(t, 0, clock.Waiters())
+}
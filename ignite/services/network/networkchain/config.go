@@ -1,7 +1,12 @@
 package networkchain
 
 import (
+	"errors"
+	"os"
 	"path/filepath"
+	"strings"
+
+	"github.com/pelletier/go-toml"
 
 	"github.com/ignite/cli/ignite/pkg/confile"
 	"github.com/ignite/cli/ignite/pkg/cosmosutil"
@@ -48,3 +53,52 @@ func (c *Chain) SPNConfigPath() (string, error) {
 	}
 	return filepath.Join(home, cosmosutil.ChainConfigDir, SPNConfigFile), nil
 }
+
+// persistentPeers returns the persistent peers set in the config.toml of the chain.
+func persistentPeers(configPath string) ([]string, error) {
+	configToml, err := toml.LoadFile(configPath)
+	if err != nil {
+		return nil, err
+	}
+	peers, _ := configToml.Get("p2p.persistent_peers").(string)
+	if peers == "" {
+		return nil, nil
+	}
+	return strings.Split(peers, ","), nil
+}
+
+// setPersistentPeers sets the persistent peers in the config.toml of the chain.
+// allowDuplicateIP allows several peers to share the same ip, it is required for tunneled peers.
+func setPersistentPeers(configPath string, peers []string, allowDuplicateIP bool) error {
+	configToml, err := toml.LoadFile(configPath)
+	if err != nil {
+		return err
+	}
+	configToml.Set("p2p.persistent_peers", strings.Join(peers, ","))
+	if allowDuplicateIP {
+		configToml.Set("p2p.allow_duplicate_ip", true)
+	}
+
+	// save config.toml file
+	configTomlFile, err := os.OpenFile(configPath, os.O_RDWR|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer configTomlFile.Close()
+
+	_, err = configToml.WriteTo(configTomlFile)
+	return err
+}
+
+// rpcAddress returns the RPC listen address set in the config.toml of the chain.
+func rpcAddress(configPath string) (string, error) {
+	configToml, err := toml.LoadFile(configPath)
+	if err != nil {
+		return "", err
+	}
+	addr, _ := configToml.Get("rpc.laddr").(string)
+	if addr == "" {
+		return "", errors.New("no rpc address set in the chain config")
+	}
+	return addr, nil
+}
@@ -0,0 +1,112 @@
+package networkchain
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/goenv"
+)
+
+const (
+	// CosmovisorDir is the directory of the Cosmovisor layout in the chain home.
+	CosmovisorDir = "cosmovisor"
+
+	// CosmovisorLaunchFile is the name of the file in the chain home containing the environment
+	// Cosmovisor must run the node with, it's the launch file of the chain home.
+	CosmovisorLaunchFile = LaunchFile
+
+	// cosmovisorGenesisBinDir is the directory of the genesis binary in the Cosmovisor layout.
+	cosmovisorGenesisBinDir = "genesis/bin"
+)
+
+// CosmovisorLaunch contains the environment hints to run the node with Cosmovisor.
+type CosmovisorLaunch struct {
+	// Binary is the path of the genesis binary.
+	Binary string `json:"binary,omitempty"`
+
+	// Env contains the environment variables read by Cosmovisor.
+	Env map[string]string `json:"env,omitempty"`
+}
+
+// WithCosmovisor installs the chain binary in the Cosmovisor layout of the chain home instead of the PATH.
+// The layout is created when missing, the upgrades of an existing layout are left untouched.
+func WithCosmovisor() Option {
+	return func(c *Chain) {
+		c.cosmovisor = true
+	}
+}
+
+// cosmovisorBinDir returns the directory of the genesis binary in the Cosmovisor layout of the chain home.
+func (c Chain) cosmovisorBinDir() (string, error) {
+	home, err := c.chain.Home()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, CosmovisorDir, filepath.FromSlash(cosmovisorGenesisBinDir)), nil
+}
+
+// binaryDir returns the directory the chain binary is installed in.
+func (c Chain) binaryDir() (string, error) {
+	if c.cosmovisor {
+		return c.cosmovisorBinDir()
+	}
+	return goenv.Bin(), nil
+}
+
+// binaryPath returns the path of the genesis binary in Cosmovisor mode,
+// the name of the binary looked up in the PATH otherwise.
+func (c Chain) binaryPath() (string, error) {
+	binary, err := c.chain.Binary()
+	if err != nil || !c.cosmovisor {
+		return binary, err
+	}
+	binDir, err := c.cosmovisorBinDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(binDir, binary), nil
+}
+
+// setupCosmovisor creates the genesis binary directory of the Cosmovisor layout if missing
+// and returns it, the upgrades directory is not modified.
+func (c Chain) setupCosmovisor() (string, error) {
+	binDir, err := c.cosmovisorBinDir()
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(binDir, 0o755); err != nil {
+		return "", errors.Wrap(err, "cannot create the Cosmovisor layout")
+	}
+	return binDir, nil
+}
+
+// writeCosmovisorLaunch writes the environment Cosmovisor must run the node with into the launch file of the chain home.
+func (c Chain) writeCosmovisorLaunch() error {
+	home, err := c.chain.Home()
+	if err != nil {
+		return err
+	}
+	binary, err := c.chain.Binary()
+	if err != nil {
+		return err
+	}
+	binaryPath, err := c.binaryPath()
+	if err != nil {
+		return err
+	}
+
+	return c.updateLaunchFile(func(launch *Launch) {
+		launch.CosmovisorLaunch = CosmovisorLaunch{
+			Binary: binaryPath,
+			Env: map[string]string{
+				"DAEMON_NAME": binary,
+				"DAEMON_HOME": home,
+				// the binaries of the upgrades are installed by the validators
+				"DAEMON_ALLOW_DOWNLOAD_BINARIES": "false",
+				"DAEMON_RESTART_AFTER_UPGRADE":   "true",
+			},
+		}
+	})
+}
@@ -0,0 +1,122 @@
+package networkchain
+
+import (
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/goenv"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+// homeTree returns the files and directories of the home relative to the home.
+func homeTree(t *testing.T, home string) []string {
+	var tree []string
+	err := filepath.WalkDir(home, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || path == home {
+			return err
+		}
+		rel, err := filepath.Rel(home, path)
+		if err != nil {
+			return err
+		}
+		tree = append(tree, filepath.ToSlash(rel))
+		return nil
+	})
+	require.NoError(t, err)
+	sort.Strings(tree)
+	return tree
+}
+
+func TestCosmovisorLayout(t *testing.T) {
+	newChain := func(t *testing.T, home string, cosmovisor bool) Chain {
+		ch, err := chain.New(fakeChainSource(t), chain.HomePath(home), chain.BinaryName("marsd"))
+		require.NoError(t, err)
+		return Chain{chain: ch, cosmovisor: cosmovisor}
+	}
+
+	t.Run("standard layout by default", func(t *testing.T) {
+		home := t.TempDir()
+		c := newChain(t, home, false)
+
+		binDir, err := c.binaryDir()
+		require.NoError(t, err)
+		require.Equal(t, goenv.Bin(), binDir)
+
+		binaryPath, err := c.binaryPath()
+		require.NoError(t, err)
+		require.Equal(t, "marsd", binaryPath)
+		require.Empty(t, homeTree(t, home))
+	})
+
+	t.Run("create the layout", func(t *testing.T) {
+		home := t.TempDir()
+		c := newChain(t, home, true)
+
+		binDir, err := c.setupCosmovisor()
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(home, "cosmovisor", "genesis", "bin"), binDir)
+
+		// the binary is built in the genesis binary directory
+		require.NoError(t, os.WriteFile(filepath.Join(binDir, "marsd"), []byte("#!/bin/sh\n"), 0o755))
+		require.NoError(t, c.writeCosmovisorLaunch())
+
+		require.Equal(t, []string{
+			"cosmovisor",
+			"cosmovisor/genesis",
+			"cosmovisor/genesis/bin",
+			"cosmovisor/genesis/bin/marsd",
+			"launch.json",
+		}, homeTree(t, home))
+
+		binaryPath, err := c.binaryPath()
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(binDir, "marsd"), binaryPath)
+
+		content, err := os.ReadFile(filepath.Join(home, CosmovisorLaunchFile))
+		require.NoError(t, err)
+		var launch CosmovisorLaunch
+		require.NoError(t, json.Unmarshal(content, &launch))
+		require.Equal(t, CosmovisorLaunch{
+			Binary: binaryPath,
+			Env: map[string]string{
+				"DAEMON_NAME":                    "marsd",
+				"DAEMON_HOME":                    home,
+				"DAEMON_ALLOW_DOWNLOAD_BINARIES": "false",
+				"DAEMON_RESTART_AFTER_UPGRADE":   "true",
+			},
+		}, launch)
+	})
+
+	t.Run("existing layout with upgrades", func(t *testing.T) {
+		home := t.TempDir()
+		upgradeBinary := filepath.Join(home, "cosmovisor", "upgrades", "v2", "bin", "marsd")
+		require.NoError(t, os.MkdirAll(filepath.Dir(upgradeBinary), 0o755))
+		require.NoError(t, os.WriteFile(upgradeBinary, []byte("v2"), 0o755))
+		c := newChain(t, home, true)
+
+		binDir, err := c.setupCosmovisor()
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(binDir, "marsd"), []byte("v1"), 0o755))
+
+		require.Equal(t, []string{
+			"cosmovisor",
+			"cosmovisor/genesis",
+			"cosmovisor/genesis/bin",
+			"cosmovisor/genesis/bin/marsd",
+			"cosmovisor/upgrades",
+			"cosmovisor/upgrades/v2",
+			"cosmovisor/upgrades/v2/bin",
+			"cosmovisor/upgrades/v2/bin/marsd",
+		}, homeTree(t, home))
+
+		content, err := os.ReadFile(upgradeBinary)
+		require.NoError(t, err)
+		require.Equal(t, "v2", string(content))
+	})
+}
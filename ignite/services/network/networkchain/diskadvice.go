@@ -0,0 +1,273 @@
+package networkchain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pelletier/go-toml"
+
+	"github.com/ignite/cli/ignite/pkg/confile"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/xos"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	// DiskAdviceHorizon is the period after the launch the disk growth of the chain home is estimated over.
+	DiskAdviceHorizon = 30 * 24 * time.Hour
+
+	// defaultBlockTime is the block time used when the config of the chain has no commit timeout.
+	defaultBlockTime = 5 * time.Second
+
+	// diskBaseBytes is the size of the databases, the WAL and the config of the chain home before the first block.
+	diskBaseBytes = 64 << 20
+
+	// genesisCopies is the number of copies of the genesis in the chain home at launch: the genesis file,
+	// the initial state of the application and the genesis state of the consensus.
+	genesisCopies = 3
+
+	// blockBaseBytes is the size stored for each block without the signatures of its commit:
+	// the header, the block meta, the ABCI responses and the consensus state.
+	blockBaseBytes = 2 << 10
+
+	// commitSigBytes is the size stored for each validator signature of the commit of a block.
+	commitSigBytes = 128
+
+	// appVersionBytes is the size of the application state written for each height,
+	// the versions are only removed from the application state with pruning.
+	appVersionBytes = 4 << 10
+)
+
+// RecommendedPruning is the pruning of the application state recommended for the validators with
+// a small disk, the state of the last blocks is kept and the older state is removed every few blocks.
+var RecommendedPruning = PruningConfig{
+	Pruning:    "custom",
+	KeepRecent: 100,
+	Interval:   10,
+}
+
+// freeSpace returns the available space of the filesystem of a path, replaced in the tests.
+var freeSpace = xos.FreeSpace
+
+// PruningConfig is the pruning of the application state in the app.toml of the chain.
+type PruningConfig struct {
+	Pruning    string `json:"pruning" yaml:"pruning"`
+	KeepRecent uint64 `json:"keep_recent" yaml:"keep_recent"`
+	Interval   uint64 `json:"interval" yaml:"interval"`
+}
+
+// DiskAdvice estimates the disk needs of the chain home from the prepared genesis, the number
+// of validators and the block time, and compares them with the free space of its filesystem.
+type DiskAdvice struct {
+	GenesisSize int64         `json:"genesis_size" yaml:"genesis_size"`
+	Validators  int           `json:"validators" yaml:"validators"`
+	BlockTime   time.Duration `json:"block_time" yaml:"block_time"`
+
+	// InitialBytes is the estimated size of the chain home at launch.
+	InitialBytes int64 `json:"initial_bytes" yaml:"initial_bytes"`
+
+	// DailyGrowthBytes is the estimated growth of the chain home per day without pruning,
+	// PrunedDailyGrowthBytes with the recommended pruning.
+	DailyGrowthBytes       int64 `json:"daily_growth_bytes" yaml:"daily_growth_bytes"`
+	PrunedDailyGrowthBytes int64 `json:"pruned_daily_growth_bytes" yaml:"pruned_daily_growth_bytes"`
+
+	// FreeBytes is the free space of the filesystem of the chain home.
+	FreeBytes uint64 `json:"free_bytes" yaml:"free_bytes"`
+
+	// Pruning is the recommended pruning, set when the free space doesn't fit the growth of the
+	// chain home without pruning over the horizon of the advice.
+	Pruning *PruningConfig `json:"pruning,omitempty" yaml:"pruning,omitempty"`
+
+	// PruningApplied tells if the recommended pruning is written in the app.toml of the chain.
+	PruningApplied bool `json:"pruning_applied,omitempty" yaml:"pruning_applied,omitempty"`
+}
+
+// RequiredBytes returns the estimated size of the chain home after the horizon of the advice,
+// with the recommended pruning when it's applied.
+func (a DiskAdvice) RequiredBytes() int64 {
+	growth := a.DailyGrowthBytes
+	if a.PruningApplied {
+		growth = a.PrunedDailyGrowthBytes
+	}
+	return a.InitialBytes + growth*int64(DiskAdviceHorizon/(24*time.Hour))
+}
+
+// IsSufficient tells if the free space fits the estimated size of the chain home after the horizon of the advice.
+func (a DiskAdvice) IsSufficient() bool {
+	return uint64(a.RequiredBytes()) <= a.FreeBytes
+}
+
+// WithRecommendedPruning writes the recommended pruning in the app.toml of the chain when the chain
+// is prepared and the free space of the home doesn't fit the growth of the chain home without pruning.
+func WithRecommendedPruning() Option {
+	return func(c *Chain) {
+		c.recommendedPruning = true
+	}
+}
+
+// estimateDisk returns the estimated size of the chain home at launch and its daily growth without and
+// with pruning. The block store keeps every block, the pruning only removes the old application states.
+func estimateDisk(genesisSize int64, validators int, blockTime time.Duration) (initial, growth, prunedGrowth int64) {
+	if validators < 1 {
+		validators = 1
+	}
+	if blockTime <= 0 {
+		blockTime = defaultBlockTime
+	}
+	blocksPerDay := int64(24 * time.Hour / blockTime)
+	blockBytes := int64(blockBaseBytes + validators*commitSigBytes)
+
+	initial = diskBaseBytes + genesisCopies*genesisSize
+	prunedGrowth = blocksPerDay * blockBytes
+	growth = prunedGrowth + blocksPerDay*appVersionBytes
+	return initial, growth, prunedGrowth
+}
+
+// AdviseDisk estimates the disk needs of the chain home from the prepared genesis and the config of the chain
+// and checks the free space of its filesystem. The recommended pruning is written in the app.toml of the chain
+// with WithRecommendedPruning. The advice is saved in the launch file of the chain home and sent as an event.
+func (c Chain) AdviseDisk(ctx context.Context) (DiskAdvice, error) {
+	ev := c.ev.WithCategory(events.CategoryGenesis)
+
+	home, err := c.Home()
+	if err != nil {
+		return DiskAdvice{}, err
+	}
+	genesisPath, err := c.chain.GenesisPath()
+	if err != nil {
+		return DiskAdvice{}, err
+	}
+	configPath, err := c.chain.ConfigTOMLPath()
+	if err != nil {
+		return DiskAdvice{}, err
+	}
+
+	genesisFile, err := cosmosutil.ReadGenesisFile(genesisPath, c.maxGenesisSize)
+	if err != nil {
+		return DiskAdvice{}, err
+	}
+	genesis, err := cosmosutil.ParseGenesisFile(genesisFile)
+	if err != nil {
+		return DiskAdvice{}, err
+	}
+	validators, err := genesis.GenTxCount()
+	if err != nil {
+		return DiskAdvice{}, err
+	}
+	blockTime, err := commitTimeout(configPath)
+	if err != nil {
+		return DiskAdvice{}, err
+	}
+	free, err := freeSpace(home)
+	if err != nil {
+		return DiskAdvice{}, fmt.Errorf("cannot check the free space of the chain home: %w", err)
+	}
+
+	advice := DiskAdvice{
+		GenesisSize: int64(len(genesisFile)),
+		Validators:  validators,
+		BlockTime:   blockTime,
+		FreeBytes:   free,
+	}
+	advice.InitialBytes, advice.DailyGrowthBytes, advice.PrunedDailyGrowthBytes = estimateDisk(
+		advice.GenesisSize,
+		advice.Validators,
+		advice.BlockTime,
+	)
+	if !advice.IsSufficient() {
+		pruning := RecommendedPruning
+		advice.Pruning = &pruning
+		if c.recommendedPruning {
+			appPath, err := c.chain.AppTOMLPath()
+			if err != nil {
+				return DiskAdvice{}, err
+			}
+			if err := setPruning(appPath, pruning); err != nil {
+				return DiskAdvice{}, err
+			}
+			advice.PruningApplied = true
+		}
+	}
+
+	if err := c.saveDiskAdvice(advice); err != nil {
+		return DiskAdvice{}, err
+	}
+
+	ev.Send(events.NewNeutral(fmt.Sprintf(
+		"The chain home needs about %s at launch and grows by %s a day, %s are free",
+		formatBytes(uint64(advice.InitialBytes)),
+		formatBytes(uint64(advice.DailyGrowthBytes)),
+		formatBytes(advice.FreeBytes),
+	)))
+	switch {
+	case advice.PruningApplied:
+		ev.Send(events.NewNeutral(fmt.Sprintf(
+			"The %s pruning is set in the app.toml, the chain home grows by %s a day",
+			advice.Pruning.Pruning,
+			formatBytes(uint64(advice.PrunedDailyGrowthBytes)),
+		)))
+	case advice.Pruning != nil:
+		ev.Send(events.NewNeutral(fmt.Sprintf(
+			"Set pruning=%q, pruning-keep-recent=\"%d\" and pruning-interval=\"%d\" in the app.toml to grow by %s a day",
+			advice.Pruning.Pruning,
+			advice.Pruning.KeepRecent,
+			advice.Pruning.Interval,
+			formatBytes(uint64(advice.PrunedDailyGrowthBytes)),
+		)))
+	}
+	if !advice.IsSufficient() {
+		c.warn(
+			WarningDiskSpace,
+			"The chain home needs about %s within %d days after the launch, only %s are free",
+			formatBytes(uint64(advice.RequiredBytes())),
+			int(DiskAdviceHorizon/(24*time.Hour)),
+			formatBytes(advice.FreeBytes),
+		)
+	}
+	return advice, nil
+}
+
+// saveDiskAdvice records the disk advice in the launch file of the chain home.
+func (c Chain) saveDiskAdvice(advice DiskAdvice) error {
+	return c.updateLaunchFile(func(launch *Launch) {
+		launch.DiskAdvice = &advice
+	})
+}
+
+// commitTimeout returns the commit timeout set in the config.toml of the chain, the time between two blocks.
+func commitTimeout(configPath string) (time.Duration, error) {
+	config, err := toml.LoadFile(configPath)
+	if err != nil {
+		return 0, err
+	}
+	timeout, _ := config.Get("consensus.timeout_commit").(string)
+	if timeout == "" {
+		return defaultBlockTime, nil
+	}
+	return time.ParseDuration(timeout)
+}
+
+// setPruning sets the pruning of the application state in the app.toml of the chain.
+func setPruning(appPath string, pruning PruningConfig) error {
+	return chain.OverrideConfig(confile.DefaultTOMLEncodingCreator, appPath, map[string]interface{}{
+		"pruning":             pruning.Pruning,
+		"pruning-keep-recent": fmt.Sprint(pruning.KeepRecent),
+		"pruning-interval":    fmt.Sprint(pruning.Interval),
+	})
+}
+
+// formatBytes formats a number of bytes with a binary unit.
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
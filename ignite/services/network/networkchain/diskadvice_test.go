@@ -0,0 +1,163 @@
+package networkchain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pelletier/go-toml"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+func TestEstimateDisk(t *testing.T) {
+	initial, growth, prunedGrowth := estimateDisk(10<<20, 100, 5*time.Second)
+
+	// 17280 blocks a day with the commits of 100 validators
+	require.EqualValues(t, 64<<20+3*(10<<20), initial)
+	require.EqualValues(t, 17280*(2048+100*128)+17280*4096, growth)
+	require.EqualValues(t, 17280*(2048+100*128), prunedGrowth)
+
+	// the defaults are used without validators and block time
+	initial, growth, _ = estimateDisk(0, 0, 0)
+	require.EqualValues(t, 64<<20, initial)
+	require.EqualValues(t, 17280*(2048+128)+17280*4096, growth)
+}
+
+func TestAdviseDisk(t *testing.T) {
+	const genesis = `{"chain_id":"foo-1","app_state":{"genutil":{"gen_txs":[{},{}]}}}`
+	var (
+		initial, growth, prunedGrowth = estimateDisk(int64(len(genesis)), 2, time.Second)
+		horizon                       = int64(DiskAdviceHorizon / (24 * time.Hour))
+	)
+
+	newChain := func(t *testing.T, free uint64, options ...Option) Chain {
+		home := t.TempDir()
+		files := map[string]string{
+			"config/genesis.json": genesis,
+			"config/config.toml":  "[consensus]\ntimeout_commit = \"1s\"\n",
+			"config/app.toml":     "pruning = \"default\"\n",
+		}
+		for name, content := range files {
+			path := filepath.Join(home, name)
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+			require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+		}
+
+		// the statfs of the home is faked
+		statfs := freeSpace
+		freeSpace = func(path string) (uint64, error) {
+			require.Equal(t, home, path)
+			return free, nil
+		}
+		t.Cleanup(func() { freeSpace = statfs })
+
+		ch, err := chain.New(fakeChainSource(t), chain.HomePath(home))
+		require.NoError(t, err)
+		c := Chain{
+			chain:          ch,
+			ev:             events.NewBus(events.WithCustomBufferSize(10)),
+			warnings:       &warningCollector{},
+			maxGenesisSize: cosmosutil.DefaultMaxGenesisSize,
+		}
+		for _, apply := range options {
+			apply(&c)
+		}
+		return c
+	}
+	appPruning := func(t *testing.T, c Chain) string {
+		appPath, err := c.chain.AppTOMLPath()
+		require.NoError(t, err)
+		config, err := toml.LoadFile(appPath)
+		require.NoError(t, err)
+		return config.Get("pruning").(string)
+	}
+
+	t.Run("enough free space", func(t *testing.T) {
+		free := uint64(initial + growth*horizon)
+		c := newChain(t, free)
+
+		advice, err := c.AdviseDisk(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, DiskAdvice{
+			GenesisSize:            int64(len(genesis)),
+			Validators:             2,
+			BlockTime:              time.Second,
+			InitialBytes:           initial,
+			DailyGrowthBytes:       growth,
+			PrunedDailyGrowthBytes: prunedGrowth,
+			FreeBytes:              free,
+		}, advice)
+		require.True(t, advice.IsSufficient())
+		require.Empty(t, c.Warnings())
+		require.Equal(t, "default", appPruning(t, c))
+
+		// the advice is recorded in the launch file
+		home, err := c.Home()
+		require.NoError(t, err)
+		launch, err := ReadLaunchFile(home)
+		require.NoError(t, err)
+		require.Equal(t, &advice, launch.DiskAdvice)
+	})
+
+	t.Run("pruning recommended", func(t *testing.T) {
+		c := newChain(t, uint64(initial+growth*horizon-1))
+
+		advice, err := c.AdviseDisk(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, &RecommendedPruning, advice.Pruning)
+		require.False(t, advice.PruningApplied)
+		require.False(t, advice.IsSufficient())
+		require.Len(t, c.Warnings(), 1)
+		require.Equal(t, WarningDiskSpace, c.Warnings()[0].Code)
+		require.Equal(t, "default", appPruning(t, c))
+	})
+
+	t.Run("pruning applied", func(t *testing.T) {
+		c := newChain(t, uint64(initial+prunedGrowth*horizon), WithRecommendedPruning())
+
+		advice, err := c.AdviseDisk(context.Background())
+		require.NoError(t, err)
+		require.True(t, advice.PruningApplied)
+		require.True(t, advice.IsSufficient())
+		require.Empty(t, c.Warnings())
+		require.Equal(t, "custom", appPruning(t, c))
+
+		appPath, err := c.chain.AppTOMLPath()
+		require.NoError(t, err)
+		config, err := toml.LoadFile(appPath)
+		require.NoError(t, err)
+		require.Equal(t, "100", config.Get("pruning-keep-recent"))
+		require.Equal(t, "10", config.Get("pruning-interval"))
+	})
+
+	t.Run("not enough free space with pruning", func(t *testing.T) {
+		c := newChain(t, uint64(initial+prunedGrowth*horizon-1), WithRecommendedPruning())
+
+		advice, err := c.AdviseDisk(context.Background())
+		require.NoError(t, err)
+		require.True(t, advice.PruningApplied)
+		require.False(t, advice.IsSufficient())
+		require.Len(t, c.Warnings(), 1)
+		require.Equal(t, WarningDiskSpace, c.Warnings()[0].Code)
+	})
+
+	t.Run("strict validation", func(t *testing.T) {
+		c := newChain(t, uint64(initial), WithStrictValidation())
+
+		_, err := c.AdviseDisk(context.Background())
+		require.NoError(t, err)
+		require.ErrorAs(t, c.checkWarnings(0), &ErrWarning{})
+	})
+}
+
+func TestFormatBytes(t *testing.T) {
+	require.Equal(t, "512 B", formatBytes(512))
+	require.Equal(t, "1.5 KiB", formatBytes(1536))
+	require.Equal(t, "10.0 GiB", formatBytes(10<<30))
+}
@@ -0,0 +1,236 @@
+package networkchain
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/dockercmd"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/chain"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+const (
+	// DefaultBuilderImage is the image the chain binaries are built in with the Docker build mode.
+	DefaultBuilderImage = "golang:1.18.10-bullseye"
+
+	// DefaultBuilderPlatform is the platform of the binaries built with the Docker build mode.
+	DefaultBuilderPlatform = "linux/amd64"
+
+	// dockerSourcePath is the path of the chain source in the builder container.
+	dockerSourcePath = "/src"
+
+	// dockerOutputDir is the directory of the binary built in the builder container.
+	dockerOutputDir = "/out"
+
+	// buildOutputEventKey is the debouncing key of the progress events of the build output.
+	buildOutputEventKey = "build-output"
+)
+
+// DockerClient is the Docker client running the builder containers.
+type DockerClient interface {
+	Ping(ctx context.Context) error
+	ImageDigest(ctx context.Context, image, platform string) (string, error)
+	Create(ctx context.Context, config dockercmd.ContainerConfig) (string, error)
+	Start(ctx context.Context, id string, stdout, stderr io.Writer) error
+	Wait(ctx context.Context, id string) (int, error)
+	CopyFrom(ctx context.Context, id, path, dst string) error
+	Remove(ctx context.Context, id string) error
+}
+
+// dockerBuild is the configuration of the Docker build mode.
+type dockerBuild struct {
+	client   DockerClient
+	image    string
+	platform string
+}
+
+// DockerBuildOption configures the Docker build mode.
+type DockerBuildOption func(*dockerBuild)
+
+// WithBuilderImage sets the image the binary is built in, the image should be pinned with a digest.
+func WithBuilderImage(image string) DockerBuildOption {
+	return func(b *dockerBuild) {
+		b.image = image
+	}
+}
+
+// WithBuilderPlatform sets the platform of the builder container.
+func WithBuilderPlatform(platform string) DockerBuildOption {
+	return func(b *dockerBuild) {
+		b.platform = platform
+	}
+}
+
+// toolchain returns the toolchain of the builder image, the digest of the image is the key of the builds.
+func (b dockerBuild) toolchain(ctx context.Context) (Toolchain, error) {
+	digest, err := b.client.ImageDigest(ctx, b.image, b.platform)
+	if err != nil {
+		return Toolchain{}, fmt.Errorf("cannot fetch the builder image %s: %w", b.image, err)
+	}
+
+	goos, goarch, _ := strings.Cut(b.platform, "/")
+	return Toolchain{
+		GoVersion:  "docker",
+		GOOS:       goos,
+		GOARCH:     goarch,
+		BuildFlags: []string{"IMAGE=" + b.image, "DIGEST=" + digest},
+	}, nil
+}
+
+// toolchain returns the toolchain the binary of the chain is built with.
+func (c *Chain) toolchain(ctx context.Context) (Toolchain, error) {
+	if c.dockerBuild != nil {
+		return c.dockerBuild.toolchain(ctx)
+	}
+	return CurrentToolchain(ctx)
+}
+
+// buildInDocker builds the binary of the chain in a builder container and installs it
+// in the Go binary directory, or in the Cosmovisor layout in Cosmovisor mode.
+func (c *Chain) buildInDocker(ctx context.Context, cacheStorage cache.Storage) (binaryName string, err error) {
+	// the binary is installed and cached for the launch, a binary that can't run on the host is refused
+	// before it's built
+	if host := RuntimePlatform().String(); c.dockerBuild.platform != host {
+		return "", fmt.Errorf(
+			"the binary built in Docker for %s can't run on %s: build the chain on a %s host or without the Docker build",
+			c.dockerBuild.platform,
+			host,
+			c.dockerBuild.platform,
+		)
+	}
+
+	if err := c.dockerBuild.ping(ctx); err != nil {
+		return "", err
+	}
+
+	buildCmd, err := c.chain.BuildCommand(ctx, cacheStorage)
+	if err != nil {
+		return "", err
+	}
+
+	binDir, err := c.binaryDir()
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(binDir, 0o755); err != nil {
+		return "", err
+	}
+
+	logs := c.buildLogWriter()
+	defer logs.Close()
+	if err := c.dockerBuild.build(ctx, c.path, buildCmd, binDir, logs); err != nil {
+		return "", err
+	}
+	return buildCmd.Binary, nil
+}
+
+// ping checks the Docker daemon is available to build the binary.
+func (b dockerBuild) ping(ctx context.Context) error {
+	err := b.client.Ping(ctx)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, dockercmd.ErrNotInstalled):
+		return fmt.Errorf("cannot build the chain in Docker, install Docker or disable the Docker build: %w", err)
+	default:
+		return fmt.Errorf("cannot build the chain in Docker, start the Docker daemon or disable the Docker build: %w", err)
+	}
+}
+
+// build runs the build command in a builder container with the source mounted and copies the built
+// binary into the binary directory. The outputs of the build are written into logs.
+func (b dockerBuild) build(
+	ctx context.Context,
+	source string,
+	buildCmd chain.BuildCommand,
+	binDir string,
+	logs io.Writer,
+) (err error) {
+	output := path.Join(dockerOutputDir, buildCmd.Binary)
+	cmd := append([]string{"go", "build", "-o", output}, buildCmd.Flags...)
+	cmd = append(cmd, "./"+filepath.ToSlash(buildCmd.MainPath))
+
+	id, err := b.client.Create(ctx, dockercmd.ContainerConfig{
+		Image:    b.image,
+		Platform: b.platform,
+		Cmd:      cmd,
+		Mounts: []dockercmd.Mount{
+			{Source: source, Target: dockerSourcePath, ReadOnly: true},
+		},
+		Workdir: dockerSourcePath,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot create the builder container: %w", err)
+	}
+	defer func() {
+		if removeErr := b.client.Remove(context.Background(), id); removeErr != nil && err == nil {
+			err = fmt.Errorf("cannot remove the builder container: %w", removeErr)
+		}
+	}()
+
+	if err := b.client.Start(ctx, id, logs, logs); err != nil {
+		return fmt.Errorf("the build in Docker failed: %w", err)
+	}
+
+	exitCode, err := b.client.Wait(ctx, id)
+	if err != nil {
+		return err
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("the build in Docker failed with exit code %d", exitCode)
+	}
+
+	if err := b.client.CopyFrom(ctx, id, output, filepath.Join(binDir, buildCmd.Binary)); err != nil {
+		return fmt.Errorf("cannot copy the binary from the builder container: %w", err)
+	}
+	return nil
+}
+
+// eventWriter sends the lines written into it as events.
+type eventWriter struct {
+	*io.PipeWriter
+	done chan struct{}
+}
+
+// Close implements io.Closer, it returns once the events of the written lines are sent.
+func (w eventWriter) Close() error {
+	err := w.PipeWriter.Close()
+	<-w.done
+	return err
+}
+
+// buildLogWriter returns a writer sending the lines written into it as debug events, the last line
+// is also sent as the progress of the build at most every progress interval.
+func (c *Chain) buildLogWriter() io.WriteCloser {
+	ev := c.ev.WithCategory(events.CategoryBuild)
+	progress := events.NewDebouncer(ev, events.DefaultProgressInterval, events.DebounceKey(func(events.Event) string {
+		return buildOutputEventKey
+	}))
+
+	r, w := io.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer progress.Flush()
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			line := scanner.Text()
+			ev.Send(events.NewDebug(line))
+			progress.Send(events.NewOngoing(events.Message(networktypes.MsgBuildingBinaryOutput, events.Params{
+				"Line": line,
+			})))
+		}
+		// the reader is drained to never block the writer on long lines
+		_, _ = io.Copy(io.Discard, r)
+	}()
+	return eventWriter{PipeWriter: w, done: done}
+}
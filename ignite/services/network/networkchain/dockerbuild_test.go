@@ -0,0 +1,234 @@
+package networkchain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/dockercmd"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/xtime"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+// fakeDocker records the calls of the Docker client, the copied files contain the container path.
+type fakeDocker struct {
+	calls     []string
+	pingErr   error
+	digest    string
+	config    dockercmd.ContainerConfig
+	output    string
+	startErr  error
+	exitCode  int
+	removeErr error
+}
+
+func (f *fakeDocker) Ping(context.Context) error {
+	f.calls = append(f.calls, "ping")
+	return f.pingErr
+}
+
+func (f *fakeDocker) ImageDigest(_ context.Context, image, platform string) (string, error) {
+	f.calls = append(f.calls, "digest "+image+" "+platform)
+	return f.digest, nil
+}
+
+func (f *fakeDocker) Create(_ context.Context, config dockercmd.ContainerConfig) (string, error) {
+	f.calls = append(f.calls, "create")
+	f.config = config
+	return "container", nil
+}
+
+func (f *fakeDocker) Start(_ context.Context, id string, stdout, _ io.Writer) error {
+	f.calls = append(f.calls, "start "+id)
+	fmt.Fprint(stdout, f.output)
+	return f.startErr
+}
+
+func (f *fakeDocker) Wait(_ context.Context, id string) (int, error) {
+	f.calls = append(f.calls, "wait "+id)
+	return f.exitCode, nil
+}
+
+func (f *fakeDocker) CopyFrom(_ context.Context, id, path, dst string) error {
+	f.calls = append(f.calls, "copy "+id+":"+path)
+	return os.WriteFile(dst, []byte(path), 0o755)
+}
+
+func (f *fakeDocker) Remove(_ context.Context, id string) error {
+	f.calls = append(f.calls, "remove "+id)
+	return f.removeErr
+}
+
+func TestDockerBuild(t *testing.T) {
+	buildCmd := chain.BuildCommand{
+		Binary:   "food",
+		MainPath: filepath.Join("cmd", "food"),
+		Flags:    []string{"-mod", "readonly", "-ldflags", "-X version=1"},
+	}
+
+	t.Run("build the binary in a container", func(t *testing.T) {
+		var (
+			fake   = &fakeDocker{output: "go: downloading\n"}
+			b      = dockerBuild{client: fake, image: DefaultBuilderImage, platform: DefaultBuilderPlatform}
+			binDir = t.TempDir()
+			logs   bytes.Buffer
+		)
+
+		err := b.build(context.Background(), "/source", buildCmd, binDir, &logs)
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"create",
+			"start container",
+			"wait container",
+			"copy container:/out/food",
+			"remove container",
+		}, fake.calls)
+		require.Equal(t, dockercmd.ContainerConfig{
+			Image:    DefaultBuilderImage,
+			Platform: DefaultBuilderPlatform,
+			Cmd: []string{
+				"go", "build", "-o", "/out/food",
+				"-mod", "readonly", "-ldflags", "-X version=1",
+				"./cmd/food",
+			},
+			Mounts:  []dockercmd.Mount{{Source: "/source", Target: "/src", ReadOnly: true}},
+			Workdir: "/src",
+		}, fake.config)
+		require.Equal(t, "go: downloading\n", logs.String())
+
+		binary, err := os.ReadFile(filepath.Join(binDir, "food"))
+		require.NoError(t, err)
+		require.Equal(t, "/out/food", string(binary))
+	})
+
+	t.Run("build failed", func(t *testing.T) {
+		var (
+			fake = &fakeDocker{exitCode: 2}
+			b    = dockerBuild{client: fake, image: DefaultBuilderImage, platform: DefaultBuilderPlatform}
+		)
+
+		err := b.build(context.Background(), "/source", buildCmd, t.TempDir(), io.Discard)
+		require.EqualError(t, err, "the build in Docker failed with exit code 2")
+		require.Equal(t, []string{"create", "start container", "wait container", "remove container"}, fake.calls)
+	})
+
+	t.Run("container not removed", func(t *testing.T) {
+		var (
+			fake = &fakeDocker{removeErr: errors.New("busy")}
+			b    = dockerBuild{client: fake, image: DefaultBuilderImage, platform: DefaultBuilderPlatform}
+		)
+
+		err := b.build(context.Background(), "/source", buildCmd, t.TempDir(), io.Discard)
+		require.EqualError(t, err, "cannot remove the builder container: busy")
+	})
+}
+
+func TestDockerBuildPing(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "docker not installed",
+			err:  dockercmd.ErrNotInstalled,
+			want: "cannot build the chain in Docker, install Docker or disable the Docker build: docker is not installed",
+		},
+		{
+			name: "daemon not running",
+			err:  fmt.Errorf("%w: connection refused", dockercmd.ErrDaemonUnavailable),
+			want: "cannot build the chain in Docker, start the Docker daemon or disable the Docker build: " +
+				"the Docker daemon is not running: connection refused",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := dockerBuild{client: &fakeDocker{pingErr: tt.err}}
+
+			err := b.ping(context.Background())
+			require.EqualError(t, err, tt.want)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestDockerBuildToolchain(t *testing.T) {
+	var (
+		fake = &fakeDocker{digest: "sha256:1234"}
+		b    = dockerBuild{client: fake, image: DefaultBuilderImage, platform: "linux/arm64"}
+	)
+
+	toolchain, err := b.toolchain(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, Toolchain{
+		GoVersion:  "docker",
+		GOOS:       "linux",
+		GOARCH:     "arm64",
+		BuildFlags: []string{"IMAGE=" + DefaultBuilderImage, "DIGEST=sha256:1234"},
+	}, toolchain)
+	require.Equal(t, []string{"digest " + DefaultBuilderImage + " linux/arm64"}, fake.calls)
+
+	// the builds are cached for the digest of the image
+	fake.digest = "sha256:5678"
+	updated, err := b.toolchain(context.Background())
+	require.NoError(t, err)
+	require.NotEqual(t, toolchain.Key(), updated.Key())
+}
+
+func TestBuildInDockerForeignPlatform(t *testing.T) {
+	platform := "linux/arm64"
+	if RuntimePlatform().String() == platform {
+		platform = DefaultBuilderPlatform
+	}
+	var (
+		fake = &fakeDocker{}
+		c    = &Chain{dockerBuild: &dockerBuild{client: fake, image: DefaultBuilderImage, platform: platform}}
+	)
+
+	// the binary is neither built nor installed on the host
+	_, err := c.buildInDocker(context.Background(), cache.Storage{})
+	require.ErrorContains(t, err, fmt.Sprintf("the binary built in Docker for %s can't run on", platform))
+	require.Empty(t, fake.calls)
+}
+
+func TestBuildLogWriter(t *testing.T) {
+	var (
+		ev = events.NewBus(
+			events.WithCustomBufferSize(10),
+			events.WithClock(xtime.NewClockMock(time.Unix(1000, 0))),
+		)
+		c    = &Chain{ev: ev}
+		logs = c.buildLogWriter()
+	)
+
+	_, err := fmt.Fprint(logs, "go: downloading a\ngo: downloading b\ngo: downloading c\n")
+	require.NoError(t, err)
+	require.NoError(t, logs.Close())
+
+	ev.Shutdown(context.Background())
+	var progress, debug []string
+	for e := range ev.Events() {
+		if e.IsOngoing() {
+			progress = append(progress, e.Description)
+		} else {
+			debug = append(debug, e.Description)
+		}
+	}
+
+	// every line is a debug event, the progress in between is coalesced
+	require.Equal(t, []string{"go: downloading a", "go: downloading b", "go: downloading c"}, debug)
+	require.Equal(t, []string{
+		"Building the chain's binary: go: downloading a",
+		"Building the chain's binary: go: downloading c",
+	}, progress)
+}
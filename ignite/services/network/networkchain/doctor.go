@@ -0,0 +1,267 @@
+package networkchain
+
+import (
+	"context"
+	"encoding/json"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/ignite/cli/ignite/pkg/goenv"
+)
+
+// DoctorReportFile is the default name of the file of the doctor report.
+const DoctorReportFile = "ignite-doctor.json"
+
+const (
+	redactedAddress  = "<address>"
+	redactedMnemonic = "<mnemonic>"
+)
+
+var (
+	// reMnemonic matches the BIP39 mnemonics, 12 words or more.
+	reMnemonic = regexp.MustCompile(`\b(?:[a-z]{3,8}\s+){11,}[a-z]{3,8}\b`)
+
+	// reAddress matches the bech32 addresses, the hex addresses and the hex address bytes of the keyring files.
+	reAddress = regexp.MustCompile(`\b(?:[a-z][a-z0-9]*1[qpzry9x8gf2tvdw0s3jn54khce6mua7l]{38,}|0x[0-9a-fA-F]{40}|[0-9a-fA-F]{40})\b`)
+)
+
+// DoctorReport is the environment of the chain collected for a bug report.
+// The report never includes the content of the files of the chain home, the mnemonics are redacted
+// from the report and the addresses are redacted unless they are explicitly included.
+type DoctorReport struct {
+	Time time.Time `json:"time"`
+	OS   string    `json:"os"`
+	Arch string    `json:"arch"`
+
+	// Toolchain is the Go toolchain the chain binary is built with.
+	Toolchain *Toolchain `json:"toolchain,omitempty"`
+
+	LaunchID      uint64 `json:"launch_id,omitempty"`
+	ChainID       string `json:"chain_id,omitempty"`
+	SourceURL     string `json:"source_url,omitempty"`
+	SourceHash    string `json:"source_hash,omitempty"`
+	SDKVersion    string `json:"sdk_version,omitempty"`
+	BinaryName    string `json:"binary_name,omitempty"`
+	BinaryVersion string `json:"binary_version,omitempty"`
+
+	Home          string           `json:"home"`
+	HomeFiles     []DoctorHomeFile `json:"home_files,omitempty"`
+	GenesisHash   string           `json:"genesis_hash,omitempty"`
+	GenesisStages []GenesisStage   `json:"genesis_stages,omitempty"`
+
+	// LastError is the error of the last failed command, with the stderr of the chain binary.
+	LastError string `json:"last_error,omitempty"`
+
+	// Problems are the errors encountered while collecting the report.
+	Problems []string `json:"problems,omitempty"`
+}
+
+// DoctorHomeFile is a file of the chain home, the content of the file is not reported.
+type DoctorHomeFile struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+	Mode string `json:"mode"`
+}
+
+type doctorOptions struct {
+	lastErr          error
+	includeAddresses bool
+}
+
+// DoctorOption configures the doctor report.
+type DoctorOption func(*doctorOptions)
+
+// DoctorWithError adds the error of the last failed command to the report.
+func DoctorWithError(err error) DoctorOption {
+	return func(o *doctorOptions) {
+		o.lastErr = err
+	}
+}
+
+// DoctorIncludeAddresses keeps the addresses in the report.
+func DoctorIncludeAddresses() DoctorOption {
+	return func(o *doctorOptions) {
+		o.includeAddresses = true
+	}
+}
+
+// Doctor collects the environment of the chain into a redacted report and writes it to the report file.
+// The report is collected on a best effort basis, the parts that cannot be collected are listed as problems.
+func (c Chain) Doctor(ctx context.Context, reportPath string, options ...DoctorOption) (DoctorReport, error) {
+	var o doctorOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	report := DoctorReport{
+		Time:       c.clock.Now().UTC(),
+		OS:         runtime.GOOS,
+		Arch:       runtime.GOARCH,
+		LaunchID:   c.launchID,
+		SourceURL:  c.url,
+		SourceHash: c.hash,
+		SDKVersion: c.chain.Version.Version,
+	}
+	problem := func(err error) {
+		report.Problems = append(report.Problems, err.Error())
+	}
+
+	if toolchain, err := CurrentToolchain(ctx); err != nil {
+		problem(err)
+	} else {
+		report.Toolchain = &toolchain
+	}
+
+	if chainID, err := c.ChainID(); err != nil {
+		problem(err)
+	} else {
+		report.ChainID = chainID
+	}
+
+	if binary, err := c.chain.Binary(); err != nil {
+		problem(err)
+	} else {
+		report.BinaryName = binary
+		if version, err := binaryVersion(ctx, binary); err != nil {
+			problem(err)
+		} else {
+			report.BinaryVersion = version
+		}
+	}
+
+	home, err := c.chain.Home()
+	if err != nil {
+		return DoctorReport{}, err
+	}
+	report.Home = home
+	if report.HomeFiles, err = homeFiles(home); err != nil {
+		problem(err)
+	}
+
+	if genesisPath, err := c.chain.GenesisPath(); err != nil {
+		problem(err)
+	} else {
+		if hash, err := fileSHA256(genesisPath); err != nil && !os.IsNotExist(err) {
+			problem(err)
+		} else {
+			report.GenesisHash = hash
+		}
+		if report.GenesisStages, err = genesisStages(genesisPath); err != nil {
+			problem(err)
+		}
+	}
+
+	if o.lastErr != nil {
+		report.LastError = o.lastErr.Error()
+	}
+
+	report.redact(o.includeAddresses)
+
+	bz, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return DoctorReport{}, err
+	}
+	if err := os.WriteFile(reportPath, bz, 0o644); err != nil {
+		return DoctorReport{}, err
+	}
+	return report, nil
+}
+
+// LastErrorPath returns the path of the file of the error of the last failed command of a chain home.
+// The file is stored next to the home to not be removed with the home content.
+func LastErrorPath(home string) string {
+	return filepath.Clean(home) + ".error"
+}
+
+// SaveLastError saves the error of a failed command of the chain for the doctor report. The errors of the
+// commands of the chain binary contain the stderr of the binary captured by the command runner.
+func (c Chain) SaveLastError(lastErr error) error {
+	home, err := c.chain.Home()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(LastErrorPath(home), []byte(lastErr.Error()), 0o644)
+}
+
+// LastError returns the error saved for the last failed command of the chain, empty when no command failed.
+func (c Chain) LastError() (string, error) {
+	home, err := c.chain.Home()
+	if err != nil {
+		return "", err
+	}
+	bz, err := os.ReadFile(LastErrorPath(home))
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	return string(bz), err
+}
+
+// redact removes the mnemonics and, unless included, the addresses from the texts of the report.
+func (r *DoctorReport) redact(includeAddresses bool) {
+	redact := func(s string) string {
+		s = reMnemonic.ReplaceAllString(s, redactedMnemonic)
+		if !includeAddresses {
+			s = reAddress.ReplaceAllString(s, redactedAddress)
+		}
+		return s
+	}
+
+	r.LastError = redact(r.LastError)
+	r.BinaryVersion = redact(r.BinaryVersion)
+	for i, f := range r.HomeFiles {
+		r.HomeFiles[i].Path = redact(f.Path)
+	}
+	for i, p := range r.Problems {
+		r.Problems[i] = redact(p)
+	}
+}
+
+// binaryVersion returns the output of the version command of the chain binary.
+func binaryVersion(ctx context.Context, binary string) (string, error) {
+	if _, err := exec.LookPath(binary); err != nil && !filepath.IsAbs(binary) {
+		binary = filepath.Join(goenv.Bin(), binary)
+	}
+
+	out, err := exec.CommandContext(ctx, binary, "version").CombinedOutput()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// homeFiles returns the layout of the chain home, the files are only stat.
+func homeFiles(home string) ([]DoctorHomeFile, error) {
+	var files []DoctorHomeFile
+	err := filepath.WalkDir(home, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == home {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(home, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, DoctorHomeFile{
+			Path: filepath.ToSlash(rel),
+			Size: info.Size(),
+			Mode: info.Mode().String(),
+		})
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return files, err
+}
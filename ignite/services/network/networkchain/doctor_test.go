@@ -0,0 +1,97 @@
+package networkchain
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/xtime"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+func TestDoctor(t *testing.T) {
+	const (
+		mnemonic = "abandon ability able about above absent absorb abstract absurd abuse access accident"
+		address  = "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj"
+	)
+
+	newChain := func(t *testing.T) (Chain, string) {
+		home := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+		require.NoError(t, os.MkdirAll(filepath.Join(home, "keyring-test"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(home, "config", "genesis.json"), []byte(`{"chain_id":"mars-1"}`), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(home, "mnemonic.txt"), []byte(mnemonic), 0o600))
+		require.NoError(t, os.WriteFile(
+			filepath.Join(home, "keyring-test", "6b55aa5c1a1528c9bc44c6bb6bc2d5d0c1081f96.address"),
+			[]byte(address),
+			0o600,
+		))
+
+		ch, err := chain.New(fakeChainSource(t), chain.HomePath(home))
+		require.NoError(t, err)
+		return Chain{chain: ch, launchID: 1, clock: xtime.NewClockMock(time.Unix(1000, 0))}, home
+	}
+
+	t.Run("report without secrets", func(t *testing.T) {
+		c, home := newChain(t)
+		reportPath := filepath.Join(t.TempDir(), DoctorReportFile)
+
+		report, err := c.Doctor(
+			context.Background(),
+			reportPath,
+			DoctorWithError(errors.New("exit status 1: invalid account "+address+" from mnemonic: "+mnemonic)),
+		)
+		require.NoError(t, err)
+		require.Equal(t, home, report.Home)
+		require.EqualValues(t, 1, report.LaunchID)
+		require.Equal(t, "v0.46.1", report.SDKVersion)
+		require.NotEmpty(t, report.GenesisHash)
+		require.Contains(t, report.HomeFiles, DoctorHomeFile{Path: "mnemonic.txt", Size: int64(len(mnemonic)), Mode: "-rw-------"})
+		require.Equal(t, "exit status 1: invalid account <address> from mnemonic: <mnemonic>", report.LastError)
+
+		bz, err := os.ReadFile(reportPath)
+		require.NoError(t, err)
+		require.NotContains(t, string(bz), mnemonic)
+		require.NotContains(t, string(bz), "abandon")
+		require.NotContains(t, string(bz), address)
+		require.NotContains(t, string(bz), "6b55aa5c1a1528c9bc44c6bb6bc2d5d0c1081f96")
+	})
+
+	t.Run("last error of a failed command", func(t *testing.T) {
+		c, home := newChain(t)
+
+		lastErr, err := c.LastError()
+		require.NoError(t, err)
+		require.Empty(t, lastErr)
+
+		require.NoError(t, c.SaveLastError(errors.New("Error: invalid genesis: exit status 1")))
+		require.FileExists(t, LastErrorPath(home))
+		lastErr, err = c.LastError()
+		require.NoError(t, err)
+		require.Equal(t, "Error: invalid genesis: exit status 1", lastErr)
+	})
+
+	t.Run("report with addresses", func(t *testing.T) {
+		c, _ := newChain(t)
+		reportPath := filepath.Join(t.TempDir(), DoctorReportFile)
+
+		report, err := c.Doctor(
+			context.Background(),
+			reportPath,
+			DoctorWithError(errors.New("invalid account "+address+" from mnemonic: "+mnemonic)),
+			DoctorIncludeAddresses(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, "invalid account "+address+" from mnemonic: <mnemonic>", report.LastError)
+
+		bz, err := os.ReadFile(reportPath)
+		require.NoError(t, err)
+		require.NotContains(t, string(bz), mnemonic)
+		require.Contains(t, string(bz), address)
+	})
+}
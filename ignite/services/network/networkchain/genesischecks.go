@@ -0,0 +1,65 @@
+package networkchain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/xerrors"
+)
+
+// GenesisCheck is a chain-specific check of the initial genesis, the state of the modules
+// without typed accessors is available with genesis.ModuleState.
+type GenesisCheck func(ctx context.Context, genesis *cosmosutil.GenesisFile) error
+
+// namedGenesisCheck is a genesis check registered under its name.
+type namedGenesisCheck struct {
+	name  string
+	check GenesisCheck
+}
+
+// ErrGenesisCheck is returned when a registered genesis check fails.
+type ErrGenesisCheck struct {
+	Name string
+	Err  error
+}
+
+// Error implements error
+func (e ErrGenesisCheck) Error() string {
+	return fmt.Sprintf("genesis check %s failed: %s", e.Name, e.Err)
+}
+
+// Unwrap returns the error of the check.
+func (e ErrGenesisCheck) Unwrap() error {
+	return e.Err
+}
+
+// RegisterGenesisCheck registers a check of the initial genesis run after the built-in checks.
+// The checks run in their registration order and a failing check doesn't prevent the next checks from running,
+// the failures are reported under the check names. Registering a check with the name of a registered check
+// replaces it, the check keeps its position.
+func (c *Chain) RegisterGenesisCheck(name string, fn func(ctx context.Context, genesis *cosmosutil.GenesisFile) error) {
+	for i, check := range c.genesisChecks {
+		if check.name == name {
+			c.genesisChecks[i].check = fn
+			return
+		}
+	}
+	c.genesisChecks = append(c.genesisChecks, namedGenesisCheck{name: name, check: fn})
+}
+
+// runGenesisChecks runs the registered genesis checks and joins their failures,
+// the remaining checks are skipped when the context is done.
+func (c Chain) runGenesisChecks(ctx context.Context, genesis *cosmosutil.GenesisFile) error {
+	var errs []error
+	for _, check := range c.genesisChecks {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+		if err := check.check(ctx, genesis); err != nil {
+			errs = append(errs, ErrGenesisCheck{Name: check.name, Err: err})
+		}
+	}
+	return xerrors.Join(errs...)
+}
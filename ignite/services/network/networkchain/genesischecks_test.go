@@ -0,0 +1,84 @@
+package networkchain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/xerrors"
+)
+
+// checkOracleFeeders is an example of a chain-specific check of a module unknown to the typed genesis.
+func checkOracleFeeders(_ context.Context, genesis *cosmosutil.GenesisFile) error {
+	var oracle struct {
+		Feeders []string `json:"feeders"`
+	}
+	if err := genesis.ModuleState("oracle", &oracle); err != nil {
+		return err
+	}
+	if len(oracle.Feeders) == 0 {
+		return errors.New("the oracle feeders list is empty")
+	}
+	return nil
+}
+
+func TestRunGenesisChecks(t *testing.T) {
+	parse := func(t *testing.T, genesis string) *cosmosutil.GenesisFile {
+		genesisFile, err := cosmosutil.ParseGenesisFile([]byte(genesis))
+		require.NoError(t, err)
+		return genesisFile
+	}
+
+	t.Run("check the state of a module", func(t *testing.T) {
+		var c Chain
+		c.RegisterGenesisCheck("oracle-feeders", checkOracleFeeders)
+
+		err := c.runGenesisChecks(context.Background(), parse(t, `{"app_state":{"oracle":{"feeders":["spn1foo"]}}}`))
+		require.NoError(t, err)
+
+		err = c.runGenesisChecks(context.Background(), parse(t, `{"app_state":{"oracle":{"feeders":[]}}}`))
+		require.EqualError(t, err, "genesis check oracle-feeders failed: the oracle feeders list is empty")
+	})
+
+	t.Run("checks run in order without short-circuit", func(t *testing.T) {
+		var (
+			c   Chain
+			ran []string
+		)
+		check := func(name string, err error) GenesisCheck {
+			return func(context.Context, *cosmosutil.GenesisFile) error {
+				ran = append(ran, name)
+				return err
+			}
+		}
+		c.RegisterGenesisCheck("first", check("first", errors.New("first failed")))
+		c.RegisterGenesisCheck("second", check("second", nil))
+		c.RegisterGenesisCheck("third", check("third", errors.New("third failed")))
+
+		// registering a check again replaces it at its position
+		c.RegisterGenesisCheck("second", check("second replaced", errors.New("second failed")))
+
+		err := c.runGenesisChecks(context.Background(), parse(t, `{}`))
+		require.Equal(t, []string{"first", "second replaced", "third"}, ran)
+		require.Equal(t, []error{
+			ErrGenesisCheck{Name: "first", Err: errors.New("first failed")},
+			ErrGenesisCheck{Name: "second", Err: errors.New("second failed")},
+			ErrGenesisCheck{Name: "third", Err: errors.New("third failed")},
+		}, xerrors.Errors(err))
+	})
+
+	t.Run("checks skipped once the context is done", func(t *testing.T) {
+		var c Chain
+		c.RegisterGenesisCheck("never run", func(context.Context, *cosmosutil.GenesisFile) error {
+			t.Fatal("the check must not run")
+			return nil
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.ErrorIs(t, c.runGenesisChecks(ctx, parse(t, `{}`)), context.Canceled)
+	})
+}
@@ -0,0 +1,264 @@
+package networkchain
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/jsondiff"
+)
+
+const (
+	// GenesisDiffFile is the name of the file in the chain home containing the full diff of a genesis mismatch.
+	GenesisDiffFile = "genesis.diff"
+
+	// genesisDiffSummary is the maximum number of differences included in the genesis mismatch error.
+	genesisDiffSummary = 10
+)
+
+// GenesisReference opens the reference genesis the genesis of the chain is compared with on a hash mismatch.
+type GenesisReference func(ctx context.Context, httpClient *http.Client) (io.ReadCloser, error)
+
+// GenesisReferenceURL uses the genesis downloaded from the URL as reference genesis.
+func GenesisReferenceURL(url string) GenesisReference {
+	return func(ctx context.Context, httpClient *http.Client) (io.ReadCloser, error) {
+		if httpClient == nil {
+			httpClient = http.DefaultClient
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("cannot download the reference genesis from %s: %s", url, resp.Status)
+		}
+		return resp.Body, nil
+	}
+}
+
+// GenesisReferenceBundle uses the genesis of the launch bundle as reference genesis.
+func GenesisReferenceBundle(path string) GenesisReference {
+	return func(context.Context, *http.Client) (io.ReadCloser, error) {
+		return openTarGzFile(path, bundleGenesisFile)
+	}
+}
+
+// ErrGenesisMismatch is returned when the genesis of the chain doesn't match the expected hash.
+type ErrGenesisMismatch struct {
+	ExpectedHash string
+	Hash         string
+
+	// Differences contains the first differences with the reference genesis.
+	Differences []string
+
+	// Count is the total number of differences with the reference genesis.
+	Count int
+
+	// ReferenceHash is the hash of the reference genesis, empty without reference genesis.
+	ReferenceHash string
+
+	// DiffPath is the path of the file containing all the differences.
+	DiffPath string
+}
+
+// Error implements error.
+func (e ErrGenesisMismatch) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "the genesis hash %s doesn't match the expected hash %s", e.Hash, e.ExpectedHash)
+	if e.ReferenceHash == "" {
+		return b.String()
+	}
+	if !strings.EqualFold(e.ReferenceHash, e.ExpectedHash) {
+		fmt.Fprintf(&b, "\nthe reference genesis doesn't match the expected hash either, its hash is %s", e.ReferenceHash)
+	}
+	if e.Count == 0 {
+		b.WriteString("\nthe genesis has the same content as the reference genesis, only the formatting or the order of the keys differs")
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "\n%d differences with the reference genesis, the full diff is written in %s:", e.Count, e.DiffPath)
+	for _, d := range e.Differences {
+		fmt.Fprintf(&b, "\n  %s", d)
+	}
+	if more := e.Count - len(e.Differences); more > 0 {
+		fmt.Fprintf(&b, "\n  ...and %d more", more)
+	}
+	return b.String()
+}
+
+// VerifyGenesisHash checks the genesis of the chain matches the hash announced by the coordinator.
+// On a mismatch, the genesis is compared with the reference genesis when provided, the first differences
+// are returned in an ErrGenesisMismatch and all the differences are written in the GenesisDiffFile of the chain home.
+func (c Chain) VerifyGenesisHash(ctx context.Context, expectedHash string, reference GenesisReference) error {
+	ev := c.ev.WithCategory(events.CategoryGenesis)
+
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+
+	ev.Send(events.NewOngoing("Verifying the genesis hash"))
+
+	hash, err := fileSHA256(genesisPath)
+	if err != nil {
+		return err
+	}
+	// the expected hash can be announced with uppercase hex digits
+	if strings.EqualFold(hash, expectedHash) {
+		ev.Send(events.NewDone("The genesis matches the expected hash", ""))
+		return nil
+	}
+
+	mismatch := ErrGenesisMismatch{
+		ExpectedHash: expectedHash,
+		Hash:         hash,
+	}
+	if reference == nil {
+		return mismatch
+	}
+
+	ev.Send(events.NewOngoing("Comparing the genesis with the reference genesis"))
+
+	ref, err := reference(ctx, c.httpClient)
+	if err != nil {
+		return err
+	}
+	defer ref.Close()
+
+	mismatch.DiffPath = filepath.Join(home, GenesisDiffFile)
+	if mismatch.ReferenceHash, err = diffGenesis(genesisPath, ref, mismatch.DiffPath, &mismatch); err != nil {
+		return err
+	}
+	return mismatch
+}
+
+// diffGenesis writes the differences of the genesis with the reference genesis into the diff file, the first
+// differences are added to the mismatch error. The hash of the reference genesis is returned.
+func diffGenesis(genesisPath string, reference io.Reader, diffPath string, mismatch *ErrGenesisMismatch) (string, error) {
+	genesis, err := os.Open(genesisPath)
+	if err != nil {
+		return "", err
+	}
+	defer genesis.Close()
+
+	diffFile, err := os.Create(diffPath)
+	if err != nil {
+		return "", err
+	}
+	defer diffFile.Close()
+	diff := bufio.NewWriter(diffFile)
+
+	var (
+		h       = sha256.New()
+		ref     = io.TeeReader(reference, h)
+		diffErr error
+	)
+	err = jsondiff.Compare(bufio.NewReader(genesis), ref, func(d jsondiff.Difference) bool {
+		line := d.Format("local genesis", "reference genesis")
+		if _, diffErr = fmt.Fprintln(diff, line); diffErr != nil {
+			return false
+		}
+		if len(mismatch.Differences) < genesisDiffSummary {
+			mismatch.Differences = append(mismatch.Differences, line)
+		}
+		mismatch.Count++
+		return true
+	})
+	if err != nil {
+		return "", fmt.Errorf("cannot compare the genesis with the reference genesis: %w", err)
+	}
+	if diffErr != nil {
+		return "", diffErr
+	}
+	if err := diff.Flush(); err != nil {
+		return "", err
+	}
+
+	// the remaining of the reference is hashed, the comparison can stop before its end
+	if _, err := io.Copy(h, reference); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// fileSHA256 returns the sha256 hash of the file without loading it in memory.
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// tarGzFile is a file read from a tar.gz archive.
+type tarGzFile struct {
+	io.Reader
+	closers []io.Closer
+}
+
+// Close implements io.Closer.
+func (f tarGzFile) Close() error {
+	var err error
+	for _, c := range f.closers {
+		if closeErr := c.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// openTarGzFile opens a regular file of a tar.gz archive without reading the other files in memory.
+func openTarGzFile(path, name string) (io.ReadCloser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	file := tarGzFile{closers: []io.Closer{gr, f}}
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			file.Close()
+			return nil, fmt.Errorf("the archive %s has no %s file", path, name)
+		}
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+		if header.Typeflag == tar.TypeReg && filepath.Clean(header.Name) == name {
+			file.Reader = tr
+			return file, nil
+		}
+	}
+}
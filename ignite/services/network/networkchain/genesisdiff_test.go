@@ -0,0 +1,132 @@
+package networkchain
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffGenesis(t *testing.T) {
+	const genesis = `{
+  "chain_id": "mars-1",
+  "app_state": {
+    "bank": {"balances": [{"address": "mars1a", "coins": [{"denom": "stake", "amount": "1000"}]}]},
+    "genutil": {"gen_txs": [{"body": {"memo": "a@1.1.1.1:26656"}}, {"body": {"memo": "b@2.2.2.2:26656"}}]}
+  }
+}`
+	dir := t.TempDir()
+	genesisPath := filepath.Join(dir, "genesis.json")
+	require.NoError(t, os.WriteFile(genesisPath, []byte(genesis), 0o644))
+
+	tests := []struct {
+		name      string
+		reference string
+		want      []string
+	}{
+		{
+			name: "different ordering",
+			reference: `{"app_state":{"genutil":{"gen_txs":[{"body":{"memo":"a@1.1.1.1:26656"}},{"body":{"memo":"b@2.2.2.2:26656"}}]},
+				"bank":{"balances":[{"coins":[{"amount":"1000","denom":"stake"}],"address":"mars1a"}]}},"chain_id":"mars-1"}`,
+		},
+		{
+			name: "different values",
+			reference: `{"chain_id":"mars-2","app_state":{"bank":{"balances":[{"address":"mars1a","coins":[{"denom":"stake","amount":"2000"}]}]},
+				"genutil":{"gen_txs":[{"body":{"memo":"a@1.1.1.1:26656"}},{"body":{"memo":"b@3.3.3.3:26656"}}]}}}`,
+			want: []string{
+				`chain_id differs: "mars-1" in the local genesis, "mars-2" in the reference genesis`,
+				`app_state.bank.balances[0].coins[0].amount differs: "1000" in the local genesis, "2000" in the reference genesis`,
+				`app_state.genutil.gen_txs[1].body.memo differs: "b@2.2.2.2:26656" in the local genesis, "b@3.3.3.3:26656" in the reference genesis`,
+			},
+		},
+		{
+			name: "different array length",
+			reference: `{"chain_id":"mars-1","app_state":{"bank":{"balances":[{"address":"mars1a","coins":[{"denom":"stake","amount":"1000"}]}]},
+				"genutil":{"gen_txs":[{"body":{"memo":"a@1.1.1.1:26656"}},{"body":{"memo":"b@2.2.2.2:26656"}},{"body":{"memo":"c"}}]}}}`,
+			want: []string{
+				`app_state.genutil.gen_txs has a different length: 2 in the local genesis, 3 in the reference genesis`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				diffPath = filepath.Join(t.TempDir(), GenesisDiffFile)
+				mismatch ErrGenesisMismatch
+			)
+
+			hash, err := diffGenesis(genesisPath, strings.NewReader(tt.reference), diffPath, &mismatch)
+			require.NoError(t, err)
+			require.Equal(t, sha256Hex([]byte(tt.reference)), hash)
+			require.Equal(t, tt.want, mismatch.Differences)
+			require.Equal(t, len(tt.want), mismatch.Count)
+
+			diff, err := os.ReadFile(diffPath)
+			require.NoError(t, err)
+			var wantDiff string
+			for _, line := range tt.want {
+				wantDiff += line + "\n"
+			}
+			require.Equal(t, wantDiff, string(diff))
+		})
+	}
+}
+
+func TestDiffGenesisSummary(t *testing.T) {
+	var local, reference []string
+	for i := 0; i < genesisDiffSummary+5; i++ {
+		local = append(local, "0")
+		reference = append(reference, "1")
+	}
+	dir := t.TempDir()
+	genesisPath := filepath.Join(dir, "genesis.json")
+	require.NoError(t, os.WriteFile(genesisPath, []byte(`{"a":[`+strings.Join(local, ",")+`]}`), 0o644))
+
+	mismatch := ErrGenesisMismatch{ExpectedHash: "abc", Hash: "def", DiffPath: filepath.Join(dir, GenesisDiffFile)}
+	hash, err := diffGenesis(genesisPath, strings.NewReader(`{"a":[`+strings.Join(reference, ",")+`]}`), mismatch.DiffPath, &mismatch)
+	require.NoError(t, err)
+	mismatch.ReferenceHash = hash
+
+	require.Len(t, mismatch.Differences, genesisDiffSummary)
+	require.Equal(t, genesisDiffSummary+5, mismatch.Count)
+	require.Contains(t, mismatch.Error(), "the genesis hash def doesn't match the expected hash abc\n"+
+		"the reference genesis doesn't match the expected hash either, its hash is "+hash+"\n"+
+		"15 differences with the reference genesis, the full diff is written in "+mismatch.DiffPath+":\n"+
+		"  a[0] differs: 0 in the local genesis, 1 in the reference genesis\n")
+	require.True(t, strings.HasSuffix(mismatch.Error(), "\n  ...and 5 more"))
+
+	diff, err := os.ReadFile(mismatch.DiffPath)
+	require.NoError(t, err)
+	require.Len(t, strings.Split(strings.TrimSpace(string(diff)), "\n"), genesisDiffSummary+5)
+}
+
+func TestErrGenesisMismatch(t *testing.T) {
+	err := ErrGenesisMismatch{ExpectedHash: "abc", Hash: "def"}
+	require.EqualError(t, err, "the genesis hash def doesn't match the expected hash abc")
+
+	// the hex digits of the hashes are compared case-insensitively
+	err.ReferenceHash = "ABC"
+	require.EqualError(t, err, "the genesis hash def doesn't match the expected hash abc\n"+
+		"the genesis has the same content as the reference genesis, only the formatting or the order of the keys differs")
+}
+
+func TestOpenTarGzFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	require.NoError(t, writeTarGz(path, map[string][]byte{
+		bundleGenesisFile:  []byte(`{"chain_id":"mars-1"}`),
+		bundleManifestFile: []byte(`{}`),
+	}))
+
+	f, err := openTarGzFile(path, bundleGenesisFile)
+	require.NoError(t, err)
+	content, err := io.ReadAll(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.Equal(t, `{"chain_id":"mars-1"}`, string(content))
+
+	_, err = openTarGzFile(path, bundlePeersFile)
+	require.EqualError(t, err, "the archive "+path+" has no peers.json file")
+}
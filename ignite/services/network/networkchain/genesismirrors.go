@@ -0,0 +1,274 @@
+package networkchain
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/xerrors"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+const (
+	// mirrorProbeTimeout is the maximum time to wait for the mirrors of the genesis to respond to the probe.
+	mirrorProbeTimeout = 5 * time.Second
+
+	// maxGenesisMirrorListSize is the maximum size of the list of the mirrors of the genesis.
+	maxGenesisMirrorListSize = 64 * 1024
+
+	// genesisDownloadEventKey is the debouncing key of the progress events of the genesis download.
+	genesisDownloadEventKey = "genesis-download"
+)
+
+// WithGenesisMirrors sets the mirrors of the genesis of the chain, the genesis is fetched from the URL of
+// the genesis or from one of the mirrors and verified against the genesis hash.
+// The mirror list of the chain metadata is not fetched when mirrors are set.
+func WithGenesisMirrors(urls ...string) Option {
+	return func(c *Chain) {
+		c.genesisMirrors = urls
+	}
+}
+
+// WithGenesisMirrorList sets the URL of the list of the mirrors of the genesis of the chain,
+// the list is fetched with the genesis, see networktypes.ParseGenesisMirrorList.
+func WithGenesisMirrorList(url string) Option {
+	return func(c *Chain) {
+		c.genesisMirrorList = url
+	}
+}
+
+// GenesisSource returns the URL the genesis of the chain was fetched from, it's the URL of the genesis
+// or one of its mirrors. It's empty when the chain is not initialized from a genesis URL.
+func (c Chain) GenesisSource() (string, error) {
+	home, err := c.Home()
+	if err != nil {
+		return "", err
+	}
+	launch, err := ReadLaunchFile(home)
+	if err != nil {
+		return "", err
+	}
+	return launch.GenesisSource, nil
+}
+
+// saveGenesisSource records the URL the genesis was fetched from in the launch file of the chain.
+func (c Chain) saveGenesisSource(source string) error {
+	return c.updateLaunchFile(func(launch *Launch) {
+		launch.GenesisSource = source
+	})
+}
+
+// resolveGenesisMirrors fetches the list of the mirrors of the genesis when no mirror is set.
+// The genesis is fetched from its URL only when the list can't be fetched.
+func (c *Chain) resolveGenesisMirrors(ctx context.Context) {
+	if c.genesisMirrorList == "" || len(c.genesisMirrors) > 0 {
+		return
+	}
+
+	list, err := c.fetchGenesisAttachment(ctx, c.genesisMirrorList, maxGenesisMirrorListSize)
+	if err == nil {
+		c.genesisMirrors, err = networktypes.ParseGenesisMirrorList(list)
+	}
+	if err != nil {
+		ev := c.ev.WithCategory(events.CategoryGenesis)
+		ev.Send(events.NewWarning(fmt.Sprintf("Cannot fetch the genesis mirror list from %s: %s", c.genesisMirrorList, err)))
+	}
+}
+
+// genesisCandidates returns the URL of the genesis followed by its mirrors, without duplicates.
+func (c Chain) genesisCandidates() []string {
+	var (
+		candidates []string
+		seen       = make(map[string]bool)
+	)
+	for _, url := range append([]string{c.genesisURL}, c.genesisMirrors...) {
+		if url != "" && !seen[url] {
+			seen[url] = true
+			candidates = append(candidates, url)
+		}
+	}
+	return candidates
+}
+
+// fetchGenesis fetches the genesis from its URL or its mirrors and returns the genesis, its hash and the URL
+// it was fetched from. The mirror responding first to a probe is tried first, then the others in their order.
+// A mirror that can't be fetched or serves a genesis not matching the genesis hash is skipped with a warning,
+// the fetch fails when no mirror serves a valid genesis.
+func (c *Chain) fetchGenesis(ctx context.Context) (genesis []byte, hash, source string, err error) {
+	ev := c.ev.WithCategory(events.CategoryGenesis)
+
+	c.resolveGenesisMirrors(ctx)
+	candidates := c.genesisCandidates()
+	if len(candidates) == 1 {
+		genesis, hash, err = c.fetchGenesisFrom(ctx, candidates[0])
+		return genesis, hash, candidates[0], err
+	}
+
+	var errs []error
+	for _, url := range c.probeMirrors(ctx, candidates) {
+		genesis, hash, err := c.fetchGenesisFrom(ctx, url)
+		if err != nil {
+			ev.Send(events.NewWarning(fmt.Sprintf("Skipping the genesis mirror %s: %s", url, err)))
+			errs = append(errs, err)
+			continue
+		}
+		return genesis, hash, url, nil
+	}
+	return nil, "", "", fmt.Errorf("no genesis mirror serves a valid genesis: %w", xerrors.Join(errs...))
+}
+
+// fetchGenesisFrom fetches the genesis from the URL and verifies it against the genesis hash.
+// The genesis is trusted on first use without genesis hash, its hash becomes the genesis hash.
+func (c *Chain) fetchGenesisFrom(ctx context.Context, url string) (genesis []byte, hash string, err error) {
+	ev := c.ev.WithCategory(events.CategoryGenesis)
+
+	if err := c.checkURLScheme(url); err != nil {
+		return nil, "", err
+	}
+
+	// an interrupted download of a large genesis is resumed on the next init
+	resumeFile, err := genesisResumeFile(url)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// the progress is reported for every read of the response, the events are throttled
+	progress := events.NewDebouncer(ev, events.DefaultProgressInterval, events.DebounceKey(func(events.Event) string {
+		return genesisDownloadEventKey
+	}))
+
+	fetchOptions := []cosmosutil.FetchOption{
+		cosmosutil.WithResumeFile(resumeFile),
+		cosmosutil.WithMaxGenesisSize(c.maxGenesisSize),
+		cosmosutil.WithDownloadProgress(func(p cosmosutil.DownloadProgress) {
+			size := "unknown size"
+			if p.Total >= 0 {
+				size = formatBytes(uint64(p.Total))
+			}
+			progress.Send(events.NewOngoing(events.Message(networktypes.MsgGenesisDownloadProgress, events.Params{
+				"Downloaded": formatBytes(uint64(p.Downloaded)),
+				"Size":       size,
+			})))
+		}),
+		cosmosutil.WithRetryNotify(func(r cosmosutil.FetchRetry) {
+			progress.Send(events.New(events.StatusOngoing, fmt.Sprintf(
+				"Genesis server responded %d, retrying in %s (attempt %d)",
+				r.StatusCode,
+				r.Delay.Round(time.Second),
+				r.Attempt,
+			)))
+		}),
+	}
+	if c.httpClient != nil {
+		fetchOptions = append(fetchOptions, cosmosutil.WithHTTPClient(c.httpClient))
+	}
+
+	genesis, hash, err = cosmosutil.GenesisAndHashFromURL(ctx, url, fetchOptions...)
+	progress.Flush()
+	if err != nil {
+		return nil, "", err
+	}
+
+	// if the blockchain has been initialized with no genesis hash, we assign the fetched hash to it
+	// otherwise we check the genesis integrity with the existing hash
+	if c.genesisHash == "" {
+		c.warn(
+			WarningGenesisHashNotPublished,
+			"The hash of the genesis from %s is not published, the fetched genesis is trusted on first use with the hash %s",
+			url,
+			hash,
+		)
+		c.genesisHash = hash
+	} else if !strings.EqualFold(hash, c.genesisHash) {
+		return nil, "", fmt.Errorf("genesis from URL %s is invalid. expected hash %s, actual hash %s", url, c.genesisHash, hash)
+	}
+	return genesis, hash, nil
+}
+
+// probeMirrors sends a HEAD request to each mirror and returns the mirrors ordered with the mirror responding
+// first, the other mirrors keep their order. The mirrors are returned in their order when none responds.
+func (c Chain) probeMirrors(ctx context.Context, mirrors []string) []string {
+	client := c.httpClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	ctx, cancel := withTimeout(ctx, c.clock, mirrorProbeTimeout)
+	defer cancel()
+
+	var (
+		fastest = make(chan int, 1)
+		once    sync.Once
+		wg      sync.WaitGroup
+	)
+	for i, url := range mirrors {
+		wg.Add(1)
+		go func(i int, url string) {
+			defer wg.Done()
+			req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+			if err != nil {
+				return
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				return
+			}
+			resp.Body.Close()
+			if resp.StatusCode < http.StatusBadRequest {
+				once.Do(func() {
+					fastest <- i
+					cancel()
+				})
+			}
+		}(i, url)
+	}
+	wg.Wait()
+
+	select {
+	case first := <-fastest:
+		ordered := append([]string{mirrors[first]}, mirrors[:first]...)
+		return append(ordered, mirrors[first+1:]...)
+	default:
+		return mirrors
+	}
+}
+
+// fetchGenesisAttachment downloads a file published with the genesis like its signature or its mirror list,
+// the download fails when the file exceeds the maximum size.
+func (c *Chain) fetchGenesisAttachment(ctx context.Context, url string, maxSize int64) ([]byte, error) {
+	if err := c.checkURLScheme(url); err != nil {
+		return nil, err
+	}
+
+	httpClient := c.httpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("the server responded %s", resp.Status)
+	}
+
+	bz, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(bz)) > maxSize {
+		return nil, fmt.Errorf("the file exceeds %d bytes", maxSize)
+	}
+	return bz, nil
+}
@@ -0,0 +1,134 @@
+package networkchain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/xtime"
+)
+
+func TestFetchGenesisMirrors(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	const genesis = `{"chain_id":"foo-1"}`
+	sum := sha256.Sum256([]byte(genesis))
+	genesisHash := hex.EncodeToString(sum[:])
+
+	serve := func(body string, delay time.Duration) *httptest.Server {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(delay)
+			w.Write([]byte(body))
+		}))
+		t.Cleanup(srv.Close)
+		return srv
+	}
+	// the slow mirror serves the valid genesis, the fast mirror a tampered genesis
+	slowGood := serve(genesis, 100*time.Millisecond)
+	fastBad := serve(`{"chain_id":"bar-1"}`, 0)
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+
+	newChain := func(genesisURL string, mirrors ...string) (*Chain, events.Bus) {
+		ev := events.NewBus(events.WithCustomBufferSize(10))
+		return &Chain{
+			genesisURL:     genesisURL,
+			genesisHash:    genesisHash,
+			genesisMirrors: mirrors,
+			maxGenesisSize: cosmosutil.DefaultMaxGenesisSize,
+			ev:             ev,
+			clock:          xtime.NewClockSystem(),
+			warnings:       &warningCollector{},
+		}, ev
+	}
+
+	t.Run("fallback to a valid mirror", func(t *testing.T) {
+		c, ev := newChain(down.URL, fastBad.URL, slowGood.URL)
+
+		fetched, hash, source, err := c.fetchGenesis(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, genesis, string(fetched))
+		require.Equal(t, genesisHash, hash)
+		require.Equal(t, slowGood.URL, source)
+
+		// the fast mirror is tried first and skipped
+		ev.Shutdown(context.Background())
+		var skipped, progress []string
+		for e := range ev.Events() {
+			switch {
+			case strings.HasPrefix(e.Description, "Skipping the genesis mirror"):
+				skipped = append(skipped, e.Description)
+			case strings.HasPrefix(e.Description, "Downloading the genesis:"):
+				progress = append(progress, e.Description)
+			}
+		}
+		require.Len(t, skipped, 2)
+		require.Contains(t, skipped[0], fastBad.URL)
+		require.Contains(t, skipped[1], down.URL)
+
+		// the download progress of the valid mirror is the last progress reported
+		size := formatBytes(uint64(len(genesis)))
+		require.Equal(t, fmt.Sprintf("Downloading the genesis: %s of %s", size, size), progress[len(progress)-1])
+	})
+
+	t.Run("expected hash with uppercase hex digits", func(t *testing.T) {
+		c, _ := newChain(slowGood.URL)
+		c.genesisHash = strings.ToUpper(genesisHash)
+
+		fetched, _, _, err := c.fetchGenesis(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, genesis, string(fetched))
+	})
+
+	t.Run("no valid mirror", func(t *testing.T) {
+		c, _ := newChain(down.URL, fastBad.URL)
+
+		_, _, _, err := c.fetchGenesis(context.Background())
+		require.ErrorContains(t, err, "no genesis mirror serves a valid genesis")
+		require.ErrorContains(t, err, "expected hash "+genesisHash)
+	})
+
+	t.Run("mirror list", func(t *testing.T) {
+		list := serve(`["`+fastBad.URL+`","`+slowGood.URL+`"]`, 0)
+		c, _ := newChain(down.URL)
+		c.genesisMirrorList = list.URL
+
+		fetched, _, source, err := c.fetchGenesis(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, genesis, string(fetched))
+		require.Equal(t, slowGood.URL, source)
+	})
+
+	t.Run("mirror list not available", func(t *testing.T) {
+		c, ev := newChain(slowGood.URL)
+		c.genesisMirrorList = down.URL
+
+		// the genesis is fetched from its URL
+		_, _, source, err := c.fetchGenesis(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, slowGood.URL, source)
+
+		ev.Shutdown(context.Background())
+		var warned bool
+		for e := range ev.Events() {
+			warned = warned || strings.HasPrefix(e.Description, "Cannot fetch the genesis mirror list from "+down.URL)
+		}
+		require.True(t, warned)
+	})
+
+	t.Run("duplicated mirrors", func(t *testing.T) {
+		c, _ := newChain(slowGood.URL, slowGood.URL)
+
+		require.Equal(t, []string{slowGood.URL}, c.genesisCandidates())
+	})
+}
@@ -0,0 +1,225 @@
+package networkchain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/sandbox"
+)
+
+// optionalGenesisModules are the modules that can be present in the genesis or the binary only
+// without preventing the chain from starting.
+var optionalGenesisModules = map[string]struct{}{
+	"crisis": {},
+}
+
+// ErrGenesisModules is returned when the modules of the genesis don't match the modules of the chain binary.
+type ErrGenesisModules struct {
+	// Missing are the modules of the binary without state in the genesis.
+	Missing []string
+
+	// Extra are the modules with a state in the genesis that the binary doesn't include.
+	Extra []string
+}
+
+// Error implements error.
+func (e ErrGenesisModules) Error() string {
+	var problems []string
+	if len(e.Missing) > 0 {
+		problems = append(problems, "missing modules from the genesis: "+strings.Join(e.Missing, ", "))
+	}
+	if len(e.Extra) > 0 {
+		problems = append(problems, "modules not included in the chain binary: "+strings.Join(e.Extra, ", "))
+	}
+	return fmt.Sprintf("the genesis doesn't match the chain binary, %s", strings.Join(problems, "; "))
+}
+
+// ModuleVersionConflict is a module with a different consensus version in the chain binary and the genesis.
+type ModuleVersionConflict struct {
+	Module  string
+	Binary  uint64
+	Genesis uint64
+}
+
+// ErrModuleVersions is returned when the module versions of the genesis don't match the module versions
+// of the chain binary, the chain panics when initializing the modules from the genesis.
+type ErrModuleVersions struct {
+	// Conflicts are the modules with a different version in the binary and the genesis.
+	Conflicts []ModuleVersionConflict
+
+	// BinaryOnly are the modules with a version in the binary only.
+	BinaryOnly []string
+
+	// GenesisOnly are the modules with a version in the genesis only.
+	GenesisOnly []string
+}
+
+// Error implements error.
+func (e ErrModuleVersions) Error() string {
+	var problems []string
+	if len(e.Conflicts) > 0 {
+		conflicts := make([]string, len(e.Conflicts))
+		for i, c := range e.Conflicts {
+			conflicts[i] = fmt.Sprintf("%s (binary %d, genesis %d)", c.Module, c.Binary, c.Genesis)
+		}
+		problems = append(problems, "conflicting versions: "+strings.Join(conflicts, ", "))
+	}
+	if len(e.BinaryOnly) > 0 {
+		problems = append(problems, "modules versioned in the chain binary only: "+strings.Join(e.BinaryOnly, ", "))
+	}
+	if len(e.GenesisOnly) > 0 {
+		problems = append(problems, "modules versioned in the genesis only: "+strings.Join(e.GenesisOnly, ", "))
+	}
+	return fmt.Sprintf("the genesis module versions don't match the chain binary, %s", strings.Join(problems, "; "))
+}
+
+// checkGenesisModules checks the modules with a state in the genesis are the modules of the chain binary
+// and their versions match when the genesis carries a version map.
+// The modules and the module versions of the binary are read offline from a default genesis initialized
+// in a temporary home.
+func (c *Chain) checkGenesisModules(ctx context.Context, chainCmd chaincmdrunner.Runner, genesisFile []byte) error {
+	ev := c.ev.WithCategory(events.CategoryGenesis)
+
+	ev.Send(events.NewOngoing("Checking the genesis modules"))
+
+	binaryGenesis, err := binaryDefaultGenesis(ctx, chainCmd, c.sandbox)
+	if err != nil {
+		return err
+	}
+	binaryModules, err := cosmosutil.GenesisModules(binaryGenesis)
+	if err != nil {
+		return err
+	}
+	genesisModules, err := cosmosutil.GenesisModules(genesisFile)
+	if err != nil {
+		return err
+	}
+
+	if err := compareGenesisModules(binaryModules, genesisModules); err != nil {
+		return err
+	}
+
+	// the versions are only compared when both the binary and the genesis carry a version map,
+	// the genesis of a new chain has no version map
+	binaryVersions, err := cosmosutil.GenesisModuleVersions(binaryGenesis)
+	if err != nil {
+		return err
+	}
+	genesisVersions, err := cosmosutil.GenesisModuleVersions(genesisFile)
+	if err != nil {
+		return err
+	}
+	switch {
+	case genesisVersions == nil:
+	case binaryVersions == nil:
+		ev.Send(events.NewDebug("The chain binary has no module version map, the genesis module versions are not checked"))
+	default:
+		if err := compareModuleVersions(binaryVersions, genesisVersions); err != nil {
+			return err
+		}
+	}
+
+	ev.Send(events.NewDone("Genesis modules checked", ""))
+	return nil
+}
+
+// binaryDefaultGenesis returns the genesis generated by the init command of the chain binary.
+// The init command runs with the sandbox runner when not nil.
+func binaryDefaultGenesis(
+	ctx context.Context,
+	chainCmd chaincmdrunner.Runner,
+	sandboxRunner sandbox.Runner,
+) ([]byte, error) {
+	home, err := os.MkdirTemp("", "ignite-genesis-modules")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(home)
+
+	initCmd := chainCmd.Cmd().Copy(chaincmd.WithHome(home))
+	if sandboxRunner != nil {
+		err = runSandboxed(ctx, sandboxRunner, initCmd.InitCommand("moniker"))
+	} else {
+		var runner chaincmdrunner.Runner
+		if runner, err = chaincmdrunner.New(ctx, initCmd); err == nil {
+			err = runner.Init(ctx, "moniker")
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot initialize a default genesis: %w", err)
+	}
+
+	return os.ReadFile(filepath.Join(home, cosmosutil.ChainConfigDir, "genesis.json"))
+}
+
+// compareGenesisModules returns an ErrGenesisModules error if the modules of the binary and the genesis differ.
+func compareGenesisModules(binaryModules, genesisModules []string) error {
+	var (
+		e  ErrGenesisModules
+		in = func(modules []string, module string) bool {
+			for _, m := range modules {
+				if m == module {
+					return true
+				}
+			}
+			return false
+		}
+	)
+
+	for _, module := range binaryModules {
+		if _, ok := optionalGenesisModules[module]; !ok && !in(genesisModules, module) {
+			e.Missing = append(e.Missing, module)
+		}
+	}
+	for _, module := range genesisModules {
+		if _, ok := optionalGenesisModules[module]; !ok && !in(binaryModules, module) {
+			e.Extra = append(e.Extra, module)
+		}
+	}
+
+	if len(e.Missing) > 0 || len(e.Extra) > 0 {
+		return e
+	}
+	return nil
+}
+
+// compareModuleVersions returns an ErrModuleVersions error if the module versions of the binary and the genesis differ.
+func compareModuleVersions(binaryVersions, genesisVersions map[string]uint64) error {
+	var e ErrModuleVersions
+	for module, binaryVersion := range binaryVersions {
+		genesisVersion, ok := genesisVersions[module]
+		switch {
+		case !ok:
+			e.BinaryOnly = append(e.BinaryOnly, module)
+		case genesisVersion != binaryVersion:
+			e.Conflicts = append(e.Conflicts, ModuleVersionConflict{
+				Module:  module,
+				Binary:  binaryVersion,
+				Genesis: genesisVersion,
+			})
+		}
+	}
+	for module := range genesisVersions {
+		if _, ok := binaryVersions[module]; !ok {
+			e.GenesisOnly = append(e.GenesisOnly, module)
+		}
+	}
+
+	if len(e.Conflicts) == 0 && len(e.BinaryOnly) == 0 && len(e.GenesisOnly) == 0 {
+		return nil
+	}
+	sort.Slice(e.Conflicts, func(i, j int) bool {
+		return e.Conflicts[i].Module < e.Conflicts[j].Module
+	})
+	sort.Strings(e.BinaryOnly)
+	sort.Strings(e.GenesisOnly)
+	return e
+}
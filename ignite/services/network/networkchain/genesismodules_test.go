@@ -0,0 +1,86 @@
+package networkchain
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+)
+
+func readGenesisModules(t *testing.T, path string) []string {
+	genesisFile, err := os.ReadFile(path)
+	require.NoError(t, err)
+	modules, err := cosmosutil.GenesisModules(genesisFile)
+	require.NoError(t, err)
+	return modules
+}
+
+func TestCompareGenesisModules(t *testing.T) {
+	binaryModules := readGenesisModules(t, "testdata/genesis_binary.json")
+
+	t.Run("same modules", func(t *testing.T) {
+		require.NoError(t, compareGenesisModules(binaryModules, binaryModules))
+	})
+
+	t.Run("optional modules are ignored", func(t *testing.T) {
+		genesisModules := []string{"auth", "bank", "capability", "distribution", "genutil", "gov", "mars", "staking"}
+		require.NoError(t, compareGenesisModules(binaryModules, genesisModules))
+	})
+
+	t.Run("mismatched modules", func(t *testing.T) {
+		err := compareGenesisModules(binaryModules, readGenesisModules(t, "testdata/genesis_mismatch.json"))
+		require.Equal(t, ErrGenesisModules{
+			Missing: []string{"capability", "mars"},
+			Extra:   []string{"feegrant", "venus"},
+		}, err)
+		require.EqualError(t, err, "the genesis doesn't match the chain binary, "+
+			"missing modules from the genesis: capability, mars; modules not included in the chain binary: feegrant, venus")
+	})
+
+	t.Run("missing modules only", func(t *testing.T) {
+		err := compareGenesisModules(binaryModules, []string{"auth", "bank", "capability", "distribution", "genutil", "gov", "staking"})
+		require.EqualError(t, err, "the genesis doesn't match the chain binary, missing modules from the genesis: mars")
+	})
+}
+
+func TestCompareModuleVersions(t *testing.T) {
+	binaryVersions := map[string]uint64{
+		"auth":    2,
+		"bank":    3,
+		"staking": 3,
+		"mars":    1,
+	}
+
+	t.Run("same versions", func(t *testing.T) {
+		require.NoError(t, compareModuleVersions(binaryVersions, binaryVersions))
+	})
+
+	t.Run("mismatched versions", func(t *testing.T) {
+		genesisVersions := map[string]uint64{
+			"auth":    2,
+			"bank":    2,
+			"staking": 2,
+			"venus":   1,
+		}
+
+		err := compareModuleVersions(binaryVersions, genesisVersions)
+		require.Equal(t, ErrModuleVersions{
+			Conflicts: []ModuleVersionConflict{
+				{Module: "bank", Binary: 3, Genesis: 2},
+				{Module: "staking", Binary: 3, Genesis: 2},
+			},
+			BinaryOnly:  []string{"mars"},
+			GenesisOnly: []string{"venus"},
+		}, err)
+		require.EqualError(t, err, "the genesis module versions don't match the chain binary, "+
+			"conflicting versions: bank (binary 3, genesis 2), staking (binary 3, genesis 2); "+
+			"modules versioned in the chain binary only: mars; modules versioned in the genesis only: venus")
+	})
+
+	t.Run("modules present on one side only", func(t *testing.T) {
+		err := compareModuleVersions(binaryVersions, map[string]uint64{"auth": 2, "bank": 3, "staking": 3})
+		require.Equal(t, ErrModuleVersions{BinaryOnly: []string{"mars"}}, err)
+	})
+}
@@ -0,0 +1,48 @@
+package networkchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+// maxGenesisSignatureSize is the maximum size of the detached signature of the genesis hash.
+const maxGenesisSignatureSize = 4096
+
+// checkGenesisSignature verifies the detached signature of the genesis hash published by the coordinator
+// next to the genesis. A genesis not marked as signed is reported with a warning, a missing or invalid
+// signature or a signature not made by the coordinator of the chain is an error.
+func (c *Chain) checkGenesisSignature(ctx context.Context, hash string) error {
+	ev := c.ev.WithCategory(events.CategoryGenesis)
+
+	if !c.genesisMetadata.IsSigned() {
+		c.warn(
+			WarningGenesisNotSigned,
+			"The hash of the genesis from %s is not signed by the coordinator, its provenance can't be verified",
+			c.genesisURL,
+		)
+		return nil
+	}
+	if c.coordinatorAddress == "" {
+		return errors.New("the genesis is signed but the address of the coordinator of the chain is unknown")
+	}
+
+	signatureURL := networktypes.GenesisSignatureURL(c.genesisURL)
+	bz, err := c.fetchGenesisAttachment(ctx, signatureURL, maxGenesisSignatureSize)
+	if err != nil {
+		return fmt.Errorf("cannot fetch the genesis signature from %s: %w", signatureURL, err)
+	}
+	signature, err := networktypes.ParseGenesisSignature(bz)
+	if err != nil {
+		return fmt.Errorf("genesis signature from %s: %w", signatureURL, err)
+	}
+	if err := signature.Verify(hash, c.coordinatorAddress); err != nil {
+		return fmt.Errorf("genesis signature from %s: %w", signatureURL, err)
+	}
+
+	ev.Send(events.NewNeutral(fmt.Sprintf("Genesis hash signed by the coordinator %s", c.coordinatorAddress)))
+	return nil
+}
@@ -0,0 +1,108 @@
+package networkchain
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+func TestCheckGenesisSignature(t *testing.T) {
+	const hash = "4ea5c508a6566e76240543f8feb06fd457777be39549c4016436afda65d2330e"
+
+	privKey := secp256k1.GenPrivKey()
+	coordinator, err := sdk.Bech32ifyAddressBytes(networktypes.SPN, privKey.PubKey().Address())
+	require.NoError(t, err)
+
+	sign := func(privKey *secp256k1.PrivKey) []byte {
+		signature, err := privKey.Sign(networktypes.GenesisHashSignBytes(hash))
+		require.NoError(t, err)
+		bz, err := json.Marshal(networktypes.GenesisSignature{
+			Hash:      hash,
+			Signature: signature,
+			PubKey:    privKey.PubKey().Bytes(),
+		})
+		require.NoError(t, err)
+		return bz
+	}
+
+	// newChain returns a chain with a genesis served with the detached signature
+	newChain := func(t *testing.T, signed bool, signature []byte) *Chain {
+		t.Helper()
+		mux := http.NewServeMux()
+		if signature != nil {
+			mux.HandleFunc("/genesis.json.sig", func(w http.ResponseWriter, r *http.Request) {
+				w.Write(signature)
+			})
+		}
+		server := httptest.NewServer(mux)
+		t.Cleanup(server.Close)
+
+		return &Chain{
+			genesisURL:         server.URL + "/genesis.json",
+			genesisMetadata:    &networktypes.GenesisMetadata{Hash: hash, Signed: signed},
+			coordinatorAddress: coordinator,
+			ev:                 events.NewBus(events.WithCustomBufferSize(10)),
+			warnings:           &warningCollector{},
+		}
+	}
+
+	t.Run("valid signature", func(t *testing.T) {
+		c := newChain(t, true, sign(privKey))
+
+		require.NoError(t, c.checkGenesisSignature(context.Background(), hash))
+		require.Empty(t, c.Warnings())
+	})
+
+	t.Run("signature of another account", func(t *testing.T) {
+		c := newChain(t, true, sign(secp256k1.GenPrivKey()))
+
+		err := c.checkGenesisSignature(context.Background(), hash)
+		var errSigner networktypes.ErrGenesisSigner
+		require.ErrorAs(t, err, &errSigner)
+		require.Equal(t, coordinator, errSigner.Coordinator)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		var signature networktypes.GenesisSignature
+		require.NoError(t, json.Unmarshal(sign(privKey), &signature))
+		signature.PubKey = secp256k1.GenPrivKey().PubKey().Bytes()
+		bz, err := json.Marshal(signature)
+		require.NoError(t, err)
+		c := newChain(t, true, bz)
+
+		err = c.checkGenesisSignature(context.Background(), hash)
+		require.ErrorIs(t, err, networktypes.ErrInvalidGenesisSignature)
+	})
+
+	t.Run("signed genesis without signature file", func(t *testing.T) {
+		c := newChain(t, true, nil)
+
+		err := c.checkGenesisSignature(context.Background(), hash)
+		require.ErrorContains(t, err, "cannot fetch the genesis signature")
+	})
+
+	t.Run("unknown coordinator", func(t *testing.T) {
+		c := newChain(t, true, sign(privKey))
+		c.coordinatorAddress = ""
+
+		err := c.checkGenesisSignature(context.Background(), hash)
+		require.ErrorContains(t, err, "the address of the coordinator of the chain is unknown")
+	})
+
+	t.Run("absent signature", func(t *testing.T) {
+		c := newChain(t, false, nil)
+
+		require.NoError(t, c.checkGenesisSignature(context.Background(), hash))
+		require.Len(t, c.Warnings(), 1)
+		require.Equal(t, WarningGenesisNotSigned, c.Warnings()[0].Code)
+	})
+}
@@ -0,0 +1,89 @@
+package networkchain
+
+import (
+	"os"
+	"path/filepath"
+)
+
+const (
+	// GenesisStageDownloaded is the genesis fetched from the genesis URL of the chain, before any change.
+	GenesisStageDownloaded = "downloaded"
+
+	// GenesisStageInitial is the initial genesis of the chain, before it is built from the requests.
+	GenesisStageInitial = "initial"
+)
+
+// genesisStageNames are the genesis stages in the order they are saved.
+var genesisStageNames = []string{GenesisStageDownloaded, GenesisStageInitial}
+
+// GenesisStage is a copy of the genesis saved at a stage of the preparation of the chain.
+type GenesisStage struct {
+	Name string
+	Path string
+
+	// Hash is the sha256 hash of the stage file.
+	Hash string
+}
+
+// KeepGenesisStages keeps a copy of the genesis at the stages of its preparation next to the genesis,
+// the downloaded genesis in genesis.downloaded.json and the initial genesis in genesis.initial.json.
+// The stage files are only used to debug the genesis, they are not verified with the genesis hash.
+func KeepGenesisStages() Option {
+	return func(c *Chain) {
+		c.keepGenesisStages = true
+	}
+}
+
+// GenesisStages returns the genesis stages saved in the chain home, in the order of the preparation.
+func (c Chain) GenesisStages() ([]GenesisStage, error) {
+	genesisPath, err := c.chain.GenesisPath()
+	if err != nil {
+		return nil, err
+	}
+	return genesisStages(genesisPath)
+}
+
+// saveGenesisStage writes the content of the genesis at a stage when the genesis stages are kept.
+func (c Chain) saveGenesisStage(genesisPath, stage string, genesis []byte) error {
+	if !c.keepGenesisStages {
+		return nil
+	}
+	return os.WriteFile(genesisStagePath(genesisPath, stage), genesis, 0o644)
+}
+
+// genesisStagePath returns the path of the stage file of the genesis.
+func genesisStagePath(genesisPath, stage string) string {
+	ext := filepath.Ext(genesisPath)
+	return genesisPath[:len(genesisPath)-len(ext)] + "." + stage + ext
+}
+
+// removeGenesisStages removes the stage files of the genesis, the stages are outdated when the genesis is recreated.
+func removeGenesisStages(genesisPath string) error {
+	for _, stage := range genesisStageNames {
+		if err := os.RemoveAll(genesisStagePath(genesisPath, stage)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// genesisStages returns the stage files of the genesis that exist.
+func genesisStages(genesisPath string) ([]GenesisStage, error) {
+	var stages []GenesisStage
+	for _, stage := range genesisStageNames {
+		path := genesisStagePath(genesisPath, stage)
+		hash, err := fileSHA256(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		stages = append(stages, GenesisStage{
+			Name: stage,
+			Path: path,
+			Hash: hash,
+		})
+	}
+	return stages, nil
+}
@@ -0,0 +1,71 @@
+package networkchain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStages(t *testing.T) {
+	const (
+		downloaded = `{"chain_id":"mars-1"}`
+		initial    = `{"chain_id":"mars-1","app_state":{}}`
+		prepared   = `{"chain_id":"mars-1","app_state":{"genutil":{}}}`
+	)
+
+	var (
+		genesisPath = filepath.Join(t.TempDir(), "genesis.json")
+		c           = Chain{keepGenesisStages: true}
+		readFile    = func(t *testing.T, path string) string {
+			content, err := os.ReadFile(path)
+			require.NoError(t, err)
+			return string(content)
+		}
+	)
+
+	// the genesis is downloaded
+	require.NoError(t, os.WriteFile(genesisPath, []byte(downloaded), 0o644))
+	require.NoError(t, c.saveGenesisStage(genesisPath, GenesisStageDownloaded, []byte(downloaded)))
+	stages, err := genesisStages(genesisPath)
+	require.NoError(t, err)
+	require.Len(t, stages, 1)
+	require.Equal(t, GenesisStageDownloaded, stages[0].Name)
+	require.Equal(t, filepath.Join(filepath.Dir(genesisPath), "genesis.downloaded.json"), stages[0].Path)
+	require.Equal(t, sha256Hex([]byte(downloaded)), stages[0].Hash)
+
+	// the genesis is initialized
+	require.NoError(t, os.WriteFile(genesisPath, []byte(initial), 0o644))
+	require.NoError(t, c.saveGenesisStage(genesisPath, GenesisStageInitial, []byte(initial)))
+
+	// the genesis is built from the requests, the stages are not changed
+	require.NoError(t, os.WriteFile(genesisPath, []byte(prepared), 0o644))
+	stages, err = genesisStages(genesisPath)
+	require.NoError(t, err)
+	require.Len(t, stages, 2)
+	require.Equal(t, GenesisStageDownloaded, stages[0].Name)
+	require.Equal(t, downloaded, readFile(t, stages[0].Path))
+	require.Equal(t, GenesisStageInitial, stages[1].Name)
+	require.Equal(t, filepath.Join(filepath.Dir(genesisPath), "genesis.initial.json"), stages[1].Path)
+	require.Equal(t, initial, readFile(t, stages[1].Path))
+	require.Equal(t, sha256Hex([]byte(initial)), stages[1].Hash)
+	require.Equal(t, prepared, readFile(t, genesisPath))
+
+	// the stages are removed when the genesis is recreated
+	require.NoError(t, removeGenesisStages(genesisPath))
+	stages, err = genesisStages(genesisPath)
+	require.NoError(t, err)
+	require.Empty(t, stages)
+	require.FileExists(t, genesisPath)
+}
+
+func TestGenesisStagesNotKept(t *testing.T) {
+	genesisPath := filepath.Join(t.TempDir(), "genesis.json")
+
+	require.NoError(t, Chain{}.saveGenesisStage(genesisPath, GenesisStageDownloaded, []byte(`{}`)))
+	require.NoFileExists(t, genesisStagePath(genesisPath, GenesisStageDownloaded))
+	stages, err := genesisStages(genesisPath)
+	require.NoError(t, err)
+	require.Empty(t, stages)
+}
@@ -2,20 +2,29 @@ package networkchain
 
 import (
 	"os"
-	"path/filepath"
 	"strconv"
 
+	"github.com/ignite/cli/ignite/pkg/xdg"
+	"github.com/ignite/cli/ignite/pkg/xfilepath"
 	"github.com/ignite/cli/ignite/services/network/networktypes"
 )
 
+// paths resolves the local paths of the chains, they follow the XDG base directories and IGNITE_ROOT.
+var paths = xdg.New()
+
 // ChainHome returns the default home dir used for a chain from SPN.
+// The homes are data paths, ~/spn/<launch-id> without relocation.
 func ChainHome(launchID uint64) (path string) {
-	home, err := os.UserHomeDir()
+	id := strconv.FormatUint(launchID, 10)
+	path, err := paths.DataPath(
+		xfilepath.JoinFromHome(xfilepath.Path(networktypes.SPN), xfilepath.Path(id)),
+		networktypes.SPN,
+		id,
+	)()
 	if err != nil {
 		panic(err)
 	}
-
-	return filepath.Join(home, networktypes.SPN, strconv.FormatUint(launchID, 10))
+	return path
 }
 
 // IsChainHomeExist checks if a home with the provided launchID already exist.
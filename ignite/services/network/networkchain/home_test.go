@@ -7,11 +7,15 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ignite/cli/ignite/pkg/xdg"
 	"github.com/ignite/cli/ignite/services/network/networkchain"
 	"github.com/ignite/cli/ignite/services/network/networktypes"
 )
 
 func TestChainHome(t *testing.T) {
+	t.Setenv(xdg.EnvRoot, "")
+	t.Setenv(xdg.EnvDataHome, "")
+
 	home, err := os.UserHomeDir()
 	require.NoError(t, err)
 
@@ -21,3 +25,42 @@ func TestChainHome(t *testing.T) {
 	chainHome = networkchain.ChainHome(10)
 	require.Equal(t, filepath.Join(home, networktypes.SPN, "10"), chainHome)
 }
+
+func TestChainHomeRelocated(t *testing.T) {
+	t.Run("XDG data directory", func(t *testing.T) {
+		var (
+			home     = t.TempDir()
+			dataHome = t.TempDir()
+		)
+		t.Setenv("HOME", home)
+		t.Setenv(xdg.EnvRoot, "")
+		t.Setenv(xdg.EnvDataHome, dataHome)
+
+		require.Equal(t, filepath.Join(dataHome, "ignite", networktypes.SPN, "10"), networkchain.ChainHome(10))
+
+		stateDir, err := networkchain.LaunchStateDir(10)
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(dataHome, "ignite", "spn", networkchain.LaunchStateDirectory, "10"), stateDir)
+	})
+
+	t.Run("root override", func(t *testing.T) {
+		root := t.TempDir()
+		t.Setenv("HOME", t.TempDir())
+		t.Setenv(xdg.EnvRoot, root)
+
+		require.Equal(t, filepath.Join(root, "data", networktypes.SPN, "10"), networkchain.ChainHome(10))
+	})
+
+	t.Run("legacy home fallback", func(t *testing.T) {
+		var (
+			home       = t.TempDir()
+			legacyHome = filepath.Join(home, networktypes.SPN, "10")
+		)
+		t.Setenv("HOME", home)
+		t.Setenv(xdg.EnvRoot, "")
+		t.Setenv(xdg.EnvDataHome, t.TempDir())
+		require.NoError(t, os.MkdirAll(legacyHome, 0o755))
+
+		require.Equal(t, legacyHome, networkchain.ChainHome(10))
+	})
+}
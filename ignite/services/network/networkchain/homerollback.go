@@ -0,0 +1,94 @@
+package networkchain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/pkg/xos"
+)
+
+// initStep is a step of the initialization of the chain home.
+type initStep func(ctx context.Context) error
+
+// HomeRollbackPath returns the path where the previous chain home is kept while the home is initialized.
+// The previous home is stored next to the home to be renamed on the same file system.
+func HomeRollbackPath(home string) string {
+	return filepath.Clean(home) + ".rollback"
+}
+
+// initHome runs the steps initializing the chain home from an empty home.
+// The previous home is moved aside during the initialization, it's restored when a step fails
+// and removed once every step succeeds. A chain home is only marked as initialized when it's
+// completely initialized, a failed initialization leaves the previous home and state intact.
+// The chain home lock must be held.
+func (c *Chain) initHome(ctx context.Context, home string, steps ...initStep) error {
+	// the previous home of an interrupted initialization is restored before a new one starts
+	if err := c.recoverHome(home); err != nil {
+		return err
+	}
+
+	backup := HomeRollbackPath(home)
+	hasBackup, err := c.moveHome(home, backup)
+	if err != nil {
+		return err
+	}
+
+	for _, step := range steps {
+		if err := step(ctx); err != nil {
+			if rollbackErr := c.rollbackHome(home, backup, hasBackup); rollbackErr != nil {
+				return fmt.Errorf("%w, %s", err, rollbackErr)
+			}
+			return err
+		}
+	}
+
+	if err := c.removeHome(backup); err != nil {
+		return err
+	}
+	c.isInitialized = true
+	return nil
+}
+
+// recoverHome restores the previous chain home kept by an initialization that was interrupted.
+func (c Chain) recoverHome(home string) error {
+	backup := HomeRollbackPath(home)
+	if _, err := os.Stat(backup); os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return c.rollbackHome(home, backup, true)
+}
+
+// rollbackHome removes the partially initialized chain home and restores the previous home when there is one.
+func (c Chain) rollbackHome(home, backup string, hasBackup bool) error {
+	if err := c.removeHome(home); err != nil {
+		return fmt.Errorf("cannot remove the partially initialized home %s: %w", home, err)
+	}
+	if !hasBackup {
+		return nil
+	}
+	if _, err := c.moveHome(backup, home); err != nil {
+		return fmt.Errorf("cannot restore the previous home, it's kept in %s: %w", backup, err)
+	}
+	return nil
+}
+
+// moveHome renames the chain home, retrying while its files are still used by a previous node process.
+// false is returned when there is no home to move.
+func (c Chain) moveHome(from, to string) (moved bool, err error) {
+	if _, err := os.Stat(from); os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	for i := 0; i < removeHomeAttempts; i++ {
+		if err = os.Rename(from, to); err == nil || !xos.IsFileInUse(err) {
+			return err == nil, err
+		}
+		<-c.clock.After(removeHomeBackoff << i)
+	}
+	return false, err
+}
@@ -0,0 +1,144 @@
+package networkchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/xtime"
+)
+
+// readHome returns the content of the files of the home by relative path, nil is returned for a missing home.
+func readHome(t *testing.T, home string) map[string]string {
+	if _, err := os.Stat(home); os.IsNotExist(err) {
+		return nil
+	}
+	files := make(map[string]string)
+	err := filepath.WalkDir(home, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(home, path)
+		files[filepath.ToSlash(rel)] = string(content)
+		return err
+	})
+	require.NoError(t, err)
+	return files
+}
+
+// writeStep returns an init step writing the file into the home.
+func writeStep(home, name, content string) initStep {
+	return func(context.Context) error {
+		path := filepath.Join(home, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		return os.WriteFile(path, []byte(content), 0o644)
+	}
+}
+
+func TestInitHome(t *testing.T) {
+	errStep := errors.New("step failed")
+	previousHome := map[string]string{
+		"config/genesis.json":            "previous genesis",
+		"data/priv_validator_state.json": "previous state",
+	}
+	newHome := func(t *testing.T, withPrevious bool) string {
+		home := filepath.Join(t.TempDir(), "home")
+		if withPrevious {
+			for name, content := range previousHome {
+				require.NoError(t, writeStep(home, name, content)(context.Background()))
+			}
+		}
+		return home
+	}
+	// steps returns the initialization steps, the step at index fails after writing its file
+	steps := func(home string, failing int) []initStep {
+		var steps []initStep
+		for i, name := range []string{"bin/built", "config/config.toml", "config/genesis.json"} {
+			step := writeStep(home, name, fmt.Sprintf("new %d", i))
+			if i == failing {
+				step = func(ctx context.Context) error {
+					if err := writeStep(home, name, "partial")(ctx); err != nil {
+						return err
+					}
+					return errStep
+				}
+			}
+			steps = append(steps, step)
+		}
+		return steps
+	}
+
+	t.Run("initialized home", func(t *testing.T) {
+		home := newHome(t, true)
+		c := &Chain{clock: xtime.NewClockSystem()}
+
+		require.NoError(t, c.initHome(context.Background(), home, steps(home, -1)...))
+		require.True(t, c.isInitialized)
+		require.Equal(t, map[string]string{
+			"bin/built":           "new 0",
+			"config/config.toml":  "new 1",
+			"config/genesis.json": "new 2",
+		}, readHome(t, home))
+		require.NoDirExists(t, HomeRollbackPath(home))
+	})
+
+	for failing := 0; failing < 3; failing++ {
+		t.Run(fmt.Sprintf("failure at step %d restores the previous home", failing), func(t *testing.T) {
+			home := newHome(t, true)
+			c := &Chain{clock: xtime.NewClockSystem()}
+
+			err := c.initHome(context.Background(), home, steps(home, failing)...)
+			require.ErrorIs(t, err, errStep)
+			require.False(t, c.isInitialized)
+			require.Equal(t, previousHome, readHome(t, home))
+			require.NoDirExists(t, HomeRollbackPath(home))
+		})
+
+		t.Run(fmt.Sprintf("failure at step %d without previous home", failing), func(t *testing.T) {
+			home := newHome(t, false)
+			c := &Chain{clock: xtime.NewClockSystem()}
+
+			err := c.initHome(context.Background(), home, steps(home, failing)...)
+			require.ErrorIs(t, err, errStep)
+			require.False(t, c.isInitialized)
+			require.NoDirExists(t, home)
+			require.NoDirExists(t, HomeRollbackPath(home))
+		})
+	}
+
+	t.Run("failed re-initialization keeps the chain initialized", func(t *testing.T) {
+		home := newHome(t, false)
+		c := &Chain{clock: xtime.NewClockSystem()}
+		require.NoError(t, c.initHome(context.Background(), home, steps(home, -1)...))
+		initialized := readHome(t, home)
+
+		require.ErrorIs(t, c.initHome(context.Background(), home, steps(home, 1)...), errStep)
+		require.True(t, c.isInitialized)
+		require.Equal(t, initialized, readHome(t, home))
+	})
+
+	t.Run("interrupted initialization is recovered", func(t *testing.T) {
+		home := newHome(t, true)
+		c := &Chain{clock: xtime.NewClockSystem()}
+
+		// an initialization interrupted after the first step leaves the previous home aside
+		require.NoError(t, os.Rename(home, HomeRollbackPath(home)))
+		require.NoError(t, writeStep(home, "bin/built", "partial")(context.Background()))
+
+		err := c.initHome(context.Background(), home, steps(home, 0)...)
+		require.ErrorIs(t, err, errStep)
+		require.Equal(t, previousHome, readHome(t, home))
+		require.NoDirExists(t, HomeRollbackPath(home))
+	})
+}
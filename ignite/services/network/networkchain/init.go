@@ -1,55 +1,131 @@
 package networkchain
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net"
+	"net/url"
 	"os"
+	"strings"
 
 	"github.com/ignite/cli/ignite/pkg/cache"
 	"github.com/ignite/cli/ignite/pkg/cosmosutil"
 	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/xerrors"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
 )
 
+// ErrGenesisWithGentx is returned when the initial genesis of the chain contains gentxs,
+// the gentxs are added to the genesis from the requests.
+var ErrGenesisWithGentx = errors.New("the initial genesis for the chain should not contain gentx")
+
+// ErrInsecureGenesisURL is returned when the genesis URL of the chain is not served over HTTPS
+// and fetching the genesis over plain HTTP is not allowed.
+type ErrInsecureGenesisURL struct {
+	URL string
+}
+
+// Error implements error
+func (e ErrInsecureGenesisURL) Error() string {
+	return fmt.Sprintf("the genesis URL %s is not served over HTTPS, the genesis could be tampered with while fetched", e.URL)
+}
+
 // Init initializes blockchain by building the binaries and running the init command and
 // create the initial genesis of the chain, and set up a validator key
-func (c *Chain) Init(ctx context.Context, cacheStorage cache.Storage) error {
+// The chain home is locked during the initialization, Init fails if the home is used by another process.
+// The previous home is restored when the initialization fails.
+func (c *Chain) Init(ctx context.Context, cacheStorage cache.Storage, options ...InitOption) error {
 	chainHome, err := c.chain.Home()
 	if err != nil {
 		return err
 	}
 
-	// cleanup home dir of app if exists.
-	if err = os.RemoveAll(chainHome); err != nil {
+	unlock, err := LockHome(chainHome)
+	if err != nil {
 		return err
 	}
+	defer unlock()
 
-	// build the chain and initialize it with a new validator key
-	if _, err := c.Build(ctx, cacheStorage); err != nil {
-		return err
-	}
+	return c.init(ctx, cacheStorage, options...)
+}
 
-	c.ev.Send(events.New(events.StatusOngoing, "Initializing the blockchain"))
+// init initializes the blockchain, the chain home lock must be held
+func (c *Chain) init(ctx context.Context, cacheStorage cache.Storage, options ...InitOption) (err error) {
+	var o initOptions
+	for _, apply := range options {
+		apply(&o)
+	}
 
-	if err = c.chain.Init(ctx, false); err != nil {
+	chainHome, err := c.chain.Home()
+	if err != nil {
 		return err
 	}
 
-	c.ev.Send(events.New(events.StatusDone, "Blockchain initialized"))
+	var keyring *preservedKeyring
+	if o.preserveKeyring {
+		backend, backendErr := c.chain.KeyringBackend()
+		if backendErr != nil {
+			return backendErr
+		}
+		if keyring, err = preserveKeyring(chainHome, backend); err != nil {
+			return err
+		}
 
-	// initialize and verify the genesis
-	if err = c.initGenesis(ctx); err != nil {
-		return err
+		// the keyring is restored even when the initialization fails to not lose the keys
+		defer func() {
+			if restoreErr := c.restoreKeyring(keyring); restoreErr != nil && err == nil {
+				err = restoreErr
+			}
+		}()
 	}
 
-	c.isInitialized = true
+	// the options are checked before the expensive steps, all the invalid options are reported together
+	if err = c.checkInitOptions(); err != nil {
+		return err
+	}
 
-	return nil
+	// the home is initialized from scratch, the previous home is restored if the initialization fails
+	return c.initHome(
+		ctx,
+		chainHome,
+		// build the chain and initialize it with a new validator key
+		func(ctx context.Context) error {
+			_, err := c.Build(ctx, cacheStorage)
+			return err
+		},
+		func(ctx context.Context) error {
+			c.ev.Send(events.New(
+				events.StatusOngoing,
+				events.Message(networktypes.MsgInitializingBlockchain, nil),
+				events.Category(events.CategoryGenesis),
+			))
+			if err := c.chain.Init(ctx, false); err != nil {
+				return err
+			}
+			c.ev.Send(events.New(
+				events.StatusDone,
+				events.Message(networktypes.MsgBlockchainInitialized, nil),
+				events.Category(events.CategoryGenesis),
+			))
+			return nil
+		},
+		// initialize and verify the genesis
+		c.initGenesis,
+	)
 }
 
 // initGenesis creates the initial genesis of the genesis depending on the initial genesis type (default, url, ...)
 func (c *Chain) initGenesis(ctx context.Context) error {
-	c.ev.Send(events.New(events.StatusOngoing, "Computing the Genesis"))
+	ev := c.ev.WithCategory(events.CategoryGenesis)
+
+	timer := ev.StartTimer(events.Message(networktypes.MsgComputingGenesis, nil))
+	mark := c.warningMark()
 
 	genesisPath, err := c.chain.GenesisPath()
 	if err != nil {
@@ -60,27 +136,33 @@ func (c *Chain) initGenesis(ctx context.Context) error {
 	if err := os.RemoveAll(genesisPath); err != nil {
 		return err
 	}
+	if err := removeGenesisStages(genesisPath); err != nil {
+		return err
+	}
 
-	// if the blockchain has a genesis URL, the initial genesis is fetched from the URL
+	// if the blockchain has a genesis URL, the initial genesis is fetched from the URL or one of its mirrors
 	// otherwise, the default genesis is used, which requires no action since the default genesis is generated from the init command
 	if c.genesisURL != "" {
-		genesis, hash, err := cosmosutil.GenesisAndHashFromURL(ctx, c.genesisURL)
+		download := timer.StartTimer(events.Message(networktypes.MsgDownloadingGenesis, nil))
+		genesis, hash, source, err := c.fetchGenesis(ctx)
 		if err != nil {
 			return err
 		}
-
-		// if the blockchain has been initialized with no genesis hash, we assign the fetched hash to it
-		// otherwise we check the genesis integrity with the existing hash
-		if c.genesisHash == "" {
-			c.genesisHash = hash
-		} else if hash != c.genesisHash {
-			return fmt.Errorf("genesis from URL %s is invalid. expected hash %s, actual hash %s", c.genesisURL, c.genesisHash, hash)
+		download.Done(events.Message(networktypes.MsgGenesisDownloaded, events.Params{"URL": source}))
+		if err := c.checkGenesisSignature(ctx, hash); err != nil {
+			return err
+		}
+		if err := c.saveGenesisSource(source); err != nil {
+			return err
 		}
 
 		// replace the default genesis with the fetched genesis
 		if err := os.WriteFile(genesisPath, genesis, 0o644); err != nil {
 			return err
 		}
+		if err := c.saveGenesisStage(genesisPath, GenesisStageDownloaded, genesis); err != nil {
+			return err
+		}
 	} else {
 		// default genesis is used, init CLI command is used to generate it
 		cmd, err := c.chain.Commands(ctx)
@@ -100,11 +182,74 @@ func (c *Chain) initGenesis(ctx context.Context) error {
 		return err
 	}
 
-	c.ev.Send(events.New(events.StatusDone, "Genesis initialized"))
+	// the initial genesis is saved before it is built from the requests
+	if c.keepGenesisStages {
+		genesis, err := os.ReadFile(genesisPath)
+		if err != nil {
+			return err
+		}
+		if err := c.saveGenesisStage(genesisPath, GenesisStageInitial, genesis); err != nil {
+			return err
+		}
+	}
+
+	if err := c.checkWarnings(mark); err != nil {
+		return err
+	}
+
+	timer.Done(events.Message(networktypes.MsgGenesisInitialized, nil))
 	return nil
 }
 
-// checkGenesis checks the stored genesis is valid
+// checkInitOptions checks the genesis URL and hash of the chain are valid.
+func (c *Chain) checkInitOptions() error {
+	var errs []error
+	for _, genesisURL := range c.genesisCandidates() {
+		if u, err := url.Parse(genesisURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, fmt.Errorf("invalid genesis URL %s, an HTTP URL is expected", genesisURL))
+		}
+	}
+	if c.genesisHash != "" {
+		if hash, err := hex.DecodeString(c.genesisHash); err != nil || len(hash) != sha256.Size {
+			errs = append(errs, fmt.Errorf("invalid genesis hash %s, a sha256 hash is expected", c.genesisHash))
+		}
+	}
+	return xerrors.Join(errs...)
+}
+
+// checkGenesisURLScheme checks the genesis URL is served over HTTPS unless plain HTTP is allowed,
+// the URLs of the local host are allowed for development.
+func (c *Chain) checkGenesisURLScheme() error {
+	return c.checkURLScheme(c.genesisURL)
+}
+
+// checkURLScheme checks the URL of the genesis or of one of its mirrors is served over HTTPS
+// unless plain HTTP is allowed.
+func (c *Chain) checkURLScheme(genesisURL string) error {
+	if c.insecureGenesisURL {
+		return nil
+	}
+	u, err := url.Parse(genesisURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme == "https" || isLocalHost(u.Hostname()) {
+		return nil
+	}
+	return ErrInsecureGenesisURL{URL: genesisURL}
+}
+
+// isLocalHost checks if the host is the local host.
+func isLocalHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// checkInitialGenesis checks the stored genesis is valid.
+// The independent checks all run, their errors are joined. The genesis must be readable to be checked.
 func (c *Chain) checkInitialGenesis(ctx context.Context) error {
 	// perform static analysis of the chain with the validate-genesis command.
 	chainCmd, err := c.chain.Commands(ctx)
@@ -112,26 +257,140 @@ func (c *Chain) checkInitialGenesis(ctx context.Context) error {
 		return err
 	}
 
-	// the chain initial genesis should not contain gentx, gentxs should be added through requests
 	genesisPath, err := c.chain.GenesisPath()
 	if err != nil {
 		return err
 	}
-	genesisFile, err := os.ReadFile(genesisPath)
+	genesisFile, err := cosmosutil.ReadGenesisFile(genesisPath, c.maxGenesisSize)
 	if err != nil {
 		return err
 	}
-	chainGenesis, err := cosmosutil.ParseChainGenesis(genesisFile)
+
+	// the genesis is only decoded up to the checked fields, the big genesis are not parsed in memory
+	// for the built-in checks
+	gentxCount, err := cosmosutil.GenTxCountFromReader(bytes.NewReader(genesisFile))
 	if err != nil {
 		return err
 	}
-	if chainGenesis.GenTxCount() > 0 {
-		return errors.New("the initial genesis for the chain should not contain gentx")
+
+	errs := []error{c.checkGenesisContent(gentxCount, genesisFile)}
+
+	// a fetched genesis can have been generated by a binary with different modules,
+	// the chain would then fail to start when initializing the modules
+	if c.genesisURL != "" {
+		errs = append(errs, c.checkGenesisModules(ctx, chainCmd, genesisFile))
 	}
 
-	return chainCmd.ValidateGenesis(ctx)
+	errs = append(errs, c.validateGenesis(ctx, chainCmd))
+
+	// the chain-specific checks run after the built-in checks, they are given the parsed genesis
+	if len(c.genesisChecks) > 0 {
+		genesis, err := cosmosutil.ParseGenesisFile(genesisFile)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			errs = append(errs, c.runGenesisChecks(ctx, genesis))
+		}
+	}
+	return xerrors.Join(errs...)
 
 	// TODO: static analysis of the genesis with validate-genesis doesn't check the full validity of the genesis
 	// example: gentxs formats are not checked
 	// to perform a full validity check of the genesis we must try to start the chain with sample accounts
 }
+
+// checkGenesisContent performs the static checks of the initial genesis with its number of gentxs
+// and joins their errors.
+func (c Chain) checkGenesisContent(gentxCount int, genesisFile []byte) error {
+	var errs []error
+
+	// the chain initial genesis should not contain gentx, gentxs should be added through requests
+	if gentxCount > 0 {
+		errs = append(errs, ErrGenesisWithGentx)
+	}
+	if accounts, err := readGenesisAccounts(genesisFile); err != nil {
+		errs = append(errs, err)
+	} else {
+		errs = append(errs, c.checkGenesisAccounts(accounts))
+	}
+	errs = append(errs, checkGenesisSupply(bytes.NewReader(genesisFile)))
+	return xerrors.Join(errs...)
+}
+
+// readGenesisAccounts returns the genesis with only the accounts and balances, the other fields are not decoded.
+func readGenesisAccounts(genesisFile []byte) (genesis cosmosutil.ChainGenesis, err error) {
+	fields := []struct {
+		path  string
+		value interface{}
+	}{
+		{"app_state.auth.accounts", &genesis.AppState.Auth.Accounts},
+		{"app_state.bank.balances", &genesis.AppState.Bank.Balances},
+	}
+	for _, field := range fields {
+		value, err := cosmosutil.FieldBytes(bytes.NewReader(genesisFile), field.path)
+		if errors.Is(err, cosmosutil.ErrFieldNotFound) {
+			continue
+		}
+		if err != nil {
+			return genesis, err
+		}
+		if err := json.Unmarshal(value, field.value); err != nil {
+			return genesis, fmt.Errorf("cannot decode the genesis field %s: %w", field.path, err)
+		}
+	}
+	return genesis, nil
+}
+
+// checkGenesisAccounts checks the addresses of the initial genesis accounts and their balances can be read.
+// The accounts wrapping a base account, like the EthAccount of the EVM compatible chains, are resolved
+// to the address of their base account. The accounts of unknown types are skipped with a warning.
+func (c Chain) checkGenesisAccounts(genesis cosmosutil.ChainGenesis) error {
+	var errs []error
+	for i, account := range genesis.AppState.Auth.Accounts {
+		if account.Address == "" {
+			c.warn(
+				WarningGenesisAccountSkipped,
+				"The genesis account %d of type %s is not checked, its address can't be read",
+				i,
+				account.Type,
+			)
+			continue
+		}
+		if _, err := cosmosutil.AddressBytes(account.Address); err != nil {
+			errs = append(errs, fmt.Errorf("invalid address for the genesis account %d: %w", i, err))
+		}
+	}
+	for _, balance := range genesis.AppState.Bank.Balances {
+		if err := balance.Coins.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid genesis balance for %s: %w", balance.Address, err))
+		}
+	}
+	return xerrors.Join(errs...)
+}
+
+// ErrGenesisSupply is returned when the supply of the bank module genesis doesn't match the genesis balances.
+type ErrGenesisSupply struct {
+	Deltas []cosmosutil.SupplyDelta
+}
+
+// Error implements error.
+func (e ErrGenesisSupply) Error() string {
+	mismatches := make([]string, len(e.Deltas))
+	for i, delta := range e.Deltas {
+		mismatches[i] = delta.String()
+	}
+	return fmt.Sprintf("the genesis supply doesn't match the genesis balances: %s", strings.Join(mismatches, "; "))
+}
+
+// checkGenesisSupply checks the supply of the bank module genesis is the sum of the genesis balances
+// for each denom, the chain would fail the bank invariants at the first block otherwise.
+func checkGenesisSupply(genesis io.Reader) error {
+	deltas, err := cosmosutil.GenesisSupplyDeltas(genesis)
+	if err != nil {
+		return err
+	}
+	if len(deltas) > 0 {
+		return ErrGenesisSupply{Deltas: deltas}
+	}
+	return nil
+}
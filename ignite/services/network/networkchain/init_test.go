@@ -0,0 +1,184 @@
+package networkchain
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/xerrors"
+)
+
+// newWarningChain returns a chain collecting the warnings of its checks.
+func newWarningChain() Chain {
+	return Chain{
+		ev:       events.NewBus(events.WithCustomBufferSize(10)),
+		warnings: &warningCollector{},
+	}
+}
+
+func TestCheckGenesisAccounts(t *testing.T) {
+	readGenesis := func(t *testing.T) cosmosutil.ChainGenesis {
+		// the fixture of the EVM compatible chain genesis is shared with the cosmosutil tests
+		genesisFile, err := os.ReadFile("../../../pkg/cosmosutil/testdata/genesis_evmos.json")
+		require.NoError(t, err)
+		genesis, err := cosmosutil.ParseChainGenesis(genesisFile)
+		require.NoError(t, err)
+		return genesis
+	}
+
+	t.Run("evm compatible chain genesis", func(t *testing.T) {
+		c := newWarningChain()
+		require.NoError(t, c.checkGenesisAccounts(readGenesis(t)))
+		require.Empty(t, c.Warnings())
+	})
+
+	t.Run("account of an unknown type", func(t *testing.T) {
+		c := newWarningChain()
+		genesis := readGenesis(t)
+		genesis.AppState.Auth.Accounts[1].Address = ""
+
+		require.NoError(t, c.checkGenesisAccounts(genesis))
+		require.Equal(t, []Warning{{
+			Code:    WarningGenesisAccountSkipped,
+			Message: "The genesis account 1 of type /evmos.vesting.v1.ClawbackVestingAccount is not checked, its address can't be read",
+		}}, c.Warnings())
+	})
+
+	t.Run("invalid balance", func(t *testing.T) {
+		c := newWarningChain()
+		genesis := readGenesis(t)
+		genesis.AppState.Bank.Balances[0].Coins = sdk.Coins{{Denom: "aevmos", Amount: sdk.NewInt(-1)}}
+
+		err := c.checkGenesisAccounts(genesis)
+		require.ErrorContains(t, err, "invalid genesis balance for evmos1dd246yq6z5vzjz9gh8cff46pll75yyl8xfzr26")
+	})
+}
+
+func TestCheckGenesisSupply(t *testing.T) {
+	genesis := func(supply string) io.Reader {
+		return strings.NewReader(`{"app_state":{"bank":{"balances":[
+			{"address":"cosmos1a","coins":[{"denom":"foo","amount":"10"},{"denom":"stake","amount":"100"}]},
+			{"address":"cosmos1b","coins":[{"denom":"stake","amount":"50"}]}
+		],"supply":` + supply + `}}}`)
+	}
+
+	t.Run("supply matching the balances", func(t *testing.T) {
+		require.NoError(t, checkGenesisSupply(genesis(`[{"denom":"foo","amount":"10"},{"denom":"stake","amount":"150"}]`)))
+	})
+
+	t.Run("supply computed by the chain", func(t *testing.T) {
+		require.NoError(t, checkGenesisSupply(genesis(`[]`)))
+	})
+
+	t.Run("supply mismatch", func(t *testing.T) {
+		err := checkGenesisSupply(genesis(`[{"denom":"stake","amount":"200"}]`))
+		require.EqualError(
+			t,
+			err,
+			"the genesis supply doesn't match the genesis balances: foo: supply 0, balances 10, delta -10; stake: supply 200, balances 150, delta 50",
+		)
+	})
+}
+
+func TestCheckGenesisContent(t *testing.T) {
+	genesisFile := []byte(`{"app_state":{
+		"auth":{"accounts":[{"@type":"/cosmos.auth.v1beta1.BaseAccount"}]},
+		"bank":{"balances":[{"address":"cosmos1a","coins":[{"denom":"stake","amount":"100"}]}],"supply":[{"denom":"stake","amount":"50"}]},
+		"genutil":{"gen_txs":[{}]}
+	}}`)
+	gentxCount, err := cosmosutil.GenTxCountFromReader(bytes.NewReader(genesisFile))
+	require.NoError(t, err)
+
+	// the independent problems are reported together
+	c := newWarningChain()
+	err = c.checkGenesisContent(gentxCount, genesisFile)
+	require.Len(t, xerrors.Errors(err), 2)
+	require.ErrorIs(t, err, ErrGenesisWithGentx)
+	require.Len(t, c.Warnings(), 1)
+	require.Equal(t, WarningGenesisAccountSkipped, c.Warnings()[0].Code)
+
+	var supplyErr ErrGenesisSupply
+	require.True(t, errors.As(err, &supplyErr))
+	require.Len(t, supplyErr.Deltas, 1)
+	require.Equal(t, "stake", supplyErr.Deltas[0].Denom)
+}
+
+func TestCheckInitOptions(t *testing.T) {
+	t.Run("valid options", func(t *testing.T) {
+		c := Chain{
+			genesisURL:  "https://example.com/genesis.json",
+			genesisHash: "ee7b4d6c7c04bd42bb7159bfeab7d3a64b33a4a2400025d3219c4e4baac00e9c",
+		}
+		require.NoError(t, c.checkInitOptions())
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		c := Chain{
+			genesisURL:  "example.com/genesis.json",
+			genesisHash: "foo",
+		}
+		err := c.checkInitOptions()
+		require.EqualError(t, err, "invalid genesis URL example.com/genesis.json, an HTTP URL is expected\n"+
+			"invalid genesis hash foo, a sha256 hash is expected")
+		require.Len(t, xerrors.Errors(err), 2)
+	})
+}
+
+func TestCheckGenesisURLScheme(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		insecure bool
+		err      error
+	}{
+		{
+			name: "https URL",
+			url:  "https://example.com/genesis.json",
+		},
+		{
+			name: "http URL",
+			url:  "http://example.com/genesis.json",
+			err:  ErrInsecureGenesisURL{URL: "http://example.com/genesis.json"},
+		},
+		{
+			name:     "http URL allowed",
+			url:      "http://example.com/genesis.json",
+			insecure: true,
+		},
+		{
+			name: "localhost URL",
+			url:  "http://localhost:8080/genesis.json",
+		},
+		{
+			name: "loopback URL",
+			url:  "http://127.0.0.1:8080/genesis.json",
+		},
+		{
+			name: "IPv6 loopback URL",
+			url:  "http://[::1]:8080/genesis.json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Chain{genesisURL: tt.url}
+			if tt.insecure {
+				WithInsecureGenesisURL()(&c)
+			}
+
+			err := c.checkGenesisURLScheme()
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
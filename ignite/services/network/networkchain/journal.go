@@ -0,0 +1,171 @@
+package networkchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/ignite/cli/ignite/pkg/xfilepath"
+)
+
+const (
+	// LaunchStateDirectory is the directory of the local state of the launches in the SPN cache.
+	LaunchStateDirectory = "launch-state"
+
+	// JournalFilename is the name of the journal file in the state directory of a launch.
+	JournalFilename = "journal.json"
+)
+
+// JournalEntry is a step of a multi-step operation completed with the inputs of the hash.
+type JournalEntry struct {
+	Step        string    `json:"step"`
+	InputHash   string    `json:"input_hash"`
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+// Journal records the completed steps of the multi-step operations of a launch.
+// An operation interrupted by a crash is resumed from the first step not completed with the same inputs.
+// The journal is written atomically, an interrupted write leaves the previous journal.
+type Journal struct {
+	path    string
+	clock   Clock
+	Entries []JournalEntry `json:"entries"`
+}
+
+// LaunchStateDir returns the local state directory of the launch, it's a data path.
+// The path is resolved on each call so the state follows the current user home and data directory.
+func LaunchStateDir(launchID uint64) (string, error) {
+	id := strconv.FormatUint(launchID, 10)
+	return paths.DataPath(
+		xfilepath.JoinFromHome(
+			xfilepath.Path(".ignite"),
+			xfilepath.Path(SPNCacheDirectory),
+			xfilepath.Path(LaunchStateDirectory),
+			xfilepath.Path(id),
+		),
+		SPNCacheDirectory,
+		LaunchStateDirectory,
+		id,
+	)()
+}
+
+// OpenJournal opens the journal of the launch, the journal is empty if no step is completed.
+// The completion time of the steps is read from the clock.
+func OpenJournal(launchID uint64, clock Clock) (*Journal, error) {
+	dir, err := LaunchStateDir(launchID)
+	if err != nil {
+		return nil, err
+	}
+
+	j := &Journal{
+		path:  filepath.Join(dir, JournalFilename),
+		clock: clock,
+	}
+	bz, err := os.ReadFile(j.path)
+	if os.IsNotExist(err) {
+		return j, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bz, j); err != nil {
+		return nil, fmt.Errorf("invalid journal %s: %w", j.path, err)
+	}
+	return j, nil
+}
+
+// ResetLaunchState removes the local state of the launch, the next operations run all their steps.
+func ResetLaunchState(launchID uint64) error {
+	dir, err := LaunchStateDir(launchID)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(dir)
+}
+
+// Completed checks if the step is completed with the inputs of the hash.
+func (j *Journal) Completed(step, inputHash string) bool {
+	for _, entry := range j.Entries {
+		if entry.Step == step {
+			return entry.InputHash == inputHash
+		}
+	}
+	return false
+}
+
+// Run runs the step unless it is completed with the inputs of the hash, the step is recorded once it succeeds.
+// The step and the steps recorded after it are invalidated before it runs since they depend on its result.
+func (j *Journal) Run(step, inputHash string, run func() error) error {
+	if j.Completed(step, inputHash) {
+		return nil
+	}
+
+	if err := j.Invalidate(step); err != nil {
+		return err
+	}
+	if err := run(); err != nil {
+		return err
+	}
+
+	j.Entries = append(j.Entries, JournalEntry{
+		Step:        step,
+		InputHash:   inputHash,
+		CompletedAt: j.clock.Now().UTC(),
+	})
+	return j.save()
+}
+
+// Invalidate removes the step and the steps recorded after it, they run again on the next operation.
+func (j *Journal) Invalidate(step string) error {
+	for i, entry := range j.Entries {
+		if entry.Step == step {
+			j.Entries = j.Entries[:i]
+			return j.save()
+		}
+	}
+	return nil
+}
+
+// Clear removes the completed steps once the operation is complete, the journal only resumes interrupted
+// operations and the next operation runs all its steps.
+func (j *Journal) Clear() error {
+	j.Entries = nil
+	if err := os.Remove(j.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// save writes the journal to a temporary file renamed to the journal file, the rename is atomic.
+func (j *Journal) save() error {
+	bz, err := json.MarshalIndent(j, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(j.path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(dir, JournalFilename+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(bz); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), j.path)
+}
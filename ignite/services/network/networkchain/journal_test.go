@@ -0,0 +1,156 @@
+package networkchain
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/xtime"
+)
+
+// fakePipeline runs the steps in order through the journal of the launch,
+// the step killAt fails as if the process was killed before completing it.
+func fakePipeline(t *testing.T, launchID uint64, inputHash, killAt string, ran *[]string) error {
+	t.Helper()
+
+	journal, err := OpenJournal(launchID, xtime.NewClockSystem())
+	require.NoError(t, err)
+
+	for _, step := range []string{"a", "b", "c"} {
+		step := step
+		if err := journal.Run(step, inputHash, func() error {
+			if step == killAt {
+				return errors.New("killed")
+			}
+			*ran = append(*ran, step)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestJournal(t *testing.T) {
+	t.Run("resume after a crash", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		var ran []string
+		require.Error(t, fakePipeline(t, 1, "hash", "b", &ran))
+		require.Equal(t, []string{"a"}, ran)
+
+		ran = nil
+		require.NoError(t, fakePipeline(t, 1, "hash", "", &ran))
+		require.Equal(t, []string{"b", "c"}, ran)
+
+		ran = nil
+		require.NoError(t, fakePipeline(t, 1, "hash", "", &ran))
+		require.Empty(t, ran)
+	})
+
+	t.Run("cleared journal runs all the steps", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		var ran []string
+		require.NoError(t, fakePipeline(t, 1, "hash", "", &ran))
+
+		journal, err := OpenJournal(1, xtime.NewClockSystem())
+		require.NoError(t, err)
+		require.NoError(t, journal.Clear())
+		require.NoError(t, journal.Clear())
+
+		ran = nil
+		require.NoError(t, fakePipeline(t, 1, "hash", "", &ran))
+		require.Equal(t, []string{"a", "b", "c"}, ran)
+	})
+
+	t.Run("run again with new inputs", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		var ran []string
+		require.NoError(t, fakePipeline(t, 1, "hash", "", &ran))
+
+		ran = nil
+		require.NoError(t, fakePipeline(t, 1, "new", "", &ran))
+		require.Equal(t, []string{"a", "b", "c"}, ran)
+	})
+
+	t.Run("steps after a step run again are invalidated", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		var ran []string
+		require.NoError(t, fakePipeline(t, 1, "hash", "", &ran))
+
+		journal, err := OpenJournal(1, xtime.NewClockSystem())
+		require.NoError(t, err)
+		require.NoError(t, journal.Invalidate("b"))
+
+		ran = nil
+		require.NoError(t, fakePipeline(t, 1, "hash", "", &ran))
+		require.Equal(t, []string{"b", "c"}, ran)
+	})
+
+	t.Run("launches have separate journals", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		var ran []string
+		require.NoError(t, fakePipeline(t, 1, "hash", "", &ran))
+
+		ran = nil
+		require.NoError(t, fakePipeline(t, 2, "hash", "", &ran))
+		require.Equal(t, []string{"a", "b", "c"}, ran)
+	})
+
+	t.Run("reset the launch state", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		var ran []string
+		require.NoError(t, fakePipeline(t, 1, "hash", "", &ran))
+		require.NoError(t, ResetLaunchState(1))
+
+		dir, err := LaunchStateDir(1)
+		require.NoError(t, err)
+		require.NoDirExists(t, dir)
+
+		ran = nil
+		require.NoError(t, fakePipeline(t, 1, "hash", "", &ran))
+		require.Equal(t, []string{"a", "b", "c"}, ran)
+	})
+
+	t.Run("interrupted write keeps the previous journal", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		var ran []string
+		require.Error(t, fakePipeline(t, 1, "hash", "c", &ran))
+
+		dir, err := LaunchStateDir(1)
+		require.NoError(t, err)
+
+		// a write killed before the rename leaves a temporary file
+		stray := filepath.Join(dir, JournalFilename+".123.tmp")
+		require.NoError(t, os.WriteFile(stray, []byte(`{"entries":[`), 0o644))
+
+		ran = nil
+		require.NoError(t, fakePipeline(t, 1, "hash", "", &ran))
+		require.Equal(t, []string{"c"}, ran)
+
+		tmp, err := filepath.Glob(filepath.Join(dir, "*.tmp"))
+		require.NoError(t, err)
+		require.Equal(t, []string{stray}, tmp)
+	})
+
+	t.Run("invalid journal", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		dir, err := LaunchStateDir(1)
+		require.NoError(t, err)
+		require.NoError(t, os.MkdirAll(dir, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, JournalFilename), []byte("{"), 0o644))
+
+		_, err = OpenJournal(1, xtime.NewClockSystem())
+		require.ErrorContains(t, err, "invalid journal")
+	})
+}
@@ -0,0 +1,174 @@
+package networkchain
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+// keyInfoExt is the extension of the keyring entries containing a key, the other entries index the keys by address.
+const keyInfoExt = ".info"
+
+// InitOption configures the initialization of the chain.
+type InitOption func(*initOptions)
+
+type initOptions struct {
+	preserveKeyring bool
+}
+
+// PreserveKeyring keeps the keys of the chain keyring when the chain home is wiped by the initialization.
+// The keys created by the init command with the name of a preserved key are replaced by the preserved key.
+// The keyring backends not stored in the chain home, like os, are not wiped and need no preservation.
+func PreserveKeyring() InitOption {
+	return func(o *initOptions) {
+		o.preserveKeyring = true
+	}
+}
+
+// keyringDir returns the directory of the keyring of the backend in the chain home,
+// an empty path is returned for the backends not stored in the home.
+func keyringDir(home string, backend chaincmd.KeyringBackend) string {
+	switch backend {
+	case chaincmd.KeyringBackendFile, chaincmd.KeyringBackendTest:
+		return filepath.Join(home, "keyring-"+string(backend))
+	default:
+		return ""
+	}
+}
+
+// preservedKeyring is a keyring relocated out of the chain home while the home is wiped.
+type preservedKeyring struct {
+	dir     string
+	backup  string
+	backend chaincmd.KeyringBackend
+}
+
+// preserveKeyring moves the keyring directory of the chain home aside, the keyring must be restored with restore.
+// A nil keyring is returned when the chain home has no keyring to preserve.
+func preserveKeyring(home string, backend chaincmd.KeyringBackend) (*preservedKeyring, error) {
+	dir := keyringDir(home, backend)
+	if dir == "" {
+		return nil, nil
+	}
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	// the keyring is moved next to the home to be renamed on the same file system
+	backup, err := os.MkdirTemp(filepath.Dir(filepath.Clean(home)), filepath.Base(home)+".keyring-")
+	if err != nil {
+		return nil, err
+	}
+	k := &preservedKeyring{
+		dir:     dir,
+		backup:  filepath.Join(backup, filepath.Base(dir)),
+		backend: backend,
+	}
+	if err := os.Rename(dir, k.backup); err != nil {
+		os.Remove(backup)
+		return nil, err
+	}
+	return k, nil
+}
+
+// restore merges the preserved keyring into the keyring of the chain home and returns the names of the keys
+// of the home that were replaced by a preserved key.
+func (k preservedKeyring) restore() (replaced []string, err error) {
+	if err := os.MkdirAll(k.dir, 0o700); err != nil {
+		return nil, k.restoreErr(err)
+	}
+	entries, err := os.ReadDir(k.backup)
+	if err != nil {
+		return nil, k.restoreErr(err)
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		content, err := os.ReadFile(filepath.Join(k.backup, name))
+		if err != nil {
+			return nil, k.restoreErr(err)
+		}
+
+		path := filepath.Join(k.dir, name)
+		existing, err := os.ReadFile(path)
+		switch {
+		case os.IsNotExist(err):
+		case err != nil:
+			return nil, k.restoreErr(err)
+		case !bytes.Equal(existing, content) && strings.HasSuffix(name, keyInfoExt):
+			replaced = append(replaced, strings.TrimSuffix(name, keyInfoExt))
+		}
+		if err := os.WriteFile(path, content, entry.Type().Perm()|0o600); err != nil {
+			return nil, k.restoreErr(err)
+		}
+	}
+	if err := k.verify(entries); err != nil {
+		return nil, k.restoreErr(err)
+	}
+	return replaced, os.RemoveAll(filepath.Dir(k.backup))
+}
+
+// verify checks the keys of the preserved keyring can be listed from the restored keyring.
+// The keys of the file backend are encrypted with the passphrase of the user, only their entries are checked.
+func (k preservedKeyring) verify(entries []os.DirEntry) error {
+	for _, entry := range entries {
+		if _, err := os.Stat(filepath.Join(k.dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+	if k.backend != chaincmd.KeyringBackendTest {
+		return nil
+	}
+
+	registry, err := cosmosaccount.New(
+		cosmosaccount.WithHome(filepath.Dir(k.dir)),
+		cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringTest),
+	)
+	if err != nil {
+		return err
+	}
+	accounts, err := registry.List()
+	if err != nil {
+		return err
+	}
+	names := make(map[string]bool)
+	for _, account := range accounts {
+		names[account.Name] = true
+	}
+	for _, entry := range entries {
+		if name := strings.TrimSuffix(entry.Name(), keyInfoExt); name != entry.Name() && !names[name] {
+			return fmt.Errorf("the key %s is missing", name)
+		}
+	}
+	return nil
+}
+
+// restoreErr returns an error for a keyring that can't be restored, the keys are kept in the backup directory.
+func (k preservedKeyring) restoreErr(err error) error {
+	return fmt.Errorf("cannot restore the keyring, the keys are kept in %s: %w", k.backup, err)
+}
+
+// restoreKeyring restores the preserved keyring and warns about the keys it replaced.
+func (c *Chain) restoreKeyring(k *preservedKeyring) error {
+	if k == nil {
+		return nil
+	}
+	replaced, err := k.restore()
+	if err != nil {
+		return err
+	}
+	for _, name := range replaced {
+		c.ev.Send(events.NewNeutral(fmt.Sprintf(
+			"The key %s created by the initialization is replaced by the preserved key with the same name",
+			name,
+		)))
+	}
+	return nil
+}
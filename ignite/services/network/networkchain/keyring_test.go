@@ -0,0 +1,113 @@
+package networkchain
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+)
+
+const testKeyringPassphrase = "passphrase"
+
+// newTestKeyring opens the keyring of the chain home, the passphrase of the file backend is read from the input.
+func newTestKeyring(t *testing.T, home string, backend chaincmd.KeyringBackend) keyring.Keyring {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(interfaceRegistry)
+	input := strings.NewReader(strings.Repeat(testKeyringPassphrase+"\n", 10))
+
+	kr, err := keyring.New(sdk.KeyringServiceName(), string(backend), home, input, codec.NewProtoCodec(interfaceRegistry))
+	require.NoError(t, err)
+	return kr
+}
+
+func newTestKey(t *testing.T, kr keyring.Keyring, name string) sdk.AccAddress {
+	record, _, err := kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+	return addr
+}
+
+func TestPreserveKeyring(t *testing.T) {
+	for _, backend := range []chaincmd.KeyringBackend{chaincmd.KeyringBackendTest, chaincmd.KeyringBackendFile} {
+		t.Run(string(backend), func(t *testing.T) {
+			var (
+				home  = filepath.Join(t.TempDir(), "home")
+				kr    = newTestKeyring(t, home, backend)
+				alice = newTestKey(t, kr, "alice")
+				bob   = newTestKey(t, kr, "bob")
+			)
+
+			k, err := preserveKeyring(home, backend)
+			require.NoError(t, err)
+			require.NotNil(t, k)
+
+			// the home is wiped and the initialization creates a key with the name of a preserved key
+			require.NoError(t, os.RemoveAll(home))
+			newTestKey(t, newTestKeyring(t, home, backend), "bob")
+			newTestKey(t, newTestKeyring(t, home, backend), "carol")
+
+			c := &Chain{}
+			require.NoError(t, c.restoreKeyring(k))
+
+			kr = newTestKeyring(t, home, backend)
+			records, err := kr.List()
+			require.NoError(t, err)
+			names := make([]string, len(records))
+			for i, record := range records {
+				names[i] = record.Name
+			}
+			require.ElementsMatch(t, []string{"alice", "bob", "carol"}, names)
+
+			// the preserved keys are kept
+			for name, addr := range map[string]sdk.AccAddress{"alice": alice, "bob": bob} {
+				record, err := kr.Key(name)
+				require.NoError(t, err)
+				recordAddr, err := record.GetAddress()
+				require.NoError(t, err)
+				require.Equal(t, addr, recordAddr)
+			}
+
+			// the backup directory is removed once the keyring is restored
+			backups, err := filepath.Glob(home + ".keyring-*")
+			require.NoError(t, err)
+			require.Empty(t, backups)
+		})
+	}
+}
+
+func TestPreserveKeyringNoKeyring(t *testing.T) {
+	home := t.TempDir()
+
+	k, err := preserveKeyring(home, chaincmd.KeyringBackendTest)
+	require.NoError(t, err)
+	require.Nil(t, k)
+
+	k, err = preserveKeyring(home, chaincmd.KeyringBackendOS)
+	require.NoError(t, err)
+	require.Nil(t, k)
+}
+
+func TestRestoreKeyringReplacedKeys(t *testing.T) {
+	home := filepath.Join(t.TempDir(), "home")
+	newTestKey(t, newTestKeyring(t, home, chaincmd.KeyringBackendTest), "alice")
+
+	k, err := preserveKeyring(home, chaincmd.KeyringBackendTest)
+	require.NoError(t, err)
+	require.NoError(t, os.RemoveAll(home))
+	newTestKey(t, newTestKeyring(t, home, chaincmd.KeyringBackendTest), "alice")
+
+	replaced, err := k.restore()
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice"}, replaced)
+}
@@ -0,0 +1,200 @@
+package networkchain
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/tendermintrpc"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+const (
+	// postLaunchCheckInterval is the interval between two polls of the node status.
+	postLaunchCheckInterval = time.Second
+
+	// postLaunchDiagnosticsTimeout is the maximum duration to collect the diagnostics after a failed check.
+	postLaunchDiagnosticsTimeout = 5 * time.Second
+
+	// postLaunchLogLines is the maximum number of error lines from the node log included in a report.
+	postLaunchLogLines = 10
+)
+
+// PostLaunchReport contains the diagnostics collected when the chain doesn't produce blocks after the launch.
+type PostLaunchReport struct {
+	// Height is the last height reported by the node, zero if the node never replied.
+	Height int64 `json:"height"`
+
+	// PeerCount is the number of peers the node is connected to.
+	PeerCount int `json:"peer_count"`
+
+	// GenesisHash is the sha256 hash of the genesis used by the node.
+	GenesisHash string `json:"genesis_hash"`
+
+	// LogErrors contains the last error lines of the node log.
+	LogErrors []string `json:"log_errors,omitempty"`
+
+	// Errors contains the errors met while checking the node and collecting the diagnostics.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// String returns a summary of the report.
+func (r PostLaunchReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "height: %d, peers: %d, genesis hash: %s", r.Height, r.PeerCount, r.GenesisHash)
+	for _, err := range r.Errors {
+		fmt.Fprintf(&b, "\n  error: %s", err)
+	}
+	for _, line := range r.LogErrors {
+		fmt.Fprintf(&b, "\n  log: %s", line)
+	}
+	return b.String()
+}
+
+// postLaunchCheck contains the parameters of a post launch check.
+type postLaunchCheck struct {
+	rpcAddr     string
+	genesisPath string
+	logPath     string
+	interval    time.Duration
+	clock       Clock
+}
+
+// PostLaunchCheck waits for the started node to produce blocks until the deadline.
+// The node is considered healthy once its height exceeds 1, otherwise the diagnostics
+// of the node are collected into a report and an error is returned.
+func (c Chain) PostLaunchCheck(ctx context.Context, deadline time.Time) (PostLaunchReport, error) {
+	ev := c.ev.WithCategory(events.CategoryNode)
+
+	configPath, err := c.ConfigTOMLPath()
+	if err != nil {
+		return PostLaunchReport{}, err
+	}
+	rpcAddr, err := rpcAddress(configPath)
+	if err != nil {
+		return PostLaunchReport{}, err
+	}
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return PostLaunchReport{}, err
+	}
+
+	ev.Send(events.NewOngoing("Waiting for the chain to produce blocks"))
+
+	report, err := postLaunchCheck{
+		rpcAddr:     rpcAddr,
+		genesisPath: genesisPath,
+		logPath:     c.logPath,
+		interval:    postLaunchCheckInterval,
+		clock:       c.clock,
+	}.run(ctx, deadline)
+	if err != nil {
+		ev.Send(events.NewFailed(fmt.Sprintf("%s\n%s", err, report)))
+		return report, err
+	}
+
+	ev.Send(events.NewDone(fmt.Sprintf("The chain is producing blocks at height %d", report.Height), ""))
+	return report, nil
+}
+
+func (p postLaunchCheck) run(ctx context.Context, deadline time.Time) (PostLaunchReport, error) {
+	addr, err := xurl.HTTP(p.rpcAddr)
+	if err != nil {
+		return PostLaunchReport{}, fmt.Errorf("invalid rpc address format %s: %w", p.rpcAddr, err)
+	}
+	client := tendermintrpc.New(addr)
+
+	var (
+		report  PostLaunchReport
+		lastErr error
+	)
+
+	pollCtx, cancel := withDeadline(ctx, p.clock, deadline)
+	defer cancel()
+
+	for {
+		height, err := client.LatestBlockHeight(pollCtx)
+		if err == nil {
+			report.Height = height
+			if height > 1 {
+				return report, nil
+			}
+		} else if pollCtx.Err() == nil {
+			lastErr = err
+		}
+
+		select {
+		case <-pollCtx.Done():
+			if ctx.Err() != nil {
+				return report, ctx.Err()
+			}
+			if lastErr != nil {
+				report.Errors = append(report.Errors, lastErr.Error())
+			}
+			p.diagnose(ctx, client, &report)
+			return report, fmt.Errorf("the chain didn't produce blocks before %s", deadline.Format(time.RFC3339))
+		case <-p.clock.After(p.interval):
+		}
+	}
+}
+
+// diagnose collects the diagnostics of the node into the report.
+func (p postLaunchCheck) diagnose(ctx context.Context, client tendermintrpc.Client, report *PostLaunchReport) {
+	ctx, cancel := withTimeout(ctx, p.clock, postLaunchDiagnosticsTimeout)
+	defer cancel()
+
+	if netInfo, err := client.GetNetInfo(ctx); err == nil {
+		report.PeerCount = netInfo.ConnectedPeers
+	} else {
+		report.Errors = append(report.Errors, fmt.Sprintf("cannot get the node peers: %s", err))
+	}
+
+	if genesis, err := os.ReadFile(p.genesisPath); err == nil {
+		report.GenesisHash = sha256Hex(genesis)
+	} else {
+		report.Errors = append(report.Errors, fmt.Sprintf("cannot read the genesis: %s", err))
+	}
+
+	if p.logPath != "" {
+		lines, err := lastErrorLines(p.logPath, postLaunchLogLines)
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("cannot read the node log: %s", err))
+		}
+		report.LogErrors = lines
+	}
+}
+
+// lastErrorLines returns the last error lines of a log file, up to max lines.
+func lastErrorLines(path string, max int) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !isErrorLogLine(line) {
+			continue
+		}
+		lines = append(lines, line)
+		if len(lines) > max {
+			lines = lines[1:]
+		}
+	}
+	return lines, scanner.Err()
+}
+
+// isErrorLogLine checks if a log line of the node reports an error.
+func isErrorLogLine(line string) bool {
+	return strings.Contains(line, "ERR") ||
+		strings.Contains(strings.ToLower(line), "error") ||
+		strings.HasPrefix(line, "panic:")
+}
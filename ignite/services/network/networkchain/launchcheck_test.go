@@ -0,0 +1,122 @@
+package networkchain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/services/network/networkchain/networkchaintest"
+)
+
+// fakeRPC is a Tendermint RPC returning the configured heights in sequence, the last one is repeated.
+type fakeRPC struct {
+	mu      sync.Mutex
+	heights []int64
+	polls   int
+}
+
+func (f *fakeRPC) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.URL.Path {
+	case "/status":
+		height := f.heights[len(f.heights)-1]
+		if f.polls < len(f.heights) {
+			height = f.heights[f.polls]
+		}
+		f.polls++
+		fmt.Fprintf(w, `{"result":{"sync_info":{"latest_block_height":"%d"}}}`, height)
+	case "/net_info":
+		fmt.Fprint(w, `{"result":{"n_peers":"2"}}`)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestPostLaunchCheck(t *testing.T) {
+	var (
+		genesis    = []byte(`{"chain_id":"foo-1"}`)
+		genesisDir = t.TempDir()
+		interval   = time.Second
+		start      = time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+		// run runs the check and advances the clock by the durations once the check waits for it
+		run = func(rpcAddr string, deadline time.Duration, advances ...time.Duration) (PostLaunchReport, error) {
+			clock := networkchaintest.NewClock(start)
+			check := postLaunchCheck{
+				rpcAddr:     rpcAddr,
+				genesisPath: filepath.Join(genesisDir, "genesis.json"),
+				logPath:     filepath.Join(genesisDir, "node.log"),
+				interval:    interval,
+				clock:       clock,
+			}
+
+			type result struct {
+				report PostLaunchReport
+				err    error
+			}
+			done := make(chan result)
+			go func() {
+				report, err := check.run(context.Background(), start.Add(deadline))
+				done <- result{report, err}
+			}()
+
+			for _, d := range advances {
+				// the check waits for the deadline and the next poll
+				clock.BlockUntil(2)
+				clock.Advance(d)
+			}
+			r := <-done
+			return r.report, r.err
+		}
+	)
+	require.NoError(t, os.WriteFile(filepath.Join(genesisDir, "genesis.json"), genesis, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(genesisDir, "node.log"), []byte(
+		"INF starting node\nERR dial failed peer=abc\nINF waiting\npanic: invalid genesis\n",
+	), 0o644))
+
+	t.Run("chain stuck then progressing", func(t *testing.T) {
+		rpc := &fakeRPC{heights: []int64{0, 1, 1, 2}}
+		srv := httptest.NewServer(rpc)
+		defer srv.Close()
+
+		report, err := run(srv.URL, 5*time.Second, interval, interval, interval)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, report.Height)
+		require.Equal(t, 4, rpc.polls)
+	})
+
+	t.Run("chain stuck until the deadline", func(t *testing.T) {
+		srv := httptest.NewServer(&fakeRPC{heights: []int64{1}})
+		defer srv.Close()
+
+		report, err := run(srv.URL, 5*time.Second, 5*time.Second)
+		require.ErrorContains(t, err, "the chain didn't produce blocks")
+		require.Equal(t, PostLaunchReport{
+			Height:      1,
+			PeerCount:   2,
+			GenesisHash: sha256Hex(genesis),
+			LogErrors:   []string{"ERR dial failed peer=abc", "panic: invalid genesis"},
+		}, report)
+	})
+
+	t.Run("node not reachable", func(t *testing.T) {
+		srv := httptest.NewServer(&fakeRPC{heights: []int64{1}})
+		srv.Close()
+
+		report, err := run(srv.URL, 5*time.Second, 5*time.Second)
+		require.ErrorContains(t, err, "the chain didn't produce blocks")
+		require.Zero(t, report.Height)
+		require.Equal(t, sha256Hex(genesis), report.GenesisHash)
+		require.Len(t, report.Errors, 2)
+	})
+}
@@ -0,0 +1,66 @@
+package networkchain
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// LaunchFile is the name of the file in the chain home describing the launch of the node:
+// the environment to run the node with Cosmovisor and the source of its genesis.
+const LaunchFile = "launch.json"
+
+// Launch is the content of the launch file of the chain home, each step of the preparation of the chain
+// updates its own keys.
+type Launch struct {
+	CosmovisorLaunch
+
+	// GenesisSource is the URL the genesis was fetched from, the URL of the genesis or one of its mirrors.
+	GenesisSource string `json:"genesis_source,omitempty"`
+
+	// DiskAdvice is the estimate of the disk needs of the chain home made when the chain was prepared.
+	DiskAdvice *DiskAdvice `json:"disk_advice,omitempty"`
+}
+
+// ReadLaunchFile reads the launch file of the chain home, an empty launch is returned when the file doesn't exist.
+func ReadLaunchFile(home string) (Launch, error) {
+	var launch Launch
+	bz, err := os.ReadFile(filepath.Join(home, LaunchFile))
+	if os.IsNotExist(err) {
+		return launch, nil
+	}
+	if err != nil {
+		return launch, err
+	}
+	if err := json.Unmarshal(bz, &launch); err != nil {
+		return launch, errors.Wrap(err, "invalid launch file")
+	}
+	return launch, nil
+}
+
+// updateLaunchFile updates the launch file of the chain home, the keys not changed by the update are preserved.
+func (c Chain) updateLaunchFile(update func(*Launch)) error {
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+	launch, err := ReadLaunchFile(home)
+	if err != nil {
+		return err
+	}
+	update(&launch)
+
+	bz, err := json.MarshalIndent(launch, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(home, 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(home, LaunchFile), bz, 0o644); err != nil {
+		return errors.Wrap(err, "cannot write the launch file")
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package networkchain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+func TestLaunchFile(t *testing.T) {
+	home := t.TempDir()
+	ch, err := chain.New(fakeChainSource(t), chain.HomePath(home), chain.BinaryName("marsd"))
+	require.NoError(t, err)
+	c := Chain{chain: ch, cosmovisor: true}
+
+	// a home without launch file has no genesis source
+	source, err := c.GenesisSource()
+	require.NoError(t, err)
+	require.Empty(t, source)
+
+	// each step keeps the keys of the other steps
+	require.NoError(t, c.saveGenesisSource("https://mirror.mars.io/genesis.json"))
+	require.NoError(t, c.writeCosmovisorLaunch())
+
+	launch, err := ReadLaunchFile(home)
+	require.NoError(t, err)
+	require.Equal(t, "https://mirror.mars.io/genesis.json", launch.GenesisSource)
+	require.Equal(t, "marsd", launch.Env["DAEMON_NAME"])
+
+	require.NoError(t, c.saveGenesisSource("https://mars.io/genesis.json"))
+	source, err = c.GenesisSource()
+	require.NoError(t, err)
+	require.Equal(t, "https://mars.io/genesis.json", source)
+	launch, err = ReadLaunchFile(home)
+	require.NoError(t, err)
+	require.Equal(t, "marsd", launch.Env["DAEMON_NAME"])
+}
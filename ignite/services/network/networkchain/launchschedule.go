@@ -0,0 +1,232 @@
+package networkchain
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+const (
+	// LaunchTimeFile is the name of the file in the chain home containing the launch time of the chain.
+	// The file contains the launch time as unix seconds on the first line and in RFC3339 on the second line.
+	LaunchTimeFile = "launch-time"
+
+	// SystemdTimerFile is the name of the systemd timer unit starting the node at launch time.
+	SystemdTimerFile = "ignite-launch.timer"
+
+	// SystemdServiceFile is the name of the systemd service unit started by the timer.
+	SystemdServiceFile = "ignite-launch.service"
+
+	// KubernetesCronJobFile is the name of the Kubernetes CronJob snippet starting the node at launch time.
+	KubernetesCronJobFile = "ignite-launch-cronjob.yaml"
+)
+
+// Supervisor is a process supervisor the node can be scheduled with to start at launch time.
+type Supervisor string
+
+const (
+	// SupervisorSystemd renders a systemd timer and service units.
+	SupervisorSystemd Supervisor = "systemd"
+
+	// SupervisorKubernetes renders a Kubernetes CronJob.
+	SupervisorKubernetes Supervisor = "kubernetes"
+)
+
+var (
+	systemdTimerTemplate = template.Must(template.New(SystemdTimerFile).Parse(`[Unit]
+Description=Start the {{.ChainID}} node at launch time
+
+[Timer]
+OnCalendar={{.LaunchTime.Format "2006-01-02 15:04:05"}} UTC
+AccuracySec=1s
+Persistent=true
+Unit=` + SystemdServiceFile + `
+
+[Install]
+WantedBy=timers.target
+`))
+
+	systemdServiceTemplate = template.Must(template.New(SystemdServiceFile).Parse(`[Unit]
+Description={{.ChainID}} node
+After=network-online.target
+
+[Service]
+ExecStart={{.Binary}} start --home {{.Home}}
+Restart=on-failure
+LimitNOFILE=65535
+`))
+
+	// the CronJob schedule has a minute granularity, the node is started at the minute of the launch time
+	kubernetesCronJobTemplate = template.Must(template.New(KubernetesCronJobFile).Parse(`apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: {{.Name}}-launch
+spec:
+  schedule: "{{.LaunchTime.Minute}} {{.LaunchTime.Hour}} {{.LaunchTime.Day}} {{printf "%d" .LaunchTime.Month}} *"
+  timeZone: "Etc/UTC"
+  concurrencyPolicy: Forbid
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          restartPolicy: OnFailure
+          containers:
+            - name: {{.Name}}
+              image: "{{.Image}}"
+              command: ["{{.Binary}}", "start", "--home", "{{.Home}}"]
+              volumeMounts:
+                - name: home
+                  mountPath: "{{.Home}}"
+                - name: bin
+                  mountPath: "{{.BinaryDir}}"
+                  readOnly: true
+          volumes:
+            - name: home
+              hostPath:
+                path: "{{.Home}}"
+            - name: bin
+              hostPath:
+                path: "{{.BinaryDir}}"
+`))
+
+	supervisorTemplates = map[Supervisor][]*template.Template{
+		SupervisorSystemd:    {systemdTimerTemplate, systemdServiceTemplate},
+		SupervisorKubernetes: {kubernetesCronJobTemplate},
+	}
+)
+
+// ParseSupervisor parses the name of a supervisor.
+func ParseSupervisor(name string) (Supervisor, error) {
+	supervisor := Supervisor(name)
+	if _, ok := supervisorTemplates[supervisor]; !ok {
+		return "", fmt.Errorf("unsupported supervisor %s", name)
+	}
+	return supervisor, nil
+}
+
+// checkSupervisors checks the supervisors are supported and the Kubernetes CronJob has an image to run.
+func checkSupervisors(supervisors []Supervisor, kubernetesImage string) error {
+	for _, supervisor := range supervisors {
+		if _, err := ParseSupervisor(string(supervisor)); err != nil {
+			return err
+		}
+		if supervisor == SupervisorKubernetes && kubernetesImage == "" {
+			return errors.New("the Kubernetes supervisor requires the image of the container running the node")
+		}
+	}
+	return nil
+}
+
+// launchSchedule contains the values rendered in the supervisor templates.
+type launchSchedule struct {
+	Name       string
+	ChainID    string
+	Binary     string
+	Home       string
+	Image      string
+	LaunchTime time.Time
+}
+
+// BinaryDir returns the directory of the binary, mounted in the Kubernetes container.
+func (s launchSchedule) BinaryDir() string {
+	return filepath.Dir(s.Binary)
+}
+
+// writeLaunchSchedule writes the launch time file of the chain and the files of the supervisors into the chain home.
+// The files are overwritten to always reflect the current launch time.
+func (c Chain) writeLaunchSchedule() error {
+	if c.launchTime.IsZero() {
+		return nil
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+	chainID, err := c.ChainID()
+	if err != nil {
+		return err
+	}
+	binary, err := c.chain.Binary()
+	if err != nil {
+		return err
+	}
+	binDir, err := c.binaryDir()
+	if err != nil {
+		return err
+	}
+
+	supervisors := c.supervisors
+	if c.launchNotice.IsActive() {
+		c.ev.Send(events.NewNeutral(fmt.Sprintf("Notice of the coordinator: %s", c.launchNotice.Message)))
+	}
+	if c.launchNotice.IsBlocking() && !c.ignoreBlockingNotice {
+		// the files rendered by a previous prepare would still start the node at launch time
+		if err := removeSupervisorFiles(home); err != nil {
+			return err
+		}
+		if len(supervisors) > 0 {
+			c.ev.Send(events.NewNeutral(
+				"The coordinator asks to hold off starting the node, it is not scheduled to start at launch time",
+			))
+		}
+		supervisors = nil
+	}
+
+	// supervisors don't share the user PATH, the absolute path of the installed binary is used
+	return writeLaunchSchedule(home, launchSchedule{
+		Name:       binary,
+		ChainID:    chainID,
+		Binary:     filepath.Join(binDir, binary),
+		Home:       home,
+		Image:      c.kubernetesImage,
+		LaunchTime: c.launchTime,
+	}, supervisors)
+}
+
+// removeSupervisorFiles removes the files of the supervisors from the chain home.
+func removeSupervisorFiles(home string) error {
+	for _, templates := range supervisorTemplates {
+		for _, tpl := range templates {
+			if err := os.Remove(filepath.Join(home, tpl.Name())); err != nil && !os.IsNotExist(err) {
+				return errors.Wrapf(err, "cannot remove %s", tpl.Name())
+			}
+		}
+	}
+	return nil
+}
+
+func writeLaunchSchedule(home string, schedule launchSchedule, supervisors []Supervisor) error {
+	schedule.LaunchTime = schedule.LaunchTime.UTC()
+
+	launchTime := fmt.Sprintf("%d\n%s\n", schedule.LaunchTime.Unix(), schedule.LaunchTime.Format(time.RFC3339))
+	if err := os.WriteFile(filepath.Join(home, LaunchTimeFile), []byte(launchTime), 0o644); err != nil {
+		return errors.Wrap(err, "cannot write the launch time file")
+	}
+
+	for _, supervisor := range supervisors {
+		templates, ok := supervisorTemplates[supervisor]
+		if !ok {
+			return fmt.Errorf("unsupported supervisor %s", supervisor)
+		}
+
+		for _, tpl := range templates {
+			var buf bytes.Buffer
+			if err := tpl.Execute(&buf, schedule); err != nil {
+				return errors.Wrapf(err, "cannot render %s", tpl.Name())
+			}
+			if err := os.WriteFile(filepath.Join(home, tpl.Name()), buf.Bytes(), 0o644); err != nil {
+				return errors.Wrapf(err, "cannot write %s", tpl.Name())
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package networkchain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteLaunchSchedule(t *testing.T) {
+	var (
+		home     = t.TempDir()
+		schedule = launchSchedule{
+			Name:       "marsd",
+			ChainID:    "mars-1",
+			Binary:     "/go/bin/marsd",
+			Home:       "/home/mars",
+			Image:      "debian:bookworm-slim",
+			LaunchTime: time.Date(2022, time.March, 4, 15, 30, 10, 0, time.UTC),
+		}
+		readFile = func(name string) string {
+			content, err := os.ReadFile(filepath.Join(home, name))
+			require.NoError(t, err)
+			return string(content)
+		}
+	)
+
+	t.Run("launch time file only", func(t *testing.T) {
+		require.NoError(t, writeLaunchSchedule(home, schedule, nil))
+		require.Equal(t, "1646407810\n2022-03-04T15:30:10Z\n", readFile(LaunchTimeFile))
+		require.NoFileExists(t, filepath.Join(home, SystemdTimerFile))
+		require.NoFileExists(t, filepath.Join(home, KubernetesCronJobFile))
+	})
+
+	t.Run("systemd", func(t *testing.T) {
+		require.NoError(t, writeLaunchSchedule(home, schedule, []Supervisor{SupervisorSystemd}))
+		require.Equal(t, `[Unit]
+Description=Start the mars-1 node at launch time
+
+[Timer]
+OnCalendar=2022-03-04 15:30:10 UTC
+AccuracySec=1s
+Persistent=true
+Unit=ignite-launch.service
+
+[Install]
+WantedBy=timers.target
+`, readFile(SystemdTimerFile))
+		require.Equal(t, `[Unit]
+Description=mars-1 node
+After=network-online.target
+
+[Service]
+ExecStart=/go/bin/marsd start --home /home/mars
+Restart=on-failure
+LimitNOFILE=65535
+`, readFile(SystemdServiceFile))
+	})
+
+	t.Run("kubernetes", func(t *testing.T) {
+		require.NoError(t, writeLaunchSchedule(home, schedule, []Supervisor{SupervisorKubernetes}))
+		require.Equal(t, `apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: marsd-launch
+spec:
+  schedule: "30 15 4 3 *"
+  timeZone: "Etc/UTC"
+  concurrencyPolicy: Forbid
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          restartPolicy: OnFailure
+          containers:
+            - name: marsd
+              image: "debian:bookworm-slim"
+              command: ["/go/bin/marsd", "start", "--home", "/home/mars"]
+              volumeMounts:
+                - name: home
+                  mountPath: "/home/mars"
+                - name: bin
+                  mountPath: "/go/bin"
+                  readOnly: true
+          volumes:
+            - name: home
+              hostPath:
+                path: "/home/mars"
+            - name: bin
+              hostPath:
+                path: "/go/bin"
+`, readFile(KubernetesCronJobFile))
+	})
+
+	t.Run("launch time change updates the files", func(t *testing.T) {
+		updated := schedule
+		updated.LaunchTime = time.Date(2022, time.March, 5, 8, 0, 0, 0, time.FixedZone("CET", 3600))
+
+		require.NoError(t, writeLaunchSchedule(home, updated, []Supervisor{SupervisorSystemd, SupervisorKubernetes}))
+		require.Equal(t, "1646463600\n2022-03-05T07:00:00Z\n", readFile(LaunchTimeFile))
+		require.Contains(t, readFile(SystemdTimerFile), "OnCalendar=2022-03-05 07:00:00 UTC\n")
+		require.Contains(t, readFile(KubernetesCronJobFile), `schedule: "0 7 5 3 *"`)
+	})
+
+	t.Run("blocking notice removes the supervisor files", func(t *testing.T) {
+		require.NoError(t, writeLaunchSchedule(home, schedule, []Supervisor{SupervisorSystemd, SupervisorKubernetes}))
+		require.NoError(t, removeSupervisorFiles(home))
+		require.NoFileExists(t, filepath.Join(home, SystemdTimerFile))
+		require.NoFileExists(t, filepath.Join(home, SystemdServiceFile))
+		require.NoFileExists(t, filepath.Join(home, KubernetesCronJobFile))
+		require.FileExists(t, filepath.Join(home, LaunchTimeFile))
+
+		// the files can already be removed
+		require.NoError(t, removeSupervisorFiles(home))
+	})
+
+	t.Run("unsupported supervisor", func(t *testing.T) {
+		err := writeLaunchSchedule(home, schedule, []Supervisor{"upstart"})
+		require.EqualError(t, err, "unsupported supervisor upstart")
+	})
+}
+
+func TestCheckSupervisors(t *testing.T) {
+	supervisor, err := ParseSupervisor("systemd")
+	require.NoError(t, err)
+	require.Equal(t, SupervisorSystemd, supervisor)
+
+	_, err = ParseSupervisor("upstart")
+	require.EqualError(t, err, "unsupported supervisor upstart")
+
+	require.NoError(t, checkSupervisors(nil, ""))
+	require.NoError(t, checkSupervisors([]Supervisor{SupervisorSystemd}, ""))
+	require.NoError(t, checkSupervisors([]Supervisor{SupervisorKubernetes}, "debian:bookworm-slim"))
+	require.EqualError(
+		t,
+		checkSupervisors([]Supervisor{SupervisorKubernetes}, ""),
+		"the Kubernetes supervisor requires the image of the container running the node",
+	)
+	require.EqualError(t, checkSupervisors([]Supervisor{"upstart"}, ""), "unsupported supervisor upstart")
+}
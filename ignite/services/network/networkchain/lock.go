@@ -0,0 +1,163 @@
+package networkchain
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb/storage"
+
+	"github.com/ignite/cli/ignite/pkg/xos"
+)
+
+const (
+	// removeHomeAttempts is the number of attempts to remove a chain home with files still in use.
+	removeHomeAttempts = 5
+
+	// removeHomeBackoff is the initial delay between attempts to remove a chain home.
+	removeHomeBackoff = 200 * time.Millisecond
+)
+
+// ErrHomeLocked is returned when the chain home is used by another process.
+type ErrHomeLocked struct {
+	Home string
+	PID  int
+}
+
+// Error implements error.
+func (err ErrHomeLocked) Error() string {
+	return fmt.Sprintf(
+		"the chain home %s is used by the process %d, stop the process or remove the lock file %s",
+		err.Home,
+		err.PID,
+		HomeLockPath(err.Home),
+	)
+}
+
+// ErrNodeRunning is returned when the node of the chain home is running.
+type ErrNodeRunning struct {
+	Home string
+}
+
+// Error implements error.
+func (err ErrNodeRunning) Error() string {
+	return fmt.Sprintf("the node of the chain home %s is running, stop the node before using its home", err.Home)
+}
+
+// HomeLockPath returns the path of the lock file of a chain home.
+// The lock file is stored next to the home to not be removed with the home content.
+func HomeLockPath(home string) string {
+	return filepath.Clean(home) + ".lock"
+}
+
+// LockHome acquires the lock of the chain home by writing the current pid in its lock file.
+// An ErrHomeLocked error is returned when the lock is held by a running process,
+// the lock of a process that is not running anymore is reclaimed.
+// The lock file only records the processes of Ignite, an ErrNodeRunning error is returned when
+// a node started outside of Ignite uses the home.
+// The returned function releases the lock.
+func LockHome(home string) (unlock func() error, err error) {
+	unlock, err = lockHome(home)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkNodeStopped(home); err != nil {
+		unlock()
+		return nil, err
+	}
+	return unlock, nil
+}
+
+func lockHome(home string) (unlock func() error, err error) {
+	lockPath := HomeLockPath(home)
+	if err := os.MkdirAll(filepath.Dir(lockPath), 0o755); err != nil {
+		return nil, err
+	}
+
+	unlock = func() error {
+		if err := os.Remove(lockPath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	// a second attempt is done when a stale lock file is removed
+	for i := 0; i < 2; i++ {
+		f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err == nil {
+			_, err = f.WriteString(strconv.Itoa(os.Getpid()))
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				unlock()
+				return nil, err
+			}
+			return unlock, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+
+		content, err := os.ReadFile(lockPath)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		// the lock file can be empty when the lock is being acquired by another process
+		if len(content) == 0 && err == nil {
+			return nil, ErrHomeLocked{Home: home}
+		}
+		pid, err := strconv.Atoi(strings.TrimSpace(string(content)))
+		if err == nil && xos.IsProcessRunning(pid) {
+			return nil, ErrHomeLocked{Home: home, PID: pid}
+		}
+		if err := os.Remove(lockPath); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+
+	return nil, ErrHomeLocked{Home: home}
+}
+
+// checkNodeStopped checks the node of the home is not running.
+// The application database of the node holds a lock of its directory while the node runs.
+func checkNodeStopped(home string) error {
+	dbPath := filepath.Join(home, "data", "application.db")
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+		return nil
+	}
+
+	db, err := storage.OpenFile(dbPath, true)
+	if xos.IsFileLocked(err) {
+		return ErrNodeRunning{Home: home}
+	}
+	if err != nil {
+		return err
+	}
+	return db.Close()
+}
+
+// Cleanup removes the chain home.
+func (c Chain) Cleanup() error {
+	home, err := c.chain.Home()
+	if err != nil {
+		return err
+	}
+
+	unlock, err := LockHome(home)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return c.removeHome(home)
+}
+
+// removeHome removes the chain home, retrying while its files are still used by a previous node process.
+// The delay between the attempts doubles after each attempt.
+func (c Chain) removeHome(home string) error {
+	return xos.RemoveAllWithRetry(home, removeHomeAttempts, removeHomeBackoff, c.clock.After)
+}
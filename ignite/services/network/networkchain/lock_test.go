@@ -0,0 +1,84 @@
+package networkchain_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+
+	"github.com/ignite/cli/ignite/services/network/networkchain"
+)
+
+func TestLockHome(t *testing.T) {
+	t.Run("lock and unlock the home", func(t *testing.T) {
+		home := filepath.Join(t.TempDir(), "home")
+
+		unlock, err := networkchain.LockHome(home)
+		require.NoError(t, err)
+		require.FileExists(t, networkchain.HomeLockPath(home))
+
+		require.NoError(t, unlock())
+		require.NoFileExists(t, networkchain.HomeLockPath(home))
+
+		// the home can be locked again once released
+		unlock, err = networkchain.LockHome(home)
+		require.NoError(t, err)
+		require.NoError(t, unlock())
+	})
+
+	t.Run("home locked by a running process", func(t *testing.T) {
+		home := filepath.Join(t.TempDir(), "home")
+
+		unlock, err := networkchain.LockHome(home)
+		require.NoError(t, err)
+		defer unlock()
+
+		_, err = networkchain.LockHome(home)
+		require.Equal(t, networkchain.ErrHomeLocked{Home: home, PID: os.Getpid()}, err)
+	})
+
+	t.Run("reclaim a stale lock", func(t *testing.T) {
+		home := filepath.Join(t.TempDir(), "home")
+
+		// a pid that can't be attributed to a running process
+		stalePID := strconv.Itoa(1 << 30)
+		require.NoError(t, os.WriteFile(networkchain.HomeLockPath(home), []byte(stalePID), 0o644))
+
+		unlock, err := networkchain.LockHome(home)
+		require.NoError(t, err)
+		defer unlock()
+
+		content, err := os.ReadFile(networkchain.HomeLockPath(home))
+		require.NoError(t, err)
+		require.Equal(t, strconv.Itoa(os.Getpid()), string(content))
+	})
+
+	t.Run("home used by a running node", func(t *testing.T) {
+		home := filepath.Join(t.TempDir(), "home")
+
+		// the node holds the lock of its application database while it runs
+		db, err := storage.OpenFile(filepath.Join(home, "data", "application.db"), false)
+		require.NoError(t, err)
+
+		_, err = networkchain.LockHome(home)
+		require.Equal(t, networkchain.ErrNodeRunning{Home: home}, err)
+		require.NoFileExists(t, networkchain.HomeLockPath(home))
+
+		// the home is locked once the node is stopped
+		require.NoError(t, db.Close())
+		unlock, err := networkchain.LockHome(home)
+		require.NoError(t, err)
+		require.NoError(t, unlock())
+	})
+
+	t.Run("lock being acquired", func(t *testing.T) {
+		home := filepath.Join(t.TempDir(), "home")
+		require.NoError(t, os.WriteFile(networkchain.HomeLockPath(home), nil, 0o644))
+
+		_, err := networkchain.LockHome(home)
+		require.Equal(t, networkchain.ErrHomeLocked{Home: home}, err)
+	})
+}
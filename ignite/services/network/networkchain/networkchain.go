@@ -3,26 +3,39 @@ package networkchain
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"sync"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
+	gitclient "github.com/go-git/go-git/v5/plumbing/transport/client"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
 
 	sperrors "github.com/ignite/cli/ignite/errors"
 	"github.com/ignite/cli/ignite/pkg/cache"
 	"github.com/ignite/cli/ignite/pkg/chaincmd"
 	"github.com/ignite/cli/ignite/pkg/checksum"
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
 	"github.com/ignite/cli/ignite/pkg/cosmosver"
 	"github.com/ignite/cli/ignite/pkg/events"
 	"github.com/ignite/cli/ignite/pkg/gitpod"
+	"github.com/ignite/cli/ignite/pkg/sandbox"
+	"github.com/ignite/cli/ignite/pkg/xhttp"
+	"github.com/ignite/cli/ignite/pkg/xtime"
 	"github.com/ignite/cli/ignite/services/chain"
 	"github.com/ignite/cli/ignite/services/network/networktypes"
 )
 
+// flagConsensusKeyAlgo is the flag of the chain init command setting the consensus key type.
+const flagConsensusKeyAlgo = "--consensus-key-algo"
+
 // Chain represents a network blockchain and lets you interact with its source code and binary.
 type Chain struct {
 	id       string
@@ -31,19 +44,74 @@ type Chain struct {
 	path string
 	home string
 
+	binaryName  string
+	defaultHome string
+
 	url         string
 	hash        string
 	genesisURL  string
 	genesisHash string
 	launchTime  time.Time
 
+	// genesisMirrors are the URLs of the mirrors of the genesis, tried when the genesis URL fails.
+	genesisMirrors []string
+
+	// genesisMirrorList is the URL of the list of the mirrors of the genesis, fetched when no mirror is set.
+	genesisMirrorList string
+
+	// genesisMetadata is the genesis metadata published by the coordinator.
+	genesisMetadata *networktypes.GenesisMetadata
+
+	// coordinatorAddress is the address of the coordinator expected to sign the genesis hash.
+	coordinatorAddress string
+
+	insecureGenesisURL bool
+	maxGenesisSize     int64
+
+	strictValidation bool
+	warnings         *warningCollector
+
+	genesisChecks []namedGenesisCheck
+
 	accountBalance sdk.Coins
 
+	validatorKeyType networktypes.ValidatorKeyType
+
+	launchNotice         *networktypes.LaunchNotice
+	ignoreBlockingNotice bool
+
 	keyringBackend chaincmd.KeyringBackend
 
 	isInitialized     bool
 	checkDependencies bool
 
+	initFlags          []string
+	collectGentxsFlags []string
+
+	logPath         string
+	supervisors     []Supervisor
+	kubernetesImage string
+
+	addrBook          bool
+	noPersistentPeers bool
+
+	keepGenesisStages bool
+
+	recommendedPruning bool
+
+	httpOptions []xhttp.ClientOption
+	httpClient  *http.Client
+
+	ociClient OCIClient
+
+	sandbox sandbox.Runner
+
+	dockerBuild *dockerBuild
+
+	cosmovisor bool
+
+	clock Clock
+
 	ref plumbing.ReferenceName
 
 	chain *chain.Chain
@@ -58,6 +126,7 @@ type SourceOption func(*Chain)
 type Option func(*Chain)
 
 // SourceRemote sets the default branch on a remote as source for the blockchain.
+// The remote is a git repository, the URL of a source tarball or an OCI artifact reference (oci://registry/repository:tag).
 func SourceRemote(url string) SourceOption {
 	return func(c *Chain) {
 		c.url = url
@@ -100,12 +169,26 @@ func SourceLaunch(launch networktypes.ChainLaunch) SourceOption {
 		c.home = ChainHome(launch.ID)
 		c.launchTime = launch.LaunchTime
 		c.accountBalance = launch.AccountBalance
+		c.validatorKeyType = launch.ValidatorKeyType
+		c.launchNotice = launch.LaunchNotice
+		c.coordinatorAddress = launch.CoordinatorAddress
+		if launch.Metadata != nil {
+			c.binaryName = launch.Metadata.BinaryName
+			c.defaultHome = launch.Metadata.DefaultHome
+			c.genesisMetadata = launch.Metadata.Genesis
+			if launch.Metadata.Genesis != nil {
+				c.genesisMirrorList = launch.Metadata.Genesis.MirrorList
+			}
+		}
 	}
 }
 
 // WithHome provides a specific home path for the blockchain for the initialization.
 func WithHome(path string) Option {
 	return func(c *Chain) {
+		if path != "" {
+			path = filepath.Clean(path)
+		}
 		c.home = path
 	}
 }
@@ -124,6 +207,137 @@ func WithGenesisFromURL(genesisURL string) Option {
 	}
 }
 
+// WithInsecureGenesisURL allows to fetch the genesis from a URL not served over HTTPS.
+func WithInsecureGenesisURL() Option {
+	return func(c *Chain) {
+		c.insecureGenesisURL = true
+	}
+}
+
+// WithMaxGenesisSize sets the maximum size in bytes of the genesis of the chain, the limit applies
+// to the download and to the decompressed size of a gzip genesis. cosmosutil.DefaultMaxGenesisSize
+// is used by default, the size is not limited when the limit is zero.
+func WithMaxGenesisSize(limit int64) Option {
+	return func(c *Chain) {
+		c.maxGenesisSize = limit
+	}
+}
+
+// WithStrictValidation elevates the warnings of the validation checks to errors,
+// the chain fails to be initialized or prepared when a check reports a warning.
+func WithStrictValidation() Option {
+	return func(c *Chain) {
+		c.strictValidation = true
+	}
+}
+
+// WithLogPath sets the path of the node log file, the output of the node started by the chain is written to it
+// and it's used to collect diagnostics when the chain fails to start.
+func WithLogPath(path string) Option {
+	return func(c *Chain) {
+		c.logPath = path
+	}
+}
+
+// WithSupervisors renders the files to start the node at launch time with the supervisors when the chain is prepared.
+func WithSupervisors(supervisors ...Supervisor) Option {
+	return func(c *Chain) {
+		c.supervisors = supervisors
+	}
+}
+
+// WithKubernetesImage sets the container image of the Kubernetes CronJob starting the node at launch time.
+// The image runs the chain binary mounted from the host, it's required with the Kubernetes supervisor.
+func WithKubernetesImage(image string) Option {
+	return func(c *Chain) {
+		c.kubernetesImage = image
+	}
+}
+
+// WithValidatorKeyType sets the consensus key type of the validator generated when the chain is initialized.
+// It overrides the key type required by the chain launch.
+func WithValidatorKeyType(keyType networktypes.ValidatorKeyType) Option {
+	return func(c *Chain) {
+		c.validatorKeyType = keyType
+	}
+}
+
+// IgnoreBlockingNotice schedules the node to start at launch time even if the coordinator published a blocking notice.
+func IgnoreBlockingNotice() Option {
+	return func(c *Chain) {
+		c.ignoreBlockingNotice = true
+	}
+}
+
+// WithAddrBook seeds the Tendermint address book of the chain with the peers of the validators when the chain is prepared.
+func WithAddrBook() Option {
+	return func(c *Chain) {
+		c.addrBook = true
+	}
+}
+
+// WithoutPersistentPeers doesn't set the peers of the validators as persistent peers when the chain is prepared.
+// It requires WithAddrBook to let the node discover the peers from its address book instead.
+func WithoutPersistentPeers() Option {
+	return func(c *Chain) {
+		c.noPersistentPeers = true
+	}
+}
+
+// WithHTTPProxy sets the proxy used to fetch the source and the genesis of the chain.
+// By default, the proxy is read from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+func WithHTTPProxy(proxyURL string) Option {
+	return func(c *Chain) {
+		c.httpOptions = append(c.httpOptions, xhttp.WithProxyURL(proxyURL))
+	}
+}
+
+// WithCAFile trusts the PEM encoded certificates of the file in addition to the system certificates
+// to fetch the source and the genesis of the chain.
+func WithCAFile(path string) Option {
+	return func(c *Chain) {
+		c.httpOptions = append(c.httpOptions, xhttp.WithCAFile(path))
+	}
+}
+
+// WithHTTPTimeout sets the time limit to fetch the genesis of the chain.
+func WithHTTPTimeout(timeout time.Duration) Option {
+	return func(c *Chain) {
+		c.httpOptions = append(c.httpOptions, xhttp.WithTimeout(timeout))
+	}
+}
+
+// WithAuthTokens sends the bearer tokens to their hosts to fetch the source and the genesis of the chain,
+// it allows to fetch a genesis from a private GitHub release with the API URL of the asset.
+func WithAuthTokens(tokens xhttp.AuthTokens) Option {
+	return func(c *Chain) {
+		c.httpOptions = append(c.httpOptions, xhttp.WithAuthTokens(tokens))
+	}
+}
+
+// WithSandbox runs the chain binary commands validating the genesis with the sandbox runner.
+// The binary is built from a source provided by the coordinator, the sandbox restricts what it can access.
+func WithSandbox(runner sandbox.Runner) Option {
+	return func(c *Chain) {
+		c.sandbox = runner
+	}
+}
+
+// WithDockerBuild builds the chain binary in a container of the builder image with the Docker client.
+// The binary doesn't depend on the host environment, the builds of the same source match on every host.
+func WithDockerBuild(client DockerClient, options ...DockerBuildOption) Option {
+	return func(c *Chain) {
+		c.dockerBuild = &dockerBuild{
+			client:   client,
+			image:    DefaultBuilderImage,
+			platform: DefaultBuilderPlatform,
+		}
+		for _, apply := range options {
+			apply(c.dockerBuild)
+		}
+	}
+}
+
 // CollectEvents collects events from the chain.
 func CollectEvents(ev events.Bus) Option {
 	return func(c *Chain) {
@@ -140,35 +354,100 @@ func CheckDependencies() Option {
 	}
 }
 
+// WithInitFlags provides extra flags to the chain init command
+func WithInitFlags(flags ...string) Option {
+	return func(c *Chain) {
+		c.initFlags = flags
+	}
+}
+
+// WithCollectGentxsFlags provides extra flags to the chain collect-gentxs command
+func WithCollectGentxsFlags(flags ...string) Option {
+	return func(c *Chain) {
+		c.collectGentxsFlags = flags
+	}
+}
+
 // New initializes a network blockchain from source and options.
 func New(ctx context.Context, ar cosmosaccount.Registry, source SourceOption, options ...Option) (*Chain, error) {
 	c := &Chain{
-		ar: ar,
+		ar:             ar,
+		maxGenesisSize: cosmosutil.DefaultMaxGenesisSize,
+		clock:          xtime.NewClockSystem(),
+		warnings:       &warningCollector{},
 	}
 	source(c)
 	for _, apply := range options {
 		apply(c)
 	}
 
-	c.ev.Send(events.New(events.StatusOngoing, "Fetching the source code"))
+	// check the extra flags before fetching the source
+	if err := chaincmd.CheckExtraFlags(c.initFlags); err != nil {
+		return nil, fmt.Errorf("invalid init flags: %w", err)
+	}
+	if err := chaincmd.CheckExtraFlags(c.collectGentxsFlags); err != nil {
+		return nil, fmt.Errorf("invalid collect-gentxs flags: %w", err)
+	}
+	// without persistent peers, the node only finds the validators from its address book
+	if c.noPersistentPeers && !c.addrBook {
+		return nil, errors.New("the chain without persistent peers must seed its address book with the validator peers")
+	}
+	// the supervisors are checked before the chain is prepared, their files are rendered at the end
+	if err := checkSupervisors(c.supervisors, c.kubernetesImage); err != nil {
+		return nil, err
+	}
+	// the binary name comes from the chain metadata and is joined to the paths of the binary directories
+	if c.binaryName != "" {
+		if err := networktypes.ValidateBinaryName(c.binaryName); err != nil {
+			return nil, err
+		}
+	}
+
+	// the default HTTP client is used when no HTTP option is provided
+	if len(c.httpOptions) > 0 {
+		httpClient, err := xhttp.NewClient(c.httpOptions...)
+		if err != nil {
+			return nil, fmt.Errorf("invalid HTTP options: %w", err)
+		}
+		c.httpClient = httpClient
+	}
+
+	// the consensus key is generated by the init command and the gentx must be issued with the same key type
+	var gentxFlags []string
+	if !c.validatorKeyType.IsDefault() {
+		keyTypeFlag := fmt.Sprintf("%s=%s", flagConsensusKeyAlgo, c.validatorKeyType)
+		c.initFlags = append(c.initFlags, keyTypeFlag)
+		gentxFlags = append(gentxFlags, keyTypeFlag)
+	}
+
+	fetch := c.ev.StartTimer(events.Message(networktypes.MsgFetchingSource, nil), events.Category(events.CategoryBuild))
 
 	var err error
-	if c.path, c.hash, err = fetchSource(ctx, c.url, c.ref, c.hash); err != nil {
+	if c.path, c.hash, err = fetchSource(ctx, c.url, c.ref, c.hash, c.httpClient, c.ociClient); err != nil {
 		return nil, err
 	}
 
-	c.ev.Send(events.New(events.StatusDone, "Source code fetched"))
-	c.ev.Send(events.New(events.StatusOngoing, "Setting up the blockchain"))
+	fetch.Done(events.Message(networktypes.MsgSourceFetched, nil), events.Category(events.CategoryBuild))
+	setup := c.ev.StartTimer(events.Message(networktypes.MsgSettingUpBlockchain, nil), events.Category(events.CategoryBuild))
 
 	chainOption := []chain.Option{
 		chain.ID(c.id),
 		chain.HomePath(c.home),
 		chain.LogLevel(chain.LogSilent),
+		chain.InitFlags(c.initFlags...),
+		chain.GentxFlags(gentxFlags...),
+		chain.CollectGentxsFlags(c.collectGentxsFlags...),
 	}
 
 	if c.checkDependencies {
 		chainOption = append(chainOption, chain.CheckDependencies())
 	}
+	if c.binaryName != "" {
+		chainOption = append(chainOption, chain.BinaryName(c.binaryName))
+	}
+	if c.defaultHome != "" {
+		chainOption = append(chainOption, chain.DefaultHome(c.defaultHome))
+	}
 
 	// use test keyring backend on Gitpod in order to prevent prompting for keyring
 	// password. This happens because Gitpod uses containers.
@@ -188,7 +467,17 @@ func New(ctx context.Context, ar cosmosaccount.Registry, source SourceOption, op
 	}
 
 	c.chain = chain
-	c.ev.Send(events.New(events.StatusDone, "Blockchain set up"))
+
+	// the commands run the genesis binary of the Cosmovisor layout, it's not installed in the PATH
+	if c.cosmovisor {
+		binaryPath, err := c.binaryPath()
+		if err != nil {
+			return nil, err
+		}
+		chain.SetBinaryPath(binaryPath)
+	}
+
+	setup.Done(events.Message(networktypes.MsgBlockchainSetUp, nil), events.Category(events.CategoryBuild))
 
 	return c, nil
 }
@@ -282,32 +571,74 @@ func (c Chain) NodeID(ctx context.Context) (string, error) {
 
 // Build builds chain sources, also checks if source was already built
 func (c *Chain) Build(ctx context.Context, cacheStorage cache.Storage) (binaryName string, err error) {
+	ev := c.ev.WithCategory(events.CategoryBuild)
+
 	// if chain was already published and has launch id check binary cache
 	if c.launchID != 0 {
-		if binaryName, err = c.chain.Binary(); err != nil {
+		binaryPath, err := c.binaryPath()
+		if err != nil {
 			return "", err
 		}
-		binaryChecksum, err := checksum.Binary(binaryName)
-		if err != nil && !errors.Is(err, exec.ErrNotFound) {
+		binaryChecksum, err := checksum.Binary(binaryPath)
+		if err != nil && !errors.Is(err, exec.ErrNotFound) && !errors.Is(err, os.ErrNotExist) {
 			return "", err
 		}
-		binaryMatch, err := checkBinaryCacheForLaunchID(c.launchID, binaryChecksum, c.hash)
+		toolchain, err := c.toolchain(ctx)
+		if err != nil {
+			return "", err
+		}
+		binaryMatch, err := checkBinaryCacheForLaunchID(c.launchID, binaryChecksum, c.hash, toolchain)
 		if err != nil {
 			return "", err
 		}
 		if binaryMatch {
-			return binaryName, nil
+			if err := c.checkBinaryPlatform(binaryPath); err != nil {
+				return "", err
+			}
+			c.checkDefaultHome(ctx, binaryPath)
+			return c.chain.Binary()
 		}
 	}
 
-	c.ev.Send(events.New(events.StatusOngoing, "Building the chain's binary"))
+	build := ev.StartTimer(events.Message(networktypes.MsgBuildingBinary, nil))
+
+	// the binary is installed in the PATH unless it's installed in the Cosmovisor layout
+	var output string
+	if c.cosmovisor {
+		if output, err = c.setupCosmovisor(); err != nil {
+			return "", err
+		}
+	}
 
 	// build binary
-	if binaryName, err = c.chain.Build(ctx, cacheStorage, "", true); err != nil {
+	if c.dockerBuild != nil {
+		binaryName, err = c.buildInDocker(ctx, cacheStorage)
+	} else {
+		binaryName, err = c.chain.Build(ctx, cacheStorage, output, true)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	build.Done(events.Message(networktypes.MsgBinaryBuilt, nil))
+
+	binaryPath, err := c.binaryPath()
+	if err != nil {
+		return "", err
+	}
+
+	// the binary can be built for another platform with a cross-compiling toolchain
+	if err := c.checkBinaryPlatform(binaryPath); err != nil {
 		return "", err
 	}
+	if c.cosmovisor {
+		if err := c.writeCosmovisorLaunch(); err != nil {
+			return "", err
+		}
+	}
 
-	c.ev.Send(events.New(events.StatusDone, "Chain's binary built"))
+	// the binary can use another default home than the one guessed from the repository
+	c.checkDefaultHome(ctx, binaryPath)
 
 	// cache built binary for launch id
 	if c.launchID != 0 {
@@ -321,23 +652,32 @@ func (c *Chain) Build(ctx context.Context, cacheStorage cache.Storage) (binaryNa
 
 // CacheBinary caches last built chain binary associated with launch id
 func (c *Chain) CacheBinary(launchID uint64) error {
-	binaryName, err := c.chain.Binary()
+	binaryPath, err := c.binaryPath()
 	if err != nil {
 		return err
 	}
-	binaryChecksum, err := checksum.Binary(binaryName)
+	binaryChecksum, err := checksum.Binary(binaryPath)
 	if err != nil {
 		return err
 	}
-	return cacheBinaryForLaunchID(launchID, binaryChecksum, c.hash)
+	toolchain, err := c.toolchain(context.Background())
+	if err != nil {
+		return err
+	}
+	return cacheBinaryForLaunchID(launchID, binaryChecksum, c.hash, toolchain)
 }
 
-// fetchSource fetches the chain source from url and returns a temporary path where source is saved
-func fetchSource(
+// gitTransportMu guards the HTTP transports of go-git, they are global and replaced while cloning with a custom HTTP client.
+var gitTransportMu sync.Mutex
+
+// fetchGitSource clones the chain source from the git repository url and returns a temporary path where source is saved.
+// The source is fetched with the default git transports when httpClient is nil.
+func fetchGitSource(
 	ctx context.Context,
 	url string,
 	ref plumbing.ReferenceName,
 	customHash string,
+	httpClient *http.Client,
 ) (path, hash string, err error) {
 	var repo *git.Repository
 
@@ -360,7 +700,7 @@ func fetchSource(
 		gitoptions.ReferenceName = ref
 		gitoptions.SingleBranch = true
 	}
-	if repo, err = git.PlainCloneContext(ctx, path, false, gitoptions); err != nil {
+	if repo, err = cloneSource(ctx, path, gitoptions, httpClient); err != nil {
 		return "", "", err
 	}
 
@@ -394,3 +734,29 @@ func fetchSource(
 
 	return path, hash, nil
 }
+
+// cloneSource clones the repository with the HTTP client when not nil.
+func cloneSource(ctx context.Context, path string, options *git.CloneOptions, httpClient *http.Client) (*git.Repository, error) {
+	if httpClient == nil {
+		return git.PlainCloneContext(ctx, path, false, options)
+	}
+
+	// go-git doesn't accept a client per clone, the HTTP transports are replaced during the clone.
+	// The timeout only applies to the genesis, a clone can take longer than a single file download.
+	gitClient := *httpClient
+	gitClient.Timeout = 0
+	transport := githttp.NewClient(&gitClient)
+
+	gitTransportMu.Lock()
+	defer gitTransportMu.Unlock()
+
+	defaultHTTP, defaultHTTPS := gitclient.Protocols["http"], gitclient.Protocols["https"]
+	gitclient.InstallProtocol("http", transport)
+	gitclient.InstallProtocol("https", transport)
+	defer func() {
+		gitclient.InstallProtocol("http", defaultHTTP)
+		gitclient.InstallProtocol("https", defaultHTTPS)
+	}()
+
+	return git.PlainCloneContext(ctx, path, false, options)
+}
@@ -0,0 +1,99 @@
+package networkchain_test
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/services/network/networkchain"
+)
+
+func TestNewWithExtraFlags(t *testing.T) {
+	source := networkchain.SourceRemote("https://github.com/ignite/example")
+
+	_, err := networkchain.New(
+		context.Background(),
+		cosmosaccount.Registry{},
+		source,
+		networkchain.WithInitFlags("--recover", "--chain-id=foo-1"),
+	)
+	require.EqualError(t, err, "invalid init flags: the flag --chain-id cannot be overridden, it is set from the chain configuration")
+
+	_, err = networkchain.New(
+		context.Background(),
+		cosmosaccount.Registry{},
+		source,
+		networkchain.WithCollectGentxsFlags("--home", "/tmp"),
+	)
+	require.EqualError(t, err, "invalid collect-gentxs flags: the flag --home cannot be overridden, it is set from the chain configuration")
+}
+
+func TestNewWithBinaryName(t *testing.T) {
+	// the binary name of the chain metadata is refused before the source is fetched
+	_, err := networkchain.New(
+		context.Background(),
+		cosmosaccount.Registry{},
+		networkchain.SourceRemote("https://github.com/ignite/example"),
+		networkchain.WithBinaryName("../../.bashrc"),
+	)
+	require.EqualError(t, err, `binary name "../../.bashrc" cannot start with '.'`)
+}
+
+func TestNewWithoutPersistentPeers(t *testing.T) {
+	source := networkchain.SourceRemote("https://github.com/ignite/example")
+
+	// the node would have no peer to connect to
+	_, err := networkchain.New(context.Background(), cosmosaccount.Registry{}, source, networkchain.WithoutPersistentPeers())
+	require.EqualError(t, err, "the chain without persistent peers must seed its address book with the validator peers")
+}
+
+func TestNewWithHTTPOptions(t *testing.T) {
+	var requests int32
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	block := &pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(block), 0o644))
+
+	source := networkchain.SourceRemote(srv.URL + "/ignite/example")
+
+	t.Run("source fetched with the default transport", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+
+		_, err := networkchain.New(context.Background(), cosmosaccount.Registry{}, source)
+		require.ErrorContains(t, err, "certificate")
+		require.Zero(t, atomic.LoadInt32(&requests))
+	})
+
+	t.Run("source fetched with a custom CA", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+
+		// the TLS handshake succeeds, the clone fails because the server is not a git server
+		_, err := networkchain.New(context.Background(), cosmosaccount.Registry{}, source, networkchain.WithCAFile(caFile))
+		require.Error(t, err)
+		require.NotContains(t, err.Error(), "certificate")
+		require.NotZero(t, atomic.LoadInt32(&requests))
+	})
+
+	t.Run("invalid CA file", func(t *testing.T) {
+		_, err := networkchain.New(
+			context.Background(),
+			cosmosaccount.Registry{},
+			source,
+			networkchain.WithCAFile(filepath.Join(t.TempDir(), "missing.pem")),
+		)
+		require.ErrorContains(t, err, "invalid HTTP options: cannot read the CA file")
+	})
+}
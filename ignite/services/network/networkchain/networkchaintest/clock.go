@@ -0,0 +1,86 @@
+// Package networkchaintest provides helpers to test the network chains.
+package networkchaintest
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is a fake clock for the network chains, its time only changes when advanced.
+type Clock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []waiter
+	added   chan struct{}
+}
+
+type waiter struct {
+	until time.Time
+	c     chan time.Time
+}
+
+// NewClock returns a new fake clock starting at the time.
+func NewClock(now time.Time) *Clock {
+	return &Clock{
+		now:   now,
+		added: make(chan struct{}, 1),
+	}
+}
+
+// Now returns the current time of the clock.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// After returns a channel receiving the time of the clock once advanced by the duration.
+func (c *Clock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+	c.waiters = append(c.waiters, waiter{until: c.now.Add(d), c: ch})
+
+	select {
+	case c.added <- struct{}{}:
+	default:
+	}
+	return ch
+}
+
+// Advance moves the time of the clock forward and fires the elapsed waits.
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	waiters := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.until.After(c.now) {
+			waiters = append(waiters, w)
+			continue
+		}
+		w.c <- c.now
+	}
+	c.waiters = waiters
+}
+
+// Waiters returns the number of waits not elapsed yet.
+func (c *Clock) Waiters() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiters)
+}
+
+// BlockUntil blocks until at least n waits are pending on the clock.
+// It lets a test advance the clock only once the tested code waits for it.
+func (c *Clock) BlockUntil(n int) {
+	for c.Waiters() < n {
+		<-c.added
+	}
+}
@@ -0,0 +1,37 @@
+package networkchaintest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/services/network/networkchain/networkchaintest"
+)
+
+func TestClock(t *testing.T) {
+	var (
+		start = time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC)
+		clock = networkchaintest.NewClock(start)
+	)
+	require.Equal(t, start, clock.Now())
+
+	second := clock.After(time.Second)
+	minute := clock.After(time.Minute)
+	require.Equal(t, 2, clock.Waiters())
+	require.Equal(t, start, <-clock.After(0))
+
+	clock.Advance(time.Second)
+	require.Equal(t, start.Add(time.Second), <-second)
+	require.Equal(t, 1, clock.Waiters())
+	select {
+	case <-minute:
+		t.Fatal("the wait didn't elapse")
+	default:
+	}
+
+	clock.Advance(time.Hour)
+	require.Equal(t, start.Add(time.Hour+time.Second), <-minute)
+	require.Equal(t, start.Add(time.Hour+time.Second), clock.Now())
+	require.Zero(t, clock.Waiters())
+}
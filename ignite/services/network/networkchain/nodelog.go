@@ -0,0 +1,238 @@
+package networkchain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+const (
+	// nodeLogBufferSize is the number of lines of the node log buffered for the classification,
+	// the lines are dropped when the buffer is full.
+	nodeLogBufferSize = 1024
+
+	// nodeHeightInterval is the minimum interval between two height progress events of the node.
+	nodeHeightInterval = 5 * time.Second
+
+	// nodeHeightEventKey is the debouncing key of the height progress events of the node.
+	nodeHeightEventKey = "height"
+)
+
+var (
+	reLogHeight = regexp.MustCompile(`(?:\bheight=|"height":"?)(\d+)`)
+	reLogPeer   = regexp.MustCompile(`(?:\bpeer=|"peer":")([^\s"]+)`)
+)
+
+// nodeLogKind is the kind of a line of the node log.
+type nodeLogKind int
+
+const (
+	nodeLogOther nodeLogKind = iota
+	nodeLogHeight
+	nodeLogPeerConnected
+	nodeLogPeerDisconnected
+	nodeLogError
+)
+
+// nodeLogLine is a classified line of the node log.
+type nodeLogLine struct {
+	kind   nodeLogKind
+	height int64
+	peer   string
+	text   string
+}
+
+// classifyLogLine classifies a line of the node log, the lines are written by Tendermint either
+// in plain text or in JSON.
+func classifyLogLine(line string) nodeLogLine {
+	l := nodeLogLine{text: strings.TrimSpace(line)}
+
+	switch {
+	case strings.Contains(line, "Added peer"):
+		l.kind = nodeLogPeerConnected
+	case strings.Contains(line, "Stopping peer for error"):
+		l.kind = nodeLogPeerDisconnected
+	case strings.Contains(line, "CONSENSUS FAILURE") || isErrorLogLine(line):
+		l.kind = nodeLogError
+	case strings.Contains(line, "committed state"):
+		m := reLogHeight.FindStringSubmatch(line)
+		if m == nil {
+			return l
+		}
+		height, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			return l
+		}
+		l.kind = nodeLogHeight
+		l.height = height
+	}
+
+	if l.kind == nodeLogPeerConnected || l.kind == nodeLogPeerDisconnected {
+		if m := reLogPeer.FindStringSubmatch(line); m != nil {
+			l.peer = m[1]
+		}
+	}
+	return l
+}
+
+// nodeLog writes the output of the node to the raw log and classifies its lines into events.
+// The node is never blocked by a slow events consumer, the lines are buffered for the classification
+// and dropped when the buffer is full. A node log is safe to use concurrently for stdout and stderr.
+type nodeLog struct {
+	raw     io.Writer
+	ev      events.Bus
+	lines   chan string
+	dropped uint64
+	done    chan struct{}
+
+	mu      sync.Mutex
+	partial []byte
+	closed  bool
+}
+
+// newNodeLog creates a node log writing to raw and sending the height progress events at most every interval.
+func newNodeLog(raw io.Writer, ev events.Bus, interval time.Duration, bufferSize int) *nodeLog {
+	l := &nodeLog{
+		raw:   raw,
+		ev:    ev,
+		lines: make(chan string, bufferSize),
+		done:  make(chan struct{}),
+	}
+	go l.classify(events.NewDebouncer(ev, interval, events.DebounceKey(func(e events.Event) string {
+		return nodeHeightEventKey
+	})))
+	return l
+}
+
+// Write implements io.Writer.
+func (l *nodeLog) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// the raw log is written even when the lines are dropped from the classification
+	if n, err := l.raw.Write(p); err != nil {
+		return n, err
+	}
+	if l.closed {
+		return len(p), nil
+	}
+
+	l.partial = append(l.partial, p...)
+	for {
+		i := bytes.IndexByte(l.partial, '\n')
+		if i < 0 {
+			break
+		}
+		l.push(string(l.partial[:i]))
+		l.partial = l.partial[i+1:]
+	}
+	return len(p), nil
+}
+
+// push buffers the line for the classification or drops it when the buffer is full, the lock must be held.
+func (l *nodeLog) push(line string) {
+	select {
+	case l.lines <- line:
+	default:
+		atomic.AddUint64(&l.dropped, 1)
+	}
+}
+
+// Dropped returns the number of lines dropped from the classification.
+func (l *nodeLog) Dropped() uint64 {
+	return atomic.LoadUint64(&l.dropped)
+}
+
+// Close classifies the last line and waits for the buffered lines to be classified.
+func (l *nodeLog) Close() error {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return nil
+	}
+	if len(l.partial) > 0 {
+		l.push(string(l.partial))
+		l.partial = nil
+	}
+	l.closed = true
+	close(l.lines)
+	l.mu.Unlock()
+
+	<-l.done
+
+	if dropped := l.Dropped(); dropped > 0 {
+		l.ev.Send(events.NewDebug(fmt.Sprintf("%d lines of the node log were not classified", dropped)))
+	}
+	return nil
+}
+
+// classify sends the events of the buffered lines until the node log is closed.
+func (l *nodeLog) classify(debouncer *events.Debouncer) {
+	defer close(l.done)
+	defer debouncer.Flush()
+
+	for line := range l.lines {
+		entry := classifyLogLine(line)
+		switch entry.kind {
+		case nodeLogHeight:
+			debouncer.Send(events.NewOngoing(fmt.Sprintf("The node is at height %d", entry.height)))
+		case nodeLogPeerConnected:
+			debouncer.Send(events.NewNeutral(fmt.Sprintf("Connected to the peer %s", entry.peer)))
+		case nodeLogPeerDisconnected:
+			debouncer.Send(events.NewNeutral(fmt.Sprintf("Disconnected from the peer %s", entry.peer)))
+		case nodeLogError:
+			debouncer.Send(events.NewWarning(fmt.Sprintf("the node reported an error: %s", entry.text)))
+		}
+	}
+}
+
+// Start starts the node of the chain until the context is canceled. The output of the node is written
+// to the log file of the chain when set, and sent as events: the height progress, the peer connections
+// and the errors of the node.
+// The home is locked while the node runs.
+func (c Chain) Start(ctx context.Context) error {
+	ev := c.ev.WithCategory(events.CategoryNode)
+
+	home, err := c.chain.Home()
+	if err != nil {
+		return err
+	}
+	unlock, err := LockHome(home)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	chainCmd, err := c.chain.Commands(ctx)
+	if err != nil {
+		return err
+	}
+
+	raw := io.Discard
+	if c.logPath != "" {
+		f, err := os.OpenFile(c.logPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		raw = f
+	}
+
+	log := newNodeLog(raw, ev, nodeHeightInterval, nodeLogBufferSize)
+	defer log.Close()
+
+	ev.Send(events.NewOngoing("Starting the node"))
+
+	return chainCmd.Copy(chaincmdrunner.Stdout(log), chaincmdrunner.Stderr(log)).Start(ctx)
+}
@@ -0,0 +1,130 @@
+package networkchain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+func TestClassifyLogLine(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want nodeLogLine
+	}{
+		{
+			name: "committed state",
+			line: "3:04PM INF committed state app_hash=8B5C height=42 module=state num_txs=0",
+			want: nodeLogLine{kind: nodeLogHeight, height: 42},
+		},
+		{
+			name: "committed state in json",
+			line: `{"level":"info","module":"state","height":7,"num_txs":0,"app_hash":"8B5C","message":"committed state"}`,
+			want: nodeLogLine{kind: nodeLogHeight, height: 7},
+		},
+		{
+			name: "added peer",
+			line: "3:04PM INF Added peer module=p2p peer=e0a2@10.0.0.1:26656",
+			want: nodeLogLine{kind: nodeLogPeerConnected, peer: "e0a2@10.0.0.1:26656"},
+		},
+		{
+			name: "stopped peer",
+			line: "3:04PM ERR Stopping peer for error err=EOF module=p2p peer=e0a2@10.0.0.1:26656",
+			want: nodeLogLine{kind: nodeLogPeerDisconnected, peer: "e0a2@10.0.0.1:26656"},
+		},
+		{
+			name: "consensus failure",
+			line: "3:04PM ERR CONSENSUS FAILURE!!! err=\"invalid app hash\" module=consensus",
+			want: nodeLogLine{kind: nodeLogError},
+		},
+		{
+			name: "error",
+			line: `{"level":"error","module":"p2p","message":"dial failed"}`,
+			want: nodeLogLine{kind: nodeLogError},
+		},
+		{
+			name: "other line",
+			line: "3:04PM INF executed block height=42 module=state num_invalid_txs=0 num_valid_txs=0",
+			want: nodeLogLine{kind: nodeLogOther},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := classifyLogLine(tt.line)
+			require.Equal(t, tt.want.kind, got.kind)
+			require.Equal(t, tt.want.height, got.height)
+			require.Equal(t, tt.want.peer, got.peer)
+			require.Equal(t, tt.line, got.text)
+		})
+	}
+}
+
+func TestNodeLog(t *testing.T) {
+	var (
+		raw bytes.Buffer
+		ev  = events.NewBus(events.WithCustomBufferSize(10))
+		log = newNodeLog(&raw, ev, time.Hour, 10)
+	)
+
+	output := "3:04PM INF committed state height=1 module=state\n" +
+		"3:04PM INF committed state height=2 module=state\n" +
+		"3:04PM INF committed state height=3 module=state\n" +
+		"3:04PM INF Added peer module=p2p peer=e0a2@10.0.0.1:26656\n" +
+		"3:04PM ERR CONSENSUS FAILURE!!! module=consensus"
+
+	// the lines are split across writes
+	for _, chunk := range []string{output[:20], output[20:100], output[100:]} {
+		n, err := log.Write([]byte(chunk))
+		require.NoError(t, err)
+		require.Equal(t, len(chunk), n)
+	}
+	require.NoError(t, log.Close())
+	require.Equal(t, output, raw.String())
+	require.Zero(t, log.Dropped())
+
+	ev.Shutdown(context.Background())
+	var got []string
+	for e := range ev.Events() {
+		got = append(got, e.Description)
+	}
+
+	// the heights in between are coalesced
+	require.Equal(t, []string{
+		"The node is at height 1",
+		"The node is at height 3",
+		"Connected to the peer e0a2@10.0.0.1:26656",
+		"the node reported an error: 3:04PM ERR CONSENSUS FAILURE!!! module=consensus",
+	}, got)
+}
+
+func TestNodeLogDropLines(t *testing.T) {
+	var (
+		raw bytes.Buffer
+		ev  = events.NewBus(events.WithCustomBufferSize(1))
+		log = newNodeLog(&raw, ev, time.Hour, 1)
+	)
+
+	// the events are not consumed, the node writes are never blocked
+	var output string
+	for i := 0; i < 100; i++ {
+		line := fmt.Sprintf("3:04PM ERR error %d module=p2p\n", i)
+		_, err := log.Write([]byte(line))
+		require.NoError(t, err)
+		output += line
+	}
+	require.Equal(t, output, raw.String())
+	require.NotZero(t, log.Dropped())
+
+	go func() {
+		for range ev.Events() {
+		}
+	}()
+	require.NoError(t, log.Close())
+	ev.Shutdown(context.Background())
+}
@@ -0,0 +1,84 @@
+package networkchain
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tendermint/tendermint/p2p"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+// NodeKeyFile is the name of the node key file in the config directory of the chain.
+const NodeKeyFile = "node_key.json"
+
+// peersExclusion counts the peers of the genesis validators excluded from the config.
+type peersExclusion struct {
+	// Self is the number of peers with the node ID of the local node.
+	Self int
+
+	// Duplicates is the number of peers with the node ID of a previous peer.
+	Duplicates int
+}
+
+// localNodeID returns the node ID of the node key in the config directory, the ID is empty
+// when the node key doesn't exist.
+func localNodeID(configDir string) (string, error) {
+	path := filepath.Join(configDir, NodeKeyFile)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	nodeKey, err := p2p.LoadNodeKey(path)
+	if err != nil {
+		return "", fmt.Errorf("invalid node key %s: %w", path, err)
+	}
+	return string(nodeKey.ID()), nil
+}
+
+// excludePeers removes the validators peering with the local node and the validators with the node ID
+// of a previous validator, the node IDs are compared case-insensitively.
+func excludePeers(genesisVals []networktypes.GenesisValidator, selfID string) (
+	[]networktypes.GenesisValidator,
+	peersExclusion,
+) {
+	var (
+		filtered  []networktypes.GenesisValidator
+		excluded  peersExclusion
+		seen      = make(map[string]bool)
+		localNode = strings.ToLower(selfID)
+	)
+	for _, val := range genesisVals {
+		id := strings.ToLower(val.Peer.Id)
+		switch {
+		case localNode != "" && id == localNode:
+			excluded.Self++
+		case seen[id]:
+			excluded.Duplicates++
+		default:
+			seen[id] = true
+			filtered = append(filtered, val)
+		}
+	}
+	return filtered, excluded
+}
+
+// reportPeersExclusion sends the summary of the peers excluded from the config.
+func (c Chain) reportPeersExclusion(excluded peersExclusion) {
+	ev := c.ev.WithCategory(events.CategoryPeers)
+
+	if excluded.Self == 0 && excluded.Duplicates == 0 {
+		return
+	}
+	ev.Send(events.NewNeutral(fmt.Sprintf(
+		"%d peer(s) excluded from the config: %d of the local node, %d duplicate(s)",
+		excluded.Self+excluded.Duplicates,
+		excluded.Self,
+		excluded.Duplicates,
+	)))
+}
@@ -0,0 +1,130 @@
+package networkchain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/tendermint/p2p"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/chain"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+func sampleGenesisValidator(id, addr string) networktypes.GenesisValidator {
+	return networktypes.GenesisValidator{
+		Peer: launchtypes.NewPeerConn(id, addr),
+	}
+}
+
+func TestLocalNodeID(t *testing.T) {
+	configDir := t.TempDir()
+
+	id, err := localNodeID(configDir)
+	require.NoError(t, err)
+	require.Empty(t, id)
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(filepath.Join(configDir, NodeKeyFile))
+	require.NoError(t, err)
+
+	id, err = localNodeID(configDir)
+	require.NoError(t, err)
+	require.Equal(t, string(nodeKey.ID()), id)
+}
+
+func TestExcludePeers(t *testing.T) {
+	var (
+		val1      = sampleGenesisValidator(testPeerID1, "10.0.0.1:26656")
+		val2      = sampleGenesisValidator(testPeerID2, "10.0.0.2:26656")
+		self      = sampleGenesisValidator(testPeerID1, "10.0.0.1:26656")
+		duplicate = sampleGenesisValidator(testPeerID2, "10.0.0.3:26656")
+	)
+
+	tests := []struct {
+		name     string
+		vals     []networktypes.GenesisValidator
+		selfID   string
+		want     []networktypes.GenesisValidator
+		excluded peersExclusion
+	}{
+		{
+			name: "no exclusion",
+			vals: []networktypes.GenesisValidator{val1, val2},
+			want: []networktypes.GenesisValidator{val1, val2},
+		},
+		{
+			name:     "local node and duplicates",
+			vals:     []networktypes.GenesisValidator{self, val2, duplicate, duplicate},
+			selfID:   testPeerID1,
+			want:     []networktypes.GenesisValidator{val2},
+			excluded: peersExclusion{Self: 1, Duplicates: 2},
+		},
+		{
+			name:     "node IDs compared case-insensitively",
+			vals:     []networktypes.GenesisValidator{val2, sampleGenesisValidator("B1F2F8521142093D21484CE8632BB7E21003D3F9", "10.0.0.3:26656")},
+			want:     []networktypes.GenesisValidator{val2},
+			excluded: peersExclusion{Duplicates: 1},
+		},
+		{
+			name:     "local node only",
+			vals:     []networktypes.GenesisValidator{self},
+			selfID:   testPeerID1,
+			excluded: peersExclusion{Self: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, excluded := excludePeers(tt.vals, tt.selfID)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.excluded, excluded)
+		})
+	}
+}
+
+func TestUpdateConfigFromGenesisValidators(t *testing.T) {
+	var (
+		home      = t.TempDir()
+		configDir = filepath.Join(home, "config")
+	)
+	require.NoError(t, os.MkdirAll(configDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "config.toml"), []byte(sampleConfigToml), 0o644))
+	nodeKey, err := p2p.LoadOrGenNodeKey(filepath.Join(configDir, NodeKeyFile))
+	require.NoError(t, err)
+
+	ch, err := chain.New(fakeChainSource(t), chain.HomePath(home))
+	require.NoError(t, err)
+
+	vals := []networktypes.GenesisValidator{
+		sampleGenesisValidator(string(nodeKey.ID()), "10.0.0.1:26656"),
+		sampleGenesisValidator(testPeerID1, "10.0.0.2:26656"),
+		sampleGenesisValidator(testPeerID2, "10.0.0.3:26656"),
+		sampleGenesisValidator(testPeerID1, "10.0.0.4:26656"),
+	}
+
+	// the preparation can run several times, the peers are replaced
+	for i := 0; i < 2; i++ {
+		var (
+			ev = events.NewBus(events.WithCustomBufferSize(10))
+			c  = Chain{chain: ch, ev: ev}
+		)
+		require.NoError(t, c.updateConfigFromGenesisValidators(vals))
+
+		ev.Shutdown(context.Background())
+		var descriptions []string
+		for e := range ev.Events() {
+			descriptions = append(descriptions, e.Description)
+		}
+		require.Equal(t, []string{"2 peer(s) excluded from the config: 1 of the local node, 1 duplicate(s)"}, descriptions)
+
+		peers, err := persistentPeers(filepath.Join(configDir, "config.toml"))
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			testPeerID1 + "@10.0.0.2:26656",
+			testPeerID2 + "@10.0.0.3:26656",
+		}, peers)
+	}
+}
@@ -2,17 +2,18 @@ package networkchain
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
-	"strings"
+	"time"
 
-	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
 
 	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/checksum"
 	"github.com/ignite/cli/ignite/pkg/cosmosutil"
 	"github.com/ignite/cli/ignite/pkg/events"
 	"github.com/ignite/cli/ignite/services/network/networktypes"
@@ -35,7 +36,35 @@ func (c Chain) ResetGenesisTime() error {
 	return nil
 }
 
+// SetChainID sets the chain ID in the genesis of the chain, the other fields of the genesis are kept as is.
+func (c Chain) SetChainID(chainID string) error {
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return errors.Wrap(err, "genesis of the blockchain can't be read")
+	}
+
+	if err := cosmosutil.UpdateGenesis(
+		genesisPath,
+		cosmosutil.WithKeyValue(cosmosutil.FieldChainID, chainID),
+	); err != nil {
+		return errors.Wrap(err, "chain ID can't be set")
+	}
+	return nil
+}
+
+// steps of the preparation recorded in the journal of the launch
+const (
+	prepareStepGenesis        = "prepare-genesis"
+	prepareStepValidate       = "prepare-validate-genesis"
+	prepareStepResetState     = "prepare-reset-state"
+	prepareStepLaunchSchedule = "prepare-launch-schedule"
+)
+
 // Prepare prepares the chain to be launched from genesis information
+// The chain home is locked during the preparation, Prepare fails if the home is used by another process
+// The completed steps are recorded in the journal of the launch, an interrupted preparation is resumed
+// from the first step not completed with the same genesis information. The journal is cleared once the
+// preparation succeeds, the chain is built, validated and reset again on the next preparation.
 func (c Chain) Prepare(
 	ctx context.Context,
 	cacheStorage cache.Storage,
@@ -45,6 +74,28 @@ func (c Chain) Prepare(
 	lastBlockHeight,
 	consumerUnbondingTime int64,
 ) error {
+	chainHome, err := c.chain.Home()
+	if err != nil {
+		return err
+	}
+
+	unlock, err := LockHome(chainHome)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	journal, err := OpenJournal(c.launchID, c.clock)
+	if err != nil {
+		return err
+	}
+	inputHash, err := c.prepareInputHash(chainHome, gi, rewardsInfo, spnChainID, lastBlockHeight, consumerUnbondingTime)
+	if err != nil {
+		return err
+	}
+
+	mark := c.warningMark()
+
 	// chain initialization
 	genesisPath, err := c.chain.GenesisPath()
 	if err != nil {
@@ -52,34 +103,54 @@ func (c Chain) Prepare(
 	}
 
 	_, err = os.Stat(genesisPath)
+	genesisExists := err == nil
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
 
-	switch {
-	case os.IsNotExist(err):
-		// if no config exists, perform a full initialization of the chain with a new validator key
-		if err = c.Init(ctx, cacheStorage); err != nil {
+	// the genesis is prepared again if it has been removed since the last preparation
+	if !genesisExists {
+		if err := journal.Invalidate(prepareStepGenesis); err != nil {
 			return err
 		}
-	case err != nil:
-		return err
-	default:
-		// if config and validator key already exists, build the chain and initialize the genesis
-		if _, err := c.Build(ctx, cacheStorage); err != nil {
-			return err
+	}
+
+	if err := journal.Run(prepareStepGenesis, inputHash, func() error {
+		if !genesisExists {
+			// if no config exists, perform a full initialization of the chain with a new validator key
+			if err = c.init(ctx, cacheStorage); err != nil {
+				return err
+			}
+		} else {
+			// if config and validator key already exists, build the chain and initialize the genesis
+			if _, err := c.Build(ctx, cacheStorage); err != nil {
+				return err
+			}
+
+			if err := c.initGenesis(ctx); err != nil {
+				return err
+			}
 		}
 
-		if err := c.initGenesis(ctx); err != nil {
+		if err := c.buildGenesis(
+			ctx,
+			gi,
+			rewardsInfo,
+			spnChainID,
+			lastBlockHeight,
+			consumerUnbondingTime,
+		); err != nil {
 			return err
 		}
-	}
 
-	if err := c.buildGenesis(
-		ctx,
-		gi,
-		rewardsInfo,
-		spnChainID,
-		lastBlockHeight,
-		consumerUnbondingTime,
-	); err != nil {
+		// the supply is checked again once the accounts of the requests are added
+		genesisFile, err := os.Open(genesisPath)
+		if err != nil {
+			return err
+		}
+		defer genesisFile.Close()
+		return checkGenesisSupply(genesisFile)
+	}); err != nil {
 		return err
 	}
 
@@ -89,16 +160,81 @@ func (c Chain) Prepare(
 	}
 
 	// ensure genesis has a valid format
-	if err := cmd.ValidateGenesis(ctx); err != nil {
+	if err := journal.Run(prepareStepValidate, inputHash, func() error {
+		if err := c.validateGenesis(ctx, cmd); err != nil {
+			return err
+		}
+		return c.checkWarnings(mark)
+	}); err != nil {
 		return err
 	}
 
 	// reset the saved state in case the chain has been started before
-	if err := cmd.UnsafeReset(ctx); err != nil {
+	if err := journal.Run(prepareStepResetState, inputHash, func() error {
+		return cmd.UnsafeReset(ctx)
+	}); err != nil {
 		return err
 	}
 
-	return nil
+	// the free space is checked on each preparation, it changes between the preparations
+	if _, err := c.AdviseDisk(ctx); err != nil {
+		return err
+	}
+	if err := c.checkWarnings(mark); err != nil {
+		return err
+	}
+
+	// let supervisors start the node at launch time
+	if err := journal.Run(prepareStepLaunchSchedule, inputHash, c.writeLaunchSchedule); err != nil {
+		return err
+	}
+
+	// the journal only resumes an interrupted preparation, the next preparation runs all the steps
+	return journal.Clear()
+}
+
+// prepareInputHash returns the hash of the inputs of the preparation, the height of the genesis
+// information is not an input since the information doesn't change with the height.
+func (c Chain) prepareInputHash(
+	chainHome string,
+	gi networktypes.GenesisInformation,
+	rewardsInfo networktypes.Reward,
+	spnChainID string,
+	lastBlockHeight,
+	consumerUnbondingTime int64,
+) (string, error) {
+	gi.Height = 0
+	bz, err := json.Marshal(struct {
+		Home                  string
+		SourceURL             string
+		SourceHash            string
+		GenesisURL            string
+		GenesisHash           string
+		ChainID               string
+		LaunchTime            time.Time
+		GenesisInformation    networktypes.GenesisInformation
+		Rewards               networktypes.Reward
+		SPNChainID            string
+		LastBlockHeight       int64
+		ConsumerUnbondingTime int64
+	}{
+		Home:                  chainHome,
+		SourceURL:             c.url,
+		SourceHash:            c.hash,
+		GenesisURL:            c.genesisURL,
+		GenesisHash:           c.genesisHash,
+		ChainID:               c.id,
+		LaunchTime:            c.launchTime,
+		GenesisInformation:    gi,
+		Rewards:               rewardsInfo,
+		SPNChainID:            spnChainID,
+		LastBlockHeight:       lastBlockHeight,
+		ConsumerUnbondingTime: consumerUnbondingTime,
+	})
+	if err != nil {
+		return "", err
+	}
+	return checksum.Strings(string(bz)), nil
 }
 
 // buildGenesis builds the genesis for the chain from the launch approved requests
@@ -110,7 +246,9 @@ func (c Chain) buildGenesis(
 	lastBlockHeight,
 	consumerUnbondingTime int64,
 ) error {
-	c.ev.Send(events.New(events.StatusOngoing, "Building the genesis"))
+	ev := c.ev.WithCategory(events.CategoryGenesis)
+
+	timer := ev.StartTimer(events.Message(networktypes.MsgBuildingGenesis, nil))
 
 	addressPrefix, err := c.detectPrefix(ctx)
 	if err != nil {
@@ -160,7 +298,7 @@ func (c Chain) buildGenesis(
 		return errors.Wrap(err, "genesis time can't be set")
 	}
 
-	c.ev.Send(events.New(events.StatusDone, "Genesis built"))
+	timer.Done(events.Message(networktypes.MsgGenesisBuilt, nil))
 
 	return nil
 }
@@ -268,11 +406,24 @@ func (c Chain) applyGenesisValidators(ctx context.Context, genesisVals []network
 }
 
 // updateConfigFromGenesisValidators adds the peer addresses into the config.toml of the chain
+// The peers replace the previous peers of the config, the local node and the duplicated node IDs are excluded.
 func (c Chain) updateConfigFromGenesisValidators(genesisVals []networktypes.GenesisValidator) error {
 	var (
 		p2pAddresses    []string
 		tunnelAddresses []TunneledPeer
 	)
+
+	configPath, err := c.chain.ConfigTOMLPath()
+	if err != nil {
+		return err
+	}
+	selfID, err := localNodeID(filepath.Dir(configPath))
+	if err != nil {
+		return err
+	}
+	genesisVals, excluded := excludePeers(genesisVals, selfID)
+	c.reportPeersExclusion(excluded)
+
 	for i, val := range genesisVals {
 		if !cosmosutil.VerifyPeerFormat(val.Peer) {
 			return errors.Errorf("invalid peer: %s", val.Peer.Id)
@@ -294,36 +445,28 @@ func (c Chain) updateConfigFromGenesisValidators(genesisVals []networktypes.Gene
 		}
 	}
 
-	if len(p2pAddresses) > 0 {
-		// set persistent peers
-		configPath, err := c.chain.ConfigTOMLPath()
-		if err != nil {
-			return err
-		}
-		configToml, err := toml.LoadFile(configPath)
-		if err != nil {
-			return err
-		}
-		configToml.Set("p2p.persistent_peers", strings.Join(p2pAddresses, ","))
-		if err != nil {
-			return err
+	// the peers of a previous preparation are cleared when the local node is the only validator
+	if len(p2pAddresses) > 0 || excluded.Self > 0 {
+		persistentPeers := p2pAddresses
+		if c.noPersistentPeers {
+			persistentPeers = nil
 		}
 
 		// if there are tunneled peers they will be connected with tunnel clients via localhost,
 		// so we need to allow to have few nodes with the same ip
-		if len(tunnelAddresses) > 0 {
-			configToml.Set("p2p.allow_duplicate_ip", true)
-		}
-
-		// save config.toml file
-		configTomlFile, err := os.OpenFile(configPath, os.O_RDWR|os.O_TRUNC, 0o644)
-		if err != nil {
+		if err := setPersistentPeers(configPath, persistentPeers, len(tunnelAddresses) > 0); err != nil {
 			return err
 		}
-		defer configTomlFile.Close()
 
-		if _, err = configToml.WriteTo(configTomlFile); err != nil {
-			return err
+		if c.addrBook {
+			addrBookPath := filepath.Join(filepath.Dir(configPath), AddrBookFile)
+			warnings, err := writeAddrBook(addrBookPath, p2pAddresses)
+			if err != nil {
+				return err
+			}
+			for _, warning := range warnings {
+				c.warn(WarningPeerSkipped, "%s", warning)
+			}
 		}
 	}
 
@@ -333,9 +476,13 @@ func (c Chain) updateConfigFromGenesisValidators(genesisVals []networktypes.Gene
 			return err
 		}
 
-		if err = SetSPNConfig(Config{
-			TunneledPeers: tunnelAddresses,
-		}, tunneledPeersConfigPath); err != nil {
+		// the other settings of the config are kept
+		spnConfig, err := GetSPNConfig(tunneledPeersConfigPath)
+		if err != nil {
+			return err
+		}
+		spnConfig.TunneledPeers = tunnelAddresses
+		if err = SetSPNConfig(spnConfig, tunneledPeersConfigPath); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,112 @@
+package networkchain
+
+import (
+	"context"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+type (
+	// GenesisPreview summarizes the genesis resulting from the application of requests.
+	GenesisPreview struct {
+		// Validators is the validator set of the genesis, the most powerful validator first
+		Validators []PreviewValidator
+
+		// Accounts is the number of genesis and vesting accounts of the genesis
+		Accounts int
+
+		// Balances is the total balance per denom of the genesis accounts
+		Balances sdk.Coins
+
+		// Conflicts are the requests that can't be applied, they are skipped from the preview
+		Conflicts []PreviewConflict
+	}
+
+	// PreviewValidator is a validator of the genesis preview.
+	PreviewValidator struct {
+		Address        string
+		Moniker        string
+		SelfDelegation sdk.Coin
+		Power          int64
+	}
+
+	// PreviewConflict is a request that can't be applied to the genesis.
+	PreviewConflict struct {
+		RequestID uint64
+		Reason    string
+	}
+)
+
+// PreviewRequests applies the requests to a copy of the genesis information and summarizes the resulting
+// genesis without writing it. The requests conflicting with the genesis, like an account added twice or
+// the removal of a validator not in the genesis, are reported in the preview and skipped.
+func (c Chain) PreviewRequests(
+	ctx context.Context,
+	gi networktypes.GenesisInformation,
+	reqs []networktypes.Request,
+) (GenesisPreview, error) {
+	// the removals change the slices in place, the genesis information of the caller must not be modified
+	gi = copyGenesisInformation(gi)
+
+	var preview GenesisPreview
+	for _, req := range reqs {
+		if err := ctx.Err(); err != nil {
+			return GenesisPreview{}, err
+		}
+
+		applied, err := gi.ApplyRequest(req)
+		if err != nil {
+			preview.Conflicts = append(preview.Conflicts, PreviewConflict{
+				RequestID: req.RequestID,
+				Reason:    err.Error(),
+			})
+			continue
+		}
+
+		// the genesis information only checks the validator removals, the validator is removed here
+		if removal, ok := req.Content.Content.(*launchtypes.RequestContent_ValidatorRemoval); ok {
+			applied.RemoveGenesisValidator(removal.ValidatorRemoval.ValAddress)
+		}
+		gi = applied
+	}
+
+	for _, acc := range gi.GenesisAccounts {
+		preview.Balances = preview.Balances.Add(acc.Coins...)
+	}
+	for _, acc := range gi.VestingAccounts {
+		preview.Balances = preview.Balances.Add(acc.TotalBalance...)
+	}
+	preview.Accounts = len(gi.GenesisAccounts) + len(gi.VestingAccounts)
+
+	for _, val := range gi.GenesisValidators {
+		v := PreviewValidator{
+			Address:        val.Address,
+			SelfDelegation: val.SelfDelegation,
+		}
+		// the moniker is only known from the gentx, the validator is still previewed without it
+		if info, _, err := cosmosutil.ParseGentx(val.Gentx); err == nil {
+			v.Moniker = info.Moniker
+		}
+		if val.SelfDelegation.Amount.IsPositive() {
+			v.Power = sdk.TokensToConsensusPower(val.SelfDelegation.Amount, sdk.DefaultPowerReduction)
+		}
+		preview.Validators = append(preview.Validators, v)
+	}
+	sort.SliceStable(preview.Validators, func(i, j int) bool {
+		return preview.Validators[i].Power > preview.Validators[j].Power
+	})
+
+	return preview, nil
+}
+
+func copyGenesisInformation(gi networktypes.GenesisInformation) networktypes.GenesisInformation {
+	gi.GenesisAccounts = append([]networktypes.GenesisAccount(nil), gi.GenesisAccounts...)
+	gi.VestingAccounts = append([]networktypes.VestingAccount(nil), gi.VestingAccounts...)
+	gi.GenesisValidators = append([]networktypes.GenesisValidator(nil), gi.GenesisValidators...)
+	return gi
+}
@@ -0,0 +1,112 @@
+package networkchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+const previewGentx = `{"body":{"messages":[{
+	"description":{"moniker":"alice"},
+	"delegator_address":"cosmos1alice",
+	"validator_address":"cosmosvaloper1alice",
+	"pubkey":{"@type":"/cosmos.crypto.ed25519.PubKey","key":"aGVsbG8="},
+	"value":{"denom":"stake","amount":"5000000"}
+}],"memo":"nodeid@0.0.0.0:26656"}}`
+
+func TestPreviewRequests(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		c     = Chain{}
+		stake = func(amount int64) sdk.Coin { return sdk.NewCoin("stake", sdkmath.NewInt(amount)) }
+		coins = func(amount int64) sdk.Coins { return sdk.NewCoins(stake(amount)) }
+	)
+
+	gi := networktypes.NewGenesisInformation(
+		[]networktypes.GenesisAccount{
+			{Address: "spn1alice", Coins: coins(1000)},
+			{Address: "spn1bob", Coins: coins(2000)},
+		},
+		nil,
+		[]networktypes.GenesisValidator{
+			{Address: "spn1alice", Gentx: []byte(previewGentx), SelfDelegation: stake(5000000)},
+			{Address: "spn1bob", SelfDelegation: stake(20000000)},
+		},
+	)
+
+	reqs := []networktypes.Request{
+		{
+			RequestID: 1,
+			Content: launchtypes.NewGenesisAccount(0, "spn1carol", sdk.NewCoins(
+				stake(500),
+				sdk.NewCoin("token", sdkmath.NewInt(10)),
+			)),
+		},
+		{
+			RequestID: 2,
+			Content:   launchtypes.NewGenesisAccount(0, "spn1alice", coins(100)),
+		},
+		{
+			RequestID: 3,
+			Content: launchtypes.NewVestingAccount(0, "spn1dave", *launchtypes.NewDelayedVesting(
+				coins(300),
+				coins(300),
+				time.Unix(1000, 0),
+			)),
+		},
+		{
+			RequestID: 4,
+			Content:   launchtypes.NewAccountRemoval("spn1bob"),
+		},
+		{
+			RequestID: 5,
+			Content:   launchtypes.NewValidatorRemoval("spn1erin"),
+		},
+		{
+			RequestID: 6,
+			Content:   launchtypes.NewValidatorRemoval("spn1bob"),
+		},
+		{
+			RequestID: 7,
+			Content: launchtypes.NewGenesisValidator(
+				0, "spn1carol", nil, nil, stake(10000000), launchtypes.Peer{},
+			),
+		},
+		{
+			RequestID: 8,
+			Content: launchtypes.NewGenesisValidator(
+				0, "spn1alice", nil, nil, stake(1), launchtypes.Peer{},
+			),
+		},
+	}
+
+	preview, err := c.PreviewRequests(ctx, gi, reqs)
+	require.NoError(t, err)
+
+	require.Equal(t, []PreviewValidator{
+		{Address: "spn1carol", SelfDelegation: stake(10000000), Power: 10},
+		{Address: "spn1alice", Moniker: "alice", SelfDelegation: stake(5000000), Power: 5},
+	}, preview.Validators)
+	require.Equal(t, 3, preview.Accounts)
+	require.Equal(t, "1800stake,10token", preview.Balances.String())
+
+	require.Len(t, preview.Conflicts, 3)
+	require.EqualValues(t, 2, preview.Conflicts[0].RequestID)
+	require.Contains(t, preview.Conflicts[0].Reason, "genesis account already in genesis")
+	require.EqualValues(t, 5, preview.Conflicts[1].RequestID)
+	require.Contains(t, preview.Conflicts[1].Reason, "genesis validator can't be removed because it doesn't exist")
+	require.EqualValues(t, 8, preview.Conflicts[2].RequestID)
+	require.Contains(t, preview.Conflicts[2].Reason, "genesis validator already in genesis")
+
+	// the genesis information of the caller is left unchanged
+	require.Len(t, gi.GenesisAccounts, 2)
+	require.Len(t, gi.GenesisValidators, 2)
+	require.Equal(t, "spn1bob", gi.GenesisAccounts[1].Address)
+}
@@ -0,0 +1,366 @@
+package networkchain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/buger/jsonparser"
+	"github.com/pelletier/go-toml"
+
+	"github.com/ignite/cli/ignite/pkg/availableport"
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/tendermintrpc"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+const (
+	// RehearsalHeight is the height all the validators of a rehearsal must reach.
+	RehearsalHeight = 5
+
+	// RehearsalTimeout is the maximum duration for the validators of a rehearsal to reach the rehearsal height.
+	RehearsalTimeout = 2 * time.Minute
+
+	// rehearsalAccount is the name of the account of a rehearsal validator in its keyring.
+	rehearsalAccount = "validator"
+
+	// rehearsalStake is the amount of the bond denom delegated by each rehearsal validator.
+	rehearsalStake = 100000000
+
+	// defaultBondDenom is the bond denom used when the genesis doesn't set the staking params.
+	defaultBondDenom = "stake"
+)
+
+// rehearsalNode is a validator of a rehearsal started from its own home.
+type rehearsalNode struct {
+	moniker string
+	home    string
+	runner  chaincmdrunner.Runner
+	address string
+	nodeID  string
+	rpcAddr string
+	p2pPort int
+}
+
+func (n rehearsalNode) genesisPath() string {
+	return filepath.Join(n.home, cosmosutil.ChainConfigDir, "genesis.json")
+}
+
+func (n rehearsalNode) appTOMLPath() string {
+	return filepath.Join(n.home, cosmosutil.ChainConfigDir, "app.toml")
+}
+
+func (n rehearsalNode) configTOMLPath() string {
+	return filepath.Join(n.home, cosmosutil.ChainConfigDir, "config.toml")
+}
+
+// Rehearse starts a local testnet with several validators from the finalized genesis of the chain to test the
+// genesis before the launch. The gentxs of the genesis are replaced by the gentxs of the local validators,
+// each validator runs from a temporary home with its own ports and the other validators as persistent peers.
+// The rehearsal succeeds once all the validators reached RehearsalHeight, the validators are then stopped and
+// their homes are removed.
+func (c Chain) Rehearse(ctx context.Context, validators int) error {
+	ev := c.ev.WithCategory(events.CategoryNode)
+
+	if validators < 1 {
+		return fmt.Errorf("at least one validator is required for the rehearsal, got %d", validators)
+	}
+
+	dir, err := os.MkdirTemp("", "ignite-rehearsal")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	ev.Send(events.NewOngoing(fmt.Sprintf("Preparing %d rehearsal validators", validators)))
+
+	nodes, err := c.prepareRehearsalNodes(ctx, dir, validators)
+	if err != nil {
+		return err
+	}
+	if err := setRehearsalConfig(nodes); err != nil {
+		return err
+	}
+
+	ev.Send(events.NewDone(fmt.Sprintf("%d rehearsal validators prepared", validators), ""))
+	ev.Send(events.NewOngoing(fmt.Sprintf("Waiting for the rehearsal validators to reach the height %d", RehearsalHeight)))
+
+	// the validators are stopped once the height is reached or the rehearsal failed
+	startCtx, cancel := context.WithCancel(ctx)
+	var (
+		wg   sync.WaitGroup
+		exit = make(chan error, len(nodes))
+	)
+	defer wg.Wait()
+	defer cancel()
+
+	for _, node := range nodes {
+		wg.Add(1)
+		go func(node rehearsalNode) {
+			defer wg.Done()
+			err := node.runner.Start(startCtx)
+			if startCtx.Err() == nil {
+				exit <- fmt.Errorf("the rehearsal validator %s stopped: %w", node.moniker, err)
+			}
+		}(node)
+	}
+
+	if err := c.waitRehearsalHeight(ctx, nodes, exit); err != nil {
+		return err
+	}
+
+	ev.Send(events.NewDone(fmt.Sprintf("Rehearsal validators reached the height %d", RehearsalHeight), ""))
+	return nil
+}
+
+// prepareRehearsalNodes initializes the homes of the rehearsal validators in the directory with the finalized
+// genesis of the chain starting now, the gentxs of the genesis are replaced by the gentxs of the validators.
+func (c Chain) prepareRehearsalNodes(ctx context.Context, dir string, validators int) ([]rehearsalNode, error) {
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return nil, err
+	}
+	genesis, err := rehearsalGenesis(genesisPath, c.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	genesisFile, err := cosmosutil.ParseGenesisFile(genesis)
+	if err != nil {
+		return nil, err
+	}
+	bondDenom, err := genesisFile.BondDenom()
+	if err != nil {
+		return nil, err
+	}
+	if bondDenom == "" {
+		bondDenom = defaultBondDenom
+	}
+
+	nodes, err := c.initRehearsalNodes(ctx, dir, genesisFile.ChainID(), validators)
+	if err != nil {
+		return nil, err
+	}
+	if err := setRehearsalGenesis(ctx, nodes, genesis, bondDenom); err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+// initRehearsalNodes initializes the homes of the rehearsal validators with their keys.
+func (c Chain) initRehearsalNodes(ctx context.Context, dir, chainID string, validators int) ([]rehearsalNode, error) {
+	chainCmd, err := c.chain.Commands(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ports, err := availableport.Find(nodePortCount * validators)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]rehearsalNode, validators)
+	for i := range nodes {
+		node := rehearsalNode{
+			moniker: fmt.Sprintf("validator%d", i),
+			home:    filepath.Join(dir, fmt.Sprintf("node%d", i)),
+		}
+
+		cmd := chainCmd.Cmd().Copy(
+			chaincmd.WithHome(node.home),
+			chaincmd.WithChainID(chainID),
+			chaincmd.WithKeyringBackend(chaincmd.KeyringBackendTest),
+		)
+		if node.runner, err = chaincmdrunner.New(ctx, cmd); err != nil {
+			return nil, err
+		}
+		if err := node.runner.Init(ctx, node.moniker); err != nil {
+			return nil, fmt.Errorf("cannot initialize the rehearsal validator %s: %w", node.moniker, err)
+		}
+
+		account, err := node.runner.AddAccount(ctx, rehearsalAccount, "", "")
+		if err != nil {
+			return nil, err
+		}
+		node.address = account.Address
+
+		if node.nodeID, err = node.runner.ShowNodeID(ctx); err != nil {
+			return nil, err
+		}
+
+		nodePorts := ports[i*nodePortCount : (i+1)*nodePortCount]
+		if node.rpcAddr, err = setNodePorts(node.appTOMLPath(), node.configTOMLPath(), nodePorts); err != nil {
+			return nil, err
+		}
+		node.p2pPort = nodePorts[3]
+
+		nodes[i] = node
+	}
+	return nodes, nil
+}
+
+// setRehearsalGenesis funds the rehearsal validators in the genesis and collects their gentxs,
+// the genesis is prepared in the home of the first validator then copied to the other validators.
+func setRehearsalGenesis(ctx context.Context, nodes []rehearsalNode, genesis []byte, bondDenom string) error {
+	first := nodes[0]
+	if err := os.WriteFile(first.genesisPath(), genesis, 0o644); err != nil {
+		return err
+	}
+
+	// the balance of the validators covers the self delegation of their gentx
+	coins := fmt.Sprintf("%d%s", rehearsalStake, bondDenom)
+	for _, node := range nodes {
+		if err := first.runner.AddGenesisAccount(ctx, node.address, coins); err != nil {
+			return err
+		}
+	}
+	if err := copyGenesis(first, nodes[1:]); err != nil {
+		return err
+	}
+
+	gentxDir := filepath.Join(first.home, cosmosutil.ChainConfigDir, "gentx")
+	if err := os.MkdirAll(gentxDir, 0o755); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		gentxPath, err := node.runner.Gentx(ctx, rehearsalAccount, coins, chaincmd.GentxWithMoniker(node.moniker))
+		if err != nil {
+			return fmt.Errorf("cannot generate the gentx of the rehearsal validator %s: %w", node.moniker, err)
+		}
+		if node.home == first.home {
+			continue
+		}
+		gentx, err := os.ReadFile(gentxPath)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(gentxDir, filepath.Base(gentxPath)), gentx, 0o644); err != nil {
+			return err
+		}
+	}
+
+	if err := first.runner.CollectGentxs(ctx); err != nil {
+		return err
+	}
+	return copyGenesis(first, nodes[1:])
+}
+
+// copyGenesis copies the genesis of the source node to the nodes.
+func copyGenesis(source rehearsalNode, nodes []rehearsalNode) error {
+	genesis, err := os.ReadFile(source.genesisPath())
+	if err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		if err := os.WriteFile(node.genesisPath(), genesis, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setRehearsalConfig sets the other rehearsal validators as persistent peers of each validator.
+// The gRPC web server is disabled since its port is the same for all the validators.
+func setRehearsalConfig(nodes []rehearsalNode) error {
+	for i, node := range nodes {
+		var peers []string
+		for j, peer := range nodes {
+			if i != j {
+				peers = append(peers, fmt.Sprintf("%s@127.0.0.1:%d", peer.nodeID, peer.p2pPort))
+			}
+		}
+
+		// the local addresses of the peers are not routable
+		if err := setPersistentPeers(node.configTOMLPath(), peers, true); err != nil {
+			return err
+		}
+		if err := setTOMLValue(node.configTOMLPath(), "p2p.addr_book_strict", false); err != nil {
+			return err
+		}
+		if err := setTOMLValue(node.appTOMLPath(), "grpc-web.enable", false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitRehearsalHeight waits for all the rehearsal validators to reach the rehearsal height.
+// An error is returned when a validator stops or the rehearsal timeout elapsed.
+func (c Chain) waitRehearsalHeight(ctx context.Context, nodes []rehearsalNode, exit <-chan error) error {
+	waitCtx, cancel := withTimeout(ctx, c.clock, RehearsalTimeout)
+	defer cancel()
+
+	for _, node := range nodes {
+		addr, err := xurl.HTTP(node.rpcAddr)
+		if err != nil {
+			return fmt.Errorf("invalid rpc address format %s: %w", node.rpcAddr, err)
+		}
+		client := tendermintrpc.New(addr)
+
+		for {
+			height, err := client.LatestBlockHeight(waitCtx)
+			if err == nil && height >= RehearsalHeight {
+				break
+			}
+
+			select {
+			case err := <-exit:
+				return err
+			case <-waitCtx.Done():
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				return fmt.Errorf(
+					"the rehearsal validator %s didn't reach the height %d in %s",
+					node.moniker,
+					RehearsalHeight,
+					RehearsalTimeout,
+				)
+			case <-c.clock.After(listeningCheckInterval):
+			}
+		}
+	}
+	return nil
+}
+
+// rehearsalGenesis returns the genesis of the chain without its gentxs and starting at the provided time.
+func rehearsalGenesis(genesisPath string, genesisTime time.Time) ([]byte, error) {
+	genesis, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, _, err := jsonparser.Get(genesis, "app_state", "genutil", "gen_txs"); err == nil {
+		if genesis, err = jsonparser.Set(genesis, []byte("[]"), "app_state", "genutil", "gen_txs"); err != nil {
+			return nil, err
+		}
+	}
+	return jsonparser.Set(
+		genesis,
+		[]byte(fmt.Sprintf(`"%s"`, genesisTime.UTC().Format(time.RFC3339Nano))),
+		cosmosutil.FieldGenesisTime,
+	)
+}
+
+// setTOMLValue sets the value of the key in the TOML file.
+func setTOMLValue(path, key string, value interface{}) error {
+	config, err := toml.LoadFile(path)
+	if err != nil {
+		return err
+	}
+	config.Set(key, value)
+
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = config.WriteTo(file)
+	return err
+}
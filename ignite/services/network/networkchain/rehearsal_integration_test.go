@@ -0,0 +1,47 @@
+//go:build rehearsal
+
+package networkchain
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/ignite/pkg/xtime"
+	"github.com/ignite/cli/ignite/services/chain"
+	envtest "github.com/ignite/cli/integration"
+)
+
+// TestRehearse starts a rehearsal of two validators from the genesis of a scaffolded chain,
+// it requires the ignite binary to scaffold the chain and is run with the rehearsal build tag.
+func TestRehearse(t *testing.T) {
+	var (
+		env = envtest.New(t)
+		app = env.Scaffold("github.com/test/mars")
+		ctx = env.Ctx()
+	)
+
+	ch, err := chain.New(
+		app.SourcePath(),
+		chain.HomePath(t.TempDir()),
+		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+	)
+	require.NoError(t, err)
+
+	cacheStorage, err := cache.NewStorage(filepath.Join(t.TempDir(), "cache.db"))
+	require.NoError(t, err)
+	_, err = ch.Build(ctx, cacheStorage, "", true)
+	require.NoError(t, err)
+
+	// the genesis of the chain is initialized without gentxs
+	commands, err := ch.Commands(ctx)
+	require.NoError(t, err)
+	require.NoError(t, commands.Init(ctx, "moniker"))
+
+	c := Chain{chain: ch, clock: xtime.NewClockSystem()}
+	require.NoError(t, c.Rehearse(context.Background(), 2))
+}
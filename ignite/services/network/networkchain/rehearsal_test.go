@@ -0,0 +1,75 @@
+package networkchain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+)
+
+func TestRehearsalGenesis(t *testing.T) {
+	genesisTime := time.Date(2022, 9, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		genesis string
+		want    string
+	}{
+		{
+			name:    "genesis with gentxs",
+			genesis: `{"genesis_time":"2022-01-01T00:00:00Z","chain_id":"mars-1","app_state":{"genutil":{"gen_txs":[{"body":{}}]}}}`,
+			want:    `{"genesis_time":"2022-09-01T10:00:00Z","chain_id":"mars-1","app_state":{"genutil":{"gen_txs":[]}}}`,
+		},
+		{
+			name:    "genesis without genutil",
+			genesis: `{"genesis_time":"2022-01-01T00:00:00Z","chain_id":"mars-1","app_state":{}}`,
+			want:    `{"genesis_time":"2022-09-01T10:00:00Z","chain_id":"mars-1","app_state":{}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			genesisPath := filepath.Join(t.TempDir(), "genesis.json")
+			require.NoError(t, os.WriteFile(genesisPath, []byte(tt.genesis), 0o644))
+
+			genesis, err := rehearsalGenesis(genesisPath, genesisTime)
+			require.NoError(t, err)
+			require.JSONEq(t, tt.want, string(genesis))
+
+			genesisFile, err := cosmosutil.ParseGenesisFile(genesis)
+			require.NoError(t, err)
+			gentxCount, err := genesisFile.GenTxCount()
+			require.NoError(t, err)
+			require.Zero(t, gentxCount)
+		})
+	}
+}
+
+func TestSetRehearsalConfig(t *testing.T) {
+	nodes := make([]rehearsalNode, 3)
+	for i := range nodes {
+		nodes[i] = rehearsalNode{
+			home:    t.TempDir(),
+			nodeID:  string(rune('a' + i)),
+			p2pPort: 26656 + i,
+		}
+		require.NoError(t, os.MkdirAll(filepath.Join(nodes[i].home, cosmosutil.ChainConfigDir), 0o755))
+		require.NoError(t, os.WriteFile(nodes[i].configTOMLPath(), []byte("[p2p]\n"), 0o644))
+		require.NoError(t, os.WriteFile(nodes[i].appTOMLPath(), []byte("[grpc-web]\nenable = true\n"), 0o644))
+	}
+
+	require.NoError(t, setRehearsalConfig(nodes))
+
+	config, err := os.ReadFile(nodes[1].configTOMLPath())
+	require.NoError(t, err)
+	require.Contains(t, string(config), `persistent_peers = "a@127.0.0.1:26656,c@127.0.0.1:26658"`)
+	require.Contains(t, string(config), "allow_duplicate_ip = true")
+	require.Contains(t, string(config), "addr_book_strict = false")
+
+	app, err := os.ReadFile(nodes[1].appTOMLPath())
+	require.NoError(t, err)
+	require.Contains(t, string(app), "enable = false")
+}
@@ -0,0 +1,197 @@
+package networkchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/goccy/go-yaml"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+const (
+	// ComposeFile is the name of the Docker Compose file of a rehearsal generated with RehearsalCompose.
+	ComposeFile = "docker-compose.yaml"
+
+	// DefaultComposeRPCPort is the host port the RPC of the first validator of a compose rehearsal is published on,
+	// the RPC of the next validators is published on the next ports.
+	DefaultComposeRPCPort = 26657
+
+	// composeHome is the path the home of a validator is mounted on in its container.
+	composeHome = "/node"
+
+	// the ports of the servers of a validator in its container
+	composeP2PPort  = 26656
+	composeRPCPort  = 26657
+	composeAPIPort  = 1317
+	composeGRPCPort = 9090
+)
+
+type (
+	// composeFile is the content of the Docker Compose file of a rehearsal.
+	composeFile struct {
+		Services yaml.MapSlice `yaml:"services"`
+	}
+
+	// composeService is the service of a validator in the Docker Compose file.
+	composeService struct {
+		Image      string        `yaml:"image"`
+		Entrypoint []string      `yaml:"entrypoint"`
+		Command    []string      `yaml:"command"`
+		Volumes    []string      `yaml:"volumes"`
+		Ports      []composePort `yaml:"ports"`
+	}
+
+	// composePort is a port of a service published on the host.
+	composePort struct {
+		Target    int `yaml:"target"`
+		Published int `yaml:"published"`
+	}
+)
+
+// ComposeOption configures the Docker Compose rehearsal.
+type ComposeOption func(*composeOptions)
+
+type composeOptions struct {
+	rpcPort int
+}
+
+// WithComposeRPCPort sets the host port the RPC of the first validator is published on.
+func WithComposeRPCPort(port int) ComposeOption {
+	return func(o *composeOptions) {
+		o.rpcPort = port
+	}
+}
+
+// RehearsalCompose generates a Docker Compose rehearsal of the launch in the directory with the validators
+// of Rehearse: a home for each validator with its own keys and a ComposeFile running a service per validator
+// from the image. The image must contain the chain binary, the homes are mounted in the containers.
+// The validators are persistent peers of each other through their service names, the RPC of each validator
+// is published on its own host port. The directory must be empty or not exist.
+func (c Chain) RehearsalCompose(
+	ctx context.Context,
+	dir string,
+	validators int,
+	image string,
+	options ...ComposeOption,
+) error {
+	ev := c.ev.WithCategory(events.CategoryNode)
+
+	o := composeOptions{rpcPort: DefaultComposeRPCPort}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	if validators < 1 {
+		return fmt.Errorf("at least one validator is required for the rehearsal, got %d", validators)
+	}
+	if image == "" {
+		return errors.New("the image of the validators is required for the rehearsal")
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(entries) > 0 {
+		return fmt.Errorf("the rehearsal directory %s is not empty", dir)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	binary, err := c.chain.Binary()
+	if err != nil {
+		return err
+	}
+
+	ev.Send(events.NewOngoing(fmt.Sprintf("Preparing %d rehearsal validators", validators)))
+
+	nodes, err := c.prepareRehearsalNodes(ctx, dir, validators)
+	if err != nil {
+		return err
+	}
+	if err := writeRehearsalCompose(dir, image, binary, nodes, o.rpcPort); err != nil {
+		return err
+	}
+
+	ev.Send(events.NewDone(
+		fmt.Sprintf("Rehearsal generated in %s, start it with docker compose up", dir),
+		"",
+	))
+	return nil
+}
+
+// writeRehearsalCompose configures the validators to run in their containers and writes the ComposeFile.
+func writeRehearsalCompose(dir, image, binary string, nodes []rehearsalNode, rpcPort int) error {
+	if err := setComposeConfig(nodes); err != nil {
+		return err
+	}
+
+	compose := newRehearsalCompose(image, binary, nodes, rpcPort)
+	bz, err := yaml.Marshal(compose)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, ComposeFile), bz, 0o644)
+}
+
+// newRehearsalCompose returns the Docker Compose file with a service per validator named after its moniker.
+// The homes of the validators are mounted from the directory of the compose file.
+func newRehearsalCompose(image, binary string, nodes []rehearsalNode, rpcPort int) composeFile {
+	var compose composeFile
+	for i, node := range nodes {
+		compose.Services = append(compose.Services, yaml.MapItem{
+			Key: node.moniker,
+			Value: composeService{
+				Image:      image,
+				Entrypoint: []string{binary},
+				Command:    []string{"start", "--home", composeHome},
+				Volumes:    []string{fmt.Sprintf("./%s:%s", filepath.Base(node.home), composeHome)},
+				Ports:      []composePort{{Target: composeRPCPort, Published: rpcPort + i}},
+			},
+		})
+	}
+	return compose
+}
+
+// setComposeConfig sets the servers of the validators to listen on all the interfaces of their container
+// with the default ports, the other validators are persistent peers through their service names.
+func setComposeConfig(nodes []rehearsalNode) error {
+	for i, node := range nodes {
+		var peers []string
+		for j, peer := range nodes {
+			if i != j {
+				peers = append(peers, fmt.Sprintf("%s@%s:%d", peer.nodeID, peer.moniker, composeP2PPort))
+			}
+		}
+		if err := setPersistentPeers(node.configTOMLPath(), peers, false); err != nil {
+			return err
+		}
+
+		configValues := map[string]interface{}{
+			"p2p.laddr":            fmt.Sprintf("tcp://0.0.0.0:%d", composeP2PPort),
+			"rpc.laddr":            fmt.Sprintf("tcp://0.0.0.0:%d", composeRPCPort),
+			"rpc.pprof_laddr":      "",
+			"p2p.addr_book_strict": false,
+		}
+		for key, value := range configValues {
+			if err := setTOMLValue(node.configTOMLPath(), key, value); err != nil {
+				return err
+			}
+		}
+
+		appValues := map[string]interface{}{
+			"api.address":  fmt.Sprintf("tcp://0.0.0.0:%d", composeAPIPort),
+			"grpc.address": fmt.Sprintf("0.0.0.0:%d", composeGRPCPort),
+		}
+		for key, value := range appValues {
+			if err := setTOMLValue(node.appTOMLPath(), key, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package networkchain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/pelletier/go-toml"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+)
+
+// composeNodes returns rehearsal validators with a home in the directory like the ones of prepareRehearsalNodes.
+func composeNodes(t *testing.T, dir string, count int) []rehearsalNode {
+	nodes := make([]rehearsalNode, count)
+	for i := range nodes {
+		nodes[i] = rehearsalNode{
+			moniker: fmt.Sprintf("validator%d", i),
+			home:    filepath.Join(dir, fmt.Sprintf("node%d", i)),
+			nodeID:  fmt.Sprintf("id%d", i),
+		}
+		require.NoError(t, os.MkdirAll(filepath.Join(nodes[i].home, cosmosutil.ChainConfigDir), 0o755))
+		require.NoError(t, os.WriteFile(nodes[i].configTOMLPath(), []byte("[p2p]\n[rpc]\n"), 0o644))
+		require.NoError(t, os.WriteFile(nodes[i].appTOMLPath(), []byte("[api]\n[grpc]\n"), 0o644))
+	}
+	return nodes
+}
+
+func TestWriteRehearsalCompose(t *testing.T) {
+	dir := t.TempDir()
+	nodes := composeNodes(t, dir, 3)
+
+	require.NoError(t, writeRehearsalCompose(dir, "example/chain:v1", "chaind", nodes, 36657))
+
+	data, err := os.ReadFile(filepath.Join(dir, ComposeFile))
+	require.NoError(t, err)
+	var compose struct {
+		Services map[string]composeService `yaml:"services"`
+	}
+	require.NoError(t, yaml.Unmarshal(data, &compose))
+
+	require.Len(t, compose.Services, 3)
+	published := make(map[int]bool)
+	for i := range nodes {
+		service, ok := compose.Services[fmt.Sprintf("validator%d", i)]
+		require.True(t, ok)
+		require.Equal(t, "example/chain:v1", service.Image)
+		require.Equal(t, []string{"chaind"}, service.Entrypoint)
+		require.Equal(t, []string{"start", "--home", "/node"}, service.Command)
+		require.Equal(t, []string{fmt.Sprintf("./node%d:/node", i)}, service.Volumes)
+		require.Equal(t, []composePort{{Target: 26657, Published: 36657 + i}}, service.Ports)
+		published[service.Ports[0].Published] = true
+	}
+	require.Len(t, published, 3)
+
+	// each validator has the other validators as peers through their service names
+	for i, node := range nodes {
+		config, err := toml.LoadFile(node.configTOMLPath())
+		require.NoError(t, err)
+		var want string
+		for j := range nodes {
+			if i != j {
+				if want != "" {
+					want += ","
+				}
+				want += fmt.Sprintf("id%d@validator%d:26656", j, j)
+			}
+		}
+		require.Equal(t, want, config.Get("p2p.persistent_peers"))
+		require.Equal(t, "tcp://0.0.0.0:26656", config.Get("p2p.laddr"))
+		require.Equal(t, "tcp://0.0.0.0:26657", config.Get("rpc.laddr"))
+		require.Equal(t, false, config.Get("p2p.addr_book_strict"))
+
+		app, err := toml.LoadFile(node.appTOMLPath())
+		require.NoError(t, err)
+		require.Equal(t, "tcp://0.0.0.0:1317", app.Get("api.address"))
+		require.Equal(t, "0.0.0.0:9090", app.Get("grpc.address"))
+	}
+
+	// the file is validated by Docker Compose when it is available, the services are not started
+	if exec.Command("docker", "compose", "version").Run() != nil {
+		t.Skip("docker compose is not available to validate the compose file")
+	}
+	cmd := exec.Command("docker", "compose", "config", "--quiet")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+func TestRehearsalComposeInvalid(t *testing.T) {
+	c := Chain{}
+
+	err := c.RehearsalCompose(context.Background(), t.TempDir(), 0, "example/chain:v1")
+	require.EqualError(t, err, "at least one validator is required for the rehearsal, got 0")
+
+	err = c.RehearsalCompose(context.Background(), t.TempDir(), 2, "")
+	require.EqualError(t, err, "the image of the validators is required for the rehearsal")
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ComposeFile), nil, 0o644))
+	err = c.RehearsalCompose(context.Background(), dir, 2, "example/chain:v1")
+	require.EqualError(t, err, fmt.Sprintf("the rehearsal directory %s is not empty", dir))
+}
@@ -0,0 +1,45 @@
+package networkchain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/ignite/pkg/goenv"
+	"github.com/ignite/cli/ignite/pkg/sandbox"
+)
+
+// validateGenesis runs the validate-genesis command of the chain binary, in the sandbox when the chain has one.
+func (c Chain) validateGenesis(ctx context.Context, chainCmd chaincmdrunner.Runner) error {
+	if c.sandbox == nil {
+		return chainCmd.ValidateGenesis(ctx)
+	}
+	return runSandboxed(ctx, c.sandbox, chainCmd.Cmd().ValidateGenesisCommand())
+}
+
+// runSandboxed runs the command of the chain binary with the sandbox runner.
+// The stderr of the command is added to the returned error like the chain commands runner does.
+func runSandboxed(ctx context.Context, runner sandbox.Runner, command step.Option) error {
+	s := step.New(command)
+
+	// the sandbox has no PATH, the chain binary is installed in the Go bin directory
+	binary := s.Exec.Command
+	if !filepath.IsAbs(binary) {
+		binary = filepath.Join(goenv.Bin(), binary)
+	}
+
+	var stderr bytes.Buffer
+	err := runner.Run(ctx, sandbox.Command{
+		Path:   binary,
+		Args:   s.Exec.Args,
+		Stderr: &stderr,
+	})
+	if err != nil && stderr.Len() > 0 {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return err
+}
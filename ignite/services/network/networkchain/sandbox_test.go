@@ -0,0 +1,69 @@
+package networkchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/goenv"
+	"github.com/ignite/cli/ignite/pkg/sandbox"
+)
+
+// fakeSandbox records the commands and returns err after writing stderr.
+type fakeSandbox struct {
+	commands []sandbox.Command
+	stderr   string
+	err      error
+}
+
+func (f *fakeSandbox) Run(_ context.Context, cmd sandbox.Command) error {
+	f.commands = append(f.commands, cmd)
+	fmt.Fprint(cmd.Stderr, f.stderr)
+	return f.err
+}
+
+func TestValidateGenesisSandbox(t *testing.T) {
+	home := t.TempDir()
+	chainCmd, err := chaincmdrunner.New(context.Background(), chaincmd.New("food", chaincmd.WithHome(home)))
+	require.NoError(t, err)
+
+	t.Run("validate the genesis in the sandbox", func(t *testing.T) {
+		fake := &fakeSandbox{}
+		c := Chain{sandbox: fake}
+
+		require.NoError(t, c.validateGenesis(context.Background(), chainCmd))
+		require.Len(t, fake.commands, 1)
+		require.Equal(t, filepath.Join(goenv.Bin(), "food"), fake.commands[0].Path)
+		require.Equal(t, []string{"validate-genesis", "--home", home}, fake.commands[0].Args)
+	})
+
+	t.Run("limit exceeded", func(t *testing.T) {
+		fake := &fakeSandbox{
+			stderr: "signal: killed\n",
+			err:    sandbox.ErrLimitExceeded{Limit: sandbox.LimitMemory, Value: "1024 bytes"},
+		}
+		c := Chain{sandbox: fake}
+
+		err := c.validateGenesis(context.Background(), chainCmd)
+		require.EqualError(t, err, "the command exceeded the memory limit of 1024 bytes: signal: killed")
+
+		var limitErr sandbox.ErrLimitExceeded
+		require.True(t, errors.As(err, &limitErr))
+		require.Equal(t, sandbox.LimitMemory, limitErr.Limit)
+	})
+
+	t.Run("genesis modules from the sandbox", func(t *testing.T) {
+		fake := &fakeSandbox{err: errors.New("exit status 1")}
+
+		_, err := binaryDefaultGenesis(context.Background(), chainCmd, fake)
+		require.EqualError(t, err, "cannot initialize a default genesis: exit status 1")
+		require.Len(t, fake.commands, 1)
+		require.Equal(t, "init", fake.commands[0].Args[0])
+	})
+}
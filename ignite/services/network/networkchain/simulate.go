@@ -7,12 +7,12 @@ import (
 	"strings"
 	"time"
 
-	"github.com/cenkalti/backoff"
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 
 	"github.com/ignite/cli/ignite/pkg/availableport"
 	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
 	"github.com/ignite/cli/ignite/pkg/events"
 	"github.com/ignite/cli/ignite/pkg/httpstatuschecker"
 	"github.com/ignite/cli/ignite/pkg/xurl"
@@ -22,6 +22,12 @@ import (
 const (
 	ListeningTimeout            = time.Minute * 1
 	ValidatorSetNilErrorMessage = "validator set is nil in genesis and still empty after InitChain"
+
+	// listeningCheckInterval is the interval between two checks of the chain RPC while simulating the start.
+	listeningCheckInterval = time.Second
+
+	// nodePortCount is the number of ports of the servers of a node.
+	nodePortCount = 5
 )
 
 // SimulateRequests simulates the genesis creation and the start of the network from the provided requests
@@ -31,20 +37,47 @@ func (c Chain) SimulateRequests(
 	gi networktypes.GenesisInformation,
 	reqs []networktypes.Request,
 ) (err error) {
-	c.ev.Send(events.New(events.StatusOngoing, "Verifying requests format"))
+	ev := c.ev.WithCategory(events.CategoryGenesis)
+
+	chainID, err := c.ChainID()
+	if err != nil {
+		return err
+	}
+
+	mark := c.warningMark()
+	ev.Send(events.New(events.StatusOngoing, "Verifying requests format"))
 	for _, req := range reqs {
 		// static verification of the request
 		if err := networktypes.VerifyRequest(req); err != nil {
 			return err
 		}
 
+		// the gentx signatures must be valid for the genesis to be started, the gentxs with the key types
+		// of another codec, like the EVM chains, can't be verified and are only reported to the coordinator
+		if err := networktypes.VerifyRequestSignature(req, chainID); errors.Is(err, cosmosutil.ErrGentxUnknownKeyType) {
+			c.warn(WarningSignatureNotVerified, "The signature of the request %d can't be verified: %s", req.RequestID, err)
+		} else if err != nil {
+			return err
+		}
+
+		// the peer of the validator must be the node signing the blocks, a gentx without memo
+		// can't be checked and is only reported to the coordinator
+		if err := networktypes.VerifyRequestPeer(req); errors.Is(err, networktypes.ErrGentxWithoutMemo) {
+			c.warn(WarningPeerNotVerified, "The node ID of the request %d can't be verified: %s", req.RequestID, err)
+		} else if err != nil {
+			return err
+		}
+
 		// apply the request to the genesis information
 		gi, err = gi.ApplyRequest(req)
 		if err != nil {
 			return err
 		}
 	}
-	c.ev.Send(events.New(events.StatusDone, "Requests format verified"))
+	if err := c.checkWarnings(mark); err != nil {
+		return err
+	}
+	ev.Send(events.New(events.StatusDone, "Requests format verified"))
 
 	// prepare the chain with the requests
 	if err := c.Prepare(
@@ -59,11 +92,11 @@ func (c Chain) SimulateRequests(
 		return err
 	}
 
-	c.ev.Send(events.New(events.StatusOngoing, "Trying starting the network with the requests"))
+	ev.Send(events.New(events.StatusOngoing, "Trying starting the network with the requests"))
 	if err := c.simulateChainStart(ctx); err != nil {
 		return err
 	}
-	c.ev.Send(events.New(events.StatusDone, "The network can be started"))
+	ev.Send(events.New(events.StatusDone, "The network can be started"))
 
 	return nil
 }
@@ -83,13 +116,13 @@ func (c Chain) simulateChainStart(ctx context.Context) error {
 	}
 
 	// verify that the chain can be started with a valid genesis
-	ctx, cancel := context.WithTimeout(ctx, ListeningTimeout)
+	ctx, cancel := withTimeout(ctx, c.clock, ListeningTimeout)
 	exit := make(chan error)
 
 	// routine to check the app is listening
 	go func() {
 		defer cancel()
-		exit <- isChainListening(ctx, rpcAddr)
+		exit <- isChainListening(ctx, c.clock, rpcAddr)
 	}()
 
 	// routine chain start
@@ -110,19 +143,34 @@ func (c Chain) simulateChainStart(ctx context.Context) error {
 // setSimulationConfig sets in the config random available ports to allow check if the chain network can start
 func (c Chain) setSimulationConfig() (string, error) {
 	// generate random server ports and servers list
-	ports, err := availableport.Find(5)
+	ports, err := availableport.Find(nodePortCount)
 	if err != nil {
 		return "", err
 	}
-	genAddr := func(port int) string {
-		return fmt.Sprintf("localhost:%d", port)
-	}
 
-	// updating app toml
 	appPath, err := c.AppTOMLPath()
 	if err != nil {
 		return "", err
 	}
+	configPath, err := c.ConfigTOMLPath()
+	if err != nil {
+		return "", err
+	}
+	return setNodePorts(appPath, configPath, ports)
+}
+
+// setNodePorts sets the ports of the servers of a node in its app.toml and config.toml,
+// the blocks are produced every second. It returns the RPC address of the node.
+// The ports are the API, gRPC, RPC, P2P and pprof ports, in this order.
+func setNodePorts(appPath, configPath string, ports []int) (string, error) {
+	if len(ports) != nodePortCount {
+		return "", fmt.Errorf("%d ports are required for a node, got %d", nodePortCount, len(ports))
+	}
+	genAddr := func(port int) string {
+		return fmt.Sprintf("localhost:%d", port)
+	}
+
+	// updating app toml
 	config, err := toml.LoadFile(appPath)
 	if err != nil {
 		return "", err
@@ -150,10 +198,6 @@ func (c Chain) setSimulationConfig() (string, error) {
 	}
 
 	// updating config toml
-	configPath, err := c.ConfigTOMLPath()
-	if err != nil {
-		return "", err
-	}
 	config, err = toml.LoadFile(configPath)
 	if err != nil {
 		return "", err
@@ -188,18 +232,26 @@ func (c Chain) setSimulationConfig() (string, error) {
 }
 
 // isChainListening checks if the chain is listening for RPC queries on the specified address
-func isChainListening(ctx context.Context, rpcAddr string) error {
-	checkAlive := func() error {
-		addr, err := xurl.HTTP(rpcAddr)
-		if err != nil {
-			return fmt.Errorf("invalid rpc address format %s: %w", rpcAddr, err)
-		}
+// the check is retried until the context is done
+func isChainListening(ctx context.Context, clock Clock, rpcAddr string) error {
+	addr, err := xurl.HTTP(rpcAddr)
+	if err != nil {
+		return fmt.Errorf("invalid rpc address format %s: %w", rpcAddr, err)
+	}
 
+	for {
 		ok, err := httpstatuschecker.Check(ctx, fmt.Sprintf("%s/health", addr))
-		if err == nil && !ok {
+		if err == nil && ok {
+			return nil
+		}
+		if err == nil {
 			err = errors.New("app is not online")
 		}
-		return err
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-clock.After(listeningCheckInterval):
+		}
 	}
-	return backoff.Retry(checkAlive, backoff.WithContext(backoff.NewConstantBackOff(time.Second), ctx))
 }
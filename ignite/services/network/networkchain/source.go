@@ -0,0 +1,283 @@
+package networkchain
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/ignite/cli/ignite/pkg/ociregistry"
+)
+
+const (
+	// SourceSchemeOCI is the scheme of the chain sources distributed as an OCI artifact.
+	SourceSchemeOCI = "oci://"
+
+	// OCISourceLayerMediaType is the media type of the layer of an OCI artifact containing the chain source.
+	// The single layer of an artifact is used as the source layer when no layer has this media type.
+	OCISourceLayerMediaType = "application/vnd.ignite.chain.source.v1.tar+gzip"
+
+	// sourceDigestPrefix is the prefix of the digest recorded as the source hash of the tarball and OCI sources.
+	sourceDigestPrefix = "sha256:"
+)
+
+// supportedSources lists the supported chain sources in the errors of the unknown source schemes.
+const supportedSources = "a git repository (https://, http://, ssh://, git://, file:// or user@host:path), " +
+	"a tarball (https://.../source.tar.gz) or an OCI artifact (oci://registry/repository:tag)"
+
+// gitSchemes are the schemes of the git repository sources.
+var gitSchemes = []string{"https", "http", "ssh", "git", "file"}
+
+// OCIClient fetches the artifacts of an OCI registry.
+type OCIClient interface {
+	Manifest(ctx context.Context, ref ociregistry.Reference) (ociregistry.Manifest, string, error)
+	Blob(ctx context.Context, ref ociregistry.Reference, digest string) (io.ReadCloser, error)
+}
+
+// WithOCIClient sets the client fetching the OCI artifact sources, the artifacts
+// are fetched with the HTTP client of the chain by default.
+func WithOCIClient(client OCIClient) Option {
+	return func(c *Chain) {
+		c.ociClient = client
+	}
+}
+
+// IsOCISource checks if the source URL is an OCI artifact reference.
+func IsOCISource(url string) bool {
+	return strings.HasPrefix(url, SourceSchemeOCI)
+}
+
+// isTarballSource checks if the source URL is the URL of a tarball.
+func isTarballSource(url string) bool {
+	if !strings.HasPrefix(url, "https://") && !strings.HasPrefix(url, "http://") {
+		return false
+	}
+	path := strings.SplitN(url, "?", 2)[0]
+	return strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz")
+}
+
+// fetchSource fetches the chain source from url and returns a temporary path where source is saved,
+// with the hash of the source: the git commit of a repository or the verified digest of a tarball or an OCI artifact.
+// The expected hash is checked out for a git repository and verified for a tarball or an OCI artifact.
+func fetchSource(
+	ctx context.Context,
+	url string,
+	ref plumbing.ReferenceName,
+	customHash string,
+	httpClient *http.Client,
+	ociClient OCIClient,
+) (path, hash string, err error) {
+	switch {
+	case IsOCISource(url), isTarballSource(url):
+		if ref != "" {
+			return "", "", fmt.Errorf("a branch or a tag can't be set for the source %s, only for a git repository", url)
+		}
+		if IsOCISource(url) {
+			if ociClient == nil {
+				ociClient = newOCIClient(httpClient)
+			}
+			return fetchOCISource(ctx, strings.TrimPrefix(url, SourceSchemeOCI), customHash, ociClient)
+		}
+		return fetchTarballSource(ctx, url, customHash, httpClient)
+	}
+
+	// the scp-like addresses and the local paths have no scheme
+	if scheme, _, ok := strings.Cut(url, "://"); ok && !isGitScheme(scheme) {
+		return "", "", fmt.Errorf("unsupported source scheme %q, the source must be %s", scheme, supportedSources)
+	}
+	return fetchGitSource(ctx, url, ref, customHash, httpClient)
+}
+
+func isGitScheme(scheme string) bool {
+	for _, s := range gitSchemes {
+		if strings.EqualFold(s, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+func newOCIClient(httpClient *http.Client) OCIClient {
+	if httpClient == nil {
+		return ociregistry.New()
+	}
+	return ociregistry.New(ociregistry.WithHTTPClient(httpClient))
+}
+
+// fetchTarballSource downloads the tarball of the chain source, verifies its digest
+// against the expected hash when set and unpacks it.
+func fetchTarballSource(ctx context.Context, url, customHash string, httpClient *http.Client) (path, hash string, err error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", "", err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", "", fmt.Errorf("cannot download the source tarball from %s: %s", url, resp.Status)
+	}
+
+	path, digest, err := unpackSource(resp.Body)
+	if err != nil {
+		return "", "", fmt.Errorf("cannot unpack the source tarball from %s: %w", url, err)
+	}
+	if customHash != "" && customHash != digest {
+		os.RemoveAll(path)
+		return "", "", fmt.Errorf("the source tarball from %s has the digest %s, expected %s", url, digest, customHash)
+	}
+	return path, digest, nil
+}
+
+// fetchOCISource pulls the source layer of the OCI artifact, verifies the digest of the manifest against
+// the expected hash when set and unpacks the layer. The digest of the manifest is the hash of the source.
+func fetchOCISource(ctx context.Context, ref, customHash string, client OCIClient) (path, hash string, err error) {
+	reference, err := ociregistry.ParseReference(ref)
+	if err != nil {
+		return "", "", err
+	}
+	// the manifest is pinned to the expected digest, a moved tag fails the fetch
+	if customHash != "" {
+		reference.Digest = customHash
+	}
+
+	manifest, digest, err := client.Manifest(ctx, reference)
+	if err != nil {
+		return "", "", err
+	}
+	if customHash != "" && customHash != digest {
+		return "", "", fmt.Errorf("the manifest of %s has the digest %s, expected %s", ref, digest, customHash)
+	}
+	layer, err := sourceLayer(manifest)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid OCI source %s: %w", ref, err)
+	}
+
+	blob, err := client.Blob(ctx, reference, layer.Digest)
+	if err != nil {
+		return "", "", err
+	}
+	defer blob.Close()
+
+	path, layerDigest, err := unpackSource(blob)
+	if err != nil {
+		return "", "", fmt.Errorf("cannot unpack the source layer of %s: %w", ref, err)
+	}
+	if layerDigest != layer.Digest {
+		os.RemoveAll(path)
+		return "", "", fmt.Errorf("%w: the source layer of %s has the digest %s", ociregistry.ErrInvalidDigest, ref, layerDigest)
+	}
+	return path, digest, nil
+}
+
+// sourceLayer returns the layer of the manifest containing the chain source.
+func sourceLayer(manifest ociregistry.Manifest) (ociregistry.Descriptor, error) {
+	for _, layer := range manifest.Layers {
+		if layer.MediaType == OCISourceLayerMediaType {
+			return layer, nil
+		}
+	}
+	if len(manifest.Layers) == 1 {
+		return manifest.Layers[0], nil
+	}
+	return ociregistry.Descriptor{}, fmt.Errorf(
+		"the artifact has %d layers and none with the media type %s",
+		len(manifest.Layers),
+		OCISourceLayerMediaType,
+	)
+}
+
+// unpackSource unpacks a tar.gz archive of the chain source into a temporary path and returns the sha256 digest
+// of the archive. The source is the single top-level directory of the archive when there is one, like in the
+// release tarballs of the git hosts.
+func unpackSource(r io.Reader) (path, digest string, err error) {
+	tmp, err := os.MkdirTemp("", "")
+	if err != nil {
+		return "", "", err
+	}
+	defer func() {
+		if err != nil {
+			os.RemoveAll(tmp)
+		}
+	}()
+
+	h := sha256.New()
+	if err := extractTarGz(io.TeeReader(r, h), tmp); err != nil {
+		return "", "", err
+	}
+	// the digest covers the whole archive, the data after the end of the tar is read too
+	if _, err := io.Copy(io.Discard, io.TeeReader(r, h)); err != nil {
+		return "", "", err
+	}
+	digest = sourceDigestPrefix + hex.EncodeToString(h.Sum(nil))
+
+	entries, err := os.ReadDir(tmp)
+	if err != nil {
+		return "", "", err
+	}
+	if len(entries) == 1 && entries[0].IsDir() {
+		return filepath.Join(tmp, entries[0].Name()), digest, nil
+	}
+	return tmp, digest, nil
+}
+
+// extractTarGz extracts the directories and the regular files of a tar.gz archive into dst.
+// The entries outside of dst and the links are refused.
+func extractTarGz(r io.Reader, dst string) error {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dst, filepath.Clean("/"+header.Name))
+		if target == dst {
+			continue
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(header.Mode)&0o755|0o644)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(f, tr)
+			f.Close()
+			if err != nil {
+				return err
+			}
+		case tar.TypeSymlink, tar.TypeLink:
+			return fmt.Errorf("the link %s is not supported in a source archive", header.Name)
+		}
+	}
+}
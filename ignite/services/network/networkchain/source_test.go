@@ -0,0 +1,204 @@
+package networkchain
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/ociregistry"
+)
+
+// sourceTarGz returns a tar.gz archive of the files.
+func sourceTarGz(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+	return buf.Bytes()
+}
+
+func sha256Digest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// fakeOCIClient serves the manifest and the blobs of a single artifact.
+type fakeOCIClient struct {
+	manifest ociregistry.Manifest
+	digest   string
+	blobs    map[string][]byte
+	pulled   []ociregistry.Reference
+}
+
+func (c *fakeOCIClient) Manifest(_ context.Context, ref ociregistry.Reference) (ociregistry.Manifest, string, error) {
+	c.pulled = append(c.pulled, ref)
+	if ref.Digest != "" && ref.Digest != c.digest {
+		return ociregistry.Manifest{}, "", ociregistry.ErrInvalidDigest
+	}
+	return c.manifest, c.digest, nil
+}
+
+func (c *fakeOCIClient) Blob(_ context.Context, _ ociregistry.Reference, digest string) (io.ReadCloser, error) {
+	blob, ok := c.blobs[digest]
+	if !ok {
+		return nil, errors.New("blob not found")
+	}
+	return io.NopCloser(bytes.NewReader(blob)), nil
+}
+
+func TestFetchTarballSource(t *testing.T) {
+	tarball := sourceTarGz(t, map[string]string{
+		"mars-1.0.0/go.mod":      "module github.com/ignite/mars\n",
+		"mars-1.0.0/app/app.go":  "package app\n",
+		"mars-1.0.0/config.yml":  "accounts: []\n",
+		"mars-1.0.0/cmd/main.go": "package main\n",
+	})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/mars-1.0.0.tar.gz" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(tarball)
+	}))
+	defer srv.Close()
+	url := srv.URL + "/mars-1.0.0.tar.gz"
+
+	t.Run("unpacked source", func(t *testing.T) {
+		path, hash, err := fetchSource(context.Background(), url, "", "", nil, nil)
+		require.NoError(t, err)
+		defer os.RemoveAll(filepath.Dir(path))
+
+		// the single top-level directory is the source
+		require.Equal(t, sha256Digest(tarball), hash)
+		require.FileExists(t, filepath.Join(path, "go.mod"))
+		require.FileExists(t, filepath.Join(path, "app", "app.go"))
+	})
+
+	t.Run("verified digest", func(t *testing.T) {
+		path, hash, err := fetchSource(context.Background(), url, "", sha256Digest(tarball), nil, nil)
+		require.NoError(t, err)
+		defer os.RemoveAll(filepath.Dir(path))
+		require.Equal(t, sha256Digest(tarball), hash)
+	})
+
+	t.Run("digest mismatch", func(t *testing.T) {
+		_, _, err := fetchSource(context.Background(), url, "", sha256Digest([]byte("foo")), nil, nil)
+		require.ErrorContains(t, err, "expected "+sha256Digest([]byte("foo")))
+	})
+
+	t.Run("missing tarball", func(t *testing.T) {
+		_, _, err := fetchSource(context.Background(), srv.URL+"/missing.tar.gz", "", "", nil, nil)
+		require.ErrorContains(t, err, "404 Not Found")
+	})
+
+	t.Run("tarball with a tag", func(t *testing.T) {
+		_, _, err := fetchSource(context.Background(), url, plumbing.NewTagReferenceName("v1.0.0"), "", nil, nil)
+		require.ErrorContains(t, err, "only for a git repository")
+	})
+}
+
+func TestFetchOCISource(t *testing.T) {
+	var (
+		layer  = sourceTarGz(t, map[string]string{"go.mod": "module github.com/ignite/mars\n"})
+		config = []byte(`{}`)
+		digest = sha256Digest([]byte("manifest"))
+	)
+	newClient := func(layers ...ociregistry.Descriptor) *fakeOCIClient {
+		return &fakeOCIClient{
+			manifest: ociregistry.Manifest{
+				MediaType: ociregistry.MediaTypeImageManifest,
+				Config:    ociregistry.Descriptor{Digest: sha256Digest(config)},
+				Layers:    layers,
+			},
+			digest: digest,
+			blobs: map[string][]byte{
+				sha256Digest(layer):  layer,
+				sha256Digest(config): config,
+			},
+		}
+	}
+	sourceLayer := ociregistry.Descriptor{MediaType: OCISourceLayerMediaType, Digest: sha256Digest(layer)}
+
+	t.Run("source layer", func(t *testing.T) {
+		client := newClient(ociregistry.Descriptor{MediaType: "text/plain", Digest: sha256Digest(config)}, sourceLayer)
+
+		path, hash, err := fetchSource(context.Background(), "oci://ghcr.io/ignite/mars:v1.0.0", "", "", nil, client)
+		require.NoError(t, err)
+		defer os.RemoveAll(path)
+
+		// the digest of the manifest is the source hash
+		require.Equal(t, digest, hash)
+		require.FileExists(t, filepath.Join(path, "go.mod"))
+		require.Equal(t, []ociregistry.Reference{{Registry: "ghcr.io", Repository: "ignite/mars", Tag: "v1.0.0"}}, client.pulled)
+	})
+
+	t.Run("manifest pinned to the expected digest", func(t *testing.T) {
+		client := newClient(sourceLayer)
+
+		path, hash, err := fetchSource(context.Background(), "oci://ghcr.io/ignite/mars:v1.0.0", "", digest, nil, client)
+		require.NoError(t, err)
+		defer os.RemoveAll(path)
+		require.Equal(t, digest, hash)
+		require.Equal(t, digest, client.pulled[0].Digest)
+
+		_, _, err = fetchSource(context.Background(), "oci://ghcr.io/ignite/mars:v1.0.0", "", sha256Digest([]byte("foo")), nil, client)
+		require.ErrorIs(t, err, ociregistry.ErrInvalidDigest)
+	})
+
+	t.Run("tampered source layer", func(t *testing.T) {
+		client := newClient(sourceLayer)
+		client.blobs[sourceLayer.Digest] = sourceTarGz(t, map[string]string{"go.mod": "module github.com/evil/mars\n"})
+
+		_, _, err := fetchSource(context.Background(), "oci://ghcr.io/ignite/mars:v1.0.0", "", "", nil, client)
+		require.ErrorIs(t, err, ociregistry.ErrInvalidDigest)
+	})
+
+	t.Run("no source layer", func(t *testing.T) {
+		client := newClient(
+			ociregistry.Descriptor{MediaType: "text/plain", Digest: sha256Digest(config)},
+			ociregistry.Descriptor{MediaType: "text/plain", Digest: sha256Digest(layer)},
+		)
+
+		_, _, err := fetchSource(context.Background(), "oci://ghcr.io/ignite/mars:v1.0.0", "", "", nil, client)
+		require.ErrorContains(t, err, "the artifact has 2 layers and none with the media type "+OCISourceLayerMediaType)
+	})
+}
+
+func TestFetchSourceUnknownScheme(t *testing.T) {
+	_, _, err := fetchSource(context.Background(), "ftp://example.com/mars.tar.gz", "", "", nil, nil)
+	require.ErrorContains(t, err, `unsupported source scheme "ftp"`)
+	require.ErrorContains(t, err, "oci://registry/repository:tag")
+}
+
+func TestExtractTarGzOutsideDestination(t *testing.T) {
+	archive := sourceTarGz(t, map[string]string{"../../evil": "evil"})
+	dst := t.TempDir()
+
+	// the entries are kept in the destination
+	require.NoError(t, extractTarGz(bytes.NewReader(archive), dst))
+	require.FileExists(t, filepath.Join(dst, "evil"))
+}
@@ -0,0 +1,268 @@
+package networkchain
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// PrivValidatorKeyFile is the name of the consensus key file in the config directory of the chain.
+	PrivValidatorKeyFile = "priv_validator_key.json"
+
+	// ValidatorKeyBundleVersion is the version of the validator key bundle format.
+	ValidatorKeyBundleVersion = 1
+
+	// the scrypt parameters recommended for interactive logins
+	validatorKeyScryptN = 1 << 15
+	validatorKeyScryptR = 8
+	validatorKeyScryptP = 1
+
+	validatorKeySaltSize = 32
+)
+
+var (
+	// ErrWrongPassphrase is returned when the passphrase doesn't decrypt the validator key bundle.
+	ErrWrongPassphrase = errors.New("wrong passphrase for the validator key bundle")
+
+	// ErrCorruptValidatorKeyBundle is returned when the validator key bundle can't be read.
+	ErrCorruptValidatorKeyBundle = errors.New("corrupt validator key bundle")
+
+	// ErrValidatorKeyExists is returned when importing a validator key into a chain home that has one.
+	ErrValidatorKeyExists = errors.New("the chain already has a validator key")
+)
+
+type (
+	// validatorKeyBundle is an encrypted validator key bundle.
+	// The scrypt derived key is split into the AES-256-GCM key and a check key, the hash of the check key
+	// tells a wrong passphrase apart from a corrupt ciphertext.
+	validatorKeyBundle struct {
+		Version    int    `json:"version"`
+		Salt       []byte `json:"salt"`
+		N          int    `json:"n"`
+		R          int    `json:"r"`
+		P          int    `json:"p"`
+		Check      []byte `json:"check"`
+		Nonce      []byte `json:"nonce"`
+		Ciphertext []byte `json:"ciphertext"`
+	}
+
+	// validatorKeys are the keys of a validator node, the content of a validator key bundle.
+	validatorKeys struct {
+		PrivValidatorKey json.RawMessage `json:"priv_validator_key"`
+		NodeKey          json.RawMessage `json:"node_key"`
+	}
+
+	// ImportValidatorKeyOption configures the import of a validator key.
+	ImportValidatorKeyOption func(*importValidatorKeyOptions)
+
+	importValidatorKeyOptions struct {
+		overwrite bool
+	}
+)
+
+// OverwriteValidatorKey replaces the validator key and the node key of the chain home when they exist.
+func OverwriteValidatorKey() ImportValidatorKeyOption {
+	return func(o *importValidatorKeyOptions) {
+		o.overwrite = true
+	}
+}
+
+// ExportValidatorKey exports the consensus key and the node key of the chain home into a bundle
+// encrypted with the passphrase, the bundle can be imported on another machine with ImportValidatorKey.
+func (c Chain) ExportValidatorKey(path, passphrase string) error {
+	configDir, err := c.configDir()
+	if err != nil {
+		return err
+	}
+
+	var keys validatorKeys
+	if keys.PrivValidatorKey, err = readKeyFile(filepath.Join(configDir, PrivValidatorKeyFile)); err != nil {
+		return err
+	}
+	if keys.NodeKey, err = readKeyFile(filepath.Join(configDir, NodeKeyFile)); err != nil {
+		return err
+	}
+
+	bundle, err := encryptValidatorKeys(keys, passphrase)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// ImportValidatorKey installs the consensus key and the node key of the bundle into the chain home.
+// The keys of the chain home are never overwritten unless OverwriteValidatorKey is used.
+func (c Chain) ImportValidatorKey(path, passphrase string, options ...ImportValidatorKeyOption) error {
+	var o importValidatorKeyOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var bundle validatorKeyBundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return errors.Wrap(ErrCorruptValidatorKeyBundle, err.Error())
+	}
+	keys, err := decryptValidatorKeys(bundle, passphrase)
+	if err != nil {
+		return err
+	}
+
+	configDir, err := c.configDir()
+	if err != nil {
+		return err
+	}
+	files := map[string]json.RawMessage{
+		filepath.Join(configDir, PrivValidatorKeyFile): keys.PrivValidatorKey,
+		filepath.Join(configDir, NodeKeyFile):          keys.NodeKey,
+	}
+	if !o.overwrite {
+		for file := range files {
+			if _, err := os.Stat(file); err == nil {
+				return errors.Wrap(ErrValidatorKeyExists, file)
+			} else if !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+
+	if err := os.MkdirAll(configDir, 0o755); err != nil {
+		return err
+	}
+	for file, key := range files {
+		if err := os.WriteFile(file, key, 0o600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// configDir returns the config directory of the chain home.
+func (c Chain) configDir() (string, error) {
+	configTOML, err := c.ConfigTOMLPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Dir(configTOML), nil
+}
+
+// readKeyFile reads a JSON key file of the chain home.
+func readKeyFile(path string) (json.RawMessage, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read the validator key")
+	}
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("invalid key file %s", path)
+	}
+	return data, nil
+}
+
+// deriveValidatorKeys derives the encryption key and the check key of a bundle from the passphrase.
+func deriveValidatorKeys(bundle validatorKeyBundle, passphrase string) (encryptionKey, check []byte, err error) {
+	derived, err := scrypt.Key([]byte(passphrase), bundle.Salt, bundle.N, bundle.R, bundle.P, 64)
+	if err != nil {
+		return nil, nil, err
+	}
+	checkHash := sha256.Sum256(derived[32:])
+	return derived[:32], checkHash[:], nil
+}
+
+func encryptValidatorKeys(keys validatorKeys, passphrase string) (validatorKeyBundle, error) {
+	bundle := validatorKeyBundle{
+		Version: ValidatorKeyBundleVersion,
+		Salt:    make([]byte, validatorKeySaltSize),
+		N:       validatorKeyScryptN,
+		R:       validatorKeyScryptR,
+		P:       validatorKeyScryptP,
+	}
+	if _, err := rand.Read(bundle.Salt); err != nil {
+		return validatorKeyBundle{}, err
+	}
+
+	encryptionKey, check, err := deriveValidatorKeys(bundle, passphrase)
+	if err != nil {
+		return validatorKeyBundle{}, err
+	}
+	bundle.Check = check
+
+	aead, err := newValidatorKeyAEAD(encryptionKey)
+	if err != nil {
+		return validatorKeyBundle{}, err
+	}
+	bundle.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(bundle.Nonce); err != nil {
+		return validatorKeyBundle{}, err
+	}
+
+	plaintext, err := json.Marshal(keys)
+	if err != nil {
+		return validatorKeyBundle{}, err
+	}
+	bundle.Ciphertext = aead.Seal(nil, bundle.Nonce, plaintext, nil)
+	return bundle, nil
+}
+
+func decryptValidatorKeys(bundle validatorKeyBundle, passphrase string) (validatorKeys, error) {
+	if bundle.Version != ValidatorKeyBundleVersion {
+		return validatorKeys{}, fmt.Errorf(
+			"unsupported validator key bundle version %d, expected %d",
+			bundle.Version,
+			ValidatorKeyBundleVersion,
+		)
+	}
+
+	encryptionKey, check, err := deriveValidatorKeys(bundle, passphrase)
+	if err != nil {
+		return validatorKeys{}, errors.Wrap(ErrCorruptValidatorKeyBundle, err.Error())
+	}
+	if subtle.ConstantTimeCompare(check, bundle.Check) != 1 {
+		return validatorKeys{}, ErrWrongPassphrase
+	}
+
+	aead, err := newValidatorKeyAEAD(encryptionKey)
+	if err != nil {
+		return validatorKeys{}, err
+	}
+	if len(bundle.Nonce) != aead.NonceSize() {
+		return validatorKeys{}, errors.Wrap(ErrCorruptValidatorKeyBundle, "invalid nonce")
+	}
+	plaintext, err := aead.Open(nil, bundle.Nonce, bundle.Ciphertext, nil)
+	if err != nil {
+		return validatorKeys{}, errors.Wrap(ErrCorruptValidatorKeyBundle, err.Error())
+	}
+
+	var keys validatorKeys
+	if err := json.Unmarshal(plaintext, &keys); err != nil {
+		return validatorKeys{}, errors.Wrap(ErrCorruptValidatorKeyBundle, err.Error())
+	}
+	if !json.Valid(keys.PrivValidatorKey) || !json.Valid(keys.NodeKey) {
+		return validatorKeys{}, errors.Wrap(ErrCorruptValidatorKeyBundle, "missing keys")
+	}
+	return keys, nil
+}
+
+func newValidatorKeyAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
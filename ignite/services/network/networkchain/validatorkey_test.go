@@ -0,0 +1,115 @@
+package networkchain
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	testPrivValidatorKey = `{"address":"6B55AA5C1A1528C9","pub_key":{"type":"tendermint/PubKeyEd25519","value":"pub"},"priv_key":{"type":"tendermint/PrivKeyEd25519","value":"priv"}}`
+	testNodeKey          = `{"priv_key":{"type":"tendermint/PrivKeyEd25519","value":"node"}}`
+)
+
+func TestValidatorKey(t *testing.T) {
+	newChain := func(t *testing.T, withKeys bool) (Chain, string) {
+		home := t.TempDir()
+		configDir := filepath.Join(home, "config")
+		if withKeys {
+			require.NoError(t, os.MkdirAll(configDir, 0o755))
+			require.NoError(t, os.WriteFile(filepath.Join(configDir, PrivValidatorKeyFile), []byte(testPrivValidatorKey), 0o600))
+			require.NoError(t, os.WriteFile(filepath.Join(configDir, NodeKeyFile), []byte(testNodeKey), 0o600))
+		}
+
+		ch, err := chain.New(fakeChainSource(t), chain.HomePath(home))
+		require.NoError(t, err)
+		return Chain{chain: ch}, configDir
+	}
+	exportKey := func(t *testing.T, passphrase string) string {
+		c, _ := newChain(t, true)
+		path := filepath.Join(t.TempDir(), "validator-key.json")
+		require.NoError(t, c.ExportValidatorKey(path, passphrase))
+		return path
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		path := exportKey(t, "secret")
+
+		// the keys are not readable from the bundle
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.NotContains(t, string(data), "tendermint/PrivKeyEd25519")
+
+		c, configDir := newChain(t, false)
+		require.NoError(t, c.ImportValidatorKey(path, "secret"))
+
+		privValidatorKey, err := os.ReadFile(filepath.Join(configDir, PrivValidatorKeyFile))
+		require.NoError(t, err)
+		require.Equal(t, testPrivValidatorKey, string(privValidatorKey))
+		nodeKey, err := os.ReadFile(filepath.Join(configDir, NodeKeyFile))
+		require.NoError(t, err)
+		require.Equal(t, testNodeKey, string(nodeKey))
+
+		info, err := os.Stat(filepath.Join(configDir, PrivValidatorKeyFile))
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	})
+
+	t.Run("existing keys are not overwritten", func(t *testing.T) {
+		path := exportKey(t, "secret")
+		c, configDir := newChain(t, true)
+		other := `{"priv_key":{"type":"tendermint/PrivKeyEd25519","value":"other"}}`
+		require.NoError(t, os.WriteFile(filepath.Join(configDir, NodeKeyFile), []byte(other), 0o600))
+
+		err := c.ImportValidatorKey(path, "secret")
+		require.ErrorIs(t, err, ErrValidatorKeyExists)
+		nodeKey, err := os.ReadFile(filepath.Join(configDir, NodeKeyFile))
+		require.NoError(t, err)
+		require.Equal(t, other, string(nodeKey))
+
+		require.NoError(t, c.ImportValidatorKey(path, "secret", OverwriteValidatorKey()))
+		nodeKey, err = os.ReadFile(filepath.Join(configDir, NodeKeyFile))
+		require.NoError(t, err)
+		require.Equal(t, testNodeKey, string(nodeKey))
+	})
+
+	t.Run("wrong passphrase", func(t *testing.T) {
+		path := exportKey(t, "secret")
+		c, _ := newChain(t, false)
+
+		err := c.ImportValidatorKey(path, "not the secret")
+		require.ErrorIs(t, err, ErrWrongPassphrase)
+		require.NotErrorIs(t, err, ErrCorruptValidatorKeyBundle)
+	})
+
+	t.Run("corrupt bundle", func(t *testing.T) {
+		path := exportKey(t, "secret")
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		var bundle validatorKeyBundle
+		require.NoError(t, json.Unmarshal(data, &bundle))
+		bundle.Ciphertext[0] ^= 0xff
+		data, err = json.Marshal(bundle)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, data, 0o600))
+		c, _ := newChain(t, false)
+
+		err = c.ImportValidatorKey(path, "secret")
+		require.ErrorIs(t, err, ErrCorruptValidatorKeyBundle)
+		require.NotErrorIs(t, err, ErrWrongPassphrase)
+
+		require.NoError(t, os.WriteFile(path, []byte("not a bundle"), 0o600))
+		require.ErrorIs(t, c.ImportValidatorKey(path, "secret"), ErrCorruptValidatorKeyBundle)
+	})
+
+	t.Run("missing keys", func(t *testing.T) {
+		c, _ := newChain(t, false)
+		err := c.ExportValidatorKey(filepath.Join(t.TempDir(), "validator-key.json"), "secret")
+		require.ErrorContains(t, err, "cannot read the validator key")
+	})
+}
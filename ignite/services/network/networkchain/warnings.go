@@ -0,0 +1,128 @@
+package networkchain
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/xerrors"
+)
+
+// WarningCode identifies the check reporting a warning.
+type WarningCode string
+
+const (
+	// WarningGenesisHashNotPublished is reported when the genesis fetched from a URL has no published hash.
+	WarningGenesisHashNotPublished WarningCode = "genesis-hash-not-published"
+
+	// WarningGenesisNotSigned is reported when the hash of the genesis fetched from a URL is not signed by the coordinator.
+	WarningGenesisNotSigned WarningCode = "genesis-not-signed"
+
+	// WarningPeerNotVerified is reported when the node ID of a gentx without memo can't be verified.
+	WarningPeerNotVerified WarningCode = "peer-not-verified"
+
+	// WarningSignatureNotVerified is reported when the signature of a gentx with key types unknown to the Cosmos SDK
+	// can't be verified.
+	WarningSignatureNotVerified WarningCode = "signature-not-verified"
+
+	// WarningPeerSkipped is reported when an invalid peer is not added to the address book.
+	WarningPeerSkipped WarningCode = "peer-skipped"
+
+	// WarningGenesisAccountSkipped is reported when the address of a genesis account of an unknown type
+	// can't be read and the account is not checked.
+	WarningGenesisAccountSkipped WarningCode = "genesis-account-skipped"
+
+	// WarningDiskSpace is reported when the free space doesn't fit the estimated growth of the chain home.
+	WarningDiskSpace WarningCode = "disk-space"
+)
+
+// Warning is an issue found by a validation check that doesn't prevent the chain from being initialized
+// or prepared, unless the validation is strict.
+type Warning struct {
+	Code    WarningCode
+	Message string
+}
+
+// String implements fmt.Stringer
+func (w Warning) String() string {
+	return fmt.Sprintf("%s: %s", w.Code, w.Message)
+}
+
+// ErrWarning is returned for a warning elevated to an error by the strict validation.
+type ErrWarning struct {
+	Warning Warning
+}
+
+// Error implements error
+func (e ErrWarning) Error() string {
+	return fmt.Sprintf("strict validation: %s", e.Warning.Message)
+}
+
+// warningCollector collects the warnings of the validation checks of a chain.
+// The collector is shared by the copies of the chain.
+type warningCollector struct {
+	mu       sync.Mutex
+	warnings []Warning
+}
+
+func (w *warningCollector) add(warning Warning) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.warnings = append(w.warnings, warning)
+}
+
+// since returns the warnings collected after the first n warnings.
+func (w *warningCollector) since(n int) []Warning {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if n >= len(w.warnings) {
+		return nil
+	}
+	return append([]Warning(nil), w.warnings[n:]...)
+}
+
+func (w *warningCollector) len() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.warnings)
+}
+
+// Warnings returns the warnings reported by the validation checks of the chain.
+func (c Chain) Warnings() []Warning {
+	if c.warnings == nil {
+		return nil
+	}
+	return c.warnings.since(0)
+}
+
+// warn reports a warning of a validation check, the warning is displayed unless the validation is strict,
+// the strict validation returns the warnings as errors at the end of the validation.
+func (c Chain) warn(code WarningCode, format string, args ...interface{}) {
+	warning := Warning{Code: code, Message: fmt.Sprintf(format, args...)}
+	if c.warnings != nil {
+		c.warnings.add(warning)
+	}
+	if !c.strictValidation {
+		c.ev.Send(events.NewWarning(warning.Message))
+	}
+}
+
+// warningMark returns the mark to pass to checkWarnings at the end of a validation.
+func (c Chain) warningMark() int {
+	if c.warnings == nil {
+		return 0
+	}
+	return c.warnings.len()
+}
+
+// checkWarnings returns the warnings reported since the mark as errors when the validation is strict.
+func (c Chain) checkWarnings(mark int) error {
+	if !c.strictValidation || c.warnings == nil {
+		return nil
+	}
+	var errs []error
+	for _, warning := range c.warnings.since(mark) {
+		errs = append(errs, ErrWarning{Warning: warning})
+	}
+	return xerrors.Join(errs...)
+}
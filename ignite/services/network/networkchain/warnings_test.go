@@ -0,0 +1,56 @@
+package networkchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+func TestWarnings(t *testing.T) {
+	t.Run("collect and display the warnings", func(t *testing.T) {
+		var (
+			bus = events.NewBus(events.WithCustomBufferSize(2))
+			c   = Chain{ev: bus, warnings: &warningCollector{}}
+		)
+
+		mark := c.warningMark()
+		c.warn(WarningPeerSkipped, "peer %s skipped", "foo@0.0.0.0:26656")
+		c.warn(WarningGenesisHashNotPublished, "hash not published")
+		require.NoError(t, c.checkWarnings(mark))
+		bus.Shutdown(context.Background())
+
+		require.Equal(t, []Warning{
+			{Code: WarningPeerSkipped, Message: "peer foo@0.0.0.0:26656 skipped"},
+			{Code: WarningGenesisHashNotPublished, Message: "hash not published"},
+		}, c.Warnings())
+
+		var displayed []events.Event
+		for ev := range bus.Events() {
+			displayed = append(displayed, ev)
+		}
+		require.Equal(t, []events.Event{
+			events.NewWarning("peer foo@0.0.0.0:26656 skipped"),
+			events.NewWarning("hash not published"),
+		}, displayed)
+	})
+
+	t.Run("strict validation elevates the warnings", func(t *testing.T) {
+		c := Chain{strictValidation: true, warnings: &warningCollector{}}
+
+		c.warn(WarningPeerSkipped, "peer skipped")
+		mark := c.warningMark()
+		require.NoError(t, c.checkWarnings(mark))
+
+		c.warn(WarningPeerNotVerified, "node ID not verified")
+		err := c.checkWarnings(mark)
+		require.EqualError(t, err, "strict validation: node ID not verified")
+
+		var warningErr ErrWarning
+		require.ErrorAs(t, err, &warningErr)
+		require.Equal(t, WarningPeerNotVerified, warningErr.Warning.Code)
+		require.Len(t, c.Warnings(), 2)
+	})
+}
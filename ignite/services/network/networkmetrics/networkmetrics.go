@@ -0,0 +1,67 @@
+// Package networkmetrics provides a Prometheus implementation of the network metrics recorder.
+// It is a separate package so the network service doesn't depend on Prometheus unless it is used.
+package networkmetrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ignite/cli/ignite/services/network"
+)
+
+const namespace = "ignite_network"
+
+var _ network.MetricsRecorder = Prometheus{}
+
+// Prometheus records the network metrics into Prometheus collectors.
+type Prometheus struct {
+	broadcasts      *prometheus.CounterVec
+	latency         *prometheus.HistogramVec
+	pendingRequests *prometheus.GaugeVec
+}
+
+// NewPrometheus creates a Prometheus metrics recorder and registers its collectors into the registerer.
+func NewPrometheus(registerer prometheus.Registerer) (Prometheus, error) {
+	p := Prometheus{
+		broadcasts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "broadcasts_total",
+			Help:      "Number of transactions broadcasted to SPN by operation and result.",
+		}, []string{"operation", "result"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "latency_seconds",
+			Help:      "Latency of the SPN queries and broadcasts by operation.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation", "kind"}),
+		pendingRequests: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "pending_requests",
+			Help:      "Number of pending requests observed for a chain launch.",
+		}, []string{"launch_id"}),
+	}
+
+	for _, c := range []prometheus.Collector{p.broadcasts, p.latency, p.pendingRequests} {
+		if err := registerer.Register(c); err != nil {
+			return Prometheus{}, err
+		}
+	}
+	return p, nil
+}
+
+// IncBroadcasts implements network.MetricsRecorder.
+func (p Prometheus) IncBroadcasts(operation, result string) {
+	p.broadcasts.WithLabelValues(operation, result).Inc()
+}
+
+// ObserveLatency implements network.MetricsRecorder.
+func (p Prometheus) ObserveLatency(operation, kind string, latency time.Duration) {
+	p.latency.WithLabelValues(operation, kind).Observe(latency.Seconds())
+}
+
+// SetPendingRequests implements network.MetricsRecorder.
+func (p Prometheus) SetPendingRequests(launchID uint64, count int) {
+	p.pendingRequests.WithLabelValues(strconv.FormatUint(launchID, 10)).Set(float64(count))
+}
@@ -0,0 +1,47 @@
+package networkmetrics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/services/network"
+	"github.com/ignite/cli/ignite/services/network/networkmetrics"
+)
+
+func TestPrometheus(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	p, err := networkmetrics.NewPrometheus(registry)
+	require.NoError(t, err)
+
+	p.IncBroadcasts("trigger_launch", network.MetricsResultSuccess)
+	p.IncBroadcasts("trigger_launch", network.MetricsResultSuccess)
+	p.IncBroadcasts("settle_request", network.MetricsResultFailure)
+	p.ObserveLatency("trigger_launch", network.MetricsKindBroadcast, time.Second)
+	p.SetPendingRequests(1, 5)
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(families))
+	for _, f := range families {
+		names = append(names, f.GetName())
+	}
+	require.ElementsMatch(t, []string{
+		"ignite_network_broadcasts_total",
+		"ignite_network_latency_seconds",
+		"ignite_network_pending_requests",
+	}, names)
+
+	// one series per label values
+	count, err := testutil.GatherAndCount(registry, "ignite_network_broadcasts_total")
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	// registering twice the collectors fails
+	_, err = networkmetrics.NewPrometheus(registry)
+	require.Error(t, err)
+}
@@ -26,6 +26,19 @@ type (
 		Network                NetworkType `json:"Network"`
 		Reward                 string      `json:"Reward,omitempty"`
 		AccountBalance         sdk.Coins   `json:"AccountBalance"`
+
+		// ValidatorKeyType is the consensus key type required by the chain, the default key type when empty.
+		ValidatorKeyType ValidatorKeyType `json:"ValidatorKeyType,omitempty"`
+
+		// LaunchNotice is the active notice of the coordinator, nil when no notice is published.
+		LaunchNotice *LaunchNotice `json:"LaunchNotice,omitempty"`
+
+		// Metadata is the metadata published by the coordinator, nil when the chain has no metadata.
+		Metadata *ChainMetadata `json:"Metadata,omitempty"`
+
+		// CoordinatorAddress is the address of the coordinator of the chain, it's only resolved when the
+		// coordinator signed the genesis to verify the signer of the genesis hash.
+		CoordinatorAddress string `json:"CoordinatorAddress,omitempty"`
 	}
 )
 
@@ -63,6 +76,19 @@ func ToChainLaunch(chain launchtypes.Chain) ChainLaunch {
 		AccountBalance:         chain.AccountBalance,
 	}
 
+	// the metadata is set by the coordinator, an invalid metadata is ignored
+	// and a metadata not following the schema is only exposed as raw
+	if metadata, err := ParseChainMetadata(chain.Metadata); err == nil && len(chain.Metadata) > 0 {
+		launch.Metadata = &metadata
+		launch.ValidatorKeyType = metadata.ValidatorKeyType
+		if metadata.ChainID != "" {
+			launch.ChainID = metadata.ChainID
+		}
+		if metadata.LaunchNotice.IsActive() {
+			launch.LaunchNotice = metadata.LaunchNotice
+		}
+	}
+
 	// check if custom genesis URL is provided.
 	if customGenesisURL := chain.InitialGenesis.GetGenesisURL(); customGenesisURL != nil {
 		launch.GenesisURL = customGenesisURL.Url
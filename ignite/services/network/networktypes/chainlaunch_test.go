@@ -73,3 +73,28 @@ func TestToChainLaunch(t *testing.T) {
 		})
 	}
 }
+
+func TestToChainLaunchMetadata(t *testing.T) {
+	launch := networktypes.ToChainLaunch(launchtypes.Chain{
+		Metadata: []byte(`{"version":1,"explorer_url":"https://e.io","validator_key_type":"bls"}`),
+	})
+	require.Equal(t, networktypes.ValidatorKeyBLS, launch.ValidatorKeyType)
+	require.NotNil(t, launch.Metadata)
+	require.Equal(t, "https://e.io", launch.Metadata.ExplorerURL)
+
+	// the chain ID of the metadata replaces the genesis chain ID once its epoch is bumped
+	launch = networktypes.ToChainLaunch(launchtypes.Chain{
+		GenesisChainID: "mars-1",
+		Metadata:       []byte(`{"version":1,"chain_id":"mars-2"}`),
+	})
+	require.Equal(t, "mars-2", launch.ChainID)
+
+	// the metadata published by other tools is exposed as raw
+	launch = networktypes.ToChainLaunch(launchtypes.Chain{Metadata: []byte("mars testnet")})
+	require.True(t, launch.ValidatorKeyType.IsDefault())
+	require.True(t, launch.Metadata.IsLegacy())
+	require.Equal(t, "mars testnet", string(launch.Metadata.Raw()))
+
+	launch = networktypes.ToChainLaunch(launchtypes.Chain{})
+	require.Nil(t, launch.Metadata)
+}
@@ -0,0 +1,388 @@
+package networktypes
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	spntypes "github.com/tendermint/spn/pkg/types"
+)
+
+// ChainMetadataVersion is the version of the chain metadata schema.
+// It must be incremented when the metadata changes in a non backward compatible way.
+const ChainMetadataVersion = 1
+
+type (
+	// ChainMetadata is the metadata of a chain published on SPN.
+	// The keys unknown to this version are preserved when the metadata is updated, a metadata that doesn't
+	// follow the schema, published by other tools or former versions, is exposed as raw.
+	ChainMetadata struct {
+		// Version is the version of the schema, the metadata published before the schema have no version.
+		Version int `json:"version,omitempty"`
+
+		// CLIVersion is the version of Ignite CLI that published the chain.
+		CLIVersion string `json:"cli_version,omitempty"`
+
+		Genesis     *GenesisMetadata `json:"genesis,omitempty"`
+		FaucetURL   string           `json:"faucet_url,omitempty"`
+		ExplorerURL string           `json:"explorer_url,omitempty"`
+
+		// Binaries contains the prebuilt binaries of the chain by platform (e.g. linux/amd64).
+		Binaries map[string]BinaryArtifact `json:"binaries,omitempty"`
+
+		// BinaryName is the name of the chain binary when it differs from the name guessed from the repository.
+		BinaryName string `json:"binary_name,omitempty"`
+
+		// DefaultHome is the default home of the chain binary when it differs from the home guessed from the repository.
+		DefaultHome string `json:"default_home,omitempty"`
+
+		// ValidatorKeyType is the consensus key type required by the chain, the default key type is used when empty.
+		ValidatorKeyType ValidatorKeyType `json:"validator_key_type,omitempty"`
+
+		// ChainID is the chain ID of the launch when it differs from the genesis chain ID registered on SPN,
+		// it's set when the epoch of the chain ID is bumped after a launch is reverted.
+		ChainID string `json:"chain_id,omitempty"`
+
+		// LaunchNotice is the notice of the coordinator to the validators, nil when no notice is published.
+		LaunchNotice *LaunchNotice `json:"launch_notice,omitempty"`
+
+		// RequestDeadline is the time the requests must be sent by, nil when the coordinator set no deadline.
+		RequestDeadline *time.Time `json:"request_deadline,omitempty"`
+
+		unknown map[string]json.RawMessage
+		raw     []byte
+	}
+
+	// GenesisMetadata describes the genesis of the chain.
+	GenesisMetadata struct {
+		URL  string `json:"url,omitempty"`
+		Hash string `json:"hash,omitempty"`
+
+		// MirrorList is the URL of the list of the mirrors serving the same genesis, the list doesn't fit
+		// in the chain metadata on SPN, see ParseGenesisMirrorList.
+		MirrorList string `json:"mirror_list,omitempty"`
+
+		// Signed is true when the coordinator published the detached signature of the genesis hash
+		// next to the genesis, see GenesisSignatureURL.
+		Signed bool `json:"signed,omitempty"`
+	}
+
+	// BinaryArtifact is a prebuilt binary of the chain.
+	BinaryArtifact struct {
+		URL string `json:"url"`
+
+		// Hash is the sha256 hash of the binary.
+		Hash string `json:"hash"`
+	}
+
+	// chainMetadataFields is the schema of the metadata without the custom JSON encoding.
+	chainMetadataFields ChainMetadata
+
+	// ChainMetadataKeySize is the size of a key of the metadata in its JSON encoding.
+	ChainMetadataKeySize struct {
+		Key  string
+		Size int
+	}
+)
+
+// ErrChainMetadataTooLarge is returned when the metadata doesn't fit in the maximum metadata size of SPN,
+// even without its informational keys.
+type ErrChainMetadataTooLarge struct {
+	Size int
+	Max  int
+
+	// Keys are the sizes of the keys of the metadata, the largest key first.
+	Keys []ChainMetadataKeySize
+}
+
+// Error implements error.
+func (e ErrChainMetadataTooLarge) Error() string {
+	keys := make([]string, len(e.Keys))
+	for i, k := range e.Keys {
+		keys[i] = fmt.Sprintf("%s (%d bytes)", k.Key, k.Size)
+	}
+	return fmt.Sprintf(
+		"invalid chain metadata: the metadata size is %d bytes, the maximum size on SPN is %d bytes: %s",
+		e.Size,
+		e.Max,
+		strings.Join(keys, ", "),
+	)
+}
+
+// NewChainMetadata returns an empty metadata with the current schema version.
+func NewChainMetadata(cliVersion string) ChainMetadata {
+	return ChainMetadata{
+		Version:    ChainMetadataVersion,
+		CLIVersion: cliVersion,
+	}
+}
+
+// ParseChainMetadata parses the metadata of a chain, an empty metadata is valid.
+// A metadata that is not a JSON object following the schema is returned as a legacy metadata with its raw content.
+func ParseChainMetadata(metadata []byte) (ChainMetadata, error) {
+	var m ChainMetadata
+	if len(bytes.TrimSpace(metadata)) == 0 {
+		return m, nil
+	}
+	if err := json.Unmarshal(metadata, &m); err != nil {
+		return ChainMetadata{raw: metadata}, nil
+	}
+	if m.ValidatorKeyType != "" {
+		t, err := ParseValidatorKeyType(string(m.ValidatorKeyType))
+		if err != nil {
+			return m, fmt.Errorf("invalid chain metadata: %w", err)
+		}
+		m.ValidatorKeyType = t
+	}
+	return m, nil
+}
+
+// SetChainID returns the chain metadata with the chain ID of the launch.
+// The other keys of the metadata are preserved, including the keys unknown to this version.
+func SetChainID(metadata []byte, chainID string) ([]byte, error) {
+	var m ChainMetadata
+	if len(metadata) > 0 {
+		if err := json.Unmarshal(metadata, &m); err != nil {
+			return nil, fmt.Errorf("invalid chain metadata: %w", err)
+		}
+	}
+
+	m.ChainID = chainID
+	return m.Bytes()
+}
+
+// IsLegacy checks if the metadata doesn't follow the schema, its content is then only available with Raw.
+func (m ChainMetadata) IsLegacy() bool {
+	return m.raw != nil
+}
+
+// Raw returns the content of a legacy metadata.
+func (m ChainMetadata) Raw() []byte {
+	return m.raw
+}
+
+// Bytes validates the metadata and returns its content to publish on SPN.
+// When the metadata doesn't fit in the maximum size of SPN, its informational keys are dropped by
+// priority, see chainMetadataDroppableKeys. A legacy metadata is returned without changes.
+func (m ChainMetadata) Bytes() ([]byte, error) {
+	if m.IsLegacy() {
+		return m.raw, nil
+	}
+	if err := m.validateContent(); err != nil {
+		return nil, err
+	}
+	return m.fit()
+}
+
+// Validate checks the metadata can be published on SPN once its informational keys are dropped.
+func (m ChainMetadata) Validate() error {
+	if err := m.validateContent(); err != nil {
+		return err
+	}
+	_, err := m.fit()
+	return err
+}
+
+// validateContent checks the values of the metadata.
+func (m ChainMetadata) validateContent() error {
+	if m.IsLegacy() {
+		return fmt.Errorf("invalid chain metadata: legacy metadata can't be validated")
+	}
+	if m.Version < 0 {
+		return fmt.Errorf("invalid chain metadata: negative version %d", m.Version)
+	}
+	if err := validateMetadataURL("faucet", m.FaucetURL); err != nil {
+		return err
+	}
+	if err := validateMetadataURL("explorer", m.ExplorerURL); err != nil {
+		return err
+	}
+	if m.Genesis != nil {
+		if err := validateMetadataURL("genesis", m.Genesis.URL); err != nil {
+			return err
+		}
+		if err := validateMetadataHash("genesis", m.Genesis.Hash); err != nil {
+			return err
+		}
+		if err := validateMetadataURL("genesis mirror list", m.Genesis.MirrorList); err != nil {
+			return err
+		}
+	}
+
+	platforms := make([]string, 0, len(m.Binaries))
+	for platform := range m.Binaries {
+		platforms = append(platforms, platform)
+	}
+	sort.Strings(platforms)
+	for _, platform := range platforms {
+		binary := m.Binaries[platform]
+		name := fmt.Sprintf("%s binary", platform)
+		if binary.URL == "" {
+			return fmt.Errorf("invalid chain metadata: the %s has no URL", name)
+		}
+		if err := validateMetadataURL(name, binary.URL); err != nil {
+			return err
+		}
+		if err := validateMetadataHash(name, binary.Hash); err != nil {
+			return err
+		}
+	}
+
+	if m.BinaryName != "" {
+		if err := ValidateBinaryName(m.BinaryName); err != nil {
+			return fmt.Errorf("invalid chain metadata: %w", err)
+		}
+	}
+
+	if m.ValidatorKeyType != "" {
+		if _, err := ParseValidatorKeyType(string(m.ValidatorKeyType)); err != nil {
+			return fmt.Errorf("invalid chain metadata: %w", err)
+		}
+	}
+
+	if m.ChainID != "" {
+		if err := ValidateChainID(m.ChainID); err != nil {
+			return fmt.Errorf("invalid chain metadata: %w", err)
+		}
+	}
+	return nil
+}
+
+// fit returns the encoded metadata within the maximum metadata size of SPN. The droppable keys are removed
+// one by one until the metadata fits, ErrChainMetadataTooLarge is returned when it still doesn't fit.
+func (m ChainMetadata) fit() ([]byte, error) {
+	bz, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	for _, drop := range chainMetadataDroppableKeys {
+		if len(bz) <= spntypes.MaxMetadataLength {
+			return bz, nil
+		}
+		drop(&m)
+		if bz, err = json.Marshal(m); err != nil {
+			return nil, err
+		}
+	}
+	if len(bz) <= spntypes.MaxMetadataLength {
+		return bz, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+	keys := make([]ChainMetadataKeySize, 0, len(fields))
+	for key, value := range fields {
+		keys = append(keys, ChainMetadataKeySize{Key: key, Size: len(key) + len(value) + 3})
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Size != keys[j].Size {
+			return keys[i].Size > keys[j].Size
+		}
+		return keys[i].Key < keys[j].Key
+	})
+	return nil, ErrChainMetadataTooLarge{
+		Size: len(bz),
+		Max:  spntypes.MaxMetadataLength,
+		Keys: keys,
+	}
+}
+
+// chainMetadataDroppableKeys remove the informational keys of the metadata, the lowest priority first.
+// The chain is joined and launched without them, the other keys, including the keys unknown to this
+// version, are never dropped.
+var chainMetadataDroppableKeys = []func(*ChainMetadata){
+	func(m *ChainMetadata) { m.CLIVersion = "" },
+	func(m *ChainMetadata) { m.ExplorerURL = "" },
+	func(m *ChainMetadata) { m.FaucetURL = "" },
+}
+
+// MarshalJSON implements json.Marshaler, the unknown keys are preserved.
+// A legacy metadata is encoded as a string with its raw content.
+func (m ChainMetadata) MarshalJSON() ([]byte, error) {
+	if m.IsLegacy() {
+		return json.Marshal(string(m.raw))
+	}
+
+	bz, err := json.Marshal(chainMetadataFields(m))
+	if err != nil || len(m.unknown) == 0 {
+		return bz, err
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+	for key, value := range m.unknown {
+		if _, ok := fields[key]; !ok {
+			fields[key] = value
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, the unknown keys are kept to be preserved on updates.
+func (m *ChainMetadata) UnmarshalJSON(bz []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return err
+	}
+	var known chainMetadataFields
+	if err := json.Unmarshal(bz, &known); err != nil {
+		return err
+	}
+
+	*m = ChainMetadata(known)
+	for _, key := range chainMetadataKeys {
+		delete(fields, key)
+	}
+	if len(fields) > 0 {
+		m.unknown = fields
+	}
+	return nil
+}
+
+// chainMetadataKeys are the keys of the metadata schema.
+var chainMetadataKeys = []string{
+	"version",
+	"cli_version",
+	"genesis",
+	"faucet_url",
+	"explorer_url",
+	"binaries",
+	"binary_name",
+	"default_home",
+	"validator_key_type",
+	"chain_id",
+	chainMetadataLaunchNotice,
+	chainMetadataRequestDeadline,
+}
+
+// validateMetadataURL checks the URL of the metadata is an absolute http URL, an empty URL is valid.
+func validateMetadataURL(name, rawURL string) error {
+	if rawURL == "" {
+		return nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid chain metadata: the %s URL %q is not an http or https URL", name, rawURL)
+	}
+	return nil
+}
+
+// validateMetadataHash checks the hash of the metadata is a sha256 hex hash, an empty hash is valid.
+func validateMetadataHash(name, hash string) error {
+	if hash == "" {
+		return nil
+	}
+	if bz, err := hex.DecodeString(hash); err != nil || len(bz) != 32 {
+		return fmt.Errorf("invalid chain metadata: the %s hash %q is not a sha256 hex hash", name, hash)
+	}
+	return nil
+}
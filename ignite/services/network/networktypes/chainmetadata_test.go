@@ -0,0 +1,265 @@
+package networktypes_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+func TestChainMetadataRoundTrip(t *testing.T) {
+	metadata := networktypes.NewChainMetadata("v0.24.0")
+	metadata.FaucetURL = "https://f.io"
+	metadata.ValidatorKeyType = networktypes.ValidatorKeyBLS
+
+	bz, err := metadata.Bytes()
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"version":1,
+		"cli_version":"v0.24.0",
+		"faucet_url":"https://f.io",
+		"validator_key_type":"bls12_381"
+	}`, string(bz))
+
+	parsed, err := networktypes.ParseChainMetadata(bz)
+	require.NoError(t, err)
+	require.False(t, parsed.IsLegacy())
+	require.Equal(t, metadata, parsed)
+}
+
+func TestChainMetadataUnknownKeys(t *testing.T) {
+	// the keys of a newer schema version are preserved when the metadata is updated
+	parsed, err := networktypes.ParseChainMetadata([]byte(`{"version":2,"rpc":["a","b"],"faucet_url":"https://f.io"}`))
+	require.NoError(t, err)
+	require.Equal(t, 2, parsed.Version)
+	require.Equal(t, "https://f.io", parsed.FaucetURL)
+
+	parsed.FaucetURL = ""
+	parsed.ExplorerURL = "https://e.io"
+	bz, err := parsed.Bytes()
+	require.NoError(t, err)
+	require.JSONEq(t, `{"version":2,"rpc":["a","b"],"explorer_url":"https://e.io"}`, string(bz))
+}
+
+func TestChainMetadataLegacy(t *testing.T) {
+	for _, raw := range []string{
+		`campaign of the mars chain`,
+		`["mars"]`,
+		`{"faucet_url":42}`,
+	} {
+		t.Run(raw, func(t *testing.T) {
+			parsed, err := networktypes.ParseChainMetadata([]byte(raw))
+			require.NoError(t, err)
+			require.True(t, parsed.IsLegacy())
+			require.Equal(t, raw, string(parsed.Raw()))
+
+			// a legacy metadata is published without changes
+			bz, err := parsed.Bytes()
+			require.NoError(t, err)
+			require.Equal(t, raw, string(bz))
+
+			// and displayed as a string
+			bz, err = json.Marshal(parsed)
+			require.NoError(t, err)
+			var s string
+			require.NoError(t, json.Unmarshal(bz, &s))
+			require.Equal(t, raw, s)
+		})
+	}
+
+	parsed, err := networktypes.ParseChainMetadata([]byte(" "))
+	require.NoError(t, err)
+	require.False(t, parsed.IsLegacy())
+}
+
+func TestChainMetadataValidate(t *testing.T) {
+	const hash = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+	tests := []struct {
+		name     string
+		metadata networktypes.ChainMetadata
+		err      string
+	}{
+		{
+			name:     "valid metadata",
+			metadata: networktypes.ChainMetadata{Genesis: &networktypes.GenesisMetadata{Hash: hash}},
+		},
+		{
+			name:     "invalid faucet URL",
+			metadata: networktypes.ChainMetadata{FaucetURL: "faucet.mars"},
+			err:      `invalid chain metadata: the faucet URL "faucet.mars" is not an http or https URL`,
+		},
+		{
+			name:     "invalid explorer URL",
+			metadata: networktypes.ChainMetadata{ExplorerURL: "ftp://explorer.mars"},
+			err:      `invalid chain metadata: the explorer URL "ftp://explorer.mars" is not an http or https URL`,
+		},
+		{
+			name:     "genesis mirror list",
+			metadata: networktypes.ChainMetadata{Genesis: &networktypes.GenesisMetadata{MirrorList: "https://mars.io/mirrors"}},
+		},
+		{
+			name:     "invalid genesis mirror list",
+			metadata: networktypes.ChainMetadata{Genesis: &networktypes.GenesisMetadata{MirrorList: "mars.io/mirrors"}},
+			err:      `invalid chain metadata: the genesis mirror list URL "mars.io/mirrors" is not an http or https URL`,
+		},
+		{
+			name:     "invalid genesis hash",
+			metadata: networktypes.ChainMetadata{Genesis: &networktypes.GenesisMetadata{Hash: "abcd"}},
+			err:      `invalid chain metadata: the genesis hash "abcd" is not a sha256 hex hash`,
+		},
+		{
+			name: "binary without URL",
+			metadata: networktypes.ChainMetadata{Binaries: map[string]networktypes.BinaryArtifact{
+				"linux/amd64": {Hash: hash},
+			}},
+			err: "invalid chain metadata: the linux/amd64 binary has no URL",
+		},
+		{
+			name:     "binary name and default home",
+			metadata: networktypes.ChainMetadata{BinaryName: "appd", DefaultHome: "$HOME/.app"},
+		},
+		{
+			name:     "binary name with a path",
+			metadata: networktypes.ChainMetadata{BinaryName: "bin/appd"},
+			err: `invalid chain metadata: binary name "bin/appd" contains the invalid character '/' at position 3, ` +
+				`only letters, digits, '.', '_' and '-' are allowed`,
+		},
+		{
+			name:     "invalid validator key type",
+			metadata: networktypes.ChainMetadata{ValidatorKeyType: "rsa"},
+			err:      "invalid chain metadata: unknown validator key type rsa, expected ed25519, secp256k1 or bls12_381",
+		},
+		{
+			name:     "chain ID",
+			metadata: networktypes.ChainMetadata{ChainID: "mars-2"},
+		},
+		{
+			name:     "invalid chain ID",
+			metadata: networktypes.ChainMetadata{ChainID: "mars-01"},
+			err:      "invalid chain metadata: chain ID mars-01 has an invalid epoch 01, the epoch must be an integer without leading zeros",
+		},
+		{
+			name:     "informational keys dropped to fit",
+			metadata: networktypes.ChainMetadata{FaucetURL: "https://faucet.mars/" + strings.Repeat("a", 100)},
+		},
+		{
+			name:     "metadata too large",
+			metadata: networktypes.ChainMetadata{DefaultHome: "$HOME/" + strings.Repeat("a", 100)},
+			err: "invalid chain metadata: the metadata size is 125 bytes, the maximum size on SPN is 100 bytes: " +
+				"default_home (123 bytes)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.metadata.Validate()
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSetChainID(t *testing.T) {
+	metadata, err := networktypes.SetChainID([]byte(`{"x":1,"explorer_url":"https://e.io"}`), "mars-2")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"x":1,"explorer_url":"https://e.io","chain_id":"mars-2"}`, string(metadata))
+
+	metadata, err = networktypes.SetChainID(nil, "mars-2")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"chain_id":"mars-2"}`, string(metadata))
+
+	_, err = networktypes.SetChainID([]byte("mars testnet"), "mars-2")
+	require.Error(t, err)
+}
+
+func TestChainMetadataSizeBudget(t *testing.T) {
+	var (
+		hash     = strings.Repeat("a", 64)
+		deadline = time.Date(2022, time.May, 6, 17, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name     string
+		metadata networktypes.ChainMetadata
+		want     string
+		err      string
+	}{
+		{
+			name: "notice and deadline",
+			metadata: networktypes.ChainMetadata{
+				LaunchNotice:    &networktypes.LaunchNotice{Message: "wait", Blocking: true},
+				RequestDeadline: &deadline,
+			},
+			want: `{
+				"launch_notice":{"message":"wait","blocking":true},
+				"request_deadline":"2022-05-06T17:00:00Z"
+			}`,
+		},
+		{
+			name: "versioned notice and deadline",
+			metadata: networktypes.ChainMetadata{
+				Version:         networktypes.ChainMetadataVersion,
+				CLIVersion:      "v0.25.0",
+				LaunchNotice:    &networktypes.LaunchNotice{Message: "wait", Blocking: true},
+				RequestDeadline: &deadline,
+			},
+			err: "invalid chain metadata: the metadata size is 106 bytes, the maximum size on SPN is 100 bytes: " +
+				"launch_notice (50 bytes), request_deadline (41 bytes), version (11 bytes)",
+		},
+		{
+			name: "informational keys dropped by priority",
+			metadata: networktypes.ChainMetadata{
+				Version:         networktypes.ChainMetadataVersion,
+				CLIVersion:      "v0.25.0",
+				FaucetURL:       "https://f.io",
+				ExplorerURL:     "https://e.io",
+				RequestDeadline: &deadline,
+			},
+			want: `{
+				"version":1,
+				"faucet_url":"https://f.io",
+				"request_deadline":"2022-05-06T17:00:00Z"
+			}`,
+		},
+		{
+			name: "unknown keys are never dropped",
+			metadata: func() networktypes.ChainMetadata {
+				m, err := networktypes.ParseChainMetadata([]byte(`{"rpc":"https://rpc.mars.io","faucet_url":"https://f.io"}`))
+				require.NoError(t, err)
+				m.LaunchNotice = &networktypes.LaunchNotice{Message: "hold off until the upgrade"}
+				return m
+			}(),
+			want: `{"rpc":"https://rpc.mars.io","launch_notice":{"message":"hold off until the upgrade"}}`,
+		},
+		{
+			name: "genesis and notice",
+			metadata: networktypes.ChainMetadata{
+				Genesis:      &networktypes.GenesisMetadata{Hash: hash},
+				LaunchNotice: &networktypes.LaunchNotice{Message: "hold off"},
+			},
+			err: "invalid chain metadata: the metadata size is 126 bytes, the maximum size on SPN is 100 bytes: " +
+				"genesis (85 bytes), launch_notice (38 bytes)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bz, err := tt.metadata.Bytes()
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				var errTooLarge networktypes.ErrChainMetadataTooLarge
+				require.ErrorAs(t, err, &errTooLarge)
+				return
+			}
+			require.NoError(t, err)
+			require.JSONEq(t, tt.want, string(bz))
+			require.LessOrEqual(t, len(bz), 100)
+		})
+	}
+}
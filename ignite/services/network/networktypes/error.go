@@ -2,10 +2,15 @@ package networktypes
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 )
 
+// ErrGentxWithoutMemo is returned when the node ID of an add validator request can't be verified
+// since its gentx has no memo
+var ErrGentxWithoutMemo = errors.New("the gentx has no memo with the node ID of the validator")
+
 // ErrInvalidRequest is an error returned in methods manipulating requests when they are invalid
 type ErrInvalidRequest struct {
 	requestID uint64
@@ -20,3 +25,17 @@ func (err ErrInvalidRequest) Error() string {
 func NewWrappedErrInvalidRequest(requestID uint64, message string) error {
 	return errors.Wrap(ErrInvalidRequest{requestID: requestID}, message)
 }
+
+// ErrConflictingRequests is an error returned when requests declare the same validator identity
+type ErrConflictingRequests struct {
+	Conflicts []RequestConflict
+}
+
+// Error implements error
+func (err ErrConflictingRequests) Error() string {
+	conflicts := make([]string, len(err.Conflicts))
+	for i, c := range err.Conflicts {
+		conflicts[i] = c.String()
+	}
+	return fmt.Sprintf("conflicting requests: %s", strings.Join(conflicts, ", "))
+}
@@ -0,0 +1,128 @@
+package networktypes
+
+import (
+	"math/big"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+)
+
+// sharePercentPrecision is the number of decimals of the share percentages.
+const sharePercentPrecision = 4
+
+type (
+	// DenomUnits maps the base denoms to the unit displayed to the users.
+	DenomUnits map[string]DenomUnit
+
+	// DenomUnit is the display unit of a base denom, an amount of the base denom is divided by 10^Exponent.
+	DenomUnit struct {
+		Display  string
+		Exponent uint32
+	}
+)
+
+// NewDenomUnits returns the display units of the denoms metadata.
+// The metadata without a display unit or with a zero exponent display unit are ignored.
+func NewDenomUnits(metadata ...banktypes.Metadata) DenomUnits {
+	units := make(DenomUnits)
+	for _, m := range metadata {
+		for _, unit := range m.DenomUnits {
+			if unit.Denom == m.Display && unit.Exponent > 0 {
+				units[m.Base] = DenomUnit{
+					Display:  m.Display,
+					Exponent: unit.Exponent,
+				}
+			}
+		}
+	}
+	return units
+}
+
+// FormatAmount formats the amount with thousands separators: 2000000 is formatted as 2,000,000.
+func FormatAmount(amount sdkmath.Int) string {
+	s := amount.String()
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	return sign + groupThousands(s)
+}
+
+// FormatCoin formats the coin in the display unit of its denom: 2000000000000uatom is formatted
+// as 2,000,000 atom when the exponent of atom is 6. The coin is formatted raw when the denom has no display unit.
+func FormatCoin(coin sdk.Coin, units DenomUnits) string {
+	unit, ok := units[coin.Denom]
+	if !ok || coin.Amount.IsNil() {
+		return coin.String()
+	}
+
+	amount := coin.Amount.BigInt()
+	sign := ""
+	if amount.Sign() < 0 {
+		sign = "-"
+		amount = new(big.Int).Neg(amount)
+	}
+
+	digits := amount.String()
+	exponent := int(unit.Exponent)
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+	integer, fractional := digits[:len(digits)-exponent], strings.TrimRight(digits[len(digits)-exponent:], "0")
+
+	formatted := sign + groupThousands(integer)
+	if fractional != "" {
+		formatted += "." + fractional
+	}
+	return formatted + " " + unit.Display
+}
+
+// FormatCoins formats the coins in the display unit of their denoms, the coins are separated by commas.
+func FormatCoins(coins sdk.Coins, units DenomUnits) string {
+	formatted := make([]string, len(coins))
+	for i, coin := range coins {
+		formatted[i] = FormatCoin(coin, units)
+	}
+	return strings.Join(formatted, ", ")
+}
+
+// FormatShares formats the shares as percentages of the total shares: 12500s/foo is formatted as 12.5% foo
+// when the total shares are 100000. The shares are formatted raw when the total shares are zero.
+func FormatShares(shares campaigntypes.Shares, totalShares uint64) string {
+	if totalShares == 0 {
+		return shares.String()
+	}
+
+	total := new(big.Int).SetUint64(totalShares)
+	formatted := make([]string, len(shares))
+	for i, share := range shares {
+		percent := new(big.Rat).SetFrac(new(big.Int).Mul(share.Amount.BigInt(), big.NewInt(100)), total)
+		value := percent.FloatString(sharePercentPrecision)
+		value = strings.TrimRight(strings.TrimRight(value, "0"), ".")
+		formatted[i] = value + "% " + strings.TrimPrefix(share.Denom, campaigntypes.SharePrefix)
+	}
+	return strings.Join(formatted, ", ")
+}
+
+// groupThousands inserts a comma every three digits from the right of the digits.
+func groupThousands(digits string) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+
+	var b strings.Builder
+	head := len(digits) % 3
+	if head > 0 {
+		b.WriteString(digits[:head])
+	}
+	for i := head; i < len(digits); i += 3 {
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(digits[i : i+3])
+	}
+	return b.String()
+}
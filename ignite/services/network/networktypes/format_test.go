@@ -0,0 +1,172 @@
+package networktypes_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+var atomMetadata = banktypes.Metadata{
+	Base:    "uatom",
+	Display: "atom",
+	DenomUnits: []*banktypes.DenomUnit{
+		{Denom: "uatom", Exponent: 0},
+		{Denom: "matom", Exponent: 3},
+		{Denom: "atom", Exponent: 6},
+	},
+}
+
+func TestNewDenomUnits(t *testing.T) {
+	units := networktypes.NewDenomUnits(
+		atomMetadata,
+		banktypes.Metadata{
+			Base:       "stake",
+			Display:    "stake",
+			DenomUnits: []*banktypes.DenomUnit{{Denom: "stake", Exponent: 0}},
+		},
+		banktypes.Metadata{Base: "foo"},
+	)
+	require.Equal(t, networktypes.DenomUnits{
+		"uatom": {Display: "atom", Exponent: 6},
+	}, units)
+}
+
+func TestFormatAmount(t *testing.T) {
+	tests := []struct {
+		amount int64
+		want   string
+	}{
+		{amount: 0, want: "0"},
+		{amount: 999, want: "999"},
+		{amount: 1000, want: "1,000"},
+		{amount: 123456, want: "123,456"},
+		{amount: 1234567, want: "1,234,567"},
+		{amount: -1234567, want: "-1,234,567"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			require.Equal(t, tt.want, networktypes.FormatAmount(sdkmath.NewInt(tt.amount)))
+		})
+	}
+}
+
+func TestFormatCoin(t *testing.T) {
+	units := networktypes.NewDenomUnits(atomMetadata)
+
+	tests := []struct {
+		name string
+		coin sdk.Coin
+		want string
+	}{
+		{
+			name: "display unit",
+			coin: sdk.NewInt64Coin("uatom", 2000000000000),
+			want: "2,000,000 atom",
+		},
+		{
+			name: "fractional amount",
+			coin: sdk.NewInt64Coin("uatom", 1500000),
+			want: "1.5 atom",
+		},
+		{
+			name: "amount lower than the display unit",
+			coin: sdk.NewInt64Coin("uatom", 42),
+			want: "0.000042 atom",
+		},
+		{
+			name: "zero amount",
+			coin: sdk.NewInt64Coin("uatom", 0),
+			want: "0 atom",
+		},
+		{
+			name: "denom without metadata",
+			coin: sdk.NewInt64Coin("stake", 2000000000000),
+			want: "2000000000000stake",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, networktypes.FormatCoin(tt.coin, units))
+		})
+	}
+}
+
+func TestFormatCoins(t *testing.T) {
+	units := networktypes.NewDenomUnits(atomMetadata)
+
+	tests := []struct {
+		name  string
+		coins sdk.Coins
+		want  string
+	}{
+		{
+			name: "no coins",
+			want: "",
+		},
+		{
+			name: "coins with and without metadata",
+			coins: sdk.NewCoins(
+				sdk.NewInt64Coin("uatom", 1234567890),
+				sdk.NewInt64Coin("stake", 1000),
+			),
+			want: "1000stake, 1,234.56789 atom",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, networktypes.FormatCoins(tt.coins, units))
+		})
+	}
+}
+
+func TestFormatShares(t *testing.T) {
+	tests := []struct {
+		name        string
+		shares      string
+		totalShares uint64
+		want        string
+	}{
+		{
+			name:        "whole percentage",
+			shares:      "50000foo",
+			totalShares: 100000,
+			want:        "50% foo",
+		},
+		{
+			name:        "fractional percentage",
+			shares:      "12500foo",
+			totalShares: 100000,
+			want:        "12.5% foo",
+		},
+		{
+			name:        "rounded percentage",
+			shares:      "1foo",
+			totalShares: 3,
+			want:        "33.3333% foo",
+		},
+		{
+			name:        "several shares",
+			shares:      "1000bar,100000foo",
+			totalShares: 100000,
+			want:        "1% bar, 100% foo",
+		},
+		{
+			name:   "no total shares",
+			shares: "1000foo",
+			want:   "1000s/foo",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shares, err := campaigntypes.NewShares(tt.shares)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, networktypes.FormatShares(shares, tt.totalShares))
+		})
+	}
+}
@@ -17,6 +17,9 @@ type GenesisInformation struct {
 	GenesisAccounts   []GenesisAccount
 	VestingAccounts   []VestingAccount
 	GenesisValidators []GenesisValidator
+
+	// Height is the SPN block height the information was queried at, zero means the latest height
+	Height int64
 }
 
 // GenesisAccount represents an account with initial coin allocation for the chain for the chain genesis
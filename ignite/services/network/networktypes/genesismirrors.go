@@ -0,0 +1,21 @@
+package networktypes
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ParseGenesisMirrorList parses the list of the mirrors of a genesis published at the URL of the mirror list
+// of the genesis metadata, the list is a JSON array of the URLs of the mirrors ordered by preference.
+func ParseGenesisMirrorList(list []byte) ([]string, error) {
+	var mirrors []string
+	if err := json.Unmarshal(list, &mirrors); err != nil {
+		return nil, fmt.Errorf("invalid genesis mirror list: %w", err)
+	}
+	for _, mirror := range mirrors {
+		if err := validateMetadataURL("genesis mirror", mirror); err != nil {
+			return nil, fmt.Errorf("invalid genesis mirror list: the mirror URL %q is not an http or https URL", mirror)
+		}
+	}
+	return mirrors, nil
+}
@@ -0,0 +1,21 @@
+package networktypes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+func TestParseGenesisMirrorList(t *testing.T) {
+	mirrors, err := networktypes.ParseGenesisMirrorList([]byte(`["https://a.mars.io/genesis.json","https://b.mars.io/genesis.json"]`))
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://a.mars.io/genesis.json", "https://b.mars.io/genesis.json"}, mirrors)
+
+	_, err = networktypes.ParseGenesisMirrorList([]byte(`{"mirrors":[]}`))
+	require.ErrorContains(t, err, "invalid genesis mirror list")
+
+	_, err = networktypes.ParseGenesisMirrorList([]byte(`["ftp://a.mars.io/genesis.json"]`))
+	require.EqualError(t, err, `invalid genesis mirror list: the mirror URL "ftp://a.mars.io/genesis.json" is not an http or https URL`)
+}
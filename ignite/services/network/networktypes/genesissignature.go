@@ -0,0 +1,109 @@
+package networktypes
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisSignatureSuffix is appended to the URL of the genesis to get the URL of its detached signature.
+const GenesisSignatureSuffix = ".sig"
+
+// ErrInvalidGenesisSignature is returned when the signature of the genesis hash doesn't match the hash.
+var ErrInvalidGenesisSignature = errors.New("invalid genesis hash signature")
+
+// ErrGenesisSigner is returned when the genesis hash is not signed by the coordinator of the chain.
+type ErrGenesisSigner struct {
+	Signer      string
+	Coordinator string
+}
+
+// Error implements error.
+func (e ErrGenesisSigner) Error() string {
+	return fmt.Sprintf(
+		"the genesis hash is signed by %s, not by the coordinator of the chain %s",
+		e.Signer,
+		e.Coordinator,
+	)
+}
+
+// GenesisSignature is the detached signature of the genesis hash by the coordinator. The signature doesn't fit
+// in the chain metadata on SPN, it's published next to the genesis at GenesisSignatureURL and the metadata only
+// marks the genesis as signed.
+type GenesisSignature struct {
+	Hash string `json:"hash"`
+
+	// Signature is the signature of GenesisHashSignBytes.
+	Signature []byte `json:"signature"`
+
+	// PubKey is the secp256k1 public key of the coordinator that signed the genesis hash.
+	PubKey []byte `json:"pub_key"`
+}
+
+// GenesisSignatureURL returns the URL of the detached signature of the genesis at the URL.
+func GenesisSignatureURL(genesisURL string) string {
+	return genesisURL + GenesisSignatureSuffix
+}
+
+// GenesisHashSignBytes returns the bytes signed by the coordinator for the hash of the genesis.
+func GenesisHashSignBytes(hash string) []byte {
+	return []byte("ignite-genesis-hash:" + hash)
+}
+
+// ParseGenesisSignature parses a detached genesis signature.
+func ParseGenesisSignature(signature []byte) (GenesisSignature, error) {
+	var s GenesisSignature
+	if err := json.Unmarshal(signature, &s); err != nil {
+		return GenesisSignature{}, fmt.Errorf("%w: %s", ErrInvalidGenesisSignature, err)
+	}
+	return s, nil
+}
+
+// Verify verifies the signature of the genesis hash is made by the coordinator address.
+func (s GenesisSignature) Verify(hash, coordinator string) error {
+	if len(s.Signature) == 0 {
+		return fmt.Errorf("%w: the signature is empty", ErrInvalidGenesisSignature)
+	}
+	if len(s.PubKey) != secp256k1.PubKeySize {
+		return fmt.Errorf("%w: the public key must be a %d bytes secp256k1 key", ErrInvalidGenesisSignature, secp256k1.PubKeySize)
+	}
+	if s.Hash != hash {
+		return fmt.Errorf("%w: the signed hash %s is not the genesis hash %s", ErrInvalidGenesisSignature, s.Hash, hash)
+	}
+
+	pubKey := &secp256k1.PubKey{Key: s.PubKey}
+	if !pubKey.VerifySignature(GenesisHashSignBytes(hash), s.Signature) {
+		return ErrInvalidGenesisSignature
+	}
+
+	signer, err := sdk.Bech32ifyAddressBytes(SPN, pubKey.Address())
+	if err != nil {
+		return err
+	}
+	if signer != coordinator {
+		return ErrGenesisSigner{Signer: signer, Coordinator: coordinator}
+	}
+	return nil
+}
+
+// IsSigned checks if the coordinator published a detached signature of the genesis hash.
+func (g *GenesisMetadata) IsSigned() bool {
+	return g != nil && g.Signed
+}
+
+// SetGenesisMetadata returns the chain metadata with the genesis metadata.
+// The other keys of the metadata are preserved, including the keys unknown to this version.
+func SetGenesisMetadata(metadata []byte, genesis GenesisMetadata) ([]byte, error) {
+	var m ChainMetadata
+	if len(metadata) > 0 {
+		if err := json.Unmarshal(metadata, &m); err != nil {
+			return nil, fmt.Errorf("invalid chain metadata: %w", err)
+		}
+	}
+
+	m.Genesis = &genesis
+	return m.Bytes()
+}
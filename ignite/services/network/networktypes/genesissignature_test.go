@@ -0,0 +1,89 @@
+package networktypes_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+const testGenesisHash = "4ea5c508a6566e76240543f8feb06fd457777be39549c4016436afda65d2330e"
+
+func genesisSignature(t *testing.T, privKey *secp256k1.PrivKey, hash string) networktypes.GenesisSignature {
+	signature, err := privKey.Sign(networktypes.GenesisHashSignBytes(hash))
+	require.NoError(t, err)
+	return networktypes.GenesisSignature{
+		Hash:      hash,
+		Signature: signature,
+		PubKey:    privKey.PubKey().Bytes(),
+	}
+}
+
+func TestGenesisSignatureVerify(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	coordinator, err := sdk.Bech32ifyAddressBytes(networktypes.SPN, privKey.PubKey().Address())
+	require.NoError(t, err)
+
+	t.Run("valid signature", func(t *testing.T) {
+		bz, err := json.Marshal(genesisSignature(t, privKey, testGenesisHash))
+		require.NoError(t, err)
+		signature, err := networktypes.ParseGenesisSignature(bz)
+		require.NoError(t, err)
+
+		require.NoError(t, signature.Verify(testGenesisHash, coordinator))
+	})
+
+	t.Run("signature of another account", func(t *testing.T) {
+		signature := genesisSignature(t, secp256k1.GenPrivKey(), testGenesisHash)
+
+		err := signature.Verify(testGenesisHash, coordinator)
+		require.ErrorAs(t, err, &networktypes.ErrGenesisSigner{})
+	})
+
+	t.Run("signature of another key", func(t *testing.T) {
+		signature := genesisSignature(t, privKey, testGenesisHash)
+		signature.PubKey = secp256k1.GenPrivKey().PubKey().Bytes()
+
+		err := signature.Verify(testGenesisHash, coordinator)
+		require.ErrorIs(t, err, networktypes.ErrInvalidGenesisSignature)
+	})
+
+	t.Run("signature of another hash", func(t *testing.T) {
+		signature := genesisSignature(t, privKey, testGenesisHash)
+		signature.Hash = "7d865e959b2466918c9863afca942d0fb89d7c9ac0c99bafc3749504ded97730"
+
+		require.ErrorIs(t, signature.Verify(signature.Hash, coordinator), networktypes.ErrInvalidGenesisSignature)
+		require.ErrorIs(t, signature.Verify(testGenesisHash, coordinator), networktypes.ErrInvalidGenesisSignature)
+	})
+
+	t.Run("empty signature", func(t *testing.T) {
+		err := networktypes.GenesisSignature{Hash: testGenesisHash}.Verify(testGenesisHash, coordinator)
+		require.ErrorIs(t, err, networktypes.ErrInvalidGenesisSignature)
+
+		_, err = networktypes.ParseGenesisSignature([]byte("not a signature"))
+		require.ErrorIs(t, err, networktypes.ErrInvalidGenesisSignature)
+	})
+}
+
+func TestSetGenesisMetadata(t *testing.T) {
+	metadata := []byte(`{"x":1,"genesis":{}}`)
+	parsed, err := networktypes.ParseChainMetadata(metadata)
+	require.NoError(t, err)
+	require.False(t, parsed.Genesis.IsSigned())
+
+	// only the genesis is marked as signed, the signature is published next to the genesis
+	metadata, err = networktypes.SetGenesisMetadata(metadata, networktypes.GenesisMetadata{Signed: true})
+	require.NoError(t, err)
+
+	parsed, err = networktypes.ParseChainMetadata(metadata)
+	require.NoError(t, err)
+	require.True(t, parsed.Genesis.IsSigned())
+	require.Contains(t, string(metadata), `"x":1`)
+
+	var absent *networktypes.GenesisMetadata
+	require.False(t, absent.IsSigned())
+}
@@ -0,0 +1,62 @@
+package networktypes
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GentxAllocation is the self-delegation the validator of a campaign-backed chain can use in its gentx.
+// It's derived from the campaign shares and the vouchers of the validator.
+type GentxAllocation struct {
+	// CampaignID is the ID of the campaign of the chain.
+	CampaignID uint64
+
+	// Denom is the denom of the self-delegation, the denom of the campaign vouchers.
+	Denom string
+
+	// Max is the maximum self-delegation amount allocated to the validator.
+	Max sdkmath.Int
+}
+
+// ErrSelfDelegationOverAllocation is returned when the self-delegation of a gentx exceeds the allocation.
+type ErrSelfDelegationOverAllocation struct {
+	SelfDelegation sdk.Coin
+	Allocation     GentxAllocation
+}
+
+// Error implements error.
+func (e ErrSelfDelegationOverAllocation) Error() string {
+	return fmt.Sprintf(
+		"the self-delegation %s exceeds the allocation of %s%s from the campaign %d",
+		e.SelfDelegation,
+		e.Allocation.Max,
+		e.Allocation.Denom,
+		e.Allocation.CampaignID,
+	)
+}
+
+// DefaultSelfDelegation returns the self-delegation prefilled in the gentx, the whole allocation.
+func (a GentxAllocation) DefaultSelfDelegation() sdk.Coin {
+	return sdk.NewCoin(a.Denom, a.Max)
+}
+
+// CheckSelfDelegation checks the self-delegation is in the denom of the allocation and doesn't exceed it.
+func (a GentxAllocation) CheckSelfDelegation(selfDelegation sdk.Coin) error {
+	if selfDelegation.Denom != a.Denom {
+		return fmt.Errorf(
+			"the self-delegation %s must be in %s, the denom of the campaign %d",
+			selfDelegation,
+			a.Denom,
+			a.CampaignID,
+		)
+	}
+	if selfDelegation.Amount.GT(a.Max) {
+		return ErrSelfDelegationOverAllocation{
+			SelfDelegation: selfDelegation,
+			Allocation:     a,
+		}
+	}
+	return nil
+}
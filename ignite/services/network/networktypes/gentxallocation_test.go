@@ -0,0 +1,32 @@
+package networktypes_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+func TestGentxAllocation(t *testing.T) {
+	allocation := networktypes.GentxAllocation{
+		CampaignID: 1,
+		Denom:      "stake",
+		Max:        sdkmath.NewInt(1000),
+	}
+
+	require.Equal(t, sdk.NewInt64Coin("stake", 1000), allocation.DefaultSelfDelegation())
+	require.NoError(t, allocation.CheckSelfDelegation(sdk.NewInt64Coin("stake", 1000)))
+	require.NoError(t, allocation.CheckSelfDelegation(sdk.NewInt64Coin("stake", 1)))
+	require.Equal(t, networktypes.ErrSelfDelegationOverAllocation{
+		SelfDelegation: sdk.NewInt64Coin("stake", 1001),
+		Allocation:     allocation,
+	}, allocation.CheckSelfDelegation(sdk.NewInt64Coin("stake", 1001)))
+	require.EqualError(
+		t,
+		allocation.CheckSelfDelegation(sdk.NewInt64Coin("uatom", 10)),
+		"the self-delegation 10uatom must be in stake, the denom of the campaign 1",
+	)
+}
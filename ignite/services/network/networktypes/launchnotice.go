@@ -0,0 +1,44 @@
+package networktypes
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// chainMetadataLaunchNotice is the key of the launch notice in the metadata of a chain.
+const chainMetadataLaunchNotice = "launch_notice"
+
+// LaunchNotice is a notice published by the coordinator to the validators of a launched chain.
+type LaunchNotice struct {
+	Message string `json:"message"`
+
+	// Blocking asks the validators to hold off starting their node until the notice is cleared.
+	Blocking bool `json:"blocking,omitempty"`
+}
+
+// IsActive checks if the notice is set.
+func (n *LaunchNotice) IsActive() bool {
+	return n != nil && n.Message != ""
+}
+
+// IsBlocking checks if the notice asks the validators to hold off starting their node.
+func (n *LaunchNotice) IsBlocking() bool {
+	return n.IsActive() && n.Blocking
+}
+
+// SetLaunchNotice returns the chain metadata with the launch notice, the notice is removed when nil.
+// The other keys of the metadata are preserved, including the keys unknown to this version.
+func SetLaunchNotice(metadata []byte, notice *LaunchNotice) ([]byte, error) {
+	var m ChainMetadata
+	if len(metadata) > 0 {
+		if err := json.Unmarshal(metadata, &m); err != nil {
+			return nil, fmt.Errorf("invalid chain metadata: %w", err)
+		}
+	}
+
+	m.LaunchNotice = nil
+	if notice.IsActive() {
+		m.LaunchNotice = notice
+	}
+	return m.Bytes()
+}
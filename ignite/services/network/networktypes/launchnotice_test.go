@@ -0,0 +1,61 @@
+package networktypes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+func TestSetLaunchNotice(t *testing.T) {
+	notice := &networktypes.LaunchNotice{Message: "hold off", Blocking: true}
+
+	t.Run("empty metadata", func(t *testing.T) {
+		metadata, err := networktypes.SetLaunchNotice(nil, notice)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"launch_notice":{"message":"hold off","blocking":true}}`, string(metadata))
+
+		parsed, err := networktypes.ParseChainMetadata(metadata)
+		require.NoError(t, err)
+		require.Equal(t, notice, parsed.LaunchNotice)
+		require.True(t, parsed.LaunchNotice.IsBlocking())
+	})
+
+	t.Run("other metadata keys are preserved", func(t *testing.T) {
+		// the metadata size on SPN is limited, the notice is short to keep the other keys
+		notice := &networktypes.LaunchNotice{Message: "wait", Blocking: true}
+		metadata, err := networktypes.SetLaunchNotice(
+			[]byte(`{"x":1,"launch_notice":{"message":"old"}}`),
+			notice,
+		)
+		require.NoError(t, err)
+		require.JSONEq(t, `{
+			"x":1,
+			"launch_notice":{"message":"wait","blocking":true}
+		}`, string(metadata))
+
+		parsed, err := networktypes.ParseChainMetadata(metadata)
+		require.NoError(t, err)
+		require.Equal(t, notice, parsed.LaunchNotice)
+	})
+
+	t.Run("clear the notice", func(t *testing.T) {
+		metadata, err := networktypes.SetLaunchNotice(
+			[]byte(`{"website":"https://mars.example","launch_notice":{"message":"old","blocking":true}}`),
+			nil,
+		)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"website":"https://mars.example"}`, string(metadata))
+
+		parsed, err := networktypes.ParseChainMetadata(metadata)
+		require.NoError(t, err)
+		require.False(t, parsed.LaunchNotice.IsActive())
+		require.False(t, parsed.LaunchNotice.IsBlocking())
+	})
+
+	t.Run("invalid metadata", func(t *testing.T) {
+		_, err := networktypes.SetLaunchNotice([]byte(`["not","an","object"]`), notice)
+		require.ErrorContains(t, err, "invalid chain metadata")
+	})
+}
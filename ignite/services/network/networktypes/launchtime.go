@@ -0,0 +1,75 @@
+package networktypes
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// launchTimeLayout is the layout of the launch times announced to the users.
+const launchTimeLayout = "2006-01-02 15:04:05 MST"
+
+// LaunchTimeAnnouncement is the launch time of a chain rendered for the coordinators and validators
+// of the different time zones.
+type LaunchTimeAnnouncement struct {
+	// UTC is the launch time in UTC.
+	UTC string `json:"UTC"`
+
+	// Local is the launch time in the local zone.
+	Local string `json:"Local"`
+
+	// Relative is the duration before or since the launch time, e.g. "in 3h12m" or "5m ago".
+	Relative string `json:"Relative"`
+
+	// Unix is the unix timestamp of the launch time.
+	Unix int64 `json:"Unix"`
+}
+
+// NewLaunchTimeAnnouncement renders the launch time in UTC, in the zone of loc and relative to now.
+func NewLaunchTimeAnnouncement(launchTime, now time.Time, loc *time.Location) LaunchTimeAnnouncement {
+	return LaunchTimeAnnouncement{
+		UTC:      launchTime.UTC().Format(launchTimeLayout),
+		Local:    launchTime.In(loc).Format(launchTimeLayout),
+		Relative: FormatRelativeTime(launchTime, now),
+		Unix:     launchTime.Unix(),
+	}
+}
+
+// String returns the announcement on a line:
+// 2022-05-01 12:00:00 UTC (2022-05-01 14:00:00 CEST, in 3h12m, unix 1651406400).
+func (a LaunchTimeAnnouncement) String() string {
+	return fmt.Sprintf("%s (%s, %s, unix %d)", a.UTC, a.Local, a.Relative, a.Unix)
+}
+
+// FormatRelativeTime formats the duration between the time and now, e.g. "in 3h12m" for a time in the future
+// and "3h12m ago" for a time in the past. The duration is rounded to the minute from one minute.
+func FormatRelativeTime(t, now time.Time) string {
+	d := t.Sub(now)
+	past := d < 0
+	if past {
+		d = -d
+	}
+
+	if d < time.Second {
+		return "now"
+	}
+	if d >= time.Minute {
+		d = d.Round(time.Minute)
+	} else {
+		d = d.Round(time.Second)
+	}
+
+	// the zero units are trimmed: 3h0m0s is formatted as 3h
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+
+	if past {
+		return s + " ago"
+	}
+	return "in " + s
+}
@@ -0,0 +1,105 @@
+package networktypes_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+func TestFormatRelativeTime(t *testing.T) {
+	now := time.Date(2022, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		{
+			name: "now",
+			t:    now.Add(400 * time.Millisecond),
+			want: "now",
+		},
+		{
+			name: "seconds",
+			t:    now.Add(45 * time.Second),
+			want: "in 45s",
+		},
+		{
+			name: "hours and minutes",
+			t:    now.Add(3*time.Hour + 12*time.Minute + 20*time.Second),
+			want: "in 3h12m",
+		},
+		{
+			name: "whole hours",
+			t:    now.Add(48 * time.Hour),
+			want: "in 48h",
+		},
+		{
+			name: "whole minutes",
+			t:    now.Add(5 * time.Minute),
+			want: "in 5m",
+		},
+		{
+			name: "past",
+			t:    now.Add(-90 * time.Minute),
+			want: "1h30m ago",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, networktypes.FormatRelativeTime(tt.t, now))
+		})
+	}
+}
+
+func TestNewLaunchTimeAnnouncement(t *testing.T) {
+	var (
+		now        = time.Date(2022, time.May, 1, 8, 48, 0, 0, time.UTC)
+		launchTime = time.Date(2022, time.May, 1, 12, 0, 0, 0, time.UTC)
+		paris      = time.FixedZone("CEST", 2*60*60)
+		newYork    = time.FixedZone("EDT", -4*60*60)
+	)
+
+	tests := []struct {
+		name       string
+		launchTime time.Time
+		loc        *time.Location
+		want       networktypes.LaunchTimeAnnouncement
+		str        string
+	}{
+		{
+			name:       "zone ahead of UTC",
+			launchTime: launchTime,
+			loc:        paris,
+			want: networktypes.LaunchTimeAnnouncement{
+				UTC:      "2022-05-01 12:00:00 UTC",
+				Local:    "2022-05-01 14:00:00 CEST",
+				Relative: "in 3h12m",
+				Unix:     1651406400,
+			},
+			str: "2022-05-01 12:00:00 UTC (2022-05-01 14:00:00 CEST, in 3h12m, unix 1651406400)",
+		},
+		{
+			name:       "zone behind UTC",
+			launchTime: launchTime.In(paris),
+			loc:        newYork,
+			want: networktypes.LaunchTimeAnnouncement{
+				UTC:      "2022-05-01 12:00:00 UTC",
+				Local:    "2022-05-01 08:00:00 EDT",
+				Relative: "in 3h12m",
+				Unix:     1651406400,
+			},
+			str: "2022-05-01 12:00:00 UTC (2022-05-01 08:00:00 EDT, in 3h12m, unix 1651406400)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := networktypes.NewLaunchTimeAnnouncement(tt.launchTime, now, tt.loc)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.str, got.String())
+		})
+	}
+}
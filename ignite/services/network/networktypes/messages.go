@@ -0,0 +1,113 @@
+package networktypes
+
+import "github.com/ignite/cli/ignite/pkg/events"
+
+// IDs of the event messages of the network services, rendered with the catalog of the language of the events.
+// The parameters of each message are listed with its English template in EnglishMessages.
+const (
+	MsgLaunchingChain          events.MessageID = "network.launch.launching"
+	MsgLaunchNoMinTime         events.MessageID = "network.launch.no-min-launch-time"
+	MsgLaunchNoMaxTime         events.MessageID = "network.launch.no-max-launch-time"
+	MsgLaunchPendingRequests   events.MessageID = "network.launch.pending-requests"
+	MsgLaunchSettingTime       events.MessageID = "network.launch.setting-launch-time"
+	MsgLaunchScheduled         events.MessageID = "network.launch.scheduled"
+	MsgRevertingLaunch         events.MessageID = "network.revert.reverting"
+	MsgLaunchReverted          events.MessageID = "network.revert.reverted"
+	MsgResettingGenesisTime    events.MessageID = "network.revert.resetting-genesis-time"
+	MsgGenesisTimeReset        events.MessageID = "network.revert.genesis-time-reset"
+	MsgChainIDBumped           events.MessageID = "network.revert.chain-id-bumped"
+	MsgInitializingBlockchain  events.MessageID = "networkchain.init.initializing"
+	MsgBlockchainInitialized   events.MessageID = "networkchain.init.initialized"
+	MsgComputingGenesis        events.MessageID = "networkchain.init.computing-genesis"
+	MsgDownloadingGenesis      events.MessageID = "networkchain.init.downloading-genesis"
+	MsgGenesisDownloadProgress events.MessageID = "networkchain.init.genesis-download-progress"
+	MsgGenesisDownloaded       events.MessageID = "networkchain.init.genesis-downloaded"
+	MsgGenesisInitialized      events.MessageID = "networkchain.init.genesis-initialized"
+	MsgBuildingGenesis         events.MessageID = "networkchain.prepare.building-genesis"
+	MsgGenesisBuilt            events.MessageID = "networkchain.prepare.genesis-built"
+	MsgFetchingSource          events.MessageID = "networkchain.build.fetching-source"
+	MsgSourceFetched           events.MessageID = "networkchain.build.source-fetched"
+	MsgSettingUpBlockchain     events.MessageID = "networkchain.build.setting-up"
+	MsgBlockchainSetUp         events.MessageID = "networkchain.build.set-up"
+	MsgBuildingBinary          events.MessageID = "networkchain.build.building-binary"
+	MsgBuildingBinaryOutput    events.MessageID = "networkchain.build.building-binary-output"
+	MsgBinaryBuilt             events.MessageID = "networkchain.build.binary-built"
+	MsgGeneratingGentx         events.MessageID = "network.join.generating-gentx"
+	MsgGentxGenerated          events.MessageID = "network.join.gentx-generated"
+	MsgVerifyingGentx          events.MessageID = "network.join.verifying-gentx"
+	MsgGentxVerified           events.MessageID = "network.join.gentx-verified"
+	MsgBroadcastingValidator   events.MessageID = "network.join.broadcasting-validator"
+	MsgValidatorAdded          events.MessageID = "network.join.validator-added"
+	MsgJoinRequestSubmitted    events.MessageID = "network.join.request-submitted"
+	MsgFetchingRequests        events.MessageID = "network.request.fetching"
+	MsgRequestsFetched         events.MessageID = "network.request.fetched"
+	MsgSubmittingRequests      events.MessageID = "network.request.submitting"
+	MsgRequestsSubmitted       events.MessageID = "network.request.submitted"
+	MsgSendingRequests         events.MessageID = "network.request.sending"
+	MsgRequestsSent            events.MessageID = "network.request.sent"
+	MsgRequestsSkipped         events.MessageID = "network.request.skipped"
+	MsgPublishingChain         events.MessageID = "network.publish.publishing"
+	MsgBroadcastingAccount     events.MessageID = "network.publish.broadcasting-account"
+	MsgAccountAdded            events.MessageID = "network.publish.account-added"
+	MsgAccountRequestSubmitted events.MessageID = "network.publish.request-submitted"
+)
+
+// EnglishMessages is the default catalog of the event messages of the network services.
+var EnglishMessages = events.Catalog{
+	Language: events.DefaultLanguage,
+	Messages: map[events.MessageID]string{
+		MsgLaunchingChain:        "Launching chain {{.LaunchID}}",
+		MsgLaunchNoMinTime:       "The SPN launch params have no minimum launch time, the launch time must only be {{.Offset}} after the SPN time",
+		MsgLaunchNoMaxTime:       "The SPN launch params have no maximum launch time, the launch time is not bounded",
+		MsgLaunchPendingRequests: "Chain {{.LaunchID}} has {{number .Count}} pending request(s), they will be ignored by the launched chain",
+		MsgLaunchSettingTime:     "Setting launch time",
+		// LaunchTime is the launch time, Announcement the launch time in UTC, in the local time and relative to now
+		MsgLaunchScheduled:        "Chain {{.LaunchID}} will be launched on {{.Announcement}}",
+		MsgRevertingLaunch:        "Reverting launched chain {{.LaunchID}}",
+		MsgLaunchReverted:         "Chain {{.LaunchID}} launch was reverted",
+		MsgResettingGenesisTime:   "Resetting the genesis time",
+		MsgGenesisTimeReset:       "Genesis time was reset",
+		MsgChainIDBumped:          "Chain ID bumped from {{.ChainID}} to {{.BumpedChainID}}, the validators must prepare the chain again",
+		MsgInitializingBlockchain: "Initializing the blockchain",
+		MsgBlockchainInitialized:  "Blockchain initialized",
+		MsgComputingGenesis:       "Computing the Genesis",
+		MsgDownloadingGenesis:     "Downloading the genesis",
+		// Downloaded and Size are formatted sizes, Size is "unknown size" when the server doesn't announce it
+		MsgGenesisDownloadProgress: "Downloading the genesis: {{.Downloaded}} of {{.Size}}",
+		MsgGenesisDownloaded:       "Genesis downloaded from {{.URL}}",
+		MsgGenesisInitialized:      "Genesis initialized",
+		MsgBuildingGenesis:         "Building the genesis",
+		MsgGenesisBuilt:            "Genesis built",
+		MsgFetchingSource:          "Fetching the source code",
+		MsgSourceFetched:           "Source code fetched",
+		MsgSettingUpBlockchain:     "Setting up the blockchain",
+		MsgBlockchainSetUp:         "Blockchain set up",
+		MsgBuildingBinary:          "Building the chain's binary",
+		// Line is the last line of the output of the build
+		MsgBuildingBinaryOutput:  "Building the chain's binary: {{.Line}}",
+		MsgBinaryBuilt:           "Chain's binary built",
+		MsgGeneratingGentx:       "Generating the gentx",
+		MsgGentxGenerated:        "Gentx generated",
+		MsgVerifyingGentx:        "Verifying the gentx",
+		MsgGentxVerified:         "Gentx verified",
+		MsgBroadcastingValidator: "Broadcasting validator transaction",
+		MsgValidatorAdded:        "Validator added to the network by the coordinator!",
+		MsgJoinRequestSubmitted:  "Request {{.RequestID}} to join the network as a validator has been submitted!",
+		MsgFetchingRequests:      "Fetching requests",
+		MsgRequestsFetched:       "Requests fetched",
+		MsgSubmittingRequests:    "Submitting requests",
+		MsgRequestsSubmitted:     "Requests submitted",
+		MsgSendingRequests:       "Sending requests",
+		MsgRequestsSent:          "Requests sent",
+		// Fee is the request fee formatted in the display units of its denoms
+		MsgRequestsSkipped:         "{{number .Skipped}} of {{number .Total}} requests won't be submitted, the account balance pays the {{coins .Fee}} fee of {{number .Remaining}} request(s)",
+		MsgPublishingChain:         "Publishing the network",
+		MsgBroadcastingAccount:     "Broadcasting account transactions",
+		MsgAccountAdded:            "Account added to the network by the coordinator!",
+		MsgAccountRequestSubmitted: "Request {{.RequestID}} to add account to the network has been submitted!",
+	},
+}
+
+func init() {
+	events.MustRegisterCatalog(EnglishMessages)
+}
@@ -0,0 +1,59 @@
+package networktypes_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+func TestEnglishMessages(t *testing.T) {
+	params := events.Params{
+		"LaunchID":      uint64(42),
+		"Count":         3,
+		"Offset":        30 * time.Second,
+		"LaunchTime":    time.Unix(1651406400, 0).UTC(),
+		"Announcement":  "2022-05-01 12:00:00 UTC",
+		"ChainID":       "mars-1",
+		"BumpedChainID": "mars-2",
+		"URL":           "https://example.com/genesis.json",
+		"RequestID":     uint64(7),
+		"Skipped":       1,
+		"Total":         1500,
+		"Fee":           "1000.5 SPN",
+		"Remaining":     1499,
+		"Downloaded":    "1.5 MiB",
+		"Size":          "12.0 MiB",
+		"Line":          "go: downloading github.com/cosmos/cosmos-sdk v0.46.0",
+	}
+
+	// every message of the default catalog renders with its parameters
+	for id := range networktypes.EnglishMessages.Messages {
+		require.NotEqual(t, string(id), events.Message(id, params), id)
+	}
+	require.Equal(t, "Chain 42 will be launched on 2022-05-01 12:00:00 UTC", events.Message(networktypes.MsgLaunchScheduled, params))
+	require.Equal(
+		t,
+		"1 of 1,500 requests won't be submitted, the account balance pays the 1,000.5 SPN fee of 1,499 request(s)",
+		events.Message(networktypes.MsgRequestsSkipped, params),
+	)
+}
+
+func TestTranslatedMessages(t *testing.T) {
+	require.NoError(t, events.RegisterCatalog(events.Catalog{
+		Language: "pt-BR",
+		Locale:   events.Locale{TimeLayout: "02/01/2006 15:04 MST"},
+		Messages: map[events.MessageID]string{
+			networktypes.MsgLaunchScheduled: "A chain {{.LaunchID}} será lançada em {{time .LaunchTime}}",
+		},
+	}))
+	require.NoError(t, events.SetLanguage("pt-BR"))
+	t.Cleanup(func() { require.NoError(t, events.SetLanguage(events.DefaultLanguage)) })
+
+	params := events.Params{"LaunchID": uint64(42), "LaunchTime": time.Unix(1651406400, 0).UTC()}
+	require.Equal(t, "A chain 42 será lançada em 01/05/2022 12:00 UTC", events.Message(networktypes.MsgLaunchScheduled, params))
+	require.Equal(t, "Launching chain 42", events.Message(networktypes.MsgLaunchingChain, params))
+}
@@ -1,7 +1,12 @@
 package networktypes
 
 import (
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	launchtypes "github.com/tendermint/spn/x/launch/types"
 	"github.com/tendermint/tendermint/crypto/ed25519"
@@ -19,9 +24,45 @@ type (
 		CreatedAt string                     `json:"CreatedAt"`
 		Content   launchtypes.RequestContent `json:"Content"`
 		Status    string                     `json:"Status"`
+
+		// RejectionReason is the reason of the coordinator for the rejection, empty when unknown.
+		RejectionReason string `json:"RejectionReason,omitempty"`
+
+		// CreationTime is the time of the SPN block the request was created in.
+		CreationTime time.Time `json:"-"`
+	}
+
+	// RequestConflict represents two add validator requests that declare the same validator identity,
+	// only one of them can be included in the genesis
+	RequestConflict struct {
+		// RequestIDs contains the IDs of the conflicting requests, the oldest request first
+		RequestIDs [2]uint64
+
+		// Field is the name of the conflicting validator field
+		Field string
+
+		// Value is the value declared by both requests
+		Value string
 	}
 )
 
+const (
+	ConflictFieldConsPubKey      = "consensus pub key"
+	ConflictFieldOperatorAddress = "operator address"
+	ConflictFieldNodeID          = "node ID"
+)
+
+// String implements fmt.Stringer
+func (c RequestConflict) String() string {
+	return fmt.Sprintf(
+		"requests %d and %d have the same %s %s",
+		c.RequestIDs[0],
+		c.RequestIDs[1],
+		c.Field,
+		c.Value,
+	)
+}
+
 // ToRequest converts a request data from SPN and returns a Request object
 func ToRequest(request launchtypes.Request) Request {
 	return Request{
@@ -31,6 +72,8 @@ func ToRequest(request launchtypes.Request) Request {
 		CreatedAt: xtime.FormatUnixInt(request.CreatedAt),
 		Content:   request.Content,
 		Status:    launchtypes.Request_Status_name[int32(request.Status)],
+
+		CreationTime: time.Unix(request.CreatedAt, 0).UTC(),
 	}
 }
 
@@ -47,6 +90,122 @@ func VerifyRequest(request Request) error {
 	return nil
 }
 
+// VerifyRequestSignature verifies the gentx of an add validator request is signed by the validator for the chain ID.
+// The sign bytes are built with the account number and sequence 0 used for the genesis transactions.
+// cosmosutil.ErrGentxUnknownKeyType is returned when the key types of the gentx are unknown to the Cosmos SDK.
+func VerifyRequestSignature(request Request, chainID string) error {
+	req, ok := request.Content.Content.(*launchtypes.RequestContent_GenesisValidator)
+	if !ok || req.GenesisValidator == nil {
+		return nil
+	}
+
+	info, _, err := cosmosutil.ParseGentx(req.GenesisValidator.GenTx)
+	if err != nil {
+		return NewWrappedErrInvalidRequest(request.RequestID, fmt.Sprintf("cannot parse gentx %s", err.Error()))
+	}
+	err = cosmosutil.VerifyGentxSignature(req.GenesisValidator.GenTx, chainID)
+	if errors.Is(err, cosmosutil.ErrGentxUnknownKeyType) {
+		return fmt.Errorf("validator %s: %w", info.Moniker, err)
+	}
+	if err != nil {
+		return NewWrappedErrInvalidRequest(request.RequestID, fmt.Sprintf("validator %s: %s", info.Moniker, err.Error()))
+	}
+	return nil
+}
+
+// VerifyRequestPeer verifies the node ID of the peer of an add validator request is the node ID of the gentx memo.
+// The memo written by the gentx command has the format <node-id>@<address>, the node ID can't be verified
+// when the gentx has no memo, ErrGentxWithoutMemo is then returned as a soft warning.
+func VerifyRequestPeer(request Request) error {
+	req, ok := request.Content.Content.(*launchtypes.RequestContent_GenesisValidator)
+	if !ok || req.GenesisValidator == nil {
+		return nil
+	}
+
+	info, _, err := cosmosutil.ParseGentx(req.GenesisValidator.GenTx)
+	if err != nil {
+		return NewWrappedErrInvalidRequest(request.RequestID, fmt.Sprintf("cannot parse gentx %s", err.Error()))
+	}
+
+	memo := strings.TrimSpace(info.Memo)
+	if memo == "" {
+		return ErrGentxWithoutMemo
+	}
+	memoNodeID := memo
+	if i := strings.Index(memo, "@"); i >= 0 {
+		memoNodeID = memo[:i]
+	}
+
+	if peerNodeID := req.GenesisValidator.Peer.Id; !strings.EqualFold(peerNodeID, memoNodeID) {
+		return NewWrappedErrInvalidRequest(request.RequestID, fmt.Sprintf(
+			"validator %s: the peer node ID %s doesn't match the node ID %s of the gentx memo",
+			info.Moniker,
+			peerNodeID,
+			memoNodeID,
+		))
+	}
+	return nil
+}
+
+// FindRequestConflicts detects the add validator requests that declare the same consensus pub key,
+// operator address or node ID. Only pending and approved requests are checked since rejected
+// requests are never included in the genesis
+func FindRequestConflicts(requests []Request) []RequestConflict {
+	sorted := make([]Request, len(requests))
+	copy(sorted, requests)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].RequestID < sorted[j].RequestID
+	})
+
+	var (
+		conflicts []RequestConflict
+		seen      = make(map[string]map[string]uint64)
+	)
+	check := func(requestID uint64, field, value string) {
+		if value == "" {
+			return
+		}
+		if seen[field] == nil {
+			seen[field] = make(map[string]uint64)
+		}
+		if firstID, ok := seen[field][value]; ok {
+			conflicts = append(conflicts, RequestConflict{
+				RequestIDs: [2]uint64{firstID, requestID},
+				Field:      field,
+				Value:      value,
+			})
+			return
+		}
+		seen[field][value] = requestID
+	}
+
+	for _, request := range sorted {
+		if request.Status != launchtypes.Request_PENDING.String() &&
+			request.Status != launchtypes.Request_APPROVED.String() {
+			continue
+		}
+		req, ok := request.Content.Content.(*launchtypes.RequestContent_GenesisValidator)
+		if !ok || req.GenesisValidator == nil {
+			continue
+		}
+		validator := req.GenesisValidator
+
+		if len(validator.ConsPubKey) > 0 {
+			check(request.RequestID, ConflictFieldConsPubKey, base64.StdEncoding.EncodeToString(validator.ConsPubKey))
+		}
+
+		// the operator address can only be fetched from the gentx, an invalid gentx
+		// is reported by the request static verification
+		if info, _, err := cosmosutil.ParseGentx(validator.GenTx); err == nil {
+			check(request.RequestID, ConflictFieldOperatorAddress, info.ValidatorAddress)
+		}
+
+		check(request.RequestID, ConflictFieldNodeID, validator.Peer.Id)
+	}
+
+	return conflicts
+}
+
 // VerifyAddValidatorRequest verify the validator request parameters
 func VerifyAddValidatorRequest(req *launchtypes.RequestContent_GenesisValidator) error {
 	// If this is an add validator request
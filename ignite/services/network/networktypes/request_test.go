@@ -1,8 +1,10 @@
 package networktypes_test
 
 import (
+	"bytes"
 	"encoding/base64"
 	"fmt"
+	"os"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -11,7 +13,9 @@ import (
 	launchtypes "github.com/tendermint/spn/x/launch/types"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
 	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
 )
 
 func TestVerifyAddValidatorRequest(t *testing.T) {
@@ -144,3 +148,202 @@ func TestVerifyAddValidatorRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyRequestSignature(t *testing.T) {
+	gentx, err := os.ReadFile("testdata/gentx_signed.json")
+	require.NoError(t, err)
+	validatorRequest := networktypes.Request{
+		RequestID: 3,
+		Content: launchtypes.NewGenesisValidator(
+			testutil.LaunchID,
+			"spn1qcrl9zy7merupfkhqksp0eqs0u40mdszhqg6l0",
+			gentx,
+			[]byte("pubkey"),
+			sdk.NewCoin("stake", sdkmath.NewInt(95000000)),
+			launchtypes.NewPeerConn("nodeid", "127.163.0.1:2446"),
+		),
+	}
+
+	t.Run("valid gentx signature", func(t *testing.T) {
+		require.NoError(t, networktypes.VerifyRequestSignature(validatorRequest, "mars-1"))
+	})
+
+	t.Run("gentx signed for another chain", func(t *testing.T) {
+		err := networktypes.VerifyRequestSignature(validatorRequest, "mars-2")
+		var invalidRequest networktypes.ErrInvalidRequest
+		require.ErrorAs(t, err, &invalidRequest)
+		require.ErrorContains(t, err, "validator alice: invalid gentx signature for the chain mars-2")
+		require.ErrorContains(t, err, "request 3 is invalid")
+	})
+
+	t.Run("gentx with a bls consensus key", func(t *testing.T) {
+		request := validatorRequest
+		request.Content = launchtypes.NewGenesisValidator(
+			testutil.LaunchID,
+			"spn1qcrl9zy7merupfkhqksp0eqs0u40mdszhqg6l0",
+			bytes.Replace(gentx, []byte("/cosmos.crypto.ed25519.PubKey"), []byte("/cosmos.crypto.bls12_381.PubKey"), 1),
+			[]byte("pubkey"),
+			sdk.NewCoin("stake", sdkmath.NewInt(95000000)),
+			launchtypes.NewPeerConn("nodeid", "127.163.0.1:2446"),
+		)
+		err := networktypes.VerifyRequestSignature(request, "mars-1")
+		require.ErrorIs(t, err, cosmosutil.ErrGentxUnknownKeyType)
+		require.ErrorContains(t, err, "validator alice")
+	})
+
+	t.Run("request without gentx", func(t *testing.T) {
+		request := networktypes.Request{
+			RequestID: 4,
+			Content:   launchtypes.NewAccountRemoval("spn1qcrl9zy7merupfkhqksp0eqs0u40mdszhqg6l0"),
+		}
+		require.NoError(t, networktypes.VerifyRequestSignature(request, "mars-1"))
+	})
+}
+
+func TestVerifyRequestPeer(t *testing.T) {
+	newRequest := func(t *testing.T, gentxPath, nodeID string) networktypes.Request {
+		gentx, err := os.ReadFile(gentxPath)
+		require.NoError(t, err)
+		return networktypes.Request{
+			RequestID: 3,
+			Content: launchtypes.NewGenesisValidator(
+				testutil.LaunchID,
+				"spn1qcrl9zy7merupfkhqksp0eqs0u40mdszhqg6l0",
+				gentx,
+				[]byte("pubkey"),
+				sdk.NewCoin("stake", sdkmath.NewInt(95000000)),
+				launchtypes.NewPeerConn(nodeID, "192.168.0.148:26656"),
+			),
+		}
+	}
+
+	t.Run("peer node ID matching the gentx memo", func(t *testing.T) {
+		request := newRequest(t, "testdata/gentx_signed.json", "9b1f4adbfb0c0b513040d914bfb717303c0eaa71")
+		require.NoError(t, networktypes.VerifyRequestPeer(request))
+	})
+
+	t.Run("peer node ID not matching the gentx memo", func(t *testing.T) {
+		request := newRequest(t, "testdata/gentx_signed.json", "a412c917cb29f73cc3ad0592bbd0152fe0e690bd")
+		err := networktypes.VerifyRequestPeer(request)
+		var invalidRequest networktypes.ErrInvalidRequest
+		require.ErrorAs(t, err, &invalidRequest)
+		require.ErrorContains(t, err, "validator alice: the peer node ID a412c917cb29f73cc3ad0592bbd0152fe0e690bd "+
+			"doesn't match the node ID 9b1f4adbfb0c0b513040d914bfb717303c0eaa71 of the gentx memo")
+	})
+
+	t.Run("gentx without memo", func(t *testing.T) {
+		request := newRequest(t, "testdata/gentx_no_memo.json", "9b1f4adbfb0c0b513040d914bfb717303c0eaa71")
+		require.ErrorIs(t, networktypes.VerifyRequestPeer(request), networktypes.ErrGentxWithoutMemo)
+	})
+
+	t.Run("request without gentx", func(t *testing.T) {
+		request := networktypes.Request{
+			RequestID: 4,
+			Content:   launchtypes.NewAccountRemoval("spn1qcrl9zy7merupfkhqksp0eqs0u40mdszhqg6l0"),
+		}
+		require.NoError(t, networktypes.VerifyRequestPeer(request))
+	})
+}
+
+func TestFindRequestConflicts(t *testing.T) {
+	newGentx := func(valAddress string) []byte {
+		return []byte(fmt.Sprintf(`{
+  "body": {
+    "messages": [
+      {
+        "delegator_address": "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj",
+        "pubkey": {
+          "@type": "/cosmos.crypto.ed25519.PubKey",
+          "key": "aeQLCJOjXUyB7evOodI4mbrshIt3vhHGlycJDbUkaMs="
+        },
+        "validator_address": "%s",
+        "value": {
+          "amount": "95000000",
+          "denom": "stake"
+        }
+      }
+    ]
+  }
+}`, valAddress))
+	}
+	newRequest := func(requestID uint64, status launchtypes.Request_Status, pubKey, valAddress, nodeID string) networktypes.Request {
+		return networktypes.Request{
+			RequestID: requestID,
+			Status:    status.String(),
+			Content: launchtypes.NewGenesisValidator(
+				testutil.LaunchID,
+				"spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g",
+				newGentx(valAddress),
+				[]byte(pubKey),
+				sdk.NewCoin("stake", sdkmath.NewInt(95000000)),
+				launchtypes.NewPeerConn(nodeID, "127.163.0.1:2446"),
+			),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		requests []networktypes.Request
+		want     []networktypes.RequestConflict
+	}{
+		{
+			name: "no conflicts",
+			requests: []networktypes.Request{
+				newRequest(1, launchtypes.Request_APPROVED, "pk1", "cosmosvaloper1", "node1"),
+				newRequest(2, launchtypes.Request_PENDING, "pk2", "cosmosvaloper2", "node2"),
+				newRequest(3, launchtypes.Request_PENDING, "pk3", "cosmosvaloper3", "node3"),
+			},
+		},
+		{
+			name: "conflicts with an approved request",
+			requests: []networktypes.Request{
+				newRequest(2, launchtypes.Request_PENDING, "pk1", "cosmosvaloper1", "node2"),
+				newRequest(1, launchtypes.Request_APPROVED, "pk1", "cosmosvaloper1", "node1"),
+			},
+			want: []networktypes.RequestConflict{
+				{
+					RequestIDs: [2]uint64{1, 2},
+					Field:      networktypes.ConflictFieldConsPubKey,
+					Value:      base64.StdEncoding.EncodeToString([]byte("pk1")),
+				},
+				{
+					RequestIDs: [2]uint64{1, 2},
+					Field:      networktypes.ConflictFieldOperatorAddress,
+					Value:      "cosmosvaloper1",
+				},
+			},
+		},
+		{
+			name: "conflicts between pending requests",
+			requests: []networktypes.Request{
+				newRequest(1, launchtypes.Request_PENDING, "pk1", "cosmosvaloper1", "node1"),
+				newRequest(2, launchtypes.Request_PENDING, "pk2", "cosmosvaloper2", "node1"),
+				newRequest(3, launchtypes.Request_PENDING, "pk3", "cosmosvaloper3", "node1"),
+			},
+			want: []networktypes.RequestConflict{
+				{
+					RequestIDs: [2]uint64{1, 2},
+					Field:      networktypes.ConflictFieldNodeID,
+					Value:      "node1",
+				},
+				{
+					RequestIDs: [2]uint64{1, 3},
+					Field:      networktypes.ConflictFieldNodeID,
+					Value:      "node1",
+				},
+			},
+		},
+		{
+			name: "rejected requests are ignored",
+			requests: []networktypes.Request{
+				newRequest(1, launchtypes.Request_REJECTED, "pk1", "cosmosvaloper1", "node1"),
+				newRequest(2, launchtypes.Request_PENDING, "pk1", "cosmosvaloper1", "node1"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, networktypes.FindRequestConflicts(tt.requests))
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package networktypes
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+)
+
+// chainMetadataRequestDeadline is the key of the request deadline in the metadata of a chain.
+const chainMetadataRequestDeadline = "request_deadline"
+
+// SetRequestDeadline returns the chain metadata with the request deadline, the deadline is removed when zero.
+// The other keys of the metadata are preserved, including the keys unknown to this version.
+func SetRequestDeadline(metadata []byte, deadline time.Time) ([]byte, error) {
+	var m ChainMetadata
+	if len(metadata) > 0 {
+		if err := json.Unmarshal(metadata, &m); err != nil {
+			return nil, fmt.Errorf("invalid chain metadata: %w", err)
+		}
+	}
+
+	m.RequestDeadline = nil
+	if !deadline.IsZero() {
+		deadline = deadline.UTC()
+		m.RequestDeadline = &deadline
+	}
+	return m.Bytes()
+}
+
+// RequestDeadline returns the request deadline of the chain, zero when the coordinator set no deadline.
+func (c ChainLaunch) RequestDeadline() time.Time {
+	if c.Metadata == nil || c.Metadata.RequestDeadline == nil {
+		return time.Time{}
+	}
+	return *c.Metadata.RequestDeadline
+}
+
+// IsLate checks if the request was created after the deadline, the requests created in a block with
+// the time of the deadline are on time. No request is late without deadline.
+func (r Request) IsLate(deadline time.Time) bool {
+	return !deadline.IsZero() && r.CreationTime.After(deadline)
+}
+
+// LateRequests returns the IDs of the pending requests created after the deadline.
+func LateRequests(requests []Request, deadline time.Time) []uint64 {
+	var ids []uint64
+	for _, request := range requests {
+		if request.Status == launchtypes.Request_PENDING.String() && request.IsLate(deadline) {
+			ids = append(ids, request.RequestID)
+		}
+	}
+	return ids
+}
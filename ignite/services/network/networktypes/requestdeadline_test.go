@@ -0,0 +1,55 @@
+package networktypes_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+func TestSetRequestDeadline(t *testing.T) {
+	deadline := time.Date(2022, time.May, 6, 18, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+
+	metadata, err := networktypes.SetRequestDeadline([]byte(`{"x":1}`), deadline)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"x":1,"request_deadline":"2022-05-06T16:00:00Z"}`, string(metadata))
+
+	launch := networktypes.ToChainLaunch(launchtypes.Chain{Metadata: metadata})
+	require.True(t, deadline.Equal(launch.RequestDeadline()))
+
+	// a zero deadline removes it
+	metadata, err = networktypes.SetRequestDeadline(metadata, time.Time{})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"x":1}`, string(metadata))
+
+	launch = networktypes.ToChainLaunch(launchtypes.Chain{Metadata: metadata})
+	require.True(t, launch.RequestDeadline().IsZero())
+}
+
+func TestLateRequests(t *testing.T) {
+	deadline := time.Date(2022, time.May, 6, 16, 0, 0, 0, time.UTC)
+	request := func(id uint64, createdAt time.Time, status launchtypes.Request_Status) networktypes.Request {
+		return networktypes.ToRequest(launchtypes.Request{
+			RequestID: id,
+			CreatedAt: createdAt.Unix(),
+			Status:    status,
+		})
+	}
+	requests := []networktypes.Request{
+		request(1, deadline.Add(-time.Second), launchtypes.Request_PENDING),
+		// the request created in the block of the deadline is on time
+		request(2, deadline, launchtypes.Request_PENDING),
+		request(3, deadline.Add(time.Second), launchtypes.Request_PENDING),
+		request(4, deadline.Add(time.Hour), launchtypes.Request_APPROVED),
+	}
+
+	require.False(t, requests[1].IsLate(deadline))
+	require.True(t, requests[2].IsLate(deadline))
+	require.Equal(t, []uint64{3}, networktypes.LateRequests(requests, deadline))
+
+	// no request is late without deadline
+	require.Empty(t, networktypes.LateRequests(requests, time.Time{}))
+}
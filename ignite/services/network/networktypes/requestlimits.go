@@ -0,0 +1,50 @@
+package networktypes
+
+import (
+	"fmt"
+
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+)
+
+// MaxGentxMemoLength is the maximum length of the memo of a gentx, the default maximum number of characters
+// of a memo in the auth module. The chain fails to start from a genesis with a gentx with a longer memo.
+const MaxGentxMemoLength = 256
+
+// ErrContentTooLarge is returned when a request or a tx exceeds a size limit.
+type ErrContentTooLarge struct {
+	// Content describes the oversized content.
+	Content string
+
+	// Size is the measured size of the content in bytes.
+	Size int
+
+	// Max is the allowed size of the content in bytes.
+	Max int
+}
+
+// Error implements error
+func (err ErrContentTooLarge) Error() string {
+	return fmt.Sprintf("%s is %d bytes, the maximum is %d bytes", err.Content, err.Size, err.Max)
+}
+
+// CheckRequestContentLimits checks the request content doesn't exceed the limits of SPN and of the chain.
+// The memo of a gentx is signed, it can't be trimmed: the gentx must be generated again with a shorter memo.
+// The gentxs that can't be parsed are verified with the request, their memo isn't checked.
+func CheckRequestContentLimits(content launchtypes.RequestContent) error {
+	req, ok := content.Content.(*launchtypes.RequestContent_GenesisValidator)
+	if !ok || req.GenesisValidator == nil {
+		return nil
+	}
+
+	info, _, err := cosmosutil.ParseGentx(req.GenesisValidator.GenTx)
+	if err == nil && len(info.Memo) > MaxGentxMemoLength {
+		return ErrContentTooLarge{
+			Content: fmt.Sprintf("the memo of the gentx of %s", req.GenesisValidator.Address),
+			Size:    len(info.Memo),
+			Max:     MaxGentxMemoLength,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package networktypes_test
+
+import (
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+func TestCheckRequestContentLimits(t *testing.T) {
+	const address = "spn1qcrl9zy7merupfkhqksp0eqs0u40mdszhqg6l0"
+	newContent := func(t *testing.T, memo string) launchtypes.RequestContent {
+		gentx := testutil.NewGentx(address, "stake", "95000000", "", memo)
+		return launchtypes.NewGenesisValidator(
+			testutil.LaunchID,
+			address,
+			gentx.JSON(t),
+			[]byte("pubkey"),
+			sdk.NewCoin("stake", sdkmath.NewInt(95000000)),
+			launchtypes.NewPeerConn("9b1f4adbfb0c0b513040d914bfb717303c0eaa71", "192.168.0.148:26656"),
+		)
+	}
+
+	t.Run("memo at the limit", func(t *testing.T) {
+		content := newContent(t, strings.Repeat("a", networktypes.MaxGentxMemoLength))
+		require.NoError(t, networktypes.CheckRequestContentLimits(content))
+	})
+
+	t.Run("memo over the limit", func(t *testing.T) {
+		content := newContent(t, strings.Repeat("a", networktypes.MaxGentxMemoLength+1))
+		err := networktypes.CheckRequestContentLimits(content)
+		require.Equal(t, networktypes.ErrContentTooLarge{
+			Content: "the memo of the gentx of " + address,
+			Size:    networktypes.MaxGentxMemoLength + 1,
+			Max:     networktypes.MaxGentxMemoLength,
+		}, err)
+		require.EqualError(t, err, "the memo of the gentx of "+address+" is 257 bytes, the maximum is 256 bytes")
+	})
+
+	t.Run("request without gentx", func(t *testing.T) {
+		content := launchtypes.NewAccountRemoval(address)
+		require.NoError(t, networktypes.CheckRequestContentLimits(content))
+	})
+}
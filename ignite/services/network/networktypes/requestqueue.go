@@ -0,0 +1,33 @@
+package networktypes
+
+import "time"
+
+// RequestQueuePosition is the position of a pending request in the queue of the pending requests of its launch.
+// The requests are reviewed in the order they were sent, the estimated wait assumes the coordinator keeps
+// settling the requests at its recent rate, it's an estimate, not a commitment of the coordinator.
+type RequestQueuePosition struct {
+	LaunchID  uint64
+	RequestID uint64
+
+	// Position is the position of the request among the pending requests, starting at 1.
+	Position int
+
+	// Pending is the number of pending requests of the launch.
+	Pending int
+
+	// SettlementRate is the number of requests the coordinator settled per hour over the recent settlements.
+	// It's zero when the coordinator didn't settle any request recently.
+	SettlementRate float64
+
+	// EstimatedWait is the estimated time until the request is settled, it's only set with a settlement rate.
+	EstimatedWait time.Duration
+
+	// EstimatedSettlement is the estimated time of the settlement of the request, it's only set with a settlement rate.
+	EstimatedSettlement time.Time
+}
+
+// HasEstimate checks if the settlement of the request is estimated,
+// the launches without recent settlements only have the position of the request.
+func (p RequestQueuePosition) HasEstimate() bool {
+	return p.SettlementRate > 0
+}
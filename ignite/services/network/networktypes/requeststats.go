@@ -0,0 +1,132 @@
+package networktypes
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+)
+
+const (
+	RequestTypeAddAccount        = "add-account"
+	RequestTypeAddVestingAccount = "add-vesting-account"
+	RequestTypeAddValidator      = "add-validator"
+	RequestTypeRemoveAccount     = "remove-account"
+	RequestTypeRemoveValidator   = "remove-validator"
+	RequestTypeUnknown           = "unknown"
+)
+
+type (
+	// RequestStats are the statistics of the requests of a launch reported by the coordinators.
+	RequestStats struct {
+		LaunchID uint64 `json:"LaunchID"`
+
+		// Total is the number of requests of the launch.
+		Total int `json:"Total"`
+
+		// Daily counts the requests created each day by type and status, ordered by day, type and status.
+		Daily []RequestDailyCount `json:"Daily"`
+
+		// ApprovalLatency are the percentiles of the delay between the creation and the approval of the requests.
+		ApprovalLatency LatencyPercentiles `json:"ApprovalLatency"`
+
+		// SelfDelegations is the distribution of the self-delegations of the pending and approved
+		// validators by denom, ordered by denom.
+		SelfDelegations []SelfDelegationDistribution `json:"SelfDelegations"`
+	}
+
+	// RequestDailyCount is the number of requests of a type and a status created in a day.
+	RequestDailyCount struct {
+		// Day is the UTC day of the creation of the requests, e.g. 2022-05-01.
+		Day    string `json:"Day"`
+		Type   string `json:"Type"`
+		Status string `json:"Status"`
+		Count  int    `json:"Count"`
+	}
+
+	// LatencyPercentiles are the percentiles of the latencies in seconds.
+	LatencyPercentiles struct {
+		// Count is the number of latencies, the percentiles are zero without latencies.
+		Count int     `json:"Count"`
+		P50   float64 `json:"P50"`
+		P90   float64 `json:"P90"`
+		P99   float64 `json:"P99"`
+	}
+
+	// SelfDelegationDistribution is the distribution of the self-delegations of a denom.
+	SelfDelegationDistribution struct {
+		Denom  string      `json:"Denom"`
+		Count  int         `json:"Count"`
+		Total  sdkmath.Int `json:"Total"`
+		Min    sdkmath.Int `json:"Min"`
+		Median sdkmath.Int `json:"Median"`
+		Max    sdkmath.Int `json:"Max"`
+	}
+)
+
+// RequestType returns the type of the request from its content.
+func RequestType(content launchtypes.RequestContent) string {
+	switch content.Content.(type) {
+	case *launchtypes.RequestContent_GenesisAccount:
+		return RequestTypeAddAccount
+	case *launchtypes.RequestContent_VestingAccount:
+		return RequestTypeAddVestingAccount
+	case *launchtypes.RequestContent_GenesisValidator:
+		return RequestTypeAddValidator
+	case *launchtypes.RequestContent_AccountRemoval:
+		return RequestTypeRemoveAccount
+	case *launchtypes.RequestContent_ValidatorRemoval:
+		return RequestTypeRemoveValidator
+	default:
+		return RequestTypeUnknown
+	}
+}
+
+// WriteJSON writes the statistics as an indented JSON object.
+func (s RequestStats) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
+
+// WriteCSV writes the statistics as CSV records with the columns metric, day, type, status, denom and value.
+// Each daily count is a "requests" record, the latencies and the self-delegation distributions are written
+// as one record for each value, e.g. "approval_latency_p90" and "self_delegation_median".
+func (s RequestStats) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	records := [][]string{{"metric", "day", "type", "status", "denom", "value"}}
+	for _, d := range s.Daily {
+		records = append(records, []string{"requests", d.Day, d.Type, d.Status, "", strconv.Itoa(d.Count)})
+	}
+
+	latency := s.ApprovalLatency
+	records = append(records,
+		[]string{"approval_count", "", "", "", "", strconv.Itoa(latency.Count)},
+		[]string{"approval_latency_p50", "", "", "", "", formatSeconds(latency.P50)},
+		[]string{"approval_latency_p90", "", "", "", "", formatSeconds(latency.P90)},
+		[]string{"approval_latency_p99", "", "", "", "", formatSeconds(latency.P99)},
+	)
+
+	for _, sd := range s.SelfDelegations {
+		records = append(records,
+			[]string{"self_delegation_count", "", "", "", sd.Denom, strconv.Itoa(sd.Count)},
+			[]string{"self_delegation_total", "", "", "", sd.Denom, sd.Total.String()},
+			[]string{"self_delegation_min", "", "", "", sd.Denom, sd.Min.String()},
+			[]string{"self_delegation_median", "", "", "", sd.Denom, sd.Median.String()},
+			[]string{"self_delegation_max", "", "", "", sd.Denom, sd.Max.String()},
+		)
+	}
+
+	if err := cw.WriteAll(records); err != nil {
+		return fmt.Errorf("cannot write the request stats: %w", err)
+	}
+	return nil
+}
+
+func formatSeconds(seconds float64) string {
+	return strconv.FormatFloat(seconds, 'f', -1, 64)
+}
@@ -0,0 +1,90 @@
+package networktypes_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+var sampleRequestStats = networktypes.RequestStats{
+	LaunchID: 1,
+	Total:    3,
+	Daily: []networktypes.RequestDailyCount{
+		{Day: "2022-05-01", Type: "add-validator", Status: "APPROVED", Count: 2},
+		{Day: "2022-05-02", Type: "add-account", Status: "PENDING", Count: 1},
+	},
+	ApprovalLatency: networktypes.LatencyPercentiles{Count: 2, P50: 60, P90: 3600.5, P99: 3600.5},
+	SelfDelegations: []networktypes.SelfDelegationDistribution{
+		{
+			Denom:  "stake",
+			Count:  2,
+			Total:  sdkmath.NewInt(300),
+			Min:    sdkmath.NewInt(100),
+			Median: sdkmath.NewInt(100),
+			Max:    sdkmath.NewInt(200),
+		},
+	},
+}
+
+func TestRequestType(t *testing.T) {
+	require.Equal(t, networktypes.RequestTypeAddAccount, networktypes.RequestType(
+		launchtypes.NewGenesisAccount(1, "spn1abc", nil),
+	))
+	require.Equal(t, networktypes.RequestTypeRemoveAccount, networktypes.RequestType(
+		launchtypes.NewAccountRemoval("spn1abc"),
+	))
+	require.Equal(t, networktypes.RequestTypeUnknown, networktypes.RequestType(launchtypes.RequestContent{}))
+}
+
+func TestRequestStatsWriteCSV(t *testing.T) {
+	t.Run("stats", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, sampleRequestStats.WriteCSV(&buf))
+		require.Equal(t, `metric,day,type,status,denom,value
+requests,2022-05-01,add-validator,APPROVED,,2
+requests,2022-05-02,add-account,PENDING,,1
+approval_count,,,,,2
+approval_latency_p50,,,,,60
+approval_latency_p90,,,,,3600.5
+approval_latency_p99,,,,,3600.5
+self_delegation_count,,,,stake,2
+self_delegation_total,,,,stake,300
+self_delegation_min,,,,stake,100
+self_delegation_median,,,,stake,100
+self_delegation_max,,,,stake,200
+`, buf.String())
+	})
+
+	t.Run("empty launch", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, networktypes.RequestStats{LaunchID: 1}.WriteCSV(&buf))
+		require.Equal(t, `metric,day,type,status,denom,value
+approval_count,,,,,0
+approval_latency_p50,,,,,0
+approval_latency_p90,,,,,0
+approval_latency_p99,,,,,0
+`, buf.String())
+	})
+}
+
+func TestRequestStatsWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, sampleRequestStats.WriteJSON(&buf))
+	require.JSONEq(t, `{
+  "LaunchID": 1,
+  "Total": 3,
+  "Daily": [
+    {"Day": "2022-05-01", "Type": "add-validator", "Status": "APPROVED", "Count": 2},
+    {"Day": "2022-05-02", "Type": "add-account", "Status": "PENDING", "Count": 1}
+  ],
+  "ApprovalLatency": {"Count": 2, "P50": 60, "P90": 3600.5, "P99": 3600.5},
+  "SelfDelegations": [
+    {"Denom": "stake", "Count": 2, "Total": "300", "Min": "100", "Median": "100", "Max": "200"}
+  ]
+}`, buf.String())
+}
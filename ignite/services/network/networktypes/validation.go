@@ -0,0 +1,209 @@
+package networktypes
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ChainIDMaxLength is the maximum length of a chain ID accepted by Tendermint.
+	ChainIDMaxLength = 50
+
+	// DenomMinLength is the minimum length of a denom accepted by the Cosmos SDK.
+	DenomMinLength = 3
+
+	// DenomMaxLength is the maximum length of a denom accepted by the Cosmos SDK.
+	DenomMaxLength = 128
+
+	ibcDenomPrefix     = "ibc/"
+	ibcDenomHashLength = 64
+	factoryDenomPrefix = "factory/"
+)
+
+// ValidateChainID checks the chain ID follows the Cosmos chain ID rules.
+// The chain ID can have an epoch suffix with the format <identifier>-<epoch>,
+// when the suffix is numeric it must be a valid epoch number.
+func ValidateChainID(chainID string) error {
+	if chainID == "" {
+		return errors.New("chain ID cannot be empty")
+	}
+	if len(chainID) > ChainIDMaxLength {
+		return fmt.Errorf(
+			"chain ID %s must have at most %d characters, it has %d",
+			chainID,
+			ChainIDMaxLength,
+			len(chainID),
+		)
+	}
+	for i, c := range chainID {
+		if !isChainIDChar(c) {
+			return fmt.Errorf(
+				"chain ID %s contains the invalid character %q at position %d, only letters, digits, '.', '_' and '-' are allowed",
+				chainID,
+				c,
+				i,
+			)
+		}
+	}
+
+	i := strings.LastIndex(chainID, "-")
+	if i == -1 {
+		return nil
+	}
+	identifier, epoch := chainID[:i], chainID[i+1:]
+	if identifier == "" {
+		return fmt.Errorf("chain ID %s must have an identifier before the epoch separator '-'", chainID)
+	}
+	if epoch == "" {
+		return fmt.Errorf("chain ID %s cannot end with the epoch separator '-'", chainID)
+	}
+	if !isNumeric(epoch) {
+		return nil
+	}
+	if _, err := strconv.ParseUint(epoch, 10, 64); err != nil || (len(epoch) > 1 && epoch[0] == '0') {
+		return fmt.Errorf(
+			"chain ID %s has an invalid epoch %s, the epoch must be an integer without leading zeros",
+			chainID,
+			epoch,
+		)
+	}
+	return nil
+}
+
+// ParseChainIDEpoch returns the identifier and the epoch of the chain ID with the format <identifier>-<epoch>.
+// A chain ID without a numeric suffix has the epoch 1 and is its own identifier.
+func ParseChainIDEpoch(chainID string) (identifier string, epoch uint64, err error) {
+	if err := ValidateChainID(chainID); err != nil {
+		return "", 0, err
+	}
+
+	i := strings.LastIndex(chainID, "-")
+	if i == -1 || !isNumeric(chainID[i+1:]) {
+		return chainID, 1, nil
+	}
+	epoch, _ = strconv.ParseUint(chainID[i+1:], 10, 64)
+	return chainID[:i], epoch, nil
+}
+
+// BumpChainIDEpoch returns the chain ID with the next epoch, a chain ID without epoch gets the epoch 2.
+func BumpChainIDEpoch(chainID string) (string, error) {
+	identifier, epoch, err := ParseChainIDEpoch(chainID)
+	if err != nil {
+		return "", err
+	}
+
+	bumped := fmt.Sprintf("%s-%d", identifier, epoch+1)
+	if err := ValidateChainID(bumped); err != nil {
+		return "", fmt.Errorf("cannot bump the epoch of the chain ID %s: %w", chainID, err)
+	}
+	return bumped, nil
+}
+
+// ValidateBinaryName checks the name of a chain binary is a plain file name, it can't contain a path.
+// The name can only contain letters, digits, '.', '_' and '-' and can't start with '.' or '-'.
+func ValidateBinaryName(name string) error {
+	if name == "" {
+		return errors.New("binary name cannot be empty")
+	}
+	if name[0] == '.' || name[0] == '-' {
+		return fmt.Errorf("binary name %q cannot start with %q", name, name[0])
+	}
+	for i, c := range name {
+		if !isChainIDChar(c) {
+			return fmt.Errorf(
+				"binary name %q contains the invalid character %q at position %d, only letters, digits, '.', '_' and '-' are allowed",
+				name,
+				c,
+				i,
+			)
+		}
+	}
+	return nil
+}
+
+// ValidateDenom checks the denom follows the Cosmos SDK denom rules.
+// IBC denoms must have the format ibc/<hash> and factory denoms the format factory/<creator>/<subdenom>.
+func ValidateDenom(denom string) error {
+	if len(denom) < DenomMinLength || len(denom) > DenomMaxLength {
+		return fmt.Errorf(
+			"denom %s must have between %d and %d characters, it has %d",
+			denom,
+			DenomMinLength,
+			DenomMaxLength,
+			len(denom),
+		)
+	}
+	if !isLetter(rune(denom[0])) {
+		return fmt.Errorf("denom %s must start with a letter", denom)
+	}
+	for i, c := range denom {
+		if !isDenomChar(c) {
+			return fmt.Errorf(
+				"denom %s contains the invalid character %q at position %d, only letters, digits, '/', ':', '.', '_' and '-' are allowed",
+				denom,
+				c,
+				i,
+			)
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(denom, ibcDenomPrefix):
+		hash := strings.TrimPrefix(denom, ibcDenomPrefix)
+		if _, err := hex.DecodeString(hash); err != nil || len(hash) != ibcDenomHashLength {
+			return fmt.Errorf(
+				"IBC denom %s must have the format ibc/<hash> with a %d characters hex hash",
+				denom,
+				ibcDenomHashLength,
+			)
+		}
+	case strings.HasPrefix(denom, factoryDenomPrefix):
+		parts := strings.Split(denom, "/")
+		if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+			return fmt.Errorf("factory denom %s must have the format factory/<creator>/<subdenom>", denom)
+		}
+	}
+
+	// ensure the denom is accepted by the SDK version used
+	return sdk.ValidateDenom(denom)
+}
+
+// ValidateCoinsDenom checks the denoms of the coins follow the Cosmos SDK denom rules.
+func ValidateCoinsDenom(coins sdk.Coins) error {
+	for _, coin := range coins {
+		if err := ValidateDenom(coin.Denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isLetter(c rune) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isDigit(c rune) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isNumeric(s string) bool {
+	for _, c := range s {
+		if !isDigit(c) {
+			return false
+		}
+	}
+	return true
+}
+
+func isChainIDChar(c rune) bool {
+	return isLetter(c) || isDigit(c) || strings.ContainsRune("._-", c)
+}
+
+func isDenomChar(c rune) bool {
+	return isLetter(c) || isDigit(c) || strings.ContainsRune("/:._-", c)
+}
@@ -0,0 +1,343 @@
+package networktypes_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+func TestValidateChainID(t *testing.T) {
+	tests := []struct {
+		name    string
+		chainID string
+		err     string
+	}{
+		{
+			name:    "chain ID with epoch",
+			chainID: "foo-1",
+		},
+		{
+			name:    "chain ID with a multi digits epoch",
+			chainID: "cosmoshub-4242",
+		},
+		{
+			name:    "chain ID without epoch",
+			chainID: "foo",
+		},
+		{
+			name:    "chain ID with a non numeric suffix",
+			chainID: "foo-testnet",
+		},
+		{
+			name:    "chain ID with several separators",
+			chainID: "evmos_9000-foo-4",
+		},
+		{
+			name:    "chain ID with dots and uppercase letters",
+			chainID: "Foo.Bar-2",
+		},
+		{
+			name:    "chain ID with the max length",
+			chainID: strings.Repeat("a", networktypes.ChainIDMaxLength),
+		},
+		{
+			name: "empty chain ID",
+			err:  "chain ID cannot be empty",
+		},
+		{
+			name:    "chain ID too long",
+			chainID: strings.Repeat("a", networktypes.ChainIDMaxLength+1),
+			err:     "chain ID " + strings.Repeat("a", networktypes.ChainIDMaxLength+1) + " must have at most 50 characters, it has 51",
+		},
+		{
+			name:    "chain ID with a space",
+			chainID: "foo bar-1",
+			err:     `chain ID foo bar-1 contains the invalid character ' ' at position 3, only letters, digits, '.', '_' and '-' are allowed`,
+		},
+		{
+			name:    "chain ID with a slash",
+			chainID: "foo/bar-1",
+			err:     `chain ID foo/bar-1 contains the invalid character '/' at position 3, only letters, digits, '.', '_' and '-' are allowed`,
+		},
+		{
+			name:    "chain ID without identifier",
+			chainID: "-1",
+			err:     "chain ID -1 must have an identifier before the epoch separator '-'",
+		},
+		{
+			name:    "chain ID ending with the separator",
+			chainID: "foo-",
+			err:     "chain ID foo- cannot end with the epoch separator '-'",
+		},
+		{
+			name:    "chain ID with a zero epoch",
+			chainID: "foo-0",
+		},
+		{
+			name:    "chain ID with an epoch with leading zeros",
+			chainID: "foo-01",
+			err:     "chain ID foo-01 has an invalid epoch 01, the epoch must be an integer without leading zeros",
+		},
+		{
+			name:    "chain ID with an epoch overflow",
+			chainID: "foo-99999999999999999999",
+			err:     "chain ID foo-99999999999999999999 has an invalid epoch 99999999999999999999, the epoch must be an integer without leading zeros",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := networktypes.ValidateChainID(tt.chainID)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBumpChainIDEpoch(t *testing.T) {
+	tests := []struct {
+		name    string
+		chainID string
+		want    string
+		err     string
+	}{
+		{
+			name:    "chain ID with epoch",
+			chainID: "mars-1",
+			want:    "mars-2",
+		},
+		{
+			name:    "chain ID with a multi digits epoch",
+			chainID: "cosmoshub-4299",
+			want:    "cosmoshub-4300",
+		},
+		{
+			name:    "epoch with more digits",
+			chainID: "mars-9",
+			want:    "mars-10",
+		},
+		{
+			name:    "chain ID without epoch",
+			chainID: "mars",
+			want:    "mars-2",
+		},
+		{
+			name:    "chain ID with a non numeric suffix",
+			chainID: "mars-testnet",
+			want:    "mars-testnet-2",
+		},
+		{
+			name:    "chain ID with several separators",
+			chainID: "evmos_9000-foo-4",
+			want:    "evmos_9000-foo-5",
+		},
+		{
+			name:    "chain ID with a zero epoch",
+			chainID: "mars-0",
+			want:    "mars-1",
+		},
+		{
+			name:    "invalid chain ID",
+			chainID: "mars-01",
+			err:     "chain ID mars-01 has an invalid epoch 01, the epoch must be an integer without leading zeros",
+		},
+		{
+			name:    "bumped chain ID too long",
+			chainID: strings.Repeat("a", networktypes.ChainIDMaxLength),
+			err: "cannot bump the epoch of the chain ID " + strings.Repeat("a", networktypes.ChainIDMaxLength) +
+				": chain ID " + strings.Repeat("a", networktypes.ChainIDMaxLength) + "-2 must have at most 50 characters, it has 52",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := networktypes.BumpChainIDEpoch(tt.chainID)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseChainIDEpoch(t *testing.T) {
+	identifier, epoch, err := networktypes.ParseChainIDEpoch("cosmoshub-4299")
+	require.NoError(t, err)
+	require.Equal(t, "cosmoshub", identifier)
+	require.EqualValues(t, 4299, epoch)
+
+	identifier, epoch, err = networktypes.ParseChainIDEpoch("mars")
+	require.NoError(t, err)
+	require.Equal(t, "mars", identifier)
+	require.EqualValues(t, 1, epoch)
+}
+
+func TestValidateBinaryName(t *testing.T) {
+	tests := []struct {
+		name       string
+		binaryName string
+		err        string
+	}{
+		{
+			name:       "binary name",
+			binaryName: "marsd",
+		},
+		{
+			name:       "binary name with special characters",
+			binaryName: "mars_app-v2.1",
+		},
+		{
+			name: "empty binary name",
+			err:  "binary name cannot be empty",
+		},
+		{
+			name:       "path traversal",
+			binaryName: "../../.bashrc",
+			err:        `binary name "../../.bashrc" cannot start with '.'`,
+		},
+		{
+			name:       "binary name with a path",
+			binaryName: "bin/marsd",
+			err:        `binary name "bin/marsd" contains the invalid character '/' at position 3, only letters, digits, '.', '_' and '-' are allowed`,
+		},
+		{
+			name:       "binary name with a windows path",
+			binaryName: `bin\marsd`,
+			err:        `binary name "bin\\marsd" contains the invalid character '\\' at position 3, only letters, digits, '.', '_' and '-' are allowed`,
+		},
+		{
+			name:       "flag",
+			binaryName: "-marsd",
+			err:        `binary name "-marsd" cannot start with '-'`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := networktypes.ValidateBinaryName(tt.binaryName)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateDenom(t *testing.T) {
+	ibcHash := "27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	tests := []struct {
+		name  string
+		denom string
+		err   string
+	}{
+		{
+			name:  "native denom",
+			denom: "stake",
+		},
+		{
+			name:  "denom with uppercase letters and digits",
+			denom: "uATOM2",
+		},
+		{
+			name:  "denom with special characters",
+			denom: "gamm/pool:1.a_b-c",
+		},
+		{
+			name:  "denom with the max length",
+			denom: "a" + strings.Repeat("b", networktypes.DenomMaxLength-1),
+		},
+		{
+			name:  "IBC denom",
+			denom: "ibc/" + ibcHash,
+		},
+		{
+			name:  "IBC denom with a lowercase hash",
+			denom: "ibc/" + strings.ToLower(ibcHash),
+		},
+		{
+			name:  "factory denom",
+			denom: "factory/cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj/foo",
+		},
+		{
+			name:  "factory denom with a subdenom path",
+			denom: "factory/cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj/foo/bar",
+			err:   "factory denom factory/cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj/foo/bar must have the format factory/<creator>/<subdenom>",
+		},
+		{
+			name: "empty denom",
+			err:  "denom  must have between 3 and 128 characters, it has 0",
+		},
+		{
+			name:  "denom too short",
+			denom: "ab",
+			err:   "denom ab must have between 3 and 128 characters, it has 2",
+		},
+		{
+			name:  "denom too long",
+			denom: strings.Repeat("a", networktypes.DenomMaxLength+1),
+			err:   "denom " + strings.Repeat("a", networktypes.DenomMaxLength+1) + " must have between 3 and 128 characters, it has 129",
+		},
+		{
+			name:  "denom starting with a digit",
+			denom: "1stake",
+			err:   "denom 1stake must start with a letter",
+		},
+		{
+			name:  "denom starting with a slash",
+			denom: "/stake",
+			err:   "denom /stake must start with a letter",
+		},
+		{
+			name:  "denom with a space",
+			denom: "my stake",
+			err:   `denom my stake contains the invalid character ' ' at position 2, only letters, digits, '/', ':', '.', '_' and '-' are allowed`,
+		},
+		{
+			name:  "denom with a non ASCII character",
+			denom: "stakeé",
+			err:   `denom stakeé contains the invalid character 'é' at position 5, only letters, digits, '/', ':', '.', '_' and '-' are allowed`,
+		},
+		{
+			name:  "IBC denom without hash",
+			denom: "ibc/",
+			err:   "IBC denom ibc/ must have the format ibc/<hash> with a 64 characters hex hash",
+		},
+		{
+			name:  "IBC denom with a short hash",
+			denom: "ibc/27394FB0",
+			err:   "IBC denom ibc/27394FB0 must have the format ibc/<hash> with a 64 characters hex hash",
+		},
+		{
+			name:  "IBC denom with a non hex hash",
+			denom: "ibc/" + strings.Repeat("Z", 64),
+			err:   "IBC denom " + "ibc/" + strings.Repeat("Z", 64) + " must have the format ibc/<hash> with a 64 characters hex hash",
+		},
+		{
+			name:  "factory denom without subdenom",
+			denom: "factory/cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj/",
+			err:   "factory denom factory/cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj/ must have the format factory/<creator>/<subdenom>",
+		},
+		{
+			name:  "factory denom without creator",
+			denom: "factory//foo",
+			err:   "factory denom factory//foo must have the format factory/<creator>/<subdenom>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := networktypes.ValidateDenom(tt.denom)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
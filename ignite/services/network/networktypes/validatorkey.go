@@ -0,0 +1,55 @@
+package networktypes
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidatorKeyType is the type of the consensus key of the chain validators.
+type ValidatorKeyType string
+
+const (
+	// ValidatorKeyEd25519 is the default consensus key type of the chains.
+	ValidatorKeyEd25519 ValidatorKeyType = "ed25519"
+
+	// ValidatorKeySecp256k1 is the secp256k1 consensus key type.
+	ValidatorKeySecp256k1 ValidatorKeyType = "secp256k1"
+
+	// ValidatorKeyBLS is the BLS12-381 consensus key type.
+	ValidatorKeyBLS ValidatorKeyType = "bls12_381"
+)
+
+// validatorKeyPubKeyTypes are the type URLs of the public keys in the gentxs for each key type.
+var validatorKeyPubKeyTypes = map[ValidatorKeyType]string{
+	ValidatorKeyEd25519:   "/cosmos.crypto.ed25519.PubKey",
+	ValidatorKeySecp256k1: "/cosmos.crypto.secp256k1.PubKey",
+	ValidatorKeyBLS:       "/cosmos.crypto.bls12_381.PubKey",
+}
+
+// ParseValidatorKeyType parses a validator key type, "bls" is accepted for BLS12-381.
+func ParseValidatorKeyType(keyType string) (ValidatorKeyType, error) {
+	t := ValidatorKeyType(strings.ToLower(keyType))
+	if t == "bls" {
+		t = ValidatorKeyBLS
+	}
+	if _, ok := validatorKeyPubKeyTypes[t]; !ok {
+		return "", fmt.Errorf(
+			"unknown validator key type %s, expected %s, %s or %s",
+			keyType,
+			ValidatorKeyEd25519,
+			ValidatorKeySecp256k1,
+			ValidatorKeyBLS,
+		)
+	}
+	return t, nil
+}
+
+// PubKeyType returns the type URL of the public keys of the type in the gentxs.
+func (t ValidatorKeyType) PubKeyType() string {
+	return validatorKeyPubKeyTypes[t]
+}
+
+// IsDefault checks if the key type is the default key type of the chains.
+func (t ValidatorKeyType) IsDefault() bool {
+	return t == "" || t == ValidatorKeyEd25519
+}
@@ -0,0 +1,54 @@
+package networktypes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+func TestParseValidatorKeyType(t *testing.T) {
+	tests := []struct {
+		keyType string
+		want    networktypes.ValidatorKeyType
+		err     string
+	}{
+		{keyType: "ed25519", want: networktypes.ValidatorKeyEd25519},
+		{keyType: "Secp256k1", want: networktypes.ValidatorKeySecp256k1},
+		{keyType: "bls", want: networktypes.ValidatorKeyBLS},
+		{keyType: "bls12_381", want: networktypes.ValidatorKeyBLS},
+		{keyType: "sr25519", err: "unknown validator key type sr25519, expected ed25519, secp256k1 or bls12_381"},
+		{keyType: "", err: "unknown validator key type , expected ed25519, secp256k1 or bls12_381"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.keyType, func(t *testing.T) {
+			got, err := networktypes.ParseValidatorKeyType(tt.keyType)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseChainMetadata(t *testing.T) {
+	metadata, err := networktypes.ParseChainMetadata(nil)
+	require.NoError(t, err)
+	require.True(t, metadata.ValidatorKeyType.IsDefault())
+
+	metadata, err = networktypes.ParseChainMetadata([]byte(`{"validator_key_type":"bls"}`))
+	require.NoError(t, err)
+	require.Equal(t, networktypes.ValidatorKeyBLS, metadata.ValidatorKeyType)
+	require.Equal(t, "/cosmos.crypto.bls12_381.PubKey", metadata.ValidatorKeyType.PubKeyType())
+
+	_, err = networktypes.ParseChainMetadata([]byte(`{"validator_key_type":"rsa"}`))
+	require.EqualError(t, err, "invalid chain metadata: unknown validator key type rsa, expected ed25519, secp256k1 or bls12_381")
+
+	// a metadata not following the schema is a legacy metadata
+	metadata, err = networktypes.ParseChainMetadata([]byte(`{`))
+	require.NoError(t, err)
+	require.True(t, metadata.IsLegacy())
+}
@@ -0,0 +1,84 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+)
+
+const (
+	// MaxListedPendingRequests is the maximum number of pending request IDs listed by ErrPendingRequests.
+	MaxListedPendingRequests = 10
+
+	// pendingRequestsPageLimit is the number of requests fetched per page when the pending requests are counted.
+	pendingRequestsPageLimit = 100
+)
+
+// ErrPendingRequests is returned when a chain is launched while requests are still pending.
+// The pending requests are ignored by the launched chain, they must be reviewed before the launch.
+type ErrPendingRequests struct {
+	LaunchID uint64
+
+	// Count is the number of pending requests.
+	Count int
+
+	// RequestIDs are the IDs of the first pending requests, up to MaxListedPendingRequests.
+	RequestIDs []uint64
+}
+
+// Error implements error.
+func (e ErrPendingRequests) Error() string {
+	ids := make([]string, len(e.RequestIDs))
+	for i, id := range e.RequestIDs {
+		ids[i] = fmt.Sprint(id)
+	}
+	list := strings.Join(ids, ", ")
+	if e.Count > len(e.RequestIDs) {
+		list += fmt.Sprintf(" and %d more", e.Count-len(e.RequestIDs))
+	}
+	return fmt.Sprintf("chain %d has %d pending request(s): %s", e.LaunchID, e.Count, list)
+}
+
+// pendingRequests counts the pending requests of the chain and returns the IDs of the first ones.
+// SPN doesn't filter the requests by status, the requests are scanned page by page until the total
+// of the first page is reached, the chains without requests are checked with a single query.
+func (n Network) pendingRequests(ctx context.Context, launchID uint64) (count int, requestIDs []uint64, err error) {
+	var (
+		pagination     = &query.PageRequest{Limit: pendingRequestsPageLimit, CountTotal: true}
+		total, scanned uint64
+	)
+	for {
+		observe := n.observeLatency("requests", MetricsKindQuery)
+		res, err := n.launchQuery.RequestAll(ctx, &launchtypes.QueryAllRequestRequest{
+			LaunchID:   launchID,
+			Pagination: pagination,
+		})
+		observe()
+		if err != nil {
+			return 0, nil, err
+		}
+		if pagination.CountTotal && res.Pagination != nil {
+			total = res.Pagination.Total
+		}
+		scanned += uint64(len(res.Request))
+		for _, request := range res.Request {
+			if request.Status != launchtypes.Request_PENDING {
+				continue
+			}
+			count++
+			if len(requestIDs) < MaxListedPendingRequests {
+				requestIDs = append(requestIDs, request.RequestID)
+			}
+		}
+		if (total > 0 && scanned >= total) || res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: pendingRequestsPageLimit}
+	}
+
+	n.metrics.SetPendingRequests(launchID, count)
+	return count, requestIDs, nil
+}
@@ -0,0 +1,156 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+// mockPendingRequests mocks the requests of the chain returned in a single page.
+func mockPendingRequests(suite testutil.Suite, requests ...launchtypes.Request) {
+	suite.LaunchQueryMock.
+		On("RequestAll", context.Background(), &launchtypes.QueryAllRequestRequest{
+			LaunchID:   testutil.LaunchID,
+			Pagination: &query.PageRequest{Limit: pendingRequestsPageLimit, CountTotal: true},
+		}).
+		Return(&launchtypes.QueryAllRequestResponse{
+			Request:    requests,
+			Pagination: &query.PageResponse{Total: uint64(len(requests))},
+		}, nil).
+		Once()
+}
+
+func TestTriggerLaunchPendingRequests(t *testing.T) {
+	var (
+		account    = testutil.NewTestAccount(t, testutil.TestAccountName)
+		launchTime = sampleTime.Add(TestMaxRemainingTime)
+	)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	newLaunchSuite := func(t *testing.T) (testutil.Suite, Network) {
+		suite, network := newSuite(account)
+		mockCoordinator(t, suite, account)
+		mockBlockTime(suite, sampleTime)
+		suite.LaunchQueryMock.
+			On("Params", context.Background(), &launchtypes.QueryParamsRequest{}).
+			Return(&launchtypes.QueryParamsResponse{
+				Params: launchtypes.NewParams(
+					TestMinRemainingTime,
+					TestMaxRemainingTime,
+					TestRevertDelay,
+					sdk.Coins(nil),
+					sdk.Coins(nil),
+				),
+			}, nil).
+			Once()
+		return suite, network
+	}
+	mockTriggerLaunch := func(suite testutil.Suite) {
+		suite.CosmosClientMock.
+			On("BroadcastTx", context.Background(), account, launchtypes.NewMsgTriggerLaunch(addr, testutil.LaunchID, launchTime)).
+			Return(testutil.NewResponse(&launchtypes.MsgTriggerLaunchResponse{}), nil).
+			Once()
+	}
+	pending := func(ids ...uint64) []launchtypes.Request {
+		requests := make([]launchtypes.Request, len(ids))
+		for i, id := range ids {
+			requests[i] = launchtypes.Request{LaunchID: testutil.LaunchID, RequestID: id, Status: launchtypes.Request_PENDING}
+		}
+		return requests
+	}
+
+	t.Run("no pending request", func(t *testing.T) {
+		suite, network := newLaunchSuite(t)
+		mockPendingRequests(suite,
+			launchtypes.Request{RequestID: 1, Status: launchtypes.Request_APPROVED},
+			launchtypes.Request{RequestID: 2, Status: launchtypes.Request_REJECTED},
+		)
+		mockTriggerLaunch(suite)
+
+		require.NoError(t, network.TriggerLaunch(context.Background(), testutil.LaunchID, launchTime))
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("pending requests", func(t *testing.T) {
+		suite, network := newLaunchSuite(t)
+		requests := append(pending(2, 4), launchtypes.Request{RequestID: 3, Status: launchtypes.Request_APPROVED})
+		mockPendingRequests(suite, requests...)
+
+		err := network.TriggerLaunch(context.Background(), testutil.LaunchID, launchTime)
+		require.Equal(t, ErrPendingRequests{LaunchID: testutil.LaunchID, Count: 2, RequestIDs: []uint64{2, 4}}, err)
+		require.EqualError(t, err, "chain 1 has 2 pending request(s): 2, 4")
+
+		// no message is broadcasted
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("many pending requests", func(t *testing.T) {
+		suite, network := newLaunchSuite(t)
+		var ids []uint64
+		for id := uint64(1); id <= 150; id++ {
+			ids = append(ids, id)
+		}
+		requests := pending(ids...)
+		suite.LaunchQueryMock.
+			On("RequestAll", context.Background(), &launchtypes.QueryAllRequestRequest{
+				LaunchID:   testutil.LaunchID,
+				Pagination: &query.PageRequest{Limit: pendingRequestsPageLimit, CountTotal: true},
+			}).
+			Return(&launchtypes.QueryAllRequestResponse{
+				Request:    requests[:pendingRequestsPageLimit],
+				Pagination: &query.PageResponse{NextKey: []byte("next"), Total: 150},
+			}, nil).
+			Once()
+		suite.LaunchQueryMock.
+			On("RequestAll", context.Background(), &launchtypes.QueryAllRequestRequest{
+				LaunchID:   testutil.LaunchID,
+				Pagination: &query.PageRequest{Key: []byte("next"), Limit: pendingRequestsPageLimit},
+			}).
+			Return(&launchtypes.QueryAllRequestResponse{
+				Request:    requests[pendingRequestsPageLimit:],
+				Pagination: &query.PageResponse{NextKey: []byte("last")},
+			}, nil).
+			Once()
+
+		err := network.TriggerLaunch(context.Background(), testutil.LaunchID, launchTime)
+		require.Equal(t, ErrPendingRequests{
+			LaunchID:   testutil.LaunchID,
+			Count:      150,
+			RequestIDs: ids[:MaxListedPendingRequests],
+		}, err)
+		require.EqualError(t, err, "chain 1 has 150 pending request(s): 1, 2, 3, 4, 5, 6, 7, 8, 9, 10 and 140 more")
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("ignore pending requests", func(t *testing.T) {
+		var (
+			suite, network = newLaunchSuite(t)
+			ev             = events.NewBus(events.WithCustomBufferSize(10))
+		)
+		CollectEvents(ev)(&network)
+		mockPendingRequests(suite, pending(1, 2, 3)...)
+		mockTriggerLaunch(suite)
+
+		err := network.TriggerLaunch(context.Background(), testutil.LaunchID, launchTime, WithIgnorePendingRequests())
+		require.NoError(t, err)
+		suite.AssertAllMocks(t)
+
+		ev.Shutdown(context.Background())
+		var warnings []string
+		for e := range ev.Events() {
+			if e.Status == events.StatusWarning {
+				warnings = append(warnings, e.Description)
+			}
+		}
+		require.Equal(t, []string{"Chain 1 has 3 pending request(s), they will be ignored by the launched chain"}, warnings)
+	})
+}
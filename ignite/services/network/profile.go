@@ -88,7 +88,7 @@ func (n Network) Balances(ctx context.Context, address string) (sdk.Coins, error
 
 // Profile returns the address profile info
 func (n Network) Profile(ctx context.Context, campaignID uint64) (networktypes.Profile, error) {
-	address, err := n.account.Address(networktypes.SPN)
+	address, err := n.accountAddress()
 	if err != nil {
 		return networktypes.Profile{}, err
 	}
@@ -2,9 +2,9 @@ package network
 
 import (
 	"context"
-	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
 	campaigntypes "github.com/tendermint/spn/x/campaign/types"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
 	profiletypes "github.com/tendermint/spn/x/profile/types"
@@ -12,19 +12,23 @@ import (
 	"github.com/ignite/cli/ignite/pkg/cosmosutil"
 	"github.com/ignite/cli/ignite/pkg/events"
 	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/version"
 )
 
 // publishOptions holds info about how to create a chain.
 type publishOptions struct {
-	genesisURL       string
-	chainID          string
-	campaignID       uint64
-	noCheck          bool
-	metadata         string
-	totalSupply      sdk.Coins
-	sharePercentages SharePercents
-	mainnet          bool
-	accountBalance   sdk.Coins
+	genesisURL        string
+	chainID           string
+	campaignID        uint64
+	noCheck           bool
+	metadata          string
+	totalSupply       sdk.Coins
+	sharePercentages  SharePercents
+	mainnet           bool
+	accountBalance    sdk.Coins
+	chainMetadata     networktypes.ChainMetadata
+	signaturePath     string
+	genesisMirrorList string
 }
 
 // PublishOption configures chain creation.
@@ -86,6 +90,31 @@ func WithAccountBalance(accountBalance sdk.Coins) PublishOption {
 	}
 }
 
+// WithChainMetadata provides the metadata of the published chain, the schema version and
+// the version of the CLI are set by Publish.
+func WithChainMetadata(metadata networktypes.ChainMetadata) PublishOption {
+	return func(c *publishOptions) {
+		c.chainMetadata = metadata
+	}
+}
+
+// WithGenesisSignature signs the hash of the custom genesis with the network account and writes the detached
+// signature to the path, the coordinator publishes it next to the genesis at networktypes.GenesisSignatureURL.
+// The genesis is marked as signed in the chain metadata.
+func WithGenesisSignature(path string) PublishOption {
+	return func(c *publishOptions) {
+		c.signaturePath = path
+	}
+}
+
+// WithGenesisMirrorList publishes the URL of the list of the mirrors of the custom genesis in the chain metadata,
+// the validators fall back to the mirrors when the genesis URL can't be fetched.
+func WithGenesisMirrorList(url string) PublishOption {
+	return func(c *publishOptions) {
+		c.genesisMirrorList = url
+	}
+}
+
 // Mainnet initialize a published chain into the mainnet
 func Mainnet() PublishOption {
 	return func(o *publishOptions) {
@@ -95,11 +124,39 @@ func Mainnet() PublishOption {
 
 // Publish submits Genesis to SPN to announce a new network.
 func (n Network) Publish(ctx context.Context, c Chain, options ...PublishOption) (launchID, campaignID uint64, err error) {
+	if !n.CanSign() {
+		return 0, 0, ErrNoSigningAccount
+	}
+
 	o := publishOptions{}
 	for _, apply := range options {
 		apply(&o)
 	}
 
+	// check the denoms before any expensive work, invalid denoms are rejected by SPN
+	if err := networktypes.ValidateCoinsDenom(o.totalSupply); err != nil {
+		return 0, 0, errors.Wrap(err, "invalid total supply")
+	}
+	if err := networktypes.ValidateCoinsDenom(o.accountBalance); err != nil {
+		return 0, 0, errors.Wrap(err, "invalid account balance")
+	}
+
+	if o.signaturePath != "" && o.genesisURL == "" {
+		return 0, 0, errors.New("only the hash of a custom genesis can be signed")
+	}
+	if o.genesisMirrorList != "" && o.genesisURL == "" {
+		return 0, 0, errors.New("only a custom genesis can have mirrors")
+	}
+
+	// the metadata is validated before any transaction, its size is limited on SPN
+	chainMetadata := o.chainMetadata
+	chainMetadata.Version = networktypes.ChainMetadataVersion
+	chainMetadata.CLIVersion = version.Version
+	metadata, err := chainMetadata.Bytes()
+	if err != nil {
+		return 0, 0, err
+	}
+
 	var (
 		genesisHash string
 		genesisFile []byte
@@ -116,6 +173,28 @@ func (n Network) Publish(ctx context.Context, c Chain, options ...PublishOption)
 		if err != nil {
 			return 0, 0, err
 		}
+		if bondDenom := genesis.AppState.Staking.Params.BondDenom; bondDenom != "" {
+			if err := networktypes.ValidateDenom(bondDenom); err != nil {
+				return 0, 0, errors.Wrap(err, "invalid genesis staking denom")
+			}
+		}
+	}
+
+	// the URL and the hash of the genesis are published in the initial genesis of the chain,
+	// the metadata only marks the genesis as signed and points to the list of its mirrors
+	if o.signaturePath != "" || o.genesisMirrorList != "" {
+		chainMetadata.Genesis = &networktypes.GenesisMetadata{
+			Signed:     o.signaturePath != "",
+			MirrorList: o.genesisMirrorList,
+		}
+		if metadata, err = chainMetadata.Bytes(); err != nil {
+			return 0, 0, err
+		}
+	}
+	if o.signaturePath != "" {
+		if err := n.writeGenesisSignature(genesisHash, o.signaturePath); err != nil {
+			return 0, 0, err
+		}
 	}
 
 	chainID := genesis.ChainID
@@ -131,13 +210,17 @@ func (n Network) Publish(ctx context.Context, c Chain, options ...PublishOption)
 		}
 	}
 
-	coordinatorAddress, err := n.account.Address(networktypes.SPN)
+	if err := networktypes.ValidateChainID(chainID); err != nil {
+		return 0, 0, err
+	}
+
+	coordinatorAddress, err := n.accountAddress()
 	if err != nil {
 		return 0, 0, err
 	}
 	campaignID = o.campaignID
 
-	n.ev.Send(events.New(events.StatusOngoing, "Publishing the network"))
+	n.ev.Send(events.New(events.StatusOngoing, events.Message(networktypes.MsgPublishingChain, nil)))
 
 	// a coordinator profile is necessary to publish a chain
 	// if the user doesn't have an associated coordinator profile, we create one
@@ -148,7 +231,7 @@ func (n Network) Publish(ctx context.Context, c Chain, options ...PublishOption)
 			"",
 			"",
 		)
-		if _, err := n.cosmos.BroadcastTx(ctx, n.account, msgCreateCoordinator); err != nil {
+		if _, err := n.broadcastTx(ctx, msgCreateCoordinator); err != nil {
 			return 0, 0, err
 		}
 	} else if err != nil {
@@ -192,7 +275,7 @@ func (n Network) Publish(ctx context.Context, c Chain, options ...PublishOption)
 		// It is better to send multiple message in a single tx too.
 		// consider ways to refactor to accomplish a better API and efficiency.
 
-		addr, err := n.account.Address(networktypes.SPN)
+		addr, err := n.accountAddress()
 		if err != nil {
 			return 0, 0, err
 		}
@@ -202,7 +285,7 @@ func (n Network) Publish(ctx context.Context, c Chain, options ...PublishOption)
 			campaignID,
 			campaigntypes.NewSharesFromCoins(sdk.NewCoins(coins...)),
 		)
-		_, err = n.cosmos.BroadcastTx(ctx, n.account, msgMintVouchers)
+		_, err = n.broadcastTx(ctx, msgMintVouchers)
 		if err != nil {
 			return 0, 0, err
 		}
@@ -215,7 +298,7 @@ func (n Network) Publish(ctx context.Context, c Chain, options ...PublishOption)
 			return 0, 0, err
 		}
 	} else {
-		addr, err := n.account.Address(networktypes.SPN)
+		addr, err := n.accountAddress()
 		if err != nil {
 			return 0, 0, err
 		}
@@ -238,9 +321,9 @@ func (n Network) Publish(ctx context.Context, c Chain, options ...PublishOption)
 			campaignID != 0,
 			campaignID,
 			o.accountBalance,
-			nil,
+			metadata,
 		)
-		res, err := n.cosmos.BroadcastTx(ctx, n.account, msgCreateChain)
+		res, err := n.broadcastTx(ctx, msgCreateChain)
 		if err != nil {
 			return 0, 0, err
 		}
@@ -258,11 +341,15 @@ func (n Network) Publish(ctx context.Context, c Chain, options ...PublishOption)
 }
 
 func (n Network) SendAccountRequestForCoordinator(ctx context.Context, launchID uint64, amount sdk.Coins) error {
-	addr, err := n.account.Address(networktypes.SPN)
+	addr, err := n.accountAddress()
 	if err != nil {
 		return err
 	}
 
+	if err := n.checkRequestQuota(ctx, launchID, 1); err != nil {
+		return err
+	}
+
 	return n.sendAccountRequest(ctx, launchID, addr, amount)
 }
 
@@ -273,7 +360,7 @@ func (n Network) sendAccountRequest(
 	address string,
 	amount sdk.Coins,
 ) error {
-	addr, err := n.account.Address(networktypes.SPN)
+	addr, err := n.accountAddress()
 	if err != nil {
 		return err
 	}
@@ -288,10 +375,10 @@ func (n Network) sendAccountRequest(
 		),
 	)
 
-	n.ev.Send(events.New(events.StatusOngoing, "Broadcasting account transactions"))
-	res, err := n.cosmos.BroadcastTx(ctx, n.account, msg)
+	n.ev.Send(events.New(events.StatusOngoing, events.Message(networktypes.MsgBroadcastingAccount, nil)))
+	res, err := n.broadcastTx(ctx, msg)
 	if err != nil {
-		return err
+		return n.requestBroadcastError(ctx, launchID, 1, err)
 	}
 
 	var requestRes launchtypes.MsgSendRequestResponse
@@ -300,12 +387,11 @@ func (n Network) sendAccountRequest(
 	}
 
 	if requestRes.AutoApproved {
-		n.ev.Send(events.New(events.StatusDone, "Account added to the network by the coordinator!"))
+		n.ev.Send(events.New(events.StatusDone, events.Message(networktypes.MsgAccountAdded, nil)))
 	} else {
-		n.ev.Send(events.New(events.StatusDone,
-			fmt.Sprintf("Request %d to add account to the network has been submitted!",
-				requestRes.RequestID),
-		))
+		n.ev.Send(events.New(events.StatusDone, events.Message(networktypes.MsgAccountRequestSubmitted, events.Params{
+			"RequestID": requestRes.RequestID,
+		})))
 	}
 	return nil
 }
@@ -19,8 +19,12 @@ import (
 	"github.com/ignite/cli/ignite/pkg/cosmosutil"
 	"github.com/ignite/cli/ignite/services/network/networktypes"
 	"github.com/ignite/cli/ignite/services/network/testutil"
+	"github.com/ignite/cli/ignite/version"
 )
 
+// publishedMetadata is the metadata of the chains published by the tests.
+var publishedMetadata = []byte(`{"version":1,"cli_version":"` + version.Version + `"}`)
+
 func startGenesisTestServer(genesis cosmosutil.ChainGenesis) *httptest.Server {
 	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		encodedGenesis, _ := json.Marshal(genesis)
@@ -72,6 +76,7 @@ func TestPublish(t *testing.T) {
 					InitialGenesis: launchtypes.NewDefaultInitialGenesis(),
 					HasCampaign:    false,
 					CampaignID:     0,
+					Metadata:       publishedMetadata,
 				},
 			).
 			Return(testutil.NewResponse(&launchtypes.MsgCreateChainResponse{
@@ -131,6 +136,7 @@ func TestPublish(t *testing.T) {
 					HasCampaign:    false,
 					CampaignID:     0,
 					AccountBalance: accountBalance,
+					Metadata:       publishedMetadata,
 				},
 			).
 			Return(testutil.NewResponse(&launchtypes.MsgCreateChainResponse{
@@ -200,6 +206,7 @@ func TestPublish(t *testing.T) {
 					InitialGenesis: launchtypes.NewDefaultInitialGenesis(),
 					HasCampaign:    true,
 					CampaignID:     testutil.CampaignID,
+					Metadata:       publishedMetadata,
 				},
 			).
 			Return(testutil.NewResponse(&launchtypes.MsgCreateChainResponse{
@@ -288,6 +295,7 @@ func TestPublish(t *testing.T) {
 					InitialGenesis: launchtypes.NewDefaultInitialGenesis(),
 					HasCampaign:    true,
 					CampaignID:     testutil.CampaignID,
+					Metadata:       publishedMetadata,
 				},
 			).
 			Return(testutil.NewResponse(&launchtypes.MsgCreateChainResponse{
@@ -315,7 +323,7 @@ func TestPublish(t *testing.T) {
 		var (
 			account              = testutil.NewTestAccount(t, testutil.TestAccountName)
 			customGenesisChainID = "test-custom-1"
-			customGenesisHash    = "61da86775013bd18d6a019b533eedf1304b778fe8005090a0a0223720adfd8eb"
+			customGenesisHash    = "f61230c779c450378bff942e9c1f7f69133f9dc2ad3183e754a9a1d655519d93"
 			gts                  = startGenesisTestServer(cosmosutil.ChainGenesis{ChainID: customGenesisChainID})
 			suite, network       = newSuite(account)
 		)
@@ -355,6 +363,7 @@ func TestPublish(t *testing.T) {
 					),
 					HasCampaign: false,
 					CampaignID:  0,
+					Metadata:    publishedMetadata,
 				},
 			).
 			Return(testutil.NewResponse(&launchtypes.MsgCreateChainResponse{
@@ -409,6 +418,7 @@ func TestPublish(t *testing.T) {
 					InitialGenesis: launchtypes.NewDefaultInitialGenesis(),
 					HasCampaign:    false,
 					CampaignID:     0,
+					Metadata:       publishedMetadata,
 				},
 			).
 			Return(testutil.NewResponse(&launchtypes.MsgCreateChainResponse{
@@ -566,18 +576,16 @@ func TestPublish(t *testing.T) {
 		suite.AssertAllMocks(t)
 	})
 
-	t.Run("failed to publish chain with custom genesis, failed to parse custom genesis", func(t *testing.T) {
+	t.Run("failed to publish chain with custom genesis, custom genesis is not JSON", func(t *testing.T) {
 		var (
 			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
 			suite, network = newSuite(account)
 			gts            = startInvalidJSONServer()
-			expectedError  = errors.New("cannot unmarshal the chain genesis file: invalid character 'i' looking for beginning of value")
 		)
 		defer gts.Close()
 
 		_, _, publishError := network.Publish(context.Background(), suite.ChainMock, WithCustomGenesis(gts.URL))
-		require.Error(t, publishError)
-		require.Equal(t, expectedError.Error(), publishError.Error())
+		require.Equal(t, cosmosutil.ErrGenesisNotJSON{URL: gts.URL}, publishError)
 		suite.AssertAllMocks(t)
 	})
 
@@ -609,6 +617,7 @@ func TestPublish(t *testing.T) {
 					InitialGenesis: launchtypes.NewDefaultInitialGenesis(),
 					HasCampaign:    false,
 					CampaignID:     0,
+					Metadata:       publishedMetadata,
 				},
 			).
 			Return(testutil.NewResponse(&launchtypes.MsgCreateChainResponse{
@@ -680,6 +689,32 @@ func TestPublish(t *testing.T) {
 		suite.AssertAllMocks(t)
 	})
 
+	t.Run("failed to publish chain, invalid chain id", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+		)
+
+		_, _, publishError := network.Publish(context.Background(), suite.ChainMock, WithChainID("foo bar-1"))
+		require.EqualError(t, publishError, `chain ID foo bar-1 contains the invalid character ' ' at position 3, only letters, digits, '.', '_' and '-' are allowed`)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to publish chain, invalid account balance denom", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+		)
+
+		_, _, publishError := network.Publish(
+			context.Background(),
+			suite.ChainMock,
+			WithAccountBalance(sdk.NewCoins(sdk.NewInt64Coin("ibc/foo", 1000))),
+		)
+		require.EqualError(t, publishError, "invalid account balance: IBC denom ibc/foo must have the format ibc/<hash> with a 64 characters hex hash")
+		suite.AssertAllMocks(t)
+	})
+
 	t.Run("failed to publish chain, failed to fetch existed campaign", func(t *testing.T) {
 		var (
 			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
@@ -756,6 +791,7 @@ func TestPublish(t *testing.T) {
 					InitialGenesis: launchtypes.NewDefaultInitialGenesis(),
 					HasCampaign:    false,
 					CampaignID:     0,
+					Metadata:       publishedMetadata,
 				},
 			).
 			Return(testutil.NewResponse(&launchtypes.MsgCreateChainResponse{
@@ -810,6 +846,7 @@ func TestPublish(t *testing.T) {
 					InitialGenesis: launchtypes.NewDefaultInitialGenesis(),
 					HasCampaign:    false,
 					CampaignID:     0,
+					Metadata:       publishedMetadata,
 				},
 			).
 			Return(testutil.NewResponse(&launchtypes.MsgCreateChainResponse{
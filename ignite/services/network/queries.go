@@ -11,6 +11,7 @@ import (
 	"github.com/pkg/errors"
 	campaigntypes "github.com/tendermint/spn/x/campaign/types"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
 	rewardtypes "github.com/tendermint/spn/x/reward/types"
 	"golang.org/x/sync/errgroup"
 
@@ -22,21 +23,38 @@ import (
 // ErrObjectNotFound is returned when the query returns a not found error.
 var ErrObjectNotFound = errors.New("query object not found")
 
+// ErrNoSigningAccount is returned when a state-changing operation is performed without signing account.
+var ErrNoSigningAccount = errors.New("no signing account configured")
+
 // ChainLaunch fetches the chain launch from Network by launch id.
 func (n Network) ChainLaunch(ctx context.Context, id uint64) (networktypes.ChainLaunch, error) {
 	n.ev.Send(events.New(events.StatusOngoing, "Fetching chain information"))
 
-	res, err := n.launchQuery.
-		Chain(ctx,
-			&launchtypes.QueryGetChainRequest{
-				LaunchID: id,
-			},
-		)
+	res, err := cachedQuery(ctx, n, fmt.Sprintf("chain/%d", id), func(ctx context.Context) (*launchtypes.QueryGetChainResponse, error) {
+		return n.launchQuery.
+			Chain(ctx,
+				&launchtypes.QueryGetChainRequest{
+					LaunchID: id,
+				},
+			)
+	})
 	if err != nil {
 		return networktypes.ChainLaunch{}, err
 	}
 
-	return networktypes.ToChainLaunch(res.Chain), nil
+	launch := networktypes.ToChainLaunch(res.Chain)
+
+	// the signature of the genesis hash is verified against the address of the coordinator
+	if launch.Metadata != nil && launch.Metadata.Genesis.IsSigned() {
+		coordinatorRes, err := n.profileQuery.Coordinator(ctx, &profiletypes.QueryGetCoordinatorRequest{
+			CoordinatorID: res.Chain.CoordinatorID,
+		})
+		if err != nil {
+			return networktypes.ChainLaunch{}, err
+		}
+		launch.CoordinatorAddress = coordinatorRes.Coordinator.Address
+	}
+	return launch, nil
 }
 
 // ChainLaunchesWithReward fetches the chain launches with rewards from Network
@@ -52,7 +70,7 @@ func (n Network) ChainLaunchesWithReward(ctx context.Context, pagination *query.
 		return nil, err
 	}
 
-	n.ev.Send(events.New(events.StatusOngoing, "Fetching reward information"))
+	progress := n.ev.StartProgress("Fetching reward information", len(res.Chain))
 	var chainLaunches []networktypes.ChainLaunch
 	var mu sync.Mutex
 
@@ -69,12 +87,14 @@ func (n Network) ChainLaunchesWithReward(ctx context.Context, pagination *query.
 			mu.Lock()
 			chainLaunches = append(chainLaunches, chainLaunch)
 			mu.Unlock()
+			progress.Increment()
 			return nil
 		})
 	}
 	if err := g.Wait(); err != nil {
 		return nil, err
 	}
+	progress.Finish("Reward information fetched")
 	// sort filenames by launch id
 	sort.Slice(chainLaunches, func(i, j int) bool {
 		return chainLaunches[i].ID > chainLaunches[j].ID
@@ -83,23 +103,32 @@ func (n Network) ChainLaunchesWithReward(ctx context.Context, pagination *query.
 }
 
 // GenesisInformation returns all the information to construct the genesis from a chain ID
+// When the height pinning is enabled, the height of the information is set
 func (n Network) GenesisInformation(ctx context.Context, launchID uint64) (gi networktypes.GenesisInformation, err error) {
-	genAccs, err := n.GenesisAccounts(ctx, launchID)
-	if err != nil {
-		return gi, errors.Wrap(err, "error querying genesis accounts")
-	}
+	err = n.withPinnedHeight(ctx, func(ctx context.Context, height int64) error {
+		genAccs, err := n.GenesisAccounts(ctx, launchID)
+		if err != nil {
+			return errors.Wrap(err, "error querying genesis accounts")
+		}
 
-	vestingAccs, err := n.VestingAccounts(ctx, launchID)
-	if err != nil {
-		return gi, errors.Wrap(err, "error querying vesting accounts")
-	}
+		vestingAccs, err := n.VestingAccounts(ctx, launchID)
+		if err != nil {
+			return errors.Wrap(err, "error querying vesting accounts")
+		}
 
-	genVals, err := n.GenesisValidators(ctx, launchID)
+		genVals, err := n.GenesisValidators(ctx, launchID)
+		if err != nil {
+			return errors.Wrap(err, "error querying genesis validators")
+		}
+
+		gi = networktypes.NewGenesisInformation(genAccs, vestingAccs, genVals)
+		gi.Height = height
+		return nil
+	})
 	if err != nil {
-		return gi, errors.Wrap(err, "error querying genesis validators")
+		return networktypes.GenesisInformation{}, err
 	}
-
-	return networktypes.NewGenesisInformation(genAccs, vestingAccs, genVals), nil
+	return gi, nil
 }
 
 // GenesisAccounts returns the list of approved genesis accounts for a launch from SPN
@@ -0,0 +1,124 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+const (
+	// DefaultQueryCacheFreshness is the default number of blocks a cached query result is reused for.
+	DefaultQueryCacheFreshness = 10
+
+	// queryCacheNamespace is the namespace of the cached query results in the cache storage.
+	queryCacheNamespace = "network.query"
+)
+
+// ErrQueryCacheBroadcast is returned when a network with a query cache broadcasts a transaction.
+var ErrQueryCacheBroadcast = errors.New("the network caches its queries, it can't broadcast transactions")
+
+// queryCache caches the results of the read-only queries of an SPN endpoint.
+type queryCache struct {
+	storage   cache.Storage
+	endpoint  string
+	freshness int64
+}
+
+// cachedQueryResult is a query result cached with the height it was fetched at.
+type cachedQueryResult struct {
+	Height int64
+	Value  []byte
+}
+
+// protoResponse is a gRPC query response.
+type protoResponse interface {
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+}
+
+// WithQueryCache caches the results of the read-only queries of the chain launches, requests and launch params
+// in the storage, the results are reused within the windows of freshness blocks of the endpoint height.
+// A network with a query cache is read-only, it can't broadcast transactions from cached results.
+func WithQueryCache(storage cache.Storage, endpoint string, freshness int64) Option {
+	return func(n *Network) {
+		n.queryCache = &queryCache{
+			storage:   storage,
+			endpoint:  endpoint,
+			freshness: freshness,
+		}
+	}
+}
+
+// WithoutQueryCache returns a copy of the network querying SPN without the query cache,
+// it's used for the reads the correctness of a following operation depends on.
+func (n Network) WithoutQueryCache() Network {
+	n.queryCache = nil
+	return n
+}
+
+// window returns the first height of the freshness window of the height.
+func (c queryCache) window(height int64) int64 {
+	if c.freshness <= 0 {
+		return height
+	}
+	return height - height%c.freshness
+}
+
+// key returns the key of the query result cached for the freshness window starting at the height.
+func (c queryCache) key(query string, window int64) string {
+	return cache.Key(c.endpoint, "/", query, "@", fmt.Sprint(window))
+}
+
+// cachedQuery returns the result of the query cached in the freshness window of the endpoint height,
+// the result is fetched and cached otherwise. The cache errors are reported as debug events and never fail the query.
+func cachedQuery[T any, PT interface {
+	*T
+	protoResponse
+}](ctx context.Context, n Network, query string, fetch func(ctx context.Context) (PT, error)) (PT, error) {
+	if n.queryCache == nil {
+		return fetch(ctx)
+	}
+
+	status, err := n.cosmos.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	height := status.SyncInfo.LatestBlockHeight
+
+	var (
+		c      = cache.New[cachedQueryResult](n.queryCache.storage, queryCacheNamespace)
+		window = n.queryCache.window(height)
+		key    = n.queryCache.key(query, window)
+	)
+	cached, err := c.Get(key)
+	switch {
+	case err == nil && height >= cached.Height:
+		res := PT(new(T))
+		if err := res.Unmarshal(cached.Value); err == nil {
+			n.ev.Send(events.NewDebug(fmt.Sprintf("Query %s served from the cache of the height %d", query, cached.Height)))
+			return res, nil
+		}
+	case err != nil && err != cache.ErrorNotFound:
+		n.ev.Send(events.NewDebug(fmt.Sprintf("Cannot read the query cache: %s", err)))
+	}
+
+	res, err := fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	value, err := res.Marshal()
+	if err == nil {
+		err = c.Put(key, cachedQueryResult{Height: height, Value: value})
+	}
+	// the result of the previous window is not reused anymore
+	if err == nil && window > 0 {
+		err = c.Delete(n.queryCache.key(query, n.queryCache.window(window-1)))
+	}
+	if err != nil {
+		n.ev.Send(events.NewDebug(fmt.Sprintf("Cannot write the query cache: %s", err)))
+	}
+	return res, nil
+}
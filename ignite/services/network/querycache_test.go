@@ -0,0 +1,99 @@
+package network
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+func TestQueryCache(t *testing.T) {
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	newCachedSuite := func(t *testing.T) (testutil.Suite, Network) {
+		storage, err := cache.NewStorage(filepath.Join(t.TempDir(), "cache.db"))
+		require.NoError(t, err)
+		suite, network := newSuite(account)
+		WithQueryCache(storage, "http://spn:26657", 5)(&network)
+		return suite, network
+	}
+	mockHeight := func(suite testutil.Suite, height int64) {
+		suite.CosmosClientMock.
+			On("Status", mock.Anything).
+			Return(&ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{
+				LatestBlockHeight: height,
+				LatestBlockTime:   sampleTime,
+			}}, nil).
+			Once()
+	}
+	mockParams := func(suite testutil.Suite, params launchtypes.Params) {
+		suite.LaunchQueryMock.
+			On("Params", context.Background(), &launchtypes.QueryParamsRequest{}).
+			Return(&launchtypes.QueryParamsResponse{Params: params}, nil).
+			Once()
+	}
+
+	t.Run("reuse the results within the freshness window", func(t *testing.T) {
+		var (
+			suite, network = newCachedSuite(t)
+			params         = launchtypes.NewParams(TestMinRemainingTime, TestMaxRemainingTime, TestRevertDelay, nil, nil)
+			newParams      = launchtypes.DefaultParams()
+		)
+
+		mockHeight(suite, 11)
+		mockParams(suite, params)
+		mockHeight(suite, 14)
+		mockHeight(suite, 15)
+		mockParams(suite, newParams)
+		mockHeight(suite, 19)
+
+		// the result cached at the height 11 is fresh until the window of 5 blocks ends at the height 14
+		for _, want := range []launchtypes.Params{params, params, newParams, newParams} {
+			got, err := network.LaunchParams(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+		}
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("refuse to broadcast with the query cache", func(t *testing.T) {
+		suite, network := newCachedSuite(t)
+
+		_, err := network.broadcastTx(
+			context.Background(),
+			launchtypes.NewMsgTriggerLaunch(addr, testutil.LaunchID, sampleTime.Add(TestMaxRemainingTime)),
+		)
+		require.ErrorIs(t, err, ErrQueryCacheBroadcast)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("explicit bypass", func(t *testing.T) {
+		var (
+			suite, network = newCachedSuite(t)
+			launch         = launchtypes.Chain{LaunchID: testutil.LaunchID, GenesisChainID: testutil.ChainID}
+		)
+
+		suite.LaunchQueryMock.
+			On("Chain", context.Background(), &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}).
+			Return(&launchtypes.QueryGetChainResponse{Chain: launch}, nil).
+			Twice()
+		mockHeight(suite, 10)
+
+		for _, n := range []Network{network, network.WithoutQueryCache()} {
+			got, err := n.ChainLaunch(context.Background(), testutil.LaunchID)
+			require.NoError(t, err)
+			require.Equal(t, networktypes.ToChainLaunch(launch), got)
+		}
+		suite.AssertAllMocks(t)
+	})
+}
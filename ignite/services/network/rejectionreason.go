@@ -0,0 +1,168 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+const (
+	// RejectionReasonMemoPrefix prefixes the reason of a rejection in the memo of its settlement tx.
+	// The tx only settles the rejected request.
+	RejectionReasonMemoPrefix = "rejection reason: "
+
+	// MaxMemoLength is the default maximum size of the memo of a tx in the auth module.
+	MaxMemoLength = 256
+)
+
+// ErrMissingRejectionReason is returned when a request is rejected without reason.
+var ErrMissingRejectionReason = errors.New("a reason is required to reject a request")
+
+// ErrRejectionReasonTooLong is returned when the reason of a rejection doesn't fit in the memo of a tx.
+type ErrRejectionReasonTooLong struct {
+	RequestID uint64
+	Length    int
+	Max       int
+}
+
+// Error implements error.
+func (e ErrRejectionReasonTooLong) Error() string {
+	return fmt.Sprintf(
+		"the rejection reason of the request %d is %d bytes, the maximum size of a reason in a tx memo is %d bytes",
+		e.RequestID,
+		e.Length,
+		e.Max,
+	)
+}
+
+// RejectionReasonMemo returns the memo of the settlement tx of a rejection with the reason.
+func RejectionReasonMemo(reason string) string {
+	return RejectionReasonMemoPrefix + reason
+}
+
+// checkRejectionReasons checks each rejection of the reviewals has a reason.
+func checkRejectionReasons(reviewals []Reviewal) error {
+	for _, reviewal := range reviewals {
+		if !reviewal.IsApproved && strings.TrimSpace(reviewal.Reason) == "" {
+			return errors.Wrapf(ErrMissingRejectionReason, "request %d", reviewal.RequestID)
+		}
+	}
+	return nil
+}
+
+// rejectionReasonMemos returns the memos of the settlement txs of the rejections of the reviewals by request ID.
+// The reasons accumulate with the rejections, they are written in the memos rather than in the chain metadata
+// whose size is limited on SPN. The size of all the memos is checked before returning them.
+func rejectionReasonMemos(reviewals []Reviewal) (map[uint64]string, error) {
+	memos := make(map[uint64]string)
+	for _, reviewal := range reviewals {
+		if reviewal.IsApproved {
+			continue
+		}
+		reason := strings.TrimSpace(reviewal.Reason)
+		memo := RejectionReasonMemo(reason)
+		if len(memo) > MaxMemoLength {
+			return nil, ErrRejectionReasonTooLong{
+				RequestID: reviewal.RequestID,
+				Length:    len(reason),
+				Max:       MaxMemoLength - len(RejectionReasonMemoPrefix),
+			}
+		}
+		memos[reviewal.RequestID] = memo
+	}
+	return memos, nil
+}
+
+// RejectionReasons returns the reasons of the rejected requests by request ID, the reasons are read from the
+// memos of their settlement txs. The requests rejected without reason have no entry. When the settlement txs
+// can't be searched, the error is reported as a warning and no reason is returned.
+func (n Network) RejectionReasons(
+	ctx context.Context,
+	launchID uint64,
+	requests ...networktypes.Request,
+) (map[uint64]string, error) {
+	var rejected []uint64
+	for _, request := range requests {
+		if request.Status == launchtypes.Request_REJECTED.String() {
+			rejected = append(rejected, request.RequestID)
+		}
+	}
+	if len(rejected) == 0 {
+		return nil, nil
+	}
+
+	memoReasons, err := n.memoRejectionReasons(ctx, launchID)
+	if err != nil {
+		n.ev.Send(events.NewWarning(fmt.Sprintf("The rejection reasons in the tx memos can't be read: %s", err)))
+		return nil, nil
+	}
+	reasons := make(map[uint64]string)
+	for _, requestID := range rejected {
+		if reason, ok := memoReasons[requestID]; ok {
+			reasons[requestID] = reason
+		}
+	}
+	return reasons, nil
+}
+
+// memoRejectionReasons returns the reasons of the rejections in the memos of the settlement txs of the launch
+// by request ID.
+func (n Network) memoRejectionReasons(ctx context.Context, launchID uint64) (map[uint64]string, error) {
+	var (
+		settledEvent = proto.MessageName(&launchtypes.EventRequestSettled{})
+		query        = fmt.Sprintf("%s.launchID='\"%d\"'", settledEvent, launchID)
+	)
+	txs, err := n.cosmos.SearchTxs(ctx, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot fetch the request settlements")
+	}
+
+	reasons := make(map[uint64]string)
+	for _, tx := range txs {
+		memo, err := txMemo(tx.Tx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid settlement tx %s", tx.Hash)
+		}
+		if !strings.HasPrefix(memo, RejectionReasonMemoPrefix) {
+			continue
+		}
+		reason := strings.TrimPrefix(memo, RejectionReasonMemoPrefix)
+
+		for _, event := range tx.TxResult.Events {
+			if event.Type != settledEvent {
+				continue
+			}
+			msg, err := sdk.ParseTypedEvent(event)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid request settlement in tx %s", tx.Hash)
+			}
+			settled, ok := msg.(*launchtypes.EventRequestSettled)
+			if ok && settled.LaunchID == launchID && !settled.Approved {
+				reasons[settled.RequestID] = reason
+			}
+		}
+	}
+	return reasons, nil
+}
+
+// txMemo returns the memo of the protobuf encoded tx, the messages of the tx are not decoded.
+func txMemo(tx []byte) (string, error) {
+	var raw txtypes.TxRaw
+	if err := raw.Unmarshal(tx); err != nil {
+		return "", err
+	}
+	var body txtypes.TxBody
+	if err := body.Unmarshal(raw.BodyBytes); err != nil {
+		return "", err
+	}
+	return body.Memo, nil
+}
@@ -0,0 +1,152 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+// memoTx returns a settlement tx with the memo.
+func memoTx(t *testing.T, memo string, settled ...*launchtypes.EventRequestSettled) *ctypes.ResultTx {
+	body, err := (&txtypes.TxBody{Memo: memo}).Marshal()
+	require.NoError(t, err)
+	tx, err := (&txtypes.TxRaw{BodyBytes: body}).Marshal()
+	require.NoError(t, err)
+
+	res := settlementTx(t, 10, settled...)
+	res.Tx = tx
+	return res
+}
+
+func TestRejectionReasonInMemo(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+	)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	mockCoordinator(t, suite, account)
+
+	// the approvals are batched, each rejection is settled in a tx with its reason in the memo
+	suite.CosmosClientMock.
+		On("BroadcastTx", context.Background(), account, launchtypes.NewMsgSettleRequest(addr, testutil.LaunchID, 1, true)).
+		Return(testutil.NewResponse(&launchtypes.MsgSettleRequestResponse{}), nil).
+		Once()
+	suite.CosmosClientMock.
+		On(
+			"BroadcastTxWithMemo",
+			context.Background(),
+			account,
+			"rejection reason: duplicate",
+			launchtypes.NewMsgSettleRequest(addr, testutil.LaunchID, 2, false),
+		).
+		Return(testutil.NewResponse(&launchtypes.MsgSettleRequestResponse{}), nil).
+		Once()
+
+	err = network.SubmitRequest(
+		context.Background(),
+		testutil.LaunchID,
+		ApproveRequest(1),
+		RejectRequest(2, "duplicate"),
+	)
+	require.NoError(t, err)
+
+	// the reasons are parsed back from the memos of the settlement txs
+	suite.CosmosClientMock.
+		On("SearchTxs", mock.Anything, settledRequestsQuery).
+		Return([]*ctypes.ResultTx{
+			memoTx(t, "", &launchtypes.EventRequestSettled{LaunchID: testutil.LaunchID, RequestID: 1, Approved: true}),
+			memoTx(t, "rejection reason: duplicate", &launchtypes.EventRequestSettled{LaunchID: testutil.LaunchID, RequestID: 2}),
+			memoTx(t, "unrelated memo", &launchtypes.EventRequestSettled{LaunchID: testutil.LaunchID, RequestID: 3}),
+		}, nil).
+		Once()
+
+	reasons, err := network.RejectionReasons(
+		context.Background(),
+		testutil.LaunchID,
+		networktypes.Request{RequestID: 1, Status: launchtypes.Request_APPROVED.String()},
+		networktypes.Request{RequestID: 2, Status: launchtypes.Request_REJECTED.String()},
+		networktypes.Request{RequestID: 3, Status: launchtypes.Request_REJECTED.String()},
+	)
+	require.NoError(t, err)
+	require.Equal(t, map[uint64]string{2: "duplicate"}, reasons)
+	suite.AssertAllMocks(t)
+}
+
+func TestRejectionReasonErrors(t *testing.T) {
+	t.Run("reason exceeding the memo limit", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+			reason         = strings.Repeat("a", MaxMemoLength)
+		)
+
+		// nothing is queried or broadcasted
+		err := network.SubmitRequest(
+			context.Background(),
+			testutil.LaunchID,
+			ApproveRequest(1),
+			RejectRequest(2, reason),
+		)
+		require.Equal(t, ErrRejectionReasonTooLong{
+			RequestID: 2,
+			Length:    MaxMemoLength,
+			Max:       MaxMemoLength - len(RejectionReasonMemoPrefix),
+		}, err)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("rejection without reason", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+		)
+
+		err := network.SubmitRequest(context.Background(), testutil.LaunchID, RejectRequest(1, " "))
+		require.ErrorIs(t, err, ErrMissingRejectionReason)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("tx search failure", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+		)
+		suite.CosmosClientMock.
+			On("SearchTxs", mock.Anything, settledRequestsQuery).
+			Return(nil, errors.New("tx indexing is disabled")).
+			Once()
+
+		// the error is only reported
+		reasons, err := network.RejectionReasons(
+			context.Background(),
+			testutil.LaunchID,
+			networktypes.Request{RequestID: 1, Status: launchtypes.Request_REJECTED.String()},
+			networktypes.Request{RequestID: 2, Status: launchtypes.Request_REJECTED.String()},
+		)
+		require.NoError(t, err)
+		require.Empty(t, reasons)
+		suite.AssertAllMocks(t)
+	})
+}
+
+func TestTxMemo(t *testing.T) {
+	tx := memoTx(t, "rejection reason: spam")
+	memo, err := txMemo(tx.Tx)
+	require.NoError(t, err)
+	require.Equal(t, "rejection reason: spam", memo)
+
+	_, err = txMemo([]byte("invalid"))
+	require.Error(t, err)
+}
@@ -0,0 +1,294 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmoserror"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/version"
+)
+
+type relaunchOptions struct {
+	chainID string
+	optOut  map[string]bool
+}
+
+// RelaunchOption configures a relaunch.
+type RelaunchOption func(*relaunchOptions)
+
+// RelaunchChainID sets the chain ID of the relaunched chain, the chain ID of the reverted launch by default.
+// The gentxs are signed for a chain ID, the validator requests are not copied when the chain ID changes.
+func RelaunchChainID(chainID string) RelaunchOption {
+	return func(o *relaunchOptions) {
+		o.chainID = chainID
+	}
+}
+
+// RelaunchWithoutValidators doesn't copy the requests of the validators that opted out of the relaunch,
+// the validators are identified by their SPN address.
+func RelaunchWithoutValidators(addresses ...string) RelaunchOption {
+	return func(o *relaunchOptions) {
+		for _, addr := range addresses {
+			o.optOut[addr] = true
+		}
+	}
+}
+
+// Relaunched is a chain relaunched from a reverted launch.
+type Relaunched struct {
+	LaunchID uint64
+	ChainID  string
+
+	// RequestIDs maps the IDs of the approved requests of the reverted launch to the IDs of their copies.
+	RequestIDs map[uint64]uint64
+
+	// Skipped maps the IDs of the approved requests of the reverted launch not copied to the reason.
+	Skipped map[uint64]string
+}
+
+// Relaunch publishes a new chain for a reverted launch and copies the approved requests of the reverted launch
+// into it, the validators don't have to send their requests again. SPN keeps the genesis of a reverted launch,
+// Relaunch is used when the chain must be launched with a new launch ID.
+// The requests are sent by the coordinator and approved, the requests of the validators that opted out,
+// the requests cancelled by an approved removal and the requests conflicting with an older request are skipped.
+func (n Network) Relaunch(ctx context.Context, oldLaunchID uint64, options ...RelaunchOption) (Relaunched, error) {
+	o := relaunchOptions{optOut: make(map[string]bool)}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	addr, err := n.accountAddress()
+	if err != nil {
+		return Relaunched{}, err
+	}
+
+	if err := n.checkCoordinator(ctx, oldLaunchID); err != nil {
+		return Relaunched{}, err
+	}
+
+	res, err := n.launchQuery.Chain(ctx, &launchtypes.QueryGetChainRequest{LaunchID: oldLaunchID})
+	if cosmoserror.Unwrap(err) == cosmoserror.ErrNotFound {
+		return Relaunched{}, ErrObjectNotFound
+	} else if err != nil {
+		return Relaunched{}, err
+	}
+	old := res.Chain
+	if old.LaunchTriggered {
+		return Relaunched{}, fmt.Errorf("the launch of the chain %d is triggered, it must be reverted to be relaunched", oldLaunchID)
+	}
+	if old.IsMainnet {
+		return Relaunched{}, fmt.Errorf("the chain %d is a mainnet and can't be relaunched", oldLaunchID)
+	}
+
+	oldChainID := networktypes.ToChainLaunch(old).ChainID
+	relaunched := Relaunched{
+		ChainID:    oldChainID,
+		RequestIDs: make(map[uint64]uint64),
+		Skipped:    make(map[uint64]string),
+	}
+	if o.chainID != "" {
+		relaunched.ChainID = o.chainID
+	}
+	if err := networktypes.ValidateChainID(relaunched.ChainID); err != nil {
+		return Relaunched{}, err
+	}
+	metadata, err := relaunchMetadata(old.Metadata)
+	if err != nil {
+		return Relaunched{}, err
+	}
+
+	requests, err := n.Requests(ctx, oldLaunchID)
+	if err != nil {
+		return Relaunched{}, err
+	}
+	copied := relaunchRequests(requests, o.optOut, relaunched.Skipped)
+	if relaunched.ChainID != oldChainID {
+		var kept []networktypes.Request
+		for _, request := range copied {
+			if _, ok := request.Content.Content.(*launchtypes.RequestContent_GenesisValidator); ok {
+				relaunched.Skipped[request.RequestID] = fmt.Sprintf("the gentx is signed for the chain ID %s", oldChainID)
+				continue
+			}
+			kept = append(kept, request)
+		}
+		copied = kept
+	}
+
+	n.ev.Send(events.NewOngoing(fmt.Sprintf("Publishing the relaunch of the chain %d", oldLaunchID)))
+
+	createRes, err := n.broadcastTx(ctx, launchtypes.NewMsgCreateChain(
+		addr,
+		relaunched.ChainID,
+		old.SourceURL,
+		old.SourceHash,
+		old.InitialGenesis,
+		old.HasCampaign,
+		old.CampaignID,
+		old.AccountBalance,
+		metadata,
+	))
+	if err != nil {
+		return Relaunched{}, err
+	}
+	var createChainRes launchtypes.MsgCreateChainResponse
+	if err := createRes.Decode(&createChainRes); err != nil {
+		return Relaunched{}, err
+	}
+	relaunched.LaunchID = createChainRes.LaunchID
+
+	n.ev.Send(events.NewDone(
+		fmt.Sprintf("Chain %d relaunched as the chain %d with the chain ID %s", oldLaunchID, relaunched.LaunchID, relaunched.ChainID),
+		"",
+	))
+
+	if len(copied) == 0 {
+		return relaunched, nil
+	}
+
+	contents := make([]launchtypes.RequestContent, len(copied))
+	for i, request := range copied {
+		contents[i] = relaunchContent(relaunched.LaunchID, request.Content)
+	}
+	sent, err := n.SendRequests(ctx, relaunched.LaunchID, contents...)
+	for i, requestID := range sent.RequestIDs {
+		relaunched.RequestIDs[copied[i].RequestID] = requestID
+	}
+	if err != nil {
+		return relaunched, err
+	}
+	for _, request := range copied[len(sent.RequestIDs):] {
+		relaunched.Skipped[request.RequestID] = "the account can't pay the request fee"
+	}
+
+	// the requests of the coordinator are approved when sent, the other requests are settled
+	autoApproved := make(map[uint64]bool)
+	for _, requestID := range sent.AutoApproved {
+		autoApproved[requestID] = true
+	}
+	var reviewals []Reviewal
+	for _, requestID := range sent.RequestIDs {
+		if !autoApproved[requestID] {
+			reviewals = append(reviewals, ApproveRequest(requestID))
+		}
+	}
+	if len(reviewals) > 0 {
+		if err := n.SubmitRequest(ctx, relaunched.LaunchID, reviewals...); err != nil {
+			return relaunched, errors.Wrap(err, "cannot approve the copied requests")
+		}
+	}
+
+	return relaunched, nil
+}
+
+// relaunchMetadata returns the metadata of the relaunched chain from the metadata of the reverted chain,
+// the chain ID and the launch notice of the reverted chain are not kept.
+func relaunchMetadata(metadata []byte) ([]byte, error) {
+	m, err := networktypes.ParseChainMetadata(metadata)
+	if err != nil {
+		return nil, err
+	}
+	if m.IsLegacy() {
+		return m.Raw(), nil
+	}
+	m.Version = networktypes.ChainMetadataVersion
+	m.CLIVersion = version.Version
+	m.ChainID = ""
+	m.LaunchNotice = nil
+	return m.Bytes()
+}
+
+// relaunchRequests returns the approved requests of the reverted chain to copy sorted by ID,
+// the IDs of the approved requests not copied are added to skipped with the reason.
+func relaunchRequests(requests []networktypes.Request, optOut map[string]bool, skipped map[uint64]string) []networktypes.Request {
+	sorted := make([]networktypes.Request, 0, len(requests))
+	for _, request := range requests {
+		if request.Status == launchtypes.Request_APPROVED.String() {
+			sorted = append(sorted, request)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].RequestID < sorted[j].RequestID
+	})
+
+	// the accounts and validators removed by an approved removal are not copied
+	var (
+		toCopy     []networktypes.Request
+		accounts   = make(map[string][]uint64)
+		validators = make(map[string][]uint64)
+	)
+	for _, request := range sorted {
+		switch content := request.Content.Content.(type) {
+		case *launchtypes.RequestContent_GenesisAccount:
+			accounts[content.GenesisAccount.Address] = append(accounts[content.GenesisAccount.Address], request.RequestID)
+		case *launchtypes.RequestContent_VestingAccount:
+			accounts[content.VestingAccount.Address] = append(accounts[content.VestingAccount.Address], request.RequestID)
+		case *launchtypes.RequestContent_GenesisValidator:
+			if optOut[content.GenesisValidator.Address] {
+				skipped[request.RequestID] = "the validator opted out of the relaunch"
+				continue
+			}
+			validators[content.GenesisValidator.Address] = append(validators[content.GenesisValidator.Address], request.RequestID)
+		case *launchtypes.RequestContent_AccountRemoval:
+			for _, requestID := range accounts[content.AccountRemoval.Address] {
+				skipped[requestID] = fmt.Sprintf("the account is removed by the request %d", request.RequestID)
+			}
+			delete(accounts, content.AccountRemoval.Address)
+			continue
+		case *launchtypes.RequestContent_ValidatorRemoval:
+			for _, requestID := range validators[content.ValidatorRemoval.ValAddress] {
+				skipped[requestID] = fmt.Sprintf("the validator is removed by the request %d", request.RequestID)
+			}
+			delete(validators, content.ValidatorRemoval.ValAddress)
+			continue
+		default:
+			continue
+		}
+		toCopy = append(toCopy, request)
+	}
+
+	var remaining []networktypes.Request
+	for _, request := range toCopy {
+		if _, ok := skipped[request.RequestID]; !ok {
+			remaining = append(remaining, request)
+		}
+	}
+
+	// only the oldest of the conflicting validator requests is copied
+	for _, conflict := range networktypes.FindRequestConflicts(remaining) {
+		if _, ok := skipped[conflict.RequestIDs[1]]; !ok {
+			skipped[conflict.RequestIDs[1]] = fmt.Sprintf(
+				"the validator has the same %s as the request %d",
+				conflict.Field,
+				conflict.RequestIDs[0],
+			)
+		}
+	}
+
+	var copied []networktypes.Request
+	for _, request := range remaining {
+		if _, ok := skipped[request.RequestID]; !ok {
+			copied = append(copied, request)
+		}
+	}
+	return copied
+}
+
+// relaunchContent returns the content of a request of the reverted chain for the relaunched chain.
+func relaunchContent(launchID uint64, content launchtypes.RequestContent) launchtypes.RequestContent {
+	switch c := content.Content.(type) {
+	case *launchtypes.RequestContent_GenesisAccount:
+		return launchtypes.NewGenesisAccount(launchID, c.GenesisAccount.Address, c.GenesisAccount.Coins)
+	case *launchtypes.RequestContent_VestingAccount:
+		return launchtypes.NewVestingAccount(launchID, c.VestingAccount.Address, c.VestingAccount.VestingOptions)
+	case *launchtypes.RequestContent_GenesisValidator:
+		v := c.GenesisValidator
+		return launchtypes.NewGenesisValidator(launchID, v.Address, v.GenTx, v.ConsPubKey, v.SelfDelegation, v.Peer)
+	}
+	return content
+}
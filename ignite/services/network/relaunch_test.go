@@ -0,0 +1,169 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+func TestRelaunch(t *testing.T) {
+	const newLaunchID = uint64(2)
+
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	var (
+		amount    = sdk.NewCoins(sdk.NewInt64Coin(TestDenom, TestAmountInt))
+		validator = func(launchID uint64, address string, consPubKey []byte, nodeID string) launchtypes.RequestContent {
+			return launchtypes.NewGenesisValidator(
+				launchID,
+				address,
+				[]byte("gentx"),
+				consPubKey,
+				sdk.NewInt64Coin(TestDenom, TestAmountInt),
+				launchtypes.NewPeerConn(nodeID, testutil.TCPAddress),
+			)
+		}
+		request = func(requestID uint64, content launchtypes.RequestContent, status launchtypes.Request_Status) launchtypes.Request {
+			return launchtypes.Request{
+				LaunchID:  testutil.LaunchID,
+				RequestID: requestID,
+				Content:   content,
+				Status:    status,
+			}
+		}
+		oldRequests = []launchtypes.Request{
+			request(1, validator(testutil.LaunchID, "spn1alice", []byte("alice"), "alice"), launchtypes.Request_APPROVED),
+			request(2, launchtypes.NewGenesisAccount(testutil.LaunchID, "spn1foo", amount), launchtypes.Request_APPROVED),
+			request(3, launchtypes.NewGenesisAccount(testutil.LaunchID, "spn1bar", amount), launchtypes.Request_APPROVED),
+			request(4, launchtypes.NewAccountRemoval("spn1bar"), launchtypes.Request_APPROVED),
+			request(5, validator(testutil.LaunchID, "spn1bob", []byte("bob"), "bob"), launchtypes.Request_APPROVED),
+			request(6, validator(testutil.LaunchID, "spn1carol", []byte("alice"), "carol"), launchtypes.Request_APPROVED),
+			request(7, launchtypes.NewGenesisAccount(testutil.LaunchID, "spn1baz", amount), launchtypes.Request_PENDING),
+		}
+		oldChain = launchtypes.Chain{
+			LaunchID:       testutil.LaunchID,
+			CoordinatorID:  testCoordinatorID,
+			GenesisChainID: testutil.ChainID,
+			SourceURL:      testutil.ChainSourceURL,
+			SourceHash:     testutil.ChainSourceHash,
+			InitialGenesis: launchtypes.NewDefaultInitialGenesis(),
+		}
+	)
+
+	mockCoordinatorOf := func(suite testutil.Suite, chain launchtypes.Chain, times int) {
+		suite.LaunchQueryMock.
+			On("Chain", context.Background(), &launchtypes.QueryGetChainRequest{LaunchID: chain.LaunchID}).
+			Return(&launchtypes.QueryGetChainResponse{Chain: chain}, nil).
+			Times(times)
+		suite.ProfileQueryMock.
+			On("Coordinator", context.Background(), &profiletypes.QueryGetCoordinatorRequest{CoordinatorID: testCoordinatorID}).
+			Return(&profiletypes.QueryGetCoordinatorResponse{
+				Coordinator: profiletypes.Coordinator{CoordinatorID: testCoordinatorID, Address: addr, Active: true},
+			}, nil).
+			Once()
+	}
+	mockRelaunch := func(suite testutil.Suite, chainID string) {
+		mockCoordinatorOf(suite, oldChain, 2)
+		suite.LaunchQueryMock.
+			On("RequestAll", context.Background(), &launchtypes.QueryAllRequestRequest{LaunchID: testutil.LaunchID}).
+			Return(&launchtypes.QueryAllRequestResponse{Request: oldRequests}, nil).
+			Once()
+		suite.CosmosClientMock.
+			On(
+				"BroadcastTx",
+				context.Background(),
+				account,
+				&launchtypes.MsgCreateChain{
+					Coordinator:    addr,
+					GenesisChainID: chainID,
+					SourceURL:      testutil.ChainSourceURL,
+					SourceHash:     testutil.ChainSourceHash,
+					InitialGenesis: launchtypes.NewDefaultInitialGenesis(),
+					Metadata:       publishedMetadata,
+				},
+			).
+			Return(testutil.NewResponse(&launchtypes.MsgCreateChainResponse{LaunchID: newLaunchID}), nil).
+			Once()
+		suite.LaunchQueryMock.
+			On("Params", context.Background(), &launchtypes.QueryParamsRequest{}).
+			Return(&launchtypes.QueryParamsResponse{}, nil).
+			Once()
+	}
+	mockSend := func(suite testutil.Suite, content launchtypes.RequestContent, requestID uint64, autoApproved bool) {
+		suite.CosmosClientMock.
+			On("BroadcastTx", context.Background(), account, launchtypes.NewMsgSendRequest(addr, newLaunchID, content)).
+			Return(testutil.NewResponse(&launchtypes.MsgSendRequestResponse{
+				RequestID:    requestID,
+				AutoApproved: autoApproved,
+			}), nil).
+			Once()
+	}
+
+	t.Run("relaunch with the approved requests", func(t *testing.T) {
+		suite, network := newSuite(account)
+
+		mockRelaunch(suite, testutil.ChainID)
+		mockSend(suite, validator(newLaunchID, "spn1alice", []byte("alice"), "alice"), 1, true)
+		mockSend(suite, launchtypes.NewGenesisAccount(newLaunchID, "spn1foo", amount), 2, false)
+
+		// the request not approved when sent is settled
+		mockCoordinatorOf(suite, launchtypes.Chain{LaunchID: newLaunchID, CoordinatorID: testCoordinatorID}, 1)
+		suite.CosmosClientMock.
+			On("BroadcastTx", context.Background(), account, launchtypes.NewMsgSettleRequest(addr, newLaunchID, 2, true)).
+			Return(testutil.NewResponse(&launchtypes.MsgSettleRequestResponse{}), nil).
+			Once()
+
+		relaunched, err := network.Relaunch(context.Background(), testutil.LaunchID, RelaunchWithoutValidators("spn1bob"))
+		require.NoError(t, err)
+		require.Equal(t, Relaunched{
+			LaunchID:   newLaunchID,
+			ChainID:    testutil.ChainID,
+			RequestIDs: map[uint64]uint64{1: 1, 2: 2},
+			Skipped: map[uint64]string{
+				3: "the account is removed by the request 4",
+				5: "the validator opted out of the relaunch",
+				6: "the validator has the same consensus pub key as the request 1",
+			},
+		}, relaunched)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("relaunch with another chain ID", func(t *testing.T) {
+		suite, network := newSuite(account)
+
+		mockRelaunch(suite, "test-2")
+		mockSend(suite, launchtypes.NewGenesisAccount(newLaunchID, "spn1foo", amount), 1, true)
+
+		relaunched, err := network.Relaunch(
+			context.Background(),
+			testutil.LaunchID,
+			RelaunchWithoutValidators("spn1bob"),
+			RelaunchChainID("test-2"),
+		)
+		require.NoError(t, err)
+		require.Equal(t, map[uint64]uint64{2: 1}, relaunched.RequestIDs)
+		require.Equal(t, "the gentx is signed for the chain ID test-1", relaunched.Skipped[1])
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("launch not reverted", func(t *testing.T) {
+		suite, network := newSuite(account)
+
+		triggered := oldChain
+		triggered.LaunchTriggered = true
+		mockCoordinatorOf(suite, triggered, 2)
+
+		_, err := network.Relaunch(context.Background(), testutil.LaunchID)
+		require.EqualError(t, err, "the launch of the chain 1 is triggered, it must be reverted to be relaunched")
+		suite.AssertAllMocks(t)
+	})
+}
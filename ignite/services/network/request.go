@@ -2,18 +2,30 @@ package network
 
 import (
 	"context"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/pkg/errors"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
 
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
 	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/numbers"
 	"github.com/ignite/cli/ignite/services/network/networktypes"
 )
 
+// SettleRequestBatchSize is the maximum number of requests settled in a single tx,
+// the batches are smaller when their messages exceed the maximum size of a tx.
+const SettleRequestBatchSize = 50
+
 // Reviewal keeps a request's reviewal.
 type Reviewal struct {
 	RequestID  uint64
 	IsApproved bool
+
+	// Reason is the reason of a rejection, it's required to reject a request.
+	Reason string
 }
 
 // ApproveRequest returns approval for a request with id.
@@ -24,31 +36,69 @@ func ApproveRequest(requestID uint64) Reviewal {
 	}
 }
 
-// RejectRequest returns rejection for a request with id.
-func RejectRequest(requestID uint64) Reviewal {
+// RejectRequest returns rejection for a request with id and the reason of the rejection for the requester.
+func RejectRequest(requestID uint64, reason string) Reviewal {
 	return Reviewal{
 		RequestID:  requestID,
 		IsApproved: false,
+		Reason:     reason,
+	}
+}
+
+// allRequests fetches the requests of the chain page by page.
+func (n Network) allRequests(ctx context.Context, launchID uint64) ([]launchtypes.Request, error) {
+	var (
+		requests   []launchtypes.Request
+		pagination *query.PageRequest
+	)
+	for {
+		res, err := n.launchQuery.RequestAll(ctx, &launchtypes.QueryAllRequestRequest{
+			LaunchID:   launchID,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, res.Request...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return requests, nil
+		}
+		pagination = &query.PageRequest{Key: res.Pagination.NextKey}
 	}
 }
 
 // Requests fetches all the chain requests from SPN by launch id
 func (n Network) Requests(ctx context.Context, launchID uint64) ([]networktypes.Request, error) {
-	res, err := n.launchQuery.RequestAll(ctx, &launchtypes.QueryAllRequestRequest{
-		LaunchID: launchID,
+	observe := n.observeLatency("requests", MetricsKindQuery)
+	res, err := cachedQuery(ctx, n, fmt.Sprintf("requests/%d", launchID), func(ctx context.Context) (*launchtypes.QueryAllRequestResponse, error) {
+		requests, err := n.allRequests(ctx, launchID)
+		if err != nil {
+			return nil, err
+		}
+		return &launchtypes.QueryAllRequestResponse{Request: requests}, nil
 	})
+	observe()
 	if err != nil {
 		return nil, err
 	}
+
+	var pending int
 	requests := make([]networktypes.Request, len(res.Request))
 	for i, req := range res.Request {
 		requests[i] = networktypes.ToRequest(req)
+		if req.Status == launchtypes.Request_PENDING {
+			pending++
+		}
 	}
+	n.metrics.SetPendingRequests(launchID, pending)
+
 	return requests, nil
 }
 
 // Request fetches the chain request from SPN by launch and request id
 func (n Network) Request(ctx context.Context, launchID, requestID uint64) (networktypes.Request, error) {
+	defer n.observeLatency("request", MetricsKindQuery)()
+
 	res, err := n.launchQuery.Request(ctx, &launchtypes.QueryGetRequestRequest{
 		LaunchID:  launchID,
 		RequestID: requestID,
@@ -62,40 +112,252 @@ func (n Network) Request(ctx context.Context, launchID, requestID uint64) (netwo
 // RequestFromIDs fetches the chain requested from SPN by launch and provided request IDs
 // TODO: once implemented, use the SPN query from https://github.com/tendermint/spn/issues/420
 func (n Network) RequestFromIDs(ctx context.Context, launchID uint64, requestIDs ...uint64) (reqs []networktypes.Request, err error) {
+	progress := n.ev.StartProgress(events.Message(networktypes.MsgFetchingRequests, nil), len(requestIDs))
 	for _, id := range requestIDs {
 		req, err := n.Request(ctx, launchID, id)
 		if err != nil {
 			return reqs, err
 		}
 		reqs = append(reqs, req)
+		progress.Increment()
 	}
+	progress.Finish(events.Message(networktypes.MsgRequestsFetched, nil))
 	return reqs, nil
 }
 
+// VerifyRequestConflicts checks that the requests don't declare the same consensus pub key,
+// operator address or node ID as another pending or approved request of the chain.
+// The conflicts are returned as a networktypes.ErrConflictingRequests error.
+func (n Network) VerifyRequestConflicts(ctx context.Context, launchID uint64, requestIDs ...uint64) error {
+	requests, err := n.WithoutQueryCache().Requests(ctx, launchID)
+	if err != nil {
+		return err
+	}
+
+	verified := make(map[uint64]bool)
+	for _, id := range requestIDs {
+		verified[id] = true
+	}
+
+	var conflicts []networktypes.RequestConflict
+	for _, conflict := range networktypes.FindRequestConflicts(requests) {
+		if verified[conflict.RequestIDs[0]] || verified[conflict.RequestIDs[1]] {
+			conflicts = append(conflicts, conflict)
+		}
+	}
+	if len(conflicts) > 0 {
+		return networktypes.ErrConflictingRequests{Conflicts: conflicts}
+	}
+	return nil
+}
+
+// ErrPartialSettlement is returned when a tx of reviewals fails after the reviewals of other txs are settled.
+type ErrPartialSettlement struct {
+	// Settled are the IDs of the requests settled before the failure.
+	Settled []uint64
+
+	// Pending are the IDs of the requests not settled.
+	Pending []uint64
+
+	Err error
+}
+
+// Error implements error.
+func (e ErrPartialSettlement) Error() string {
+	return fmt.Sprintf(
+		"the requests %s are settled but the requests %s are not: %s",
+		numbers.List(e.Settled, "#"),
+		numbers.List(e.Pending, "#"),
+		e.Err,
+	)
+}
+
+// Unwrap returns the error of the failed tx.
+func (e ErrPartialSettlement) Unwrap() error {
+	return e.Err
+}
+
 // SubmitRequest submits reviewals for proposals in batch for chain.
+// Reviewals are broadcasted in txs of at most SettleRequestBatchSize messages within the maximum size of a tx.
+// The rejections require a reason, each rejection is settled in its own tx with the reason in the tx memo.
+// The txs are not atomic, when a tx fails after others are settled, ErrPartialSettlement reports the
+// requests settled before the failure.
 func (n Network) SubmitRequest(ctx context.Context, launchID uint64, reviewal ...Reviewal) error {
-	n.ev.Send(events.New(events.StatusOngoing, "Submitting requests..."))
-
-	addr, err := n.account.Address(networktypes.SPN)
+	addr, err := n.accountAddress()
+	if err != nil {
+		return err
+	}
+	if err := checkRejectionReasons(reviewal); err != nil {
+		return err
+	}
+	memos, err := rejectionReasonMemos(reviewal)
 	if err != nil {
 		return err
 	}
 
-	messages := make([]sdk.Msg, len(reviewal))
-	for i, reviewal := range reviewal {
-		messages[i] = launchtypes.NewMsgSettleRequest(
+	if err := n.checkCoordinator(ctx, launchID); err != nil {
+		return err
+	}
+
+	messages := make([]sdk.Msg, 0, len(reviewal))
+	memoMessages := make([]*launchtypes.MsgSettleRequest, 0, len(memos))
+	for _, reviewal := range reviewal {
+		msg := launchtypes.NewMsgSettleRequest(
 			addr,
 			launchID,
 			reviewal.RequestID,
 			reviewal.IsApproved,
 		)
+		if _, ok := memos[reviewal.RequestID]; ok {
+			memoMessages = append(memoMessages, msg)
+			continue
+		}
+		messages = append(messages, msg)
 	}
-
-	res, err := n.cosmos.BroadcastTx(ctx, n.account, messages...)
+	maxBytes, err := n.maxMsgsBytes(ctx)
+	if err != nil {
+		return err
+	}
+	batches, err := splitMsgs(messages, maxBytes, SettleRequestBatchSize)
 	if err != nil {
 		return err
 	}
 
-	var requestRes launchtypes.MsgSettleRequestResponse
-	return res.Decode(&requestRes)
+	// settled are the IDs of the requests of the successful txs
+	settled := make([]uint64, 0, len(reviewal))
+	settlementError := func(err error) error {
+		if len(settled) == 0 {
+			return err
+		}
+
+		isSettled := make(map[uint64]bool, len(settled))
+		for _, id := range settled {
+			isSettled[id] = true
+		}
+		var pending []uint64
+		for _, r := range reviewal {
+			if !isSettled[r.RequestID] {
+				pending = append(pending, r.RequestID)
+			}
+		}
+		return ErrPartialSettlement{Settled: settled, Pending: pending, Err: err}
+	}
+
+	progress := n.ev.StartProgress(events.Message(networktypes.MsgSubmittingRequests, nil), len(reviewal))
+	for _, messages := range batches {
+		res, err := n.broadcastTx(ctx, messages...)
+		if err != nil {
+			return settlementError(err)
+		}
+		for _, msg := range messages {
+			settled = append(settled, msg.(*launchtypes.MsgSettleRequest).RequestID)
+		}
+
+		var requestRes launchtypes.MsgSettleRequestResponse
+		if err := res.Decode(&requestRes); err != nil {
+			return settlementError(err)
+		}
+		progress.Add(len(messages))
+	}
+	for _, msg := range memoMessages {
+		res, err := n.broadcastTxWithMemo(ctx, memos[msg.RequestID], msg)
+		if err != nil {
+			return settlementError(err)
+		}
+		settled = append(settled, msg.RequestID)
+
+		var requestRes launchtypes.MsgSettleRequestResponse
+		if err := res.Decode(&requestRes); err != nil {
+			return settlementError(err)
+		}
+		progress.Add(1)
+	}
+	progress.Finish(events.Message(networktypes.MsgRequestsSubmitted, nil))
+
+	return nil
+}
+
+// SentRequests is the result of a batch of requests sent to SPN.
+type SentRequests struct {
+	// RequestIDs are the IDs of the sent requests in the order of their contents.
+	RequestIDs []uint64
+
+	// Skipped are the contents of the requests not sent because the account can't pay their fees.
+	Skipped []launchtypes.RequestContent
+
+	// AutoApproved are the IDs of the sent requests approved when sent, like the requests of the coordinator.
+	AutoApproved []uint64
+}
+
+// SendRequests sends a request for each content to the chain.
+// The batch is trimmed to the number of requests the account can pay, the contents of the requests not sent
+// are reported in the result. The contents are checked against the size limits before any broadcast.
+// The requests are broadcasted in a tx each, when a broadcast fails the result contains the requests sent before it.
+func (n Network) SendRequests(
+	ctx context.Context,
+	launchID uint64,
+	contents ...launchtypes.RequestContent,
+) (SentRequests, error) {
+	var sent SentRequests
+
+	addr, err := n.accountAddress()
+	if err != nil {
+		return sent, err
+	}
+
+	quota, err := n.RequestQuota(ctx, launchID)
+	if err != nil {
+		return sent, err
+	}
+	if !quota.Allows(len(contents)) {
+		sent.Skipped = contents[quota.Remaining:]
+		contents = contents[:quota.Remaining]
+
+		n.ev.Send(events.New(
+			events.StatusNeutral,
+			events.Message(networktypes.MsgRequestsSkipped, events.Params{
+				"Skipped":   len(sent.Skipped),
+				"Total":     len(sent.Skipped) + len(contents),
+				"Fee":       networktypes.FormatCoins(quota.Fee, n.denomUnits(ctx)),
+				"Remaining": quota.Remaining,
+			}),
+			events.Icon(icons.Info),
+		))
+	}
+
+	maxBytes, err := n.maxMsgsBytes(ctx)
+	if err != nil {
+		return sent, err
+	}
+	messages := make([]sdk.Msg, len(contents))
+	for i, content := range contents {
+		messages[i] = launchtypes.NewMsgSendRequest(addr, launchID, content)
+		if err := networktypes.CheckRequestContentLimits(content); err != nil {
+			return sent, errors.Wrapf(err, "invalid content of the request %d", i+1)
+		}
+		if err := checkMsgSize(messages[i], maxBytes, fmt.Sprintf("the request %d", i+1)); err != nil {
+			return sent, err
+		}
+	}
+
+	progress := n.ev.StartProgress(events.Message(networktypes.MsgSendingRequests, nil), len(contents))
+	for i, msg := range messages {
+		res, err := n.broadcastTx(ctx, msg)
+		if err != nil {
+			return sent, n.requestBroadcastError(ctx, launchID, len(contents)-i, err)
+		}
+
+		var requestRes launchtypes.MsgSendRequestResponse
+		if err := res.Decode(&requestRes); err != nil {
+			return sent, err
+		}
+		sent.RequestIDs = append(sent.RequestIDs, requestRes.RequestID)
+		if requestRes.AutoApproved {
+			sent.AutoApproved = append(sent.AutoApproved, requestRes.RequestID)
+		}
+		progress.Add(1)
+	}
+	progress.Finish(events.Message(networktypes.MsgRequestsSent, nil))
+
+	return sent, nil
 }
@@ -0,0 +1,185 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+func TestSubmitRequest(t *testing.T) {
+	t.Run("submit requests in several batches", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+			reviewals      = make([]Reviewal, SettleRequestBatchSize+1)
+		)
+
+		mockCoordinator(t, suite, account)
+
+		addr, err := account.Address(networktypes.SPN)
+		require.NoError(t, err)
+
+		for i := range reviewals {
+			reviewals[i] = ApproveRequest(uint64(i + 1))
+		}
+		msgs := func(reviewals []Reviewal) []interface{} {
+			msgs := []interface{}{context.Background(), account}
+			for _, r := range reviewals {
+				msgs = append(msgs, launchtypes.NewMsgSettleRequest(addr, testutil.LaunchID, r.RequestID, r.IsApproved))
+			}
+			return msgs
+		}
+
+		suite.CosmosClientMock.
+			On("BroadcastTx", msgs(reviewals[:SettleRequestBatchSize])...).
+			Return(testutil.NewResponse(&launchtypes.MsgSettleRequestResponse{}), nil).
+			Once()
+		suite.CosmosClientMock.
+			On("BroadcastTx", msgs(reviewals[SettleRequestBatchSize:])...).
+			Return(testutil.NewResponse(&launchtypes.MsgSettleRequestResponse{}), nil).
+			Once()
+
+		require.NoError(t, network.SubmitRequest(context.Background(), testutil.LaunchID, reviewals...))
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to submit the second batch of requests", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+			reviewals      = make([]Reviewal, SettleRequestBatchSize+2)
+			ids            = make([]uint64, len(reviewals))
+		)
+
+		mockCoordinator(t, suite, account)
+
+		addr, err := account.Address(networktypes.SPN)
+		require.NoError(t, err)
+
+		for i := range reviewals {
+			ids[i] = uint64(i + 1)
+			reviewals[i] = ApproveRequest(ids[i])
+		}
+		msgs := func(reviewals []Reviewal) []interface{} {
+			msgs := []interface{}{context.Background(), account}
+			for _, r := range reviewals {
+				msgs = append(msgs, launchtypes.NewMsgSettleRequest(addr, testutil.LaunchID, r.RequestID, r.IsApproved))
+			}
+			return msgs
+		}
+
+		suite.CosmosClientMock.
+			On("BroadcastTx", msgs(reviewals[:SettleRequestBatchSize])...).
+			Return(testutil.NewResponse(&launchtypes.MsgSettleRequestResponse{}), nil).
+			Once()
+		suite.CosmosClientMock.
+			On("BroadcastTx", msgs(reviewals[SettleRequestBatchSize:])...).
+			Return(testutil.NewResponse(&launchtypes.MsgSettleRequestResponse{}), sdk.ErrInvalidLengthCoin).
+			Once()
+
+		err = network.SubmitRequest(context.Background(), testutil.LaunchID, reviewals...)
+		require.ErrorIs(t, err, sdk.ErrInvalidLengthCoin)
+		var errPartial ErrPartialSettlement
+		require.ErrorAs(t, err, &errPartial)
+		require.Equal(t, ids[:SettleRequestBatchSize], errPartial.Settled)
+		require.Equal(t, ids[SettleRequestBatchSize:], errPartial.Pending)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to submit requests, failed to broadcast the tx", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+		)
+
+		mockCoordinator(t, suite, account)
+
+		suite.CosmosClientMock.
+			On("BroadcastTxWithMemo", context.Background(), account, "rejection reason: invalid gentx", mock.Anything).
+			Return(testutil.NewResponse(&launchtypes.MsgSettleRequestResponse{}), sdk.ErrInvalidLengthCoin).
+			Once()
+
+		err := network.SubmitRequest(context.Background(), testutil.LaunchID, RejectRequest(1, "invalid gentx"))
+		require.ErrorIs(t, err, sdk.ErrInvalidLengthCoin)
+		suite.AssertAllMocks(t)
+	})
+}
+
+func TestVerifyRequestConflicts(t *testing.T) {
+	newRequest := func(requestID uint64, nodeID string) launchtypes.Request {
+		return launchtypes.Request{
+			LaunchID:  testutil.LaunchID,
+			RequestID: requestID,
+			Status:    launchtypes.Request_PENDING,
+			Content: launchtypes.NewGenesisValidator(
+				testutil.LaunchID,
+				"spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g",
+				[]byte(`{}`),
+				[]byte(fmt.Sprintf("pk%d", requestID)),
+				sdk.NewCoin("stake", sdkmath.NewInt(95000000)),
+				launchtypes.NewPeerConn(nodeID, "127.163.0.1:2446"),
+			),
+		}
+	}
+
+	t.Run("requests without conflicts", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+		)
+
+		suite.LaunchQueryMock.
+			On("RequestAll", context.Background(), &launchtypes.QueryAllRequestRequest{LaunchID: testutil.LaunchID}).
+			Return(&launchtypes.QueryAllRequestResponse{
+				Request: []launchtypes.Request{
+					newRequest(1, "node1"),
+					newRequest(2, "node2"),
+				},
+			}, nil).
+			Once()
+
+		require.NoError(t, network.VerifyRequestConflicts(context.Background(), testutil.LaunchID, 2))
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("requests with conflicts", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+		)
+
+		suite.LaunchQueryMock.
+			On("RequestAll", context.Background(), &launchtypes.QueryAllRequestRequest{LaunchID: testutil.LaunchID}).
+			Return(&launchtypes.QueryAllRequestResponse{
+				Request: []launchtypes.Request{
+					newRequest(1, "node1"),
+					newRequest(2, "node1"),
+					newRequest(3, "node3"),
+					newRequest(4, "node3"),
+				},
+			}, nil).
+			Once()
+
+		err := network.VerifyRequestConflicts(context.Background(), testutil.LaunchID, 2)
+		require.Equal(t, networktypes.ErrConflictingRequests{
+			Conflicts: []networktypes.RequestConflict{
+				{
+					RequestIDs: [2]uint64{1, 2},
+					Field:      networktypes.ConflictFieldNodeID,
+					Value:      "node1",
+				},
+			},
+		}, err)
+		require.EqualError(t, err, "conflicting requests: requests 1 and 2 have the same node ID node1")
+		suite.AssertAllMocks(t)
+	})
+}
@@ -0,0 +1,224 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmoserror"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+	"github.com/ignite/cli/ignite/services/network/networkchain"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+// ErrRequestSettled is returned when a request to amend is already approved or rejected.
+var ErrRequestSettled = errors.New("the request is already settled")
+
+type amendOptions struct {
+	publicAddress string
+	gentxPath     string
+	accountAmount sdk.Coins
+}
+
+// AmendOption configures the changes of an amended request.
+type AmendOption func(*amendOptions)
+
+// AmendPublicAddress changes the peer address of a validator request, the node ID of the peer is kept.
+func AmendPublicAddress(addr string) AmendOption {
+	return func(o *amendOptions) {
+		o.publicAddress = addr
+	}
+}
+
+// AmendGentx replaces the gentx of a validator request, the gentx must be issued by the same validator.
+// The peer is parsed from the memo of the gentx unless the public address is amended too.
+func AmendGentx(gentxPath string) AmendOption {
+	return func(o *amendOptions) {
+		o.gentxPath = gentxPath
+	}
+}
+
+// AmendAccountAmount changes the coins of a genesis account request.
+func AmendAccountAmount(amount sdk.Coins) AmendOption {
+	return func(o *amendOptions) {
+		o.accountAmount = amount
+	}
+}
+
+// AmendedRequest is a request replaced by an amended request.
+type AmendedRequest struct {
+	// PreviousRequestID is the ID of the replaced request, it is rejected.
+	PreviousRequestID uint64
+
+	// RequestID is the ID of the amended request.
+	RequestID uint64
+
+	// AutoApproved is true when the amended request is approved with the request of the coordinator.
+	AutoApproved bool
+}
+
+// AmendRequest replaces a pending request of the network account with a request with the amended fields.
+// The amended request is sent and the previous request is rejected in the same tx, the previous request
+// is left untouched when the amended request can't be sent. The request must be still pending.
+func (n Network) AmendRequest(
+	ctx context.Context,
+	launchID,
+	requestID uint64,
+	options ...AmendOption,
+) (AmendedRequest, error) {
+	o := amendOptions{}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	addr, err := n.accountAddress()
+	if err != nil {
+		return AmendedRequest{}, err
+	}
+
+	request, err := n.Request(ctx, launchID, requestID)
+	if cosmoserror.Unwrap(err) == cosmoserror.ErrNotFound {
+		return AmendedRequest{}, ErrObjectNotFound
+	} else if err != nil {
+		return AmendedRequest{}, err
+	}
+	if request.Status != launchtypes.Request_PENDING.String() {
+		return AmendedRequest{}, errors.Wrapf(
+			ErrRequestSettled,
+			"the request %d is %s and can't be amended",
+			requestID,
+			strings.ToLower(request.Status),
+		)
+	}
+	if request.Creator != addr {
+		return AmendedRequest{}, fmt.Errorf("the request %d was sent by %s and can't be amended by %s", requestID, request.Creator, addr)
+	}
+
+	content, err := amendRequestContent(request, o)
+	if err != nil {
+		return AmendedRequest{}, err
+	}
+
+	// the amended request is checked like the requests verified by the coordinator before it is sent
+	amended := request
+	amended.Content = content
+	if err := networktypes.VerifyRequest(amended); err != nil {
+		return AmendedRequest{}, err
+	}
+	if err := networktypes.VerifyRequestPeer(amended); err != nil && !errors.Is(err, networktypes.ErrGentxWithoutMemo) {
+		return AmendedRequest{}, err
+	}
+
+	if err := n.checkRequestQuota(ctx, launchID, 1); err != nil {
+		return AmendedRequest{}, err
+	}
+
+	n.ev.Send(events.NewOngoing(fmt.Sprintf("Amending the request %d", requestID)))
+
+	// the amended request is sent first, the response of the tx is the response of its first message
+	res, err := n.broadcastTx(
+		ctx,
+		launchtypes.NewMsgSendRequest(addr, launchID, content),
+		launchtypes.NewMsgSettleRequest(addr, launchID, requestID, false),
+	)
+	if err != nil {
+		return AmendedRequest{}, n.requestBroadcastError(ctx, launchID, 1, err)
+	}
+
+	var requestRes launchtypes.MsgSendRequestResponse
+	if err := res.Decode(&requestRes); err != nil {
+		return AmendedRequest{}, err
+	}
+
+	n.ev.Send(events.NewDone(
+		fmt.Sprintf("Request %d replaced by the amended request %d", requestID, requestRes.RequestID),
+		"",
+	))
+
+	return AmendedRequest{
+		PreviousRequestID: requestID,
+		RequestID:         requestRes.RequestID,
+		AutoApproved:      requestRes.AutoApproved,
+	}, nil
+}
+
+// amendRequestContent returns the content of the request with the amended fields.
+func amendRequestContent(request networktypes.Request, o amendOptions) (launchtypes.RequestContent, error) {
+	switch content := request.Content.Content.(type) {
+	case *launchtypes.RequestContent_GenesisValidator:
+		if !o.accountAmount.Empty() {
+			return launchtypes.RequestContent{}, fmt.Errorf("the request %d is not a genesis account request", request.RequestID)
+		}
+		if o.publicAddress == "" && o.gentxPath == "" {
+			return launchtypes.RequestContent{}, fmt.Errorf("nothing to amend in the validator request %d", request.RequestID)
+		}
+
+		validator := *content.GenesisValidator
+		if o.gentxPath != "" {
+			gentxInfo, gentx, err := cosmosutil.GentxFromPath(o.gentxPath)
+			if err != nil {
+				return launchtypes.RequestContent{}, err
+			}
+			address, err := cosmosutil.ChangeAddressPrefix(gentxInfo.DelegatorAddress, networktypes.SPN)
+			if err != nil {
+				return launchtypes.RequestContent{}, err
+			}
+			if address != validator.Address {
+				return launchtypes.RequestContent{}, fmt.Errorf(
+					"the gentx is issued by %s, the validator of the request %d is %s",
+					address,
+					request.RequestID,
+					validator.Address,
+				)
+			}
+
+			validator.GenTx = gentx
+			validator.ConsPubKey = gentxInfo.PubKey
+			validator.SelfDelegation = gentxInfo.SelfDelegation
+			if peer, err := ParsePeerAddress(gentxInfo.Memo); err == nil {
+				validator.Peer = peer
+			} else if o.publicAddress == "" {
+				return launchtypes.RequestContent{}, err
+			}
+		}
+		if o.publicAddress != "" {
+			if xurl.IsHTTP(o.publicAddress) {
+				validator.Peer = launchtypes.NewPeerTunnel(validator.Peer.Id, networkchain.HTTPTunnelChisel, o.publicAddress)
+			} else {
+				validator.Peer = launchtypes.NewPeerConn(validator.Peer.Id, o.publicAddress)
+			}
+		}
+
+		return launchtypes.NewGenesisValidator(
+			validator.LaunchID,
+			validator.Address,
+			validator.GenTx,
+			validator.ConsPubKey,
+			validator.SelfDelegation,
+			validator.Peer,
+		), nil
+
+	case *launchtypes.RequestContent_GenesisAccount:
+		if o.publicAddress != "" || o.gentxPath != "" {
+			return launchtypes.RequestContent{}, fmt.Errorf("the request %d is not a validator request", request.RequestID)
+		}
+		if o.accountAmount.Empty() {
+			return launchtypes.RequestContent{}, fmt.Errorf("nothing to amend in the genesis account request %d", request.RequestID)
+		}
+		if err := networktypes.ValidateCoinsDenom(o.accountAmount); err != nil {
+			return launchtypes.RequestContent{}, errors.Wrap(err, "invalid account amount")
+		}
+
+		account := content.GenesisAccount
+		return launchtypes.NewGenesisAccount(account.LaunchID, account.Address, o.accountAmount), nil
+
+	default:
+		return launchtypes.RequestContent{}, fmt.Errorf("the request %d can't be amended", request.RequestID)
+	}
+}
@@ -0,0 +1,118 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+func TestAmendRequest(t *testing.T) {
+	var (
+		account            = testutil.NewTestAccount(t, testutil.TestAccountName)
+		requestID          = uint64(3)
+		amendedRequestID   = uint64(7)
+		amendedPeerAddress = "1.2.3.4:26657"
+	)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	gentx := testutil.NewGentx(addr, TestDenom, TestAmountString, "", testutil.PeerAddress).JSON(t)
+	validatorRequest := func(peer launchtypes.Peer) launchtypes.RequestContent {
+		return launchtypes.NewGenesisValidator(
+			testutil.LaunchID,
+			addr,
+			gentx,
+			[]byte{},
+			sdk.NewInt64Coin(TestDenom, TestAmountInt),
+			peer,
+		)
+	}
+	mockRequest := func(suite testutil.Suite, status launchtypes.Request_Status) {
+		suite.LaunchQueryMock.
+			On("Request", context.Background(), &launchtypes.QueryGetRequestRequest{
+				LaunchID:  testutil.LaunchID,
+				RequestID: requestID,
+			}).
+			Return(&launchtypes.QueryGetRequestResponse{
+				Request: launchtypes.Request{
+					LaunchID:  testutil.LaunchID,
+					RequestID: requestID,
+					Creator:   addr,
+					Content:   validatorRequest(launchtypes.NewPeerConn(testutil.NodeID, testutil.TCPAddress)),
+					Status:    status,
+				},
+			}, nil).
+			Once()
+	}
+
+	t.Run("amend the peer address in a single tx", func(t *testing.T) {
+		suite, network := newSuite(account)
+
+		mockRequest(suite, launchtypes.Request_PENDING)
+		mockRequestQuota(suite, addr, nil, nil)
+		suite.CosmosClientMock.
+			On(
+				"BroadcastTx",
+				context.Background(),
+				account,
+				launchtypes.NewMsgSendRequest(
+					addr,
+					testutil.LaunchID,
+					validatorRequest(launchtypes.NewPeerConn(testutil.NodeID, amendedPeerAddress)),
+				),
+				launchtypes.NewMsgSettleRequest(addr, testutil.LaunchID, requestID, false),
+			).
+			Return(testutil.NewResponse(&launchtypes.MsgSendRequestResponse{RequestID: amendedRequestID}), nil).
+			Once()
+
+		amended, err := network.AmendRequest(
+			context.Background(),
+			testutil.LaunchID,
+			requestID,
+			AmendPublicAddress(amendedPeerAddress),
+		)
+		require.NoError(t, err)
+		require.Equal(t, AmendedRequest{
+			PreviousRequestID: requestID,
+			RequestID:         amendedRequestID,
+		}, amended)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("request already settled", func(t *testing.T) {
+		suite, network := newSuite(account)
+
+		mockRequest(suite, launchtypes.Request_APPROVED)
+
+		_, err := network.AmendRequest(
+			context.Background(),
+			testutil.LaunchID,
+			requestID,
+			AmendPublicAddress(amendedPeerAddress),
+		)
+		require.ErrorIs(t, err, ErrRequestSettled)
+		require.EqualError(t, err, "the request 3 is approved and can't be amended: the request is already settled")
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("amend the account amount of a validator request", func(t *testing.T) {
+		suite, network := newSuite(account)
+
+		mockRequest(suite, launchtypes.Request_PENDING)
+
+		_, err := network.AmendRequest(
+			context.Background(),
+			testutil.LaunchID,
+			requestID,
+			AmendAccountAmount(sdk.NewCoins(sdk.NewInt64Coin(TestDenom, 10))),
+		)
+		require.EqualError(t, err, "the request 3 is not a genesis account request")
+		suite.AssertAllMocks(t)
+	})
+}
@@ -0,0 +1,298 @@
+package network
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+// RequestBundleVersion is the version of the request bundle format.
+// It must be incremented when the bundle content changes in a non backward compatible way.
+const RequestBundleVersion = 1
+
+// RequestDecision is the decision of the coordinator for a request of a bundle.
+type RequestDecision string
+
+const (
+	// DecisionNone leaves the request pending.
+	DecisionNone RequestDecision = ""
+
+	// DecisionApprove approves the request.
+	DecisionApprove RequestDecision = "approve"
+
+	// DecisionReject rejects the request.
+	DecisionReject RequestDecision = "reject"
+)
+
+type (
+	// RequestBundle contains the pending requests of a chain to review them offline.
+	// The coordinator sets the decision of each request in the bundle and imports it back to settle them.
+	RequestBundle struct {
+		Manifest RequestBundleManifest `json:"manifest"`
+
+		// Signature is the signature of the manifest by the exporting account.
+		Signature []byte `json:"signature"`
+
+		Requests []RequestBundleEntry `json:"requests"`
+	}
+
+	// RequestBundleManifest identifies the requests of a bundle, it is signed when the bundle is exported.
+	RequestBundleManifest struct {
+		// Version is the version of the bundle format.
+		Version int `json:"version"`
+
+		LaunchID   uint64    `json:"launch_id"`
+		Exporter   string    `json:"exporter"`
+		ExportedAt time.Time `json:"exported_at"`
+
+		// Hashes contains the sha256 hash of the content of each request by request ID.
+		Hashes map[uint64]string `json:"hashes"`
+	}
+
+	// RequestBundleEntry is a request of a bundle with the decision of the coordinator.
+	RequestBundleEntry struct {
+		RequestID uint64 `json:"request_id"`
+		Creator   string `json:"creator"`
+		CreatedAt string `json:"created_at"`
+
+		// Content is the JSON encoded content of the request, including the gentx of the validator requests.
+		Content json.RawMessage `json:"content"`
+
+		// Decision is set by the coordinator during the review.
+		Decision RequestDecision `json:"decision"`
+
+		// Reason is the reason of a rejection for the requester, it's required to reject the request.
+		Reason string `json:"reason,omitempty"`
+	}
+
+	// ErrChangedRequests is returned when the requests of a bundle changed on SPN since the export.
+	ErrChangedRequests struct {
+		RequestIDs []uint64
+	}
+)
+
+// Error implements error.
+func (e ErrChangedRequests) Error() string {
+	ids := make([]string, len(e.RequestIDs))
+	for i, id := range e.RequestIDs {
+		ids[i] = fmt.Sprint(id)
+	}
+	return fmt.Sprintf(
+		"the requests %s changed since the bundle was exported, export a new bundle to review them",
+		strings.Join(ids, ", "),
+	)
+}
+
+// ExportRequests writes a bundle with the pending requests of the chain into w.
+// The manifest of the bundle is signed with the network account, a bundle can only be imported by the
+// account that exported it.
+func (n Network) ExportRequests(ctx context.Context, launchID uint64, w io.Writer) error {
+	addr, err := n.accountAddress()
+	if err != nil {
+		return err
+	}
+
+	requests, err := n.WithoutQueryCache().Requests(ctx, launchID)
+	if err != nil {
+		return err
+	}
+
+	bundle := RequestBundle{
+		Manifest: RequestBundleManifest{
+			Version:    RequestBundleVersion,
+			LaunchID:   launchID,
+			Exporter:   addr,
+			ExportedAt: n.clock.Now().UTC(),
+			Hashes:     make(map[uint64]string),
+		},
+		Requests: []RequestBundleEntry{},
+	}
+	for _, request := range requests {
+		if request.Status != launchtypes.Request_PENDING.String() {
+			continue
+		}
+
+		content, err := launchtypes.ModuleCdc.MarshalJSON(&request.Content)
+		if err != nil {
+			return err
+		}
+		hash, err := requestContentHash(request.Content)
+		if err != nil {
+			return err
+		}
+
+		bundle.Manifest.Hashes[request.RequestID] = hash
+		bundle.Requests = append(bundle.Requests, RequestBundleEntry{
+			RequestID: request.RequestID,
+			Creator:   request.Creator,
+			CreatedAt: request.CreatedAt,
+			Content:   content,
+		})
+	}
+
+	manifest, err := json.Marshal(bundle.Manifest)
+	if err != nil {
+		return err
+	}
+	if bundle.Signature, _, err = n.account.Sign(manifest); err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(bundle); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.NewDone(fmt.Sprintf("%d pending requests exported", len(bundle.Requests)), ""))
+	return nil
+}
+
+// ImportDecisions reads a reviewed bundle from r and settles its requests with a decision.
+// The bundle must be signed by the network account and its requests must be unchanged on SPN since the export,
+// otherwise no request is settled. The reviewals of the settled requests are returned.
+func (n Network) ImportDecisions(ctx context.Context, r io.Reader) ([]Reviewal, error) {
+	addr, err := n.accountAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	var bundle RequestBundle
+	if err := json.NewDecoder(r).Decode(&bundle); err != nil {
+		return nil, fmt.Errorf("invalid request bundle: %w", err)
+	}
+	if bundle.Manifest.Version != RequestBundleVersion {
+		return nil, fmt.Errorf(
+			"unsupported request bundle version %d, expected %d",
+			bundle.Manifest.Version,
+			RequestBundleVersion,
+		)
+	}
+	if bundle.Manifest.Exporter != addr {
+		return nil, fmt.Errorf("the request bundle was exported by %s and can't be imported by %s", bundle.Manifest.Exporter, addr)
+	}
+	if err := n.verifyManifest(bundle); err != nil {
+		return nil, err
+	}
+
+	launchID := bundle.Manifest.LaunchID
+	reviewals, err := bundleReviewals(bundle)
+	if err != nil {
+		return nil, err
+	}
+	if len(reviewals) == 0 {
+		n.ev.Send(events.NewNeutral("No request decision in the bundle"))
+		return nil, nil
+	}
+
+	// the decisions were made for the exported requests, they don't apply to changed requests
+	var changed ErrChangedRequests
+	for _, reviewal := range reviewals {
+		request, err := n.Request(ctx, launchID, reviewal.RequestID)
+		if err != nil {
+			return nil, err
+		}
+		hash, err := requestContentHash(request.Content)
+		if err != nil {
+			return nil, err
+		}
+		if request.Status != launchtypes.Request_PENDING.String() || hash != bundle.Manifest.Hashes[reviewal.RequestID] {
+			changed.RequestIDs = append(changed.RequestIDs, reviewal.RequestID)
+		}
+	}
+	if len(changed.RequestIDs) > 0 {
+		return nil, changed
+	}
+
+	if err := n.SubmitRequest(ctx, launchID, reviewals...); err != nil {
+		return nil, err
+	}
+	return reviewals, nil
+}
+
+// verifyManifest verifies the manifest of the bundle is signed by the network account.
+func (n Network) verifyManifest(bundle RequestBundle) error {
+	manifest, err := json.Marshal(bundle.Manifest)
+	if err != nil {
+		return err
+	}
+	pubKey, err := n.account.Record.GetPubKey()
+	if err != nil {
+		return err
+	}
+	if !pubKey.VerifySignature(manifest, bundle.Signature) {
+		return errors.New("invalid request bundle signature, the manifest was modified")
+	}
+	return nil
+}
+
+// bundleReviewals returns the reviewals of the requests of the bundle with a decision, sorted by request ID.
+// The content of each reviewed request must be the content signed in the manifest.
+func bundleReviewals(bundle RequestBundle) ([]Reviewal, error) {
+	var reviewals []Reviewal
+	for _, entry := range bundle.Requests {
+		if entry.Decision == DecisionNone {
+			continue
+		}
+
+		expectedHash, ok := bundle.Manifest.Hashes[entry.RequestID]
+		if !ok {
+			return nil, fmt.Errorf("the request %d is not part of the bundle manifest", entry.RequestID)
+		}
+		var content launchtypes.RequestContent
+		if err := launchtypes.ModuleCdc.UnmarshalJSON(entry.Content, &content); err != nil {
+			return nil, fmt.Errorf("invalid content for the request %d: %w", entry.RequestID, err)
+		}
+		hash, err := requestContentHash(content)
+		if err != nil {
+			return nil, err
+		}
+		if hash != expectedHash {
+			return nil, fmt.Errorf("the content of the request %d doesn't match the bundle manifest", entry.RequestID)
+		}
+
+		switch entry.Decision {
+		case DecisionApprove:
+			reviewals = append(reviewals, ApproveRequest(entry.RequestID))
+		case DecisionReject:
+			if strings.TrimSpace(entry.Reason) == "" {
+				return nil, fmt.Errorf("the request %d is rejected without reason, set the reason in the bundle", entry.RequestID)
+			}
+			reviewals = append(reviewals, RejectRequest(entry.RequestID, entry.Reason))
+		default:
+			return nil, fmt.Errorf(
+				"invalid decision %q for the request %d, expected %s or %s",
+				entry.Decision,
+				entry.RequestID,
+				DecisionApprove,
+				DecisionReject,
+			)
+		}
+	}
+
+	sort.Slice(reviewals, func(i, j int) bool {
+		return reviewals[i].RequestID < reviewals[j].RequestID
+	})
+	return reviewals, nil
+}
+
+// requestContentHash returns the sha256 hash of the protobuf encoded content of a request.
+func requestContentHash(content launchtypes.RequestContent) (string, error) {
+	bz, err := content.Marshal()
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(bz)
+	return hex.EncodeToString(hash[:]), nil
+}
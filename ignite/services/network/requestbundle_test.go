@@ -0,0 +1,226 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+func TestRequestBundle(t *testing.T) {
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	var (
+		gentx    = testutil.NewGentx(addr, TestDenom, TestAmountString, "", testutil.PeerAddress)
+		requests = []launchtypes.Request{
+			{
+				LaunchID:  testutil.LaunchID,
+				RequestID: 1,
+				Creator:   addr,
+				Status:    launchtypes.Request_PENDING,
+				Content: launchtypes.NewGenesisAccount(
+					testutil.LaunchID,
+					addr,
+					sdk.NewCoins(sdk.NewInt64Coin(TestDenom, TestAmountInt)),
+				),
+			},
+			{
+				LaunchID:  testutil.LaunchID,
+				RequestID: 2,
+				Creator:   addr,
+				Status:    launchtypes.Request_PENDING,
+				Content: launchtypes.NewGenesisValidator(
+					testutil.LaunchID,
+					addr,
+					gentx.JSON(t),
+					[]byte("pubkey"),
+					sdk.NewInt64Coin(TestDenom, TestAmountInt),
+					launchtypes.NewPeerConn(testutil.NodeID, testutil.TCPAddress),
+				),
+			},
+			{
+				LaunchID:  testutil.LaunchID,
+				RequestID: 3,
+				Creator:   addr,
+				Status:    launchtypes.Request_APPROVED,
+				Content:   launchtypes.NewAccountRemoval(addr),
+			},
+		}
+		exportBundle = func(t *testing.T) RequestBundle {
+			suite, network := newSuite(account)
+			suite.LaunchQueryMock.
+				On("RequestAll", context.Background(), &launchtypes.QueryAllRequestRequest{LaunchID: testutil.LaunchID}).
+				Return(&launchtypes.QueryAllRequestResponse{Request: requests}, nil).
+				Once()
+
+			var buf bytes.Buffer
+			require.NoError(t, network.ExportRequests(context.Background(), testutil.LaunchID, &buf))
+			suite.AssertAllMocks(t)
+
+			var bundle RequestBundle
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &bundle))
+			return bundle
+		}
+		encode = func(t *testing.T, bundle RequestBundle) *bytes.Buffer {
+			var buf bytes.Buffer
+			require.NoError(t, json.NewEncoder(&buf).Encode(bundle))
+			return &buf
+		}
+		mockRequest = func(suite testutil.Suite, request launchtypes.Request) {
+			suite.LaunchQueryMock.
+				On("Request", context.Background(), &launchtypes.QueryGetRequestRequest{
+					LaunchID:  testutil.LaunchID,
+					RequestID: request.RequestID,
+				}).
+				Return(&launchtypes.QueryGetRequestResponse{Request: request}, nil).
+				Once()
+		}
+	)
+
+	t.Run("export the pending requests", func(t *testing.T) {
+		bundle := exportBundle(t)
+
+		require.Equal(t, RequestBundleVersion, bundle.Manifest.Version)
+		require.Equal(t, testutil.LaunchID, bundle.Manifest.LaunchID)
+		require.Equal(t, addr, bundle.Manifest.Exporter)
+		require.Equal(t, sampleTime.UTC(), bundle.Manifest.ExportedAt)
+		require.Len(t, bundle.Manifest.Hashes, 2)
+		require.Len(t, bundle.Requests, 2)
+		require.Equal(t, uint64(1), bundle.Requests[0].RequestID)
+		require.Equal(t, uint64(2), bundle.Requests[1].RequestID)
+		require.Equal(t, DecisionNone, bundle.Requests[0].Decision)
+
+		// the gentx of the validator request is included in the bundle
+		var content launchtypes.RequestContent
+		require.NoError(t, launchtypes.ModuleCdc.UnmarshalJSON(bundle.Requests[1].Content, &content))
+		require.Equal(t, gentx.JSON(t), content.GetGenesisValidator().GenTx)
+	})
+
+	t.Run("import the decisions of an exported bundle", func(t *testing.T) {
+		bundle := exportBundle(t)
+		bundle.Requests[0].Decision = DecisionApprove
+		bundle.Requests[1].Decision = DecisionReject
+		bundle.Requests[1].Reason = "invalid gentx"
+
+		suite, network := newSuite(account)
+		mockRequest(suite, requests[0])
+		mockRequest(suite, requests[1])
+		mockCoordinator(t, suite, account)
+		suite.CosmosClientMock.
+			On("BroadcastTx", context.Background(), account, launchtypes.NewMsgSettleRequest(addr, testutil.LaunchID, 1, true)).
+			Return(testutil.NewResponse(&launchtypes.MsgSettleRequestResponse{}), nil).
+			Once()
+		suite.CosmosClientMock.
+			On(
+				"BroadcastTxWithMemo",
+				context.Background(),
+				account,
+				"rejection reason: invalid gentx",
+				launchtypes.NewMsgSettleRequest(addr, testutil.LaunchID, 2, false),
+			).
+			Return(testutil.NewResponse(&launchtypes.MsgSettleRequestResponse{}), nil).
+			Once()
+
+		reviewals, err := network.ImportDecisions(context.Background(), encode(t, bundle))
+		require.NoError(t, err)
+		require.Equal(t, []Reviewal{ApproveRequest(1), RejectRequest(2, "invalid gentx")}, reviewals)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("import a bundle without decisions", func(t *testing.T) {
+		bundle := exportBundle(t)
+		suite, network := newSuite(account)
+
+		reviewals, err := network.ImportDecisions(context.Background(), encode(t, bundle))
+		require.NoError(t, err)
+		require.Empty(t, reviewals)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to import, requests changed on SPN", func(t *testing.T) {
+		bundle := exportBundle(t)
+		bundle.Requests[0].Decision = DecisionApprove
+		bundle.Requests[1].Decision = DecisionApprove
+
+		changedAccount := requests[0]
+		changedAccount.Content = launchtypes.NewGenesisAccount(
+			testutil.LaunchID,
+			addr,
+			sdk.NewCoins(sdk.NewInt64Coin(TestDenom, 1)),
+		)
+		settledValidator := requests[1]
+		settledValidator.Status = launchtypes.Request_REJECTED
+
+		suite, network := newSuite(account)
+		mockRequest(suite, changedAccount)
+		mockRequest(suite, settledValidator)
+
+		_, err := network.ImportDecisions(context.Background(), encode(t, bundle))
+		require.Equal(t, ErrChangedRequests{RequestIDs: []uint64{1, 2}}, err)
+		require.EqualError(t, err, "the requests 1, 2 changed since the bundle was exported, export a new bundle to review them")
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to import, modified manifest", func(t *testing.T) {
+		bundle := exportBundle(t)
+		bundle.Requests[0].Decision = DecisionApprove
+		bundle.Manifest.LaunchID++
+		suite, network := newSuite(account)
+
+		_, err := network.ImportDecisions(context.Background(), encode(t, bundle))
+		require.EqualError(t, err, "invalid request bundle signature, the manifest was modified")
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to import, rejection without reason", func(t *testing.T) {
+		bundle := exportBundle(t)
+		bundle.Requests[1].Decision = DecisionReject
+		suite, network := newSuite(account)
+
+		_, err := network.ImportDecisions(context.Background(), encode(t, bundle))
+		require.EqualError(t, err, "the request 2 is rejected without reason, set the reason in the bundle")
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to import, modified request content", func(t *testing.T) {
+		bundle := exportBundle(t)
+		bundle.Requests[0].Decision = DecisionApprove
+		bundle.Requests[0].Content = bundle.Requests[1].Content
+		suite, network := newSuite(account)
+
+		_, err := network.ImportDecisions(context.Background(), encode(t, bundle))
+		require.EqualError(t, err, "the content of the request 1 doesn't match the bundle manifest")
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to import, bundle of another account", func(t *testing.T) {
+		bundle := exportBundle(t)
+		other := testutil.NewTestAccount(t, "other")
+		otherAddr, err := other.Address(networktypes.SPN)
+		require.NoError(t, err)
+		suite, network := newSuite(other)
+
+		_, err = network.ImportDecisions(context.Background(), encode(t, bundle))
+		require.EqualError(t, err, "the request bundle was exported by "+addr+" and can't be imported by "+otherAddr)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to import, invalid decision", func(t *testing.T) {
+		bundle := exportBundle(t)
+		bundle.Requests[0].Decision = "maybe"
+		suite, network := newSuite(account)
+
+		_, err := network.ImportDecisions(context.Background(), encode(t, bundle))
+		require.EqualError(t, err, `invalid decision "maybe" for the request 1, expected approve or reject`)
+		suite.AssertAllMocks(t)
+	})
+}
@@ -0,0 +1,83 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmoserror"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+// SetRequestDeadline sets the time the requests of the chain must be sent by as a coordinator,
+// a zero deadline removes it. The deadline is stored in the chain metadata, the other metadata keys are preserved.
+func (n Network) SetRequestDeadline(ctx context.Context, launchID uint64, deadline time.Time) error {
+	addr, err := n.accountAddress()
+	if err != nil {
+		return err
+	}
+
+	res, err := n.launchQuery.Chain(ctx, &launchtypes.QueryGetChainRequest{
+		LaunchID: launchID,
+	})
+	if cosmoserror.Unwrap(err) == cosmoserror.ErrNotFound {
+		return ErrObjectNotFound
+	} else if err != nil {
+		return err
+	}
+
+	// the request times are block times in seconds
+	deadline = deadline.Truncate(time.Second)
+	metadata, err := networktypes.SetRequestDeadline(res.Chain.Metadata, deadline)
+	if err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, "Updating the request deadline"))
+
+	msg := launchtypes.NewMsgEditChain(addr, launchID, false, 0, metadata)
+	if _, err := n.broadcastTx(ctx, msg); err != nil {
+		return err
+	}
+
+	if deadline.IsZero() {
+		n.ev.Send(events.New(events.StatusDone, fmt.Sprintf("Request deadline of the chain %d removed", launchID)))
+		return nil
+	}
+	n.ev.Send(events.New(events.StatusDone, fmt.Sprintf(
+		"Requests of the chain %d must be sent by %s",
+		launchID,
+		deadline.UTC().Format(time.RFC3339),
+	)))
+	return nil
+}
+
+// LateRequests returns the request deadline of the chain and the IDs of its pending requests created after it.
+// The requests are compared to the deadline with the time of the block they were created in.
+func (n Network) LateRequests(ctx context.Context, launchID uint64, requestIDs ...uint64) (time.Time, []uint64, error) {
+	// the late requests are approved with an explicit confirmation, the requests must be fresh
+	n = n.WithoutQueryCache()
+
+	launch, err := n.ChainLaunch(ctx, launchID)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	deadline := launch.RequestDeadline()
+	if deadline.IsZero() {
+		return deadline, nil, nil
+	}
+
+	var requests []networktypes.Request
+	if len(requestIDs) > 0 {
+		requests, err = n.RequestFromIDs(ctx, launchID, requestIDs...)
+	} else {
+		requests, err = n.Requests(ctx, launchID)
+	}
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	return deadline, networktypes.LateRequests(requests, deadline), nil
+}
@@ -0,0 +1,45 @@
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+)
+
+func TestRequestDeadline(t *testing.T) {
+	sim, network, launchID := newLaunchSimulator(t)
+	deadline := sampleTime.Add(-time.Minute).Truncate(time.Second)
+
+	// the deadline is stored with the precision of the block times
+	require.NoError(t, network.SetRequestDeadline(context.Background(), launchID, deadline.Add(500*time.Millisecond)))
+	chain, _ := sim.Chain(launchID)
+	require.JSONEq(t, `{"request_deadline":"`+deadline.UTC().Format(time.RFC3339)+`"}`, string(chain.Metadata))
+
+	for _, createdAt := range []time.Time{deadline, deadline.Add(time.Second)} {
+		sim.AddRequest(launchtypes.Request{
+			LaunchID:  launchID,
+			CreatedAt: createdAt.Unix(),
+			Content:   launchtypes.NewAccountRemoval("spn1foo"),
+			Status:    launchtypes.Request_PENDING,
+		})
+	}
+
+	got, late, err := network.LateRequests(context.Background(), launchID)
+	require.NoError(t, err)
+	require.True(t, deadline.Equal(got))
+	require.Equal(t, []uint64{2}, late)
+
+	_, late, err = network.LateRequests(context.Background(), launchID, 1)
+	require.NoError(t, err)
+	require.Empty(t, late)
+
+	// without deadline no request is late
+	require.NoError(t, network.SetRequestDeadline(context.Background(), launchID, time.Time{}))
+	got, late, err = network.LateRequests(context.Background(), launchID)
+	require.NoError(t, err)
+	require.True(t, got.IsZero())
+	require.Empty(t, late)
+}
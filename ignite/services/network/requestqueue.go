@@ -0,0 +1,120 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+const (
+	// SettlementRateWindow is the period before now the settlement rate of a coordinator is measured over.
+	SettlementRateWindow = 7 * 24 * time.Hour
+
+	// minSettlementRateSpan is the minimum period the settlement rate is measured over,
+	// the requests settled together right before now don't inflate the rate.
+	minSettlementRateSpan = time.Hour
+)
+
+// RequestQueuePosition returns the position of the pending request among the pending requests of the launch
+// and estimates when the coordinator settles it from the rate of its recent settlements.
+// The launches without recent settlements only have the position of the request.
+func (n Network) RequestQueuePosition(
+	ctx context.Context,
+	launchID,
+	requestID uint64,
+) (networktypes.RequestQueuePosition, error) {
+	var (
+		pending    []uint64
+		status     launchtypes.Request_Status
+		found      bool
+		pagination = &query.PageRequest{Limit: pendingRequestsPageLimit}
+	)
+	for {
+		res, err := n.launchQuery.RequestAll(ctx, &launchtypes.QueryAllRequestRequest{
+			LaunchID:   launchID,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return networktypes.RequestQueuePosition{}, err
+		}
+		for _, request := range res.Request {
+			if request.RequestID == requestID {
+				status, found = request.Status, true
+			}
+			if request.Status == launchtypes.Request_PENDING {
+				pending = append(pending, request.RequestID)
+			}
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: pendingRequestsPageLimit}
+	}
+	if !found {
+		return networktypes.RequestQueuePosition{}, fmt.Errorf("request %d not found for the chain %d", requestID, launchID)
+	}
+	if status != launchtypes.Request_PENDING {
+		return networktypes.RequestQueuePosition{}, fmt.Errorf(
+			"request %d is not pending, it's %s",
+			requestID,
+			strings.ToLower(launchtypes.Request_Status_name[int32(status)]),
+		)
+	}
+
+	// the requests are reviewed in the order they were sent
+	sort.Slice(pending, func(i, j int) bool { return pending[i] < pending[j] })
+	position := networktypes.RequestQueuePosition{
+		LaunchID:  launchID,
+		RequestID: requestID,
+		Position:  sort.Search(len(pending), func(i int) bool { return pending[i] >= requestID }) + 1,
+		Pending:   len(pending),
+	}
+
+	settlements, err := n.settlements(ctx, launchID)
+	if err != nil {
+		return networktypes.RequestQueuePosition{}, err
+	}
+	now := n.clock.Now()
+	if position.SettlementRate = settlementRate(settlements, now); position.HasEstimate() {
+		hours := float64(position.Position) / position.SettlementRate
+		position.EstimatedWait = time.Duration(hours * float64(time.Hour)).Round(time.Second)
+		position.EstimatedSettlement = now.Add(position.EstimatedWait)
+	}
+	return position, nil
+}
+
+// settlementRate returns the number of requests settled per hour within the settlement rate window before now.
+// The rate is measured from the first settlement of the window, a coordinator that stopped settling the
+// requests has a decreasing rate.
+func settlementRate(settlements map[uint64]requestSettlement, now time.Time) float64 {
+	var (
+		windowStart = now.Add(-SettlementRateWindow)
+		first       = now
+		count       int
+	)
+	for _, settlement := range settlements {
+		if !settlement.Time.After(windowStart) || settlement.Time.After(now) {
+			continue
+		}
+		count++
+		if settlement.Time.Before(first) {
+			first = settlement.Time
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+
+	span := now.Sub(first)
+	if span < minSettlementRateSpan {
+		span = minSettlementRateSpan
+	}
+	return float64(count) / span.Hours()
+}
@@ -0,0 +1,174 @@
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+func TestRequestQueuePosition(t *testing.T) {
+	queueRequest := func(requestID uint64, status launchtypes.Request_Status) launchtypes.Request {
+		return launchtypes.Request{LaunchID: testutil.LaunchID, RequestID: requestID, Status: status}
+	}
+	// the queue has the pending requests 3, 5 and 8 over two pages
+	mockQueue := func(suite testutil.Suite) {
+		suite.LaunchQueryMock.
+			On("RequestAll", mock.Anything, &launchtypes.QueryAllRequestRequest{
+				LaunchID:   testutil.LaunchID,
+				Pagination: &query.PageRequest{Limit: pendingRequestsPageLimit},
+			}).
+			Return(&launchtypes.QueryAllRequestResponse{
+				Request: []launchtypes.Request{
+					queueRequest(1, launchtypes.Request_APPROVED),
+					queueRequest(2, launchtypes.Request_REJECTED),
+					queueRequest(3, launchtypes.Request_PENDING),
+					queueRequest(4, launchtypes.Request_APPROVED),
+				},
+				Pagination: &query.PageResponse{NextKey: []byte("5")},
+			}, nil).
+			Once()
+		suite.LaunchQueryMock.
+			On("RequestAll", mock.Anything, &launchtypes.QueryAllRequestRequest{
+				LaunchID:   testutil.LaunchID,
+				Pagination: &query.PageRequest{Key: []byte("5"), Limit: pendingRequestsPageLimit},
+			}).
+			Return(&launchtypes.QueryAllRequestResponse{
+				Request: []launchtypes.Request{
+					queueRequest(5, launchtypes.Request_PENDING),
+					queueRequest(6, launchtypes.Request_APPROVED),
+					queueRequest(8, launchtypes.Request_PENDING),
+				},
+				Pagination: &query.PageResponse{},
+			}, nil).
+			Once()
+	}
+	mockSettlements := func(t *testing.T, suite testutil.Suite, blockTimes map[int64]time.Time) {
+		suite.CosmosClientMock.
+			On("SearchTxs", mock.Anything, settledRequestsQuery).
+			Return([]*ctypes.ResultTx{
+				settlementTx(t, 10,
+					&launchtypes.EventRequestSettled{LaunchID: testutil.LaunchID, RequestID: 1, Approved: true},
+					&launchtypes.EventRequestSettled{LaunchID: testutil.LaunchID, RequestID: 2},
+				),
+				settlementTx(t, 20,
+					&launchtypes.EventRequestSettled{LaunchID: testutil.LaunchID, RequestID: 4, Approved: true},
+				),
+				settlementTx(t, 30,
+					&launchtypes.EventRequestSettled{LaunchID: testutil.LaunchID, RequestID: 6, Approved: true},
+				),
+			}, nil).
+			Once()
+		suite.CosmosClientMock.
+			On("BlockTimes", mock.Anything, int64(10), int64(20), int64(30)).
+			Return(blockTimes, nil).
+			Once()
+	}
+
+	t.Run("position and estimated settlement", func(t *testing.T) {
+		suite, network := newSuite(testutil.NewTestAccount(t, testutil.TestAccountName))
+		mockQueue(suite)
+		// 4 requests settled over the last 2 hours
+		mockSettlements(t, suite, map[int64]time.Time{
+			10: sampleTime.Add(-2 * time.Hour),
+			20: sampleTime.Add(-time.Hour),
+			30: sampleTime.Add(-30 * time.Minute),
+		})
+
+		position, err := network.RequestQueuePosition(context.Background(), testutil.LaunchID, 5)
+		require.NoError(t, err)
+		require.Equal(t, networktypes.RequestQueuePosition{
+			LaunchID:            testutil.LaunchID,
+			RequestID:           5,
+			Position:            2,
+			Pending:             3,
+			SettlementRate:      2,
+			EstimatedWait:       time.Hour,
+			EstimatedSettlement: sampleTime.Add(time.Hour),
+		}, position)
+		require.True(t, position.HasEstimate())
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("settlements outside the window", func(t *testing.T) {
+		suite, network := newSuite(testutil.NewTestAccount(t, testutil.TestAccountName))
+		mockQueue(suite)
+		// only the latest settlement is recent, the rate is measured from it
+		mockSettlements(t, suite, map[int64]time.Time{
+			10: sampleTime.Add(-30 * 24 * time.Hour),
+			20: sampleTime.Add(-10 * 24 * time.Hour),
+			30: sampleTime.Add(-4 * time.Hour),
+		})
+
+		position, err := network.RequestQueuePosition(context.Background(), testutil.LaunchID, 8)
+		require.NoError(t, err)
+		require.Equal(t, 3, position.Position)
+		require.Equal(t, 0.25, position.SettlementRate)
+		require.Equal(t, 12*time.Hour, position.EstimatedWait)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("burst of settlements", func(t *testing.T) {
+		suite, network := newSuite(testutil.NewTestAccount(t, testutil.TestAccountName))
+		mockQueue(suite)
+		// the settlements of the last minutes are measured over an hour
+		mockSettlements(t, suite, map[int64]time.Time{
+			10: sampleTime.Add(-3 * time.Minute),
+			20: sampleTime.Add(-2 * time.Minute),
+			30: sampleTime.Add(-time.Minute),
+		})
+
+		position, err := network.RequestQueuePosition(context.Background(), testutil.LaunchID, 3)
+		require.NoError(t, err)
+		require.Equal(t, 1, position.Position)
+		require.Equal(t, float64(4), position.SettlementRate)
+		require.Equal(t, 15*time.Minute, position.EstimatedWait)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("no settlement history", func(t *testing.T) {
+		suite, network := newSuite(testutil.NewTestAccount(t, testutil.TestAccountName))
+		mockQueue(suite)
+		suite.CosmosClientMock.
+			On("SearchTxs", mock.Anything, settledRequestsQuery).
+			Return(nil, nil).
+			Once()
+
+		position, err := network.RequestQueuePosition(context.Background(), testutil.LaunchID, 8)
+		require.NoError(t, err)
+		require.Equal(t, networktypes.RequestQueuePosition{
+			LaunchID:  testutil.LaunchID,
+			RequestID: 8,
+			Position:  3,
+			Pending:   3,
+		}, position)
+		require.False(t, position.HasEstimate())
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("settled request", func(t *testing.T) {
+		suite, network := newSuite(testutil.NewTestAccount(t, testutil.TestAccountName))
+		mockQueue(suite)
+
+		_, err := network.RequestQueuePosition(context.Background(), testutil.LaunchID, 4)
+		require.EqualError(t, err, "request 4 is not pending, it's approved")
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("unknown request", func(t *testing.T) {
+		suite, network := newSuite(testutil.NewTestAccount(t, testutil.TestAccountName))
+		mockQueue(suite)
+
+		_, err := network.RequestQueuePosition(context.Background(), testutil.LaunchID, 7)
+		require.EqualError(t, err, "request 7 not found for the chain 1")
+		suite.AssertAllMocks(t)
+	})
+}
@@ -0,0 +1,172 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+)
+
+// NoRequestLimit is the remaining quota of the accounts when SPN doesn't charge a fee for the requests.
+const NoRequestLimit = -1
+
+// RequestQuota is the number of requests an account can still send for a chain.
+// SPN charges the request fee of the launch params for every request, the quota is the number of fees the
+// balance of the account can pay.
+type RequestQuota struct {
+	// Fee is the fee charged for a request.
+	Fee sdk.Coins
+
+	// Balance is the balance of the account.
+	Balance sdk.Coins
+
+	// Pending is the number of pending requests sent by the account for the chain.
+	Pending int
+
+	// Remaining is the number of requests the account can pay, NoRequestLimit when the requests are free.
+	Remaining int
+}
+
+// Allows checks if the quota allows to send count requests.
+func (q RequestQuota) Allows(count int) bool {
+	return q.Remaining == NoRequestLimit || count <= q.Remaining
+}
+
+// ErrRequestQuota is returned when the account can't pay the fees of the requests it sends.
+type ErrRequestQuota struct {
+	// Count is the number of requests sent.
+	Count int
+
+	// Limit is the number of requests the account can pay.
+	Limit int
+
+	// Pending is the number of pending requests sent by the account for the chain.
+	Pending int
+
+	// Fee is the fee charged for a request.
+	Fee sdk.Coins
+}
+
+// Error implements error.
+func (e ErrRequestQuota) Error() string {
+	return fmt.Sprintf(
+		"request quota exceeded: cannot send %d request(s), the account balance pays the %s fee of %d request(s) (%d pending)",
+		e.Count,
+		e.Fee,
+		e.Limit,
+		e.Pending,
+	)
+}
+
+// RequestQuota fetches the request quota of the account for the chain.
+func (n Network) RequestQuota(ctx context.Context, launchID uint64) (RequestQuota, error) {
+	addr, err := n.accountAddress()
+	if err != nil {
+		return RequestQuota{}, err
+	}
+
+	params, err := n.WithoutQueryCache().LaunchParams(ctx)
+	if err != nil {
+		return RequestQuota{}, err
+	}
+
+	quota := RequestQuota{
+		Fee:       params.RequestFee,
+		Remaining: NoRequestLimit,
+	}
+	if quota.Fee.IsZero() {
+		return quota, nil
+	}
+
+	observe := n.observeLatency("balances", MetricsKindQuery)
+	balances, err := n.bankQuery.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{Address: addr})
+	observe()
+	if err != nil {
+		return RequestQuota{}, err
+	}
+	quota.Balance = balances.Balances
+	quota.Remaining = requestsPaid(quota.Balance, quota.Fee)
+
+	observe = n.observeLatency("requests", MetricsKindQuery)
+	requests, err := n.allRequests(ctx, launchID)
+	observe()
+	if err != nil {
+		return RequestQuota{}, err
+	}
+	for _, request := range requests {
+		if request.Creator == addr && request.Status == launchtypes.Request_PENDING {
+			quota.Pending++
+		}
+	}
+
+	return quota, nil
+}
+
+// checkRequestQuota returns an ErrRequestQuota error if the account can't pay the fees of count requests.
+func (n Network) checkRequestQuota(ctx context.Context, launchID uint64, count int) error {
+	quota, err := n.RequestQuota(ctx, launchID)
+	if err != nil {
+		return err
+	}
+	if !quota.Allows(count) {
+		return quotaError(quota, count)
+	}
+	return nil
+}
+
+// requestBroadcastError translates the broadcast error of count requests into an ErrRequestQuota error
+// when SPN rejected them because the account can't pay their fees.
+// The quota is fetched again since the balance used by the pre-check can be outdated.
+func (n Network) requestBroadcastError(ctx context.Context, launchID uint64, count int, err error) error {
+	if !isInsufficientFunds(err) {
+		return err
+	}
+	quota, quotaErr := n.RequestQuota(ctx, launchID)
+	if quotaErr != nil || quota.Remaining == NoRequestLimit {
+		return err
+	}
+	return quotaError(quota, count)
+}
+
+func quotaError(quota RequestQuota, count int) ErrRequestQuota {
+	return ErrRequestQuota{
+		Count:   count,
+		Limit:   quota.Remaining,
+		Pending: quota.Pending,
+		Fee:     quota.Fee,
+	}
+}
+
+// requestsPaid returns the number of fees paid by the balance.
+func requestsPaid(balance, fee sdk.Coins) int {
+	paid := int64(math.MaxInt32)
+	for _, coin := range fee {
+		if coin.Amount.IsZero() {
+			continue
+		}
+		n := balance.AmountOf(coin.Denom).Quo(coin.Amount)
+		if !n.IsInt64() {
+			continue
+		}
+		if n.Int64() < paid {
+			paid = n.Int64()
+		}
+	}
+	return int(paid)
+}
+
+// isInsufficientFunds checks if the broadcast error is the SDK error returned when the fees can't be paid.
+// The broadcast errors only contain the raw log of the tx, the error is matched from its description.
+func isInsufficientFunds(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	return strings.Contains(msg, sdkerrors.ErrInsufficientFunds.Error()) ||
+		strings.Contains(msg, sdkerrors.ErrInsufficientFee.Error())
+}
@@ -0,0 +1,239 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+// mockRequestQuota mocks the queries of the request quota of the account, the balance and the requests
+// are only queried when the requests aren't free.
+func mockRequestQuota(suite testutil.Suite, addr string, fee, balance sdk.Coins, requests ...launchtypes.Request) {
+	suite.LaunchQueryMock.
+		On("Params", context.Background(), &launchtypes.QueryParamsRequest{}).
+		Return(&launchtypes.QueryParamsResponse{Params: launchtypes.Params{RequestFee: fee}}, nil).
+		Once()
+	if fee.IsZero() {
+		return
+	}
+	suite.BankClient.
+		On("AllBalances", context.Background(), &banktypes.QueryAllBalancesRequest{Address: addr}).
+		Return(&banktypes.QueryAllBalancesResponse{Balances: balance}, nil).
+		Once()
+	suite.LaunchQueryMock.
+		On("RequestAll", context.Background(), &launchtypes.QueryAllRequestRequest{LaunchID: testutil.LaunchID}).
+		Return(&launchtypes.QueryAllRequestResponse{Request: requests}, nil).
+		Once()
+}
+
+func TestRequestQuota(t *testing.T) {
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	var (
+		fee     = sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("token", 1))
+		pending = []launchtypes.Request{
+			{Creator: addr, Status: launchtypes.Request_PENDING},
+			{Creator: addr, Status: launchtypes.Request_APPROVED},
+			{Creator: "spn1other", Status: launchtypes.Request_PENDING},
+		}
+	)
+
+	tests := []struct {
+		name    string
+		fee     sdk.Coins
+		balance sdk.Coins
+		want    RequestQuota
+	}{
+		{
+			name: "free requests",
+			want: RequestQuota{Remaining: NoRequestLimit},
+		},
+		{
+			name:    "balance at the limit of a fee",
+			fee:     fee,
+			balance: sdk.NewCoins(sdk.NewInt64Coin("stake", 30), sdk.NewInt64Coin("token", 3)),
+			want: RequestQuota{
+				Fee:       fee,
+				Balance:   sdk.NewCoins(sdk.NewInt64Coin("stake", 30), sdk.NewInt64Coin("token", 3)),
+				Pending:   1,
+				Remaining: 3,
+			},
+		},
+		{
+			name:    "balance below the limit of a fee",
+			fee:     fee,
+			balance: sdk.NewCoins(sdk.NewInt64Coin("stake", 29), sdk.NewInt64Coin("token", 3)),
+			want: RequestQuota{
+				Fee:       fee,
+				Balance:   sdk.NewCoins(sdk.NewInt64Coin("stake", 29), sdk.NewInt64Coin("token", 3)),
+				Pending:   1,
+				Remaining: 2,
+			},
+		},
+		{
+			name:    "missing fee denom",
+			fee:     fee,
+			balance: sdk.NewCoins(sdk.NewInt64Coin("stake", 30)),
+			want: RequestQuota{
+				Fee:       fee,
+				Balance:   sdk.NewCoins(sdk.NewInt64Coin("stake", 30)),
+				Pending:   1,
+				Remaining: 0,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suite, network := newSuite(account)
+			mockRequestQuota(suite, addr, tt.fee, tt.balance, pending...)
+
+			quota, err := network.RequestQuota(context.Background(), testutil.LaunchID)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, quota)
+			suite.AssertAllMocks(t)
+		})
+	}
+}
+
+func TestJoinRequestQuota(t *testing.T) {
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	var (
+		fee       = sdk.NewCoins(sdk.NewInt64Coin(TestDenom, 10))
+		gentx     = testutil.NewGentx(addr, TestDenom, TestAmountString, "", testutil.PeerAddress)
+		gentxPath = gentx.SaveTo(t, t.TempDir())
+	)
+
+	t.Run("failed to send join request, the account can't pay the account request", func(t *testing.T) {
+		suite, network := newSuite(account)
+		mockRequestQuota(suite, addr, fee, sdk.NewCoins(sdk.NewInt64Coin(TestDenom, 19)))
+
+		err := network.Join(
+			context.Background(),
+			suite.ChainMock,
+			testutil.LaunchID,
+			gentxPath,
+			WithAccountRequest(sdk.NewCoins(sdk.NewInt64Coin(TestDenom, TestAmountInt))),
+		)
+		require.Equal(t, ErrRequestQuota{Count: 2, Limit: 1, Fee: fee}, err)
+		require.EqualError(
+			t,
+			err,
+			"request quota exceeded: cannot send 2 request(s), the account balance pays the 10stake fee of 1 request(s) (0 pending)",
+		)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to send join request, the fee is rejected by SPN", func(t *testing.T) {
+		suite, network := newSuite(account)
+		mockRequestQuota(suite, addr, fee, sdk.NewCoins(sdk.NewInt64Coin(TestDenom, 10)))
+		suite.CosmosClientMock.
+			On("BroadcastTx", context.Background(), account, mock.Anything).
+			Return(testutil.NewResponse(&launchtypes.MsgSendRequestResponse{}), errors.New(
+				"error code: '5' msg: 'failed to execute message; message index: 0: 5stake is smaller than 10stake: insufficient funds'",
+			)).
+			Once()
+		// the balance is spent by another tx in the meantime
+		mockRequestQuota(suite, addr, fee, sdk.NewCoins(sdk.NewInt64Coin(TestDenom, 5)))
+
+		err := network.Join(context.Background(), suite.ChainMock, testutil.LaunchID, gentxPath)
+		require.Equal(t, ErrRequestQuota{Count: 1, Limit: 0, Fee: fee}, err)
+		suite.AssertAllMocks(t)
+	})
+}
+
+func TestSendRequests(t *testing.T) {
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	var (
+		fee      = sdk.NewCoins(sdk.NewInt64Coin(TestDenom, 10))
+		contents = []launchtypes.RequestContent{
+			launchtypes.NewGenesisAccount(testutil.LaunchID, "spn1foo", sdk.NewCoins(sdk.NewInt64Coin(TestDenom, 1))),
+			launchtypes.NewGenesisAccount(testutil.LaunchID, "spn1bar", sdk.NewCoins(sdk.NewInt64Coin(TestDenom, 2))),
+			launchtypes.NewAccountRemoval("spn1baz"),
+		}
+		mockSend = func(suite testutil.Suite, content launchtypes.RequestContent, requestID uint64) {
+			suite.CosmosClientMock.
+				On(
+					"BroadcastTx",
+					context.Background(),
+					account,
+					launchtypes.NewMsgSendRequest(addr, testutil.LaunchID, content),
+				).
+				Return(testutil.NewResponse(&launchtypes.MsgSendRequestResponse{RequestID: requestID}), nil).
+				Once()
+		}
+	)
+
+	t.Run("successfully send requests", func(t *testing.T) {
+		suite, network := newSuite(account)
+		mockRequestQuota(suite, addr, fee, sdk.NewCoins(sdk.NewInt64Coin(TestDenom, 30)))
+		for i, content := range contents {
+			mockSend(suite, content, uint64(i+1))
+		}
+
+		sent, err := network.SendRequests(context.Background(), testutil.LaunchID, contents...)
+		require.NoError(t, err)
+		require.Equal(t, SentRequests{RequestIDs: []uint64{1, 2, 3}}, sent)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("successfully send requests trimmed to the quota", func(t *testing.T) {
+		var (
+			suite, network = newSuite(account)
+			ev             = events.NewBus(events.WithCustomBufferSize(10))
+		)
+		CollectEvents(ev)(&network)
+		mockRequestQuota(suite, addr, fee, sdk.NewCoins(sdk.NewInt64Coin(TestDenom, 29)))
+		mockDenomsMetadata(suite, testDenomMetadata)
+		mockSend(suite, contents[0], 1)
+		mockSend(suite, contents[1], 2)
+
+		sent, err := network.SendRequests(context.Background(), testutil.LaunchID, contents...)
+		require.NoError(t, err)
+		require.Equal(t, SentRequests{RequestIDs: []uint64{1, 2}, Skipped: contents[2:]}, sent)
+		suite.AssertAllMocks(t)
+		require.Contains(
+			t,
+			collectDescriptions(ev),
+			"1 of 3 requests won't be submitted, the account balance pays the 0.01 mstake fee of 2 request(s)",
+		)
+	})
+
+	t.Run("failed to send requests, failed to broadcast", func(t *testing.T) {
+		suite, network := newSuite(account)
+		expectedErr := errors.New("failed to broadcast")
+		mockRequestQuota(suite, addr, nil, nil)
+		mockSend(suite, contents[0], 1)
+		suite.CosmosClientMock.
+			On(
+				"BroadcastTx",
+				context.Background(),
+				account,
+				launchtypes.NewMsgSendRequest(addr, testutil.LaunchID, contents[1]),
+			).
+			Return(testutil.NewResponse(&launchtypes.MsgSendRequestResponse{}), expectedErr).
+			Once()
+
+		sent, err := network.SendRequests(context.Background(), testutil.LaunchID, contents...)
+		require.ErrorIs(t, err, expectedErr)
+		require.Equal(t, SentRequests{RequestIDs: []uint64{1}}, sent)
+		suite.AssertAllMocks(t)
+	})
+}
@@ -0,0 +1,209 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+// requestStatsDayLayout is the layout of the days of the request stats.
+const requestStatsDayLayout = "2006-01-02"
+
+// RequestStats computes the statistics of the requests of a launch: the daily counts of the requests
+// by type and status, the percentiles of the approval latency and the distribution of the validator
+// self-delegations. The approval times are the times of the blocks of the settlement txs.
+func (n Network) RequestStats(ctx context.Context, launchID uint64) (networktypes.RequestStats, error) {
+	requests, err := n.allRequests(ctx, launchID)
+	if err != nil {
+		return networktypes.RequestStats{}, err
+	}
+
+	approvalTimes, err := n.approvalTimes(ctx, launchID)
+	if err != nil {
+		return networktypes.RequestStats{}, err
+	}
+
+	var (
+		stats = networktypes.RequestStats{
+			LaunchID: launchID,
+			Total:    len(requests),
+		}
+		daily           = make(map[networktypes.RequestDailyCount]int)
+		latencies       []time.Duration
+		selfDelegations = make(map[string][]sdkmath.Int)
+	)
+	for _, req := range requests {
+		key := networktypes.RequestDailyCount{
+			Day:    time.Unix(req.CreatedAt, 0).UTC().Format(requestStatsDayLayout),
+			Type:   networktypes.RequestType(req.Content),
+			Status: launchtypes.Request_Status_name[int32(req.Status)],
+		}
+		daily[key]++
+
+		if approvedAt, ok := approvalTimes[req.RequestID]; ok && req.Status == launchtypes.Request_APPROVED {
+			latencies = append(latencies, approvedAt.Sub(time.Unix(req.CreatedAt, 0)))
+		}
+
+		validator := req.Content.GetGenesisValidator()
+		if validator != nil && req.Status != launchtypes.Request_REJECTED {
+			denom := validator.SelfDelegation.Denom
+			selfDelegations[denom] = append(selfDelegations[denom], validator.SelfDelegation.Amount)
+		}
+	}
+
+	for key, count := range daily {
+		key.Count = count
+		stats.Daily = append(stats.Daily, key)
+	}
+	sort.Slice(stats.Daily, func(i, j int) bool {
+		a, b := stats.Daily[i], stats.Daily[j]
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.Status < b.Status
+	})
+
+	stats.ApprovalLatency = latencyPercentiles(latencies)
+
+	for denom, amounts := range selfDelegations {
+		stats.SelfDelegations = append(stats.SelfDelegations, selfDelegationDistribution(denom, amounts))
+	}
+	sort.Slice(stats.SelfDelegations, func(i, j int) bool {
+		return stats.SelfDelegations[i].Denom < stats.SelfDelegations[j].Denom
+	})
+
+	return stats, nil
+}
+
+// requestSettlement is the settlement of a request in a tx.
+type requestSettlement struct {
+	Time     time.Time
+	Approved bool
+}
+
+// approvalTimes returns the times of the blocks of the settlement txs of the approved requests by request ID.
+func (n Network) approvalTimes(ctx context.Context, launchID uint64) (map[uint64]time.Time, error) {
+	settlements, err := n.settlements(ctx, launchID)
+	if err != nil {
+		return nil, err
+	}
+
+	var approvalTimes map[uint64]time.Time
+	for requestID, settlement := range settlements {
+		if !settlement.Approved {
+			continue
+		}
+		if approvalTimes == nil {
+			approvalTimes = make(map[uint64]time.Time)
+		}
+		approvalTimes[requestID] = settlement.Time
+	}
+	return approvalTimes, nil
+}
+
+// settlements returns the settlements of the requests of the launch by request ID, the times of the
+// settlements are the times of the blocks of the settlement txs. The times of the blocks are fetched in batches.
+func (n Network) settlements(ctx context.Context, launchID uint64) (map[uint64]requestSettlement, error) {
+	var (
+		settledEvent = proto.MessageName(&launchtypes.EventRequestSettled{})
+		query        = fmt.Sprintf("%s.launchID='\"%d\"'", settledEvent, launchID)
+	)
+	txs, err := n.cosmos.SearchTxs(ctx, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot fetch the request settlements")
+	}
+
+	var (
+		settledHeights = make(map[uint64]int64)
+		approved       = make(map[uint64]bool)
+		heights        []int64
+	)
+	for _, tx := range txs {
+		for _, event := range tx.TxResult.Events {
+			if event.Type != settledEvent {
+				continue
+			}
+			msg, err := sdk.ParseTypedEvent(event)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid request settlement in tx %s", tx.Hash)
+			}
+			settled, ok := msg.(*launchtypes.EventRequestSettled)
+			if !ok || settled.LaunchID != launchID {
+				continue
+			}
+			settledHeights[settled.RequestID] = tx.Height
+			approved[settled.RequestID] = settled.Approved
+			if len(heights) == 0 || heights[len(heights)-1] != tx.Height {
+				heights = append(heights, tx.Height)
+			}
+		}
+	}
+	if len(heights) == 0 {
+		return nil, nil
+	}
+
+	blockTimes, err := n.cosmos.BlockTimes(ctx, heights...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot fetch the times of the request settlements")
+	}
+
+	settlements := make(map[uint64]requestSettlement, len(settledHeights))
+	for requestID, height := range settledHeights {
+		settlements[requestID] = requestSettlement{
+			Time:     blockTimes[height],
+			Approved: approved[requestID],
+		}
+	}
+	return settlements, nil
+}
+
+// latencyPercentiles returns the nearest-rank percentiles of the latencies.
+func latencyPercentiles(latencies []time.Duration) networktypes.LatencyPercentiles {
+	if len(latencies) == 0 {
+		return networktypes.LatencyPercentiles{}
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	percentile := func(p float64) float64 {
+		rank := int(math.Ceil(p * float64(len(latencies))))
+		return latencies[rank-1].Seconds()
+	}
+	return networktypes.LatencyPercentiles{
+		Count: len(latencies),
+		P50:   percentile(0.5),
+		P90:   percentile(0.9),
+		P99:   percentile(0.99),
+	}
+}
+
+// selfDelegationDistribution returns the distribution of the self-delegation amounts of a denom,
+// the median of an even number of amounts is the lower middle amount.
+func selfDelegationDistribution(denom string, amounts []sdkmath.Int) networktypes.SelfDelegationDistribution {
+	sort.Slice(amounts, func(i, j int) bool { return amounts[i].LT(amounts[j]) })
+
+	total := sdkmath.ZeroInt()
+	for _, amount := range amounts {
+		total = total.Add(amount)
+	}
+	return networktypes.SelfDelegationDistribution{
+		Denom:  denom,
+		Count:  len(amounts),
+		Total:  total,
+		Min:    amounts[0],
+		Median: amounts[(len(amounts)-1)/2],
+		Max:    amounts[len(amounts)-1],
+	}
+}
@@ -0,0 +1,227 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+const settledRequestsQuery = `tendermint.spn.launch.EventRequestSettled.launchID='"1"'`
+
+// settlementTx returns a tx at the height settling the requests.
+func settlementTx(t *testing.T, height int64, settled ...*launchtypes.EventRequestSettled) *ctypes.ResultTx {
+	var events []abci.Event
+	for _, s := range settled {
+		event, err := sdk.TypedEventToEvent(s)
+		require.NoError(t, err)
+		events = append(events, abci.Event(event))
+	}
+	return &ctypes.ResultTx{
+		Height:   height,
+		TxResult: abci.ResponseDeliverTx{Events: events},
+	}
+}
+
+func TestRequestStats(t *testing.T) {
+	var (
+		day1 = time.Date(2022, time.May, 1, 10, 0, 0, 0, time.UTC)
+		day2 = time.Date(2022, time.May, 2, 23, 30, 0, 0, time.UTC)
+	)
+	newStatsRequest := func(
+		requestID uint64,
+		createdAt time.Time,
+		status launchtypes.Request_Status,
+		content launchtypes.RequestContent,
+	) launchtypes.Request {
+		return launchtypes.Request{
+			LaunchID:  testutil.LaunchID,
+			RequestID: requestID,
+			CreatedAt: createdAt.Unix(),
+			Content:   content,
+			Status:    status,
+		}
+	}
+	newValidator := func(selfDelegation sdk.Coin) launchtypes.RequestContent {
+		return launchtypes.NewGenesisValidator(testutil.LaunchID, "spn1abc", nil, nil, selfDelegation, launchtypes.Peer{})
+	}
+	account := launchtypes.NewGenesisAccount(testutil.LaunchID, "spn1abc", sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+
+	t.Run("synthetic request history", func(t *testing.T) {
+		var (
+			spnAccount     = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(spnAccount)
+		)
+		suite.LaunchQueryMock.
+			On("RequestAll", mock.Anything, &launchtypes.QueryAllRequestRequest{LaunchID: testutil.LaunchID}).
+			Return(&launchtypes.QueryAllRequestResponse{
+				Request: []launchtypes.Request{
+					newStatsRequest(1, day1, launchtypes.Request_APPROVED, newValidator(sdk.NewInt64Coin("stake", 300))),
+					newStatsRequest(2, day1, launchtypes.Request_APPROVED, newValidator(sdk.NewInt64Coin("stake", 100))),
+					newStatsRequest(3, day1, launchtypes.Request_REJECTED, newValidator(sdk.NewInt64Coin("stake", 5000))),
+					newStatsRequest(4, day1, launchtypes.Request_APPROVED, account),
+					newStatsRequest(5, day2, launchtypes.Request_PENDING, newValidator(sdk.NewInt64Coin("stake", 200))),
+					newStatsRequest(6, day2, launchtypes.Request_PENDING, newValidator(sdk.NewInt64Coin("uatom", 7))),
+					newStatsRequest(7, day2, launchtypes.Request_PENDING, account),
+					newStatsRequest(8, day2, launchtypes.Request_PENDING, account),
+				},
+			}, nil).
+			Once()
+		suite.CosmosClientMock.
+			On("SearchTxs", mock.Anything, settledRequestsQuery).
+			Return([]*ctypes.ResultTx{
+				settlementTx(t, 10,
+					&launchtypes.EventRequestSettled{LaunchID: testutil.LaunchID, RequestID: 1, Approved: true},
+					&launchtypes.EventRequestSettled{LaunchID: testutil.LaunchID, RequestID: 3},
+				),
+				settlementTx(t, 25,
+					&launchtypes.EventRequestSettled{LaunchID: testutil.LaunchID, RequestID: 2, Approved: true},
+				),
+				settlementTx(t, 40,
+					&launchtypes.EventRequestSettled{LaunchID: testutil.LaunchID, RequestID: 4, Approved: true},
+				),
+			}, nil).
+			Once()
+		suite.CosmosClientMock.
+			On("BlockTimes", mock.Anything, int64(10), int64(25), int64(40)).
+			Return(map[int64]time.Time{
+				10: day1.Add(time.Minute),
+				25: day1.Add(time.Hour),
+				40: day1.Add(3 * time.Hour),
+			}, nil).
+			Once()
+
+		stats, err := network.RequestStats(context.Background(), testutil.LaunchID)
+		require.NoError(t, err)
+		require.Equal(t, networktypes.RequestStats{
+			LaunchID: testutil.LaunchID,
+			Total:    8,
+			Daily: []networktypes.RequestDailyCount{
+				{Day: "2022-05-01", Type: "add-account", Status: "APPROVED", Count: 1},
+				{Day: "2022-05-01", Type: "add-validator", Status: "APPROVED", Count: 2},
+				{Day: "2022-05-01", Type: "add-validator", Status: "REJECTED", Count: 1},
+				{Day: "2022-05-02", Type: "add-account", Status: "PENDING", Count: 2},
+				{Day: "2022-05-02", Type: "add-validator", Status: "PENDING", Count: 2},
+			},
+			ApprovalLatency: networktypes.LatencyPercentiles{
+				Count: 3,
+				P50:   3600,
+				P90:   10800,
+				P99:   10800,
+			},
+			SelfDelegations: []networktypes.SelfDelegationDistribution{
+				{
+					Denom:  "stake",
+					Count:  3,
+					Total:  sdkmath.NewInt(600),
+					Min:    sdkmath.NewInt(100),
+					Median: sdkmath.NewInt(200),
+					Max:    sdkmath.NewInt(300),
+				},
+				{
+					Denom:  "uatom",
+					Count:  1,
+					Total:  sdkmath.NewInt(7),
+					Min:    sdkmath.NewInt(7),
+					Median: sdkmath.NewInt(7),
+					Max:    sdkmath.NewInt(7),
+				},
+			},
+		}, stats)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("empty launch", func(t *testing.T) {
+		var (
+			spnAccount     = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(spnAccount)
+		)
+		suite.LaunchQueryMock.
+			On("RequestAll", mock.Anything, &launchtypes.QueryAllRequestRequest{LaunchID: testutil.LaunchID}).
+			Return(&launchtypes.QueryAllRequestResponse{}, nil).
+			Once()
+		suite.CosmosClientMock.
+			On("SearchTxs", mock.Anything, settledRequestsQuery).
+			Return(nil, nil).
+			Once()
+
+		stats, err := network.RequestStats(context.Background(), testutil.LaunchID)
+		require.NoError(t, err)
+		require.Equal(t, networktypes.RequestStats{LaunchID: testutil.LaunchID}, stats)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("requests on several pages", func(t *testing.T) {
+		var (
+			spnAccount     = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(spnAccount)
+		)
+		suite.LaunchQueryMock.
+			On("RequestAll", mock.Anything, &launchtypes.QueryAllRequestRequest{LaunchID: testutil.LaunchID}).
+			Return(&launchtypes.QueryAllRequestResponse{
+				Request:    []launchtypes.Request{newStatsRequest(1, day1, launchtypes.Request_PENDING, account)},
+				Pagination: &query.PageResponse{NextKey: []byte("next")},
+			}, nil).
+			Once()
+		suite.LaunchQueryMock.
+			On("RequestAll", mock.Anything, &launchtypes.QueryAllRequestRequest{
+				LaunchID:   testutil.LaunchID,
+				Pagination: &query.PageRequest{Key: []byte("next")},
+			}).
+			Return(&launchtypes.QueryAllRequestResponse{
+				Request: []launchtypes.Request{newStatsRequest(2, day2, launchtypes.Request_PENDING, account)},
+			}, nil).
+			Once()
+		suite.CosmosClientMock.
+			On("SearchTxs", mock.Anything, settledRequestsQuery).
+			Return(nil, nil).
+			Once()
+
+		stats, err := network.RequestStats(context.Background(), testutil.LaunchID)
+		require.NoError(t, err)
+		require.Equal(t, 2, stats.Total)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to fetch the times of the settlements", func(t *testing.T) {
+		var (
+			spnAccount     = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(spnAccount)
+			expectedErr    = errors.New("block not found")
+		)
+		suite.LaunchQueryMock.
+			On("RequestAll", mock.Anything, &launchtypes.QueryAllRequestRequest{LaunchID: testutil.LaunchID}).
+			Return(&launchtypes.QueryAllRequestResponse{
+				Request: []launchtypes.Request{newStatsRequest(1, day1, launchtypes.Request_APPROVED, account)},
+			}, nil).
+			Once()
+		suite.CosmosClientMock.
+			On("SearchTxs", mock.Anything, settledRequestsQuery).
+			Return([]*ctypes.ResultTx{
+				settlementTx(t, 10,
+					&launchtypes.EventRequestSettled{LaunchID: testutil.LaunchID, RequestID: 1, Approved: true},
+				),
+			}, nil).
+			Once()
+		suite.CosmosClientMock.
+			On("BlockTimes", mock.Anything, int64(10)).
+			Return(nil, expectedErr).
+			Once()
+
+		_, err := network.RequestStats(context.Background(), testutil.LaunchID)
+		require.ErrorIs(t, err, expectedErr)
+		suite.AssertAllMocks(t)
+	})
+}
@@ -15,16 +15,18 @@ import (
 
 // SetReward set a chain reward
 func (n Network) SetReward(ctx context.Context, launchID uint64, lastRewardHeight int64, coins sdk.Coins) error {
+	units := n.denomUnits(ctx)
+
 	n.ev.Send(events.New(
 		events.StatusOngoing,
 		fmt.Sprintf("Setting reward %s to the chain %d at height %d",
-			coins.String(),
+			networktypes.FormatCoins(coins, units),
 			launchID,
 			lastRewardHeight,
 		),
 	))
 
-	addr, err := n.account.Address(networktypes.SPN)
+	addr, err := n.accountAddress()
 	if err != nil {
 		return err
 	}
@@ -35,7 +37,7 @@ func (n Network) SetReward(ctx context.Context, launchID uint64, lastRewardHeigh
 		lastRewardHeight,
 		coins,
 	)
-	res, err := n.cosmos.BroadcastTx(ctx, n.account, msg)
+	res, err := n.broadcastTx(ctx, msg)
 	if err != nil {
 		return err
 	}
@@ -55,8 +57,8 @@ func (n Network) SetReward(ctx context.Context, launchID uint64, lastRewardHeigh
 		n.ev.Send(events.New(events.StatusDone,
 			fmt.Sprintf(
 				"Previous reward pool %s at height %d is overwritten",
-				coins.String(),
-				lastRewardHeight,
+				networktypes.FormatCoins(setRewardRes.PreviousCoins, units),
+				setRewardRes.PreviousLastRewardHeight,
 			),
 			events.Icon(icons.Info),
 		))
@@ -67,7 +69,7 @@ func (n Network) SetReward(ctx context.Context, launchID uint64, lastRewardHeigh
 	} else {
 		n.ev.Send(events.New(events.StatusDone, fmt.Sprintf(
 			"%s will be distributed to validators at height %d. The chain %d is now an incentivized testnet",
-			coins.String(),
+			networktypes.FormatCoins(setRewardRes.NewCoins, units),
 			lastRewardHeight,
 			launchID,
 		)))
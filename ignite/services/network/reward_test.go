@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 	rewardtypes "github.com/tendermint/spn/x/reward/types"
 
+	"github.com/ignite/cli/ignite/pkg/events"
 	"github.com/ignite/cli/ignite/services/network/networktypes"
 	"github.com/ignite/cli/ignite/services/network/testutil"
 )
@@ -21,7 +22,10 @@ func TestSetReward(t *testing.T) {
 			suite, network  = newSuite(account)
 			coins           = sdk.NewCoins(sdk.NewCoin(TestDenom, sdkmath.NewInt(TestAmountInt)))
 			lastRewarHeight = int64(10)
+			ev              = events.NewBus(events.WithCustomBufferSize(10))
 		)
+		CollectEvents(ev)(&network)
+		mockDenomsMetadata(suite, testDenomMetadata)
 
 		addr, err := account.Address(networktypes.SPN)
 		require.NoError(t, err)
@@ -49,6 +53,13 @@ func TestSetReward(t *testing.T) {
 		setRewardError := network.SetReward(context.Background(), testutil.LaunchID, lastRewarHeight, coins)
 		require.NoError(t, setRewardError)
 		suite.AssertAllMocks(t)
+		descriptions := collectDescriptions(ev)
+		require.Contains(t, descriptions, "Setting reward 95,000 mstake to the chain 1 at height 10")
+		require.Contains(
+			t,
+			descriptions,
+			"95,000 mstake will be distributed to validators at height 10. The chain 1 is now an incentivized testnet",
+		)
 	})
 	t.Run("failed to set reward, failed to broadcast set reward tx", func(t *testing.T) {
 		var (
@@ -58,6 +69,7 @@ func TestSetReward(t *testing.T) {
 			lastRewarHeight = int64(10)
 			expectedErr     = errors.New("failed to set reward")
 		)
+		mockDenomsMetadata(suite)
 
 		addr, err := account.Address(networktypes.SPN)
 		require.NoError(t, err)
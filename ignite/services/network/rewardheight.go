@@ -0,0 +1,193 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	monitoringctypes "github.com/tendermint/spn/x/monitoringc/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmoserror"
+)
+
+const (
+	// DefaultExpectedBlockTime is the block time used to estimate the reward height of a chain not launched yet.
+	DefaultExpectedBlockTime = 6 * time.Second
+
+	// blockTimeWindow is the number of the latest blocks of the chain used to measure its block time.
+	blockTimeWindow = 100
+)
+
+// ErrRewardHeightTooLow is returned when the reward height doesn't exceed the height monitored on SPN.
+type ErrRewardHeightTooLow struct {
+	Height          int64
+	MonitoredHeight int64
+}
+
+// Error implements error
+func (e ErrRewardHeightTooLow) Error() string {
+	return fmt.Sprintf(
+		"the last reward height %d must exceed the current monitored height %d",
+		e.Height,
+		e.MonitoredHeight,
+	)
+}
+
+// RewardHeight is the last reward height computed from a reward duration.
+type RewardHeight struct {
+	// Height is the last reward height.
+	Height int64
+
+	// BlockTime is the block time used to convert the duration into blocks.
+	BlockTime time.Duration
+
+	// Measured is true when the block time is measured from the blocks of the launched chain,
+	// it's the expected block time otherwise.
+	Measured bool
+
+	// MonitoredHeight is the height of the chain monitored on SPN, zero before the first monitoring packet.
+	MonitoredHeight int64
+}
+
+type (
+	// RewardHeightOption configures the computation of the reward height.
+	RewardHeightOption func(*rewardHeightOptions)
+
+	rewardHeightOptions struct {
+		expectedBlockTime time.Duration
+		chainNode         *Node
+	}
+)
+
+// WithExpectedBlockTime sets the block time used to estimate the reward height of a chain not launched yet.
+func WithExpectedBlockTime(blockTime time.Duration) RewardHeightOption {
+	return func(o *rewardHeightOptions) {
+		o.expectedBlockTime = blockTime
+	}
+}
+
+// WithMeasuredBlockTime measures the block time from the latest blocks of the launched chain served by the node,
+// the reward height is computed from the latest height of the chain.
+func WithMeasuredBlockTime(chainNode Node) RewardHeightOption {
+	return func(o *rewardHeightOptions) {
+		o.chainNode = &chainNode
+	}
+}
+
+// RewardHeight converts the reward duration into the last reward height of the chain.
+// Before the launch, the duration is converted with the expected block time from the monitored height.
+// After the launch, the block time is measured from the chain and the duration is converted
+// from the latest height of the chain.
+func (n Network) RewardHeight(
+	ctx context.Context,
+	launchID uint64,
+	duration time.Duration,
+	options ...RewardHeightOption,
+) (RewardHeight, error) {
+	o := rewardHeightOptions{
+		expectedBlockTime: DefaultExpectedBlockTime,
+	}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	if duration <= 0 {
+		return RewardHeight{}, fmt.Errorf("the reward duration must be positive: %s", duration)
+	}
+
+	monitoredHeight, err := n.monitoredHeight(ctx, launchID)
+	if err != nil {
+		return RewardHeight{}, err
+	}
+
+	rh := RewardHeight{
+		BlockTime:       o.expectedBlockTime,
+		MonitoredHeight: monitoredHeight,
+	}
+	startHeight := monitoredHeight
+	if o.chainNode != nil {
+		startHeight, rh.BlockTime, err = o.chainNode.blockTime(ctx)
+		if err != nil {
+			return RewardHeight{}, err
+		}
+		rh.Measured = true
+	}
+	if rh.BlockTime <= 0 {
+		return RewardHeight{}, fmt.Errorf("the block time must be positive: %s", rh.BlockTime)
+	}
+
+	// the partial block of the duration is rewarded
+	blocks := int64(duration / rh.BlockTime)
+	if duration%rh.BlockTime != 0 {
+		blocks++
+	}
+	rh.Height = startHeight + blocks
+
+	if rh.Height <= monitoredHeight {
+		return RewardHeight{}, ErrRewardHeightTooLow{
+			Height:          rh.Height,
+			MonitoredHeight: monitoredHeight,
+		}
+	}
+	return rh, nil
+}
+
+// CheckRewardHeight checks the last reward height exceeds the height of the chain monitored on SPN.
+func (n Network) CheckRewardHeight(ctx context.Context, launchID uint64, height int64) error {
+	monitoredHeight, err := n.monitoredHeight(ctx, launchID)
+	if err != nil {
+		return err
+	}
+	if height <= monitoredHeight {
+		return ErrRewardHeightTooLow{
+			Height:          height,
+			MonitoredHeight: monitoredHeight,
+		}
+	}
+	return nil
+}
+
+// monitoredHeight returns the height of the latest monitoring packet of the chain received by SPN,
+// zero when no packet is received.
+func (n Network) monitoredHeight(ctx context.Context, launchID uint64) (int64, error) {
+	res, err := n.monitoringConsumerQuery.MonitoringHistory(ctx, &monitoringctypes.QueryGetMonitoringHistoryRequest{
+		LaunchID: launchID,
+	})
+	if cosmoserror.Unwrap(err) == cosmoserror.ErrNotFound {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.Wrap(err, "cannot fetch the monitored height")
+	}
+	return res.MonitoringHistory.LatestMonitoringPacket.BlockHeight, nil
+}
+
+// blockTime measures the average block time of the latest blocks of the chain and returns it with
+// the latest height.
+func (n Node) blockTime(ctx context.Context) (latestHeight int64, blockTime time.Duration, err error) {
+	status, err := n.cosmos.Status(ctx)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "cannot fetch the latest block of the chain")
+	}
+	latestHeight = status.SyncInfo.LatestBlockHeight
+
+	window := int64(blockTimeWindow)
+	if latestHeight <= window {
+		window = latestHeight - 1
+	}
+	if window < 1 {
+		return 0, 0, fmt.Errorf("not enough blocks to measure the block time at height %d", latestHeight)
+	}
+
+	info, err := n.cosmos.ConsensusInfo(ctx, latestHeight-window)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "cannot fetch the block %d of the chain", latestHeight-window)
+	}
+	earlier, err := time.Parse(time.RFC3339Nano, info.Timestamp)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "invalid time of the block %d", latestHeight-window)
+	}
+
+	blockTime = status.SyncInfo.LatestBlockTime.Sub(earlier) / time.Duration(window)
+	return latestHeight, blockTime, nil
+}
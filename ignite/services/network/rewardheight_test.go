@@ -0,0 +1,257 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	spntypes "github.com/tendermint/spn/pkg/types"
+	monitoringctypes "github.com/tendermint/spn/x/monitoringc/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmoserror"
+	"github.com/ignite/cli/ignite/services/network/mocks"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+func mockMonitoredHeight(suite testutil.Suite, height int64, err error) {
+	var res *monitoringctypes.QueryGetMonitoringHistoryResponse
+	if err == nil {
+		res = &monitoringctypes.QueryGetMonitoringHistoryResponse{
+			MonitoringHistory: monitoringctypes.MonitoringHistory{
+				LaunchID:               testutil.LaunchID,
+				LatestMonitoringPacket: spntypes.MonitoringPacket{BlockHeight: height},
+			},
+		}
+	}
+	suite.MonitoringConsumerClient.
+		On(
+			"MonitoringHistory",
+			mock.Anything,
+			&monitoringctypes.QueryGetMonitoringHistoryRequest{LaunchID: testutil.LaunchID},
+		).
+		Return(res, err).
+		Once()
+}
+
+func TestRewardHeight(t *testing.T) {
+	t.Run("estimate the height before the launch", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+		)
+		mockMonitoredHeight(suite, 0, cosmoserror.ErrNotFound)
+
+		rh, err := network.RewardHeight(context.Background(), testutil.LaunchID, 24*time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, RewardHeight{
+			Height:    14400,
+			BlockTime: DefaultExpectedBlockTime,
+		}, rh)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("estimate the height with the expected block time", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+		)
+		mockMonitoredHeight(suite, 0, cosmoserror.ErrNotFound)
+
+		rh, err := network.RewardHeight(
+			context.Background(),
+			testutil.LaunchID,
+			time.Hour+time.Second,
+			WithExpectedBlockTime(5*time.Second),
+		)
+		require.NoError(t, err)
+		require.Equal(t, RewardHeight{
+			Height:    721,
+			BlockTime: 5 * time.Second,
+		}, rh)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("measure the block time after the launch", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+			cosmos         = mocks.NewCosmosClient(t)
+			latestTime     = time.Date(2022, time.May, 1, 12, 0, 0, 0, time.UTC)
+		)
+		mockMonitoredHeight(suite, 950, nil)
+		cosmos.
+			On("Status", mock.Anything).
+			Return(&ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{
+				LatestBlockHeight: 1000,
+				LatestBlockTime:   latestTime,
+			}}, nil).
+			Once()
+		cosmos.
+			On("ConsensusInfo", mock.Anything, int64(900)).
+			Return(cosmosclient.ConsensusInfo{
+				Timestamp: latestTime.Add(-400 * time.Second).Format(time.RFC3339Nano),
+			}, nil).
+			Once()
+
+		rh, err := network.RewardHeight(
+			context.Background(),
+			testutil.LaunchID,
+			time.Hour,
+			WithMeasuredBlockTime(Node{cosmos: cosmos}),
+		)
+		require.NoError(t, err)
+		require.Equal(t, RewardHeight{
+			Height:          1900,
+			BlockTime:       4 * time.Second,
+			Measured:        true,
+			MonitoredHeight: 950,
+		}, rh)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("measure the block time of a young chain", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+			cosmos         = mocks.NewCosmosClient(t)
+			latestTime     = time.Date(2022, time.May, 1, 12, 0, 0, 0, time.UTC)
+		)
+		mockMonitoredHeight(suite, 0, cosmoserror.ErrNotFound)
+		cosmos.
+			On("Status", mock.Anything).
+			Return(&ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{
+				LatestBlockHeight: 11,
+				LatestBlockTime:   latestTime,
+			}}, nil).
+			Once()
+		cosmos.
+			On("ConsensusInfo", mock.Anything, int64(1)).
+			Return(cosmosclient.ConsensusInfo{
+				Timestamp: latestTime.Add(-20 * time.Second).Format(time.RFC3339Nano),
+			}, nil).
+			Once()
+
+		rh, err := network.RewardHeight(
+			context.Background(),
+			testutil.LaunchID,
+			time.Minute,
+			WithMeasuredBlockTime(Node{cosmos: cosmos}),
+		)
+		require.NoError(t, err)
+		require.Equal(t, RewardHeight{
+			Height:    41,
+			BlockTime: 2 * time.Second,
+			Measured:  true,
+		}, rh)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to measure the block time of a chain without blocks", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+			cosmos         = mocks.NewCosmosClient(t)
+		)
+		mockMonitoredHeight(suite, 0, cosmoserror.ErrNotFound)
+		cosmos.
+			On("Status", mock.Anything).
+			Return(newStatus(1), nil).
+			Once()
+
+		_, err := network.RewardHeight(
+			context.Background(),
+			testutil.LaunchID,
+			time.Minute,
+			WithMeasuredBlockTime(Node{cosmos: cosmos}),
+		)
+		require.EqualError(t, err, "not enough blocks to measure the block time at height 1")
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to compute a height below the monitored height", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+			cosmos         = mocks.NewCosmosClient(t)
+			latestTime     = time.Date(2022, time.May, 1, 12, 0, 0, 0, time.UTC)
+		)
+		// the node of the chain is behind the monitored height
+		mockMonitoredHeight(suite, 5000, nil)
+		cosmos.
+			On("Status", mock.Anything).
+			Return(&ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{
+				LatestBlockHeight: 1000,
+				LatestBlockTime:   latestTime,
+			}}, nil).
+			Once()
+		cosmos.
+			On("ConsensusInfo", mock.Anything, int64(900)).
+			Return(cosmosclient.ConsensusInfo{
+				Timestamp: latestTime.Add(-400 * time.Second).Format(time.RFC3339Nano),
+			}, nil).
+			Once()
+
+		_, err := network.RewardHeight(
+			context.Background(),
+			testutil.LaunchID,
+			time.Hour,
+			WithMeasuredBlockTime(Node{cosmos: cosmos}),
+		)
+		require.ErrorIs(t, err, ErrRewardHeightTooLow{Height: 1900, MonitoredHeight: 5000})
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to estimate the height of a negative duration", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+		)
+
+		_, err := network.RewardHeight(context.Background(), testutil.LaunchID, -time.Hour)
+		require.EqualError(t, err, "the reward duration must be positive: -1h0m0s")
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to fetch the monitored height", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+			expectedErr    = errors.New("failed to query")
+		)
+		mockMonitoredHeight(suite, 0, expectedErr)
+
+		_, err := network.RewardHeight(context.Background(), testutil.LaunchID, time.Hour)
+		require.ErrorIs(t, err, expectedErr)
+		suite.AssertAllMocks(t)
+	})
+}
+
+func TestCheckRewardHeight(t *testing.T) {
+	t.Run("height above the monitored height", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+		)
+		mockMonitoredHeight(suite, 100, nil)
+
+		require.NoError(t, network.CheckRewardHeight(context.Background(), testutil.LaunchID, 101))
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("height not above the monitored height", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+		)
+		mockMonitoredHeight(suite, 100, nil)
+
+		err := network.CheckRewardHeight(context.Background(), testutil.LaunchID, 100)
+		require.EqualError(t, err, "the last reward height 100 must exceed the current monitored height 100")
+		suite.AssertAllMocks(t)
+	})
+}
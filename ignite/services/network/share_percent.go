@@ -2,6 +2,7 @@ package network
 
 import (
 	"fmt"
+	"math/big"
 	"regexp"
 	"strconv"
 	"strings"
@@ -38,17 +39,22 @@ func NewSharePercent(denom string, nominator, denominator uint64) (SharePercent,
 }
 
 // Share returns coin share of total according to underlying percent
+// the share is computed with big integers, the 18 decimals totals don't overflow
 func (p SharePercent) Share(total uint64) (sdk.Coin, error) {
-	resultNominator := total * p.nominator
-	if resultNominator%p.denominator != 0 {
-		err := fmt.Errorf("%s share from total %d is not integer: %f",
+	var (
+		resultNominator = new(big.Int).Mul(new(big.Int).SetUint64(total), new(big.Int).SetUint64(p.nominator))
+		denominator     = new(big.Int).SetUint64(p.denominator)
+		result, rem     = new(big.Int).QuoRem(resultNominator, denominator, new(big.Int))
+	)
+	if rem.Sign() != 0 {
+		err := fmt.Errorf("%s share from total %d is not integer: %s",
 			p.denom,
 			total,
-			float64(resultNominator)/float64(p.denominator),
+			new(big.Rat).SetFrac(resultNominator, denominator).FloatString(6),
 		)
 		return sdk.Coin{}, err
 	}
-	return sdk.NewInt64Coin(p.denom, int64(resultNominator/p.denominator)), nil
+	return sdk.NewCoin(p.denom, sdk.NewIntFromBigInt(result)), nil
 }
 
 // SharePercentFromString parses share percent from string
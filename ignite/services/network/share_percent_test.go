@@ -110,6 +110,12 @@ func TestShare(t *testing.T) {
 			total:   10000,
 			want:    sdk.NewInt64Coin("foo", 297),
 		},
+		{
+			name:    "18 decimals total",
+			percent: network.SampleSharePercent(t, "foo", 297, 10000),
+			total:   10000000000000000000,
+			want:    sdk.NewInt64Coin("foo", 297000000000000000),
+		},
 		{
 			name:    "non integer share",
 			percent: network.SampleSharePercent(t, "foo", 297, 10001),
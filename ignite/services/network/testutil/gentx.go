@@ -32,7 +32,8 @@ type (
 	}
 
 	MessagePubKey struct {
-		Key string `json:"key"`
+		Type string `json:"@type,omitempty"`
+		Key  string `json:"key"`
 	}
 )
 
@@ -50,6 +51,12 @@ func NewGentx(address, denom, amount, pubkey, memo string) *Gentx {
 	}}
 }
 
+// WithPubKeyType sets the type URL of the validator public key of the gentx
+func (g *Gentx) WithPubKeyType(pubKeyType string) *Gentx {
+	g.Body.Messages[0].PubKey.Type = pubKeyType
+	return g
+}
+
 // SaveTo saves gentx json representation to the specified directory and returns full path
 func (g *Gentx) SaveTo(t *testing.T, dir string) string {
 	encoded, err := json.Marshal(g)
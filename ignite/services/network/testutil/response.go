@@ -14,12 +14,20 @@ import (
 // NewResponse creates cosmosclient.Response object from proto struct
 // for using as a return result for a cosmosclient mock
 func NewResponse(data protoiface.MessageV1) cosmosclient.Response {
+	return newTxResponse(data)
+}
+
+// newTxResponse creates cosmosclient.Response object from the responses of the messages of a transaction
+func newTxResponse(data ...protoiface.MessageV1) cosmosclient.Response {
 	marshaler := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
-	anyEncoded, _ := codectypes.NewAnyWithValue(data)
 
-	txData := &sdk.TxMsgData{MsgResponses: []*codectypes.Any{anyEncoded}}
+	var txData sdk.TxMsgData
+	for _, d := range data {
+		anyEncoded, _ := codectypes.NewAnyWithValue(d)
+		txData.MsgResponses = append(txData.MsgResponses, anyEncoded)
+	}
 
-	encodedTxData, _ := marshaler.Marshal(txData)
+	encodedTxData, _ := marshaler.Marshal(&txData)
 	resp := cosmosclient.Response{
 		Codec: marshaler,
 		TxResponse: &sdk.TxResponse{
@@ -0,0 +1,480 @@
+package testutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	spntypes "github.com/tendermint/spn/pkg/types"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+)
+
+// ErrUnsupportedMsg is returned when the simulator broadcasts a message it cannot apply.
+var ErrUnsupportedMsg = errors.New("message not supported by the simulator")
+
+// Simulator is an in-process SPN simulator backed by in-memory state.
+// It implements the subset of the launch, campaign and profile queries used by the network service
+// and applies the broadcasted launch messages to its state with the validation rules of SPN.
+// The simulator is a CosmosClient for the network service, the query clients are returned by
+// LaunchQueryClient, CampaignQueryClient and ProfileQueryClient.
+type Simulator struct {
+	mu sync.Mutex
+
+	params    launchtypes.Params
+	blockTime time.Time
+	height    int64
+
+	// blockTimes are the times of the blocks added with AddBlocks by height.
+	blockTimes map[int64]time.Time
+
+	chains       map[uint64]launchtypes.Chain
+	requests     map[uint64]map[uint64]launchtypes.Request
+	coordinators map[uint64]profiletypes.Coordinator
+	campaigns    map[uint64]campaigntypes.Campaign
+}
+
+// SimulatorOption configures the simulator.
+type SimulatorOption func(*Simulator)
+
+// WithSimulatorParams sets the launch params of the simulator.
+func WithSimulatorParams(params launchtypes.Params) SimulatorOption {
+	return func(s *Simulator) {
+		s.params = params
+	}
+}
+
+// WithSimulatorBlockTime sets the time of the latest block of the simulator.
+func WithSimulatorBlockTime(blockTime time.Time) SimulatorOption {
+	return func(s *Simulator) {
+		s.blockTime = blockTime
+	}
+}
+
+// NewSimulator creates a new SPN simulator with the default launch params.
+func NewSimulator(options ...SimulatorOption) *Simulator {
+	s := &Simulator{
+		params:       launchtypes.DefaultParams(),
+		blockTime:    time.Now().UTC(),
+		height:       1,
+		blockTimes:   make(map[int64]time.Time),
+		chains:       make(map[uint64]launchtypes.Chain),
+		requests:     make(map[uint64]map[uint64]launchtypes.Request),
+		coordinators: make(map[uint64]profiletypes.Coordinator),
+		campaigns:    make(map[uint64]campaigntypes.Campaign),
+	}
+	for _, apply := range options {
+		apply(s)
+	}
+	return s
+}
+
+// SetParams sets the launch params.
+func (s *Simulator) SetParams(params launchtypes.Params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.params = params
+}
+
+// SetBlockTime sets the time of the latest block, the messages are applied with this block time.
+func (s *Simulator) SetBlockTime(blockTime time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blockTime = blockTime
+}
+
+// AddCoordinator adds an active coordinator with the address and returns its ID.
+func (s *Simulator) AddCoordinator(address string) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := uint64(len(s.coordinators)) + 1
+	s.coordinators[id] = profiletypes.Coordinator{
+		CoordinatorID: id,
+		Address:       address,
+		Active:        true,
+	}
+	return id
+}
+
+// AddChain adds the chain and returns its launch ID, the launch ID of the chain is ignored.
+func (s *Simulator) AddChain(chain launchtypes.Chain) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	chain.LaunchID = uint64(len(s.chains)) + 1
+	s.chains[chain.LaunchID] = chain
+	return chain.LaunchID
+}
+
+// AddRequest adds the request to its chain and returns its request ID, the request ID of the request is ignored.
+func (s *Simulator) AddRequest(request launchtypes.Request) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addRequest(request)
+}
+
+// AddCampaign adds the campaign and returns its campaign ID, the campaign ID of the campaign is ignored.
+func (s *Simulator) AddCampaign(campaign campaigntypes.Campaign) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	campaign.CampaignID = uint64(len(s.campaigns)) + 1
+	s.campaigns[campaign.CampaignID] = campaign
+	return campaign.CampaignID
+}
+
+// Chain returns the chain with the launch ID.
+func (s *Simulator) Chain(launchID uint64) (launchtypes.Chain, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	chain, ok := s.chains[launchID]
+	return chain, ok
+}
+
+// Request returns the request of the chain with the request ID.
+func (s *Simulator) Request(launchID, requestID uint64) (launchtypes.Request, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	request, ok := s.requests[launchID][requestID]
+	return request, ok
+}
+
+// Context implements the CosmosClient of the network service.
+func (s *Simulator) Context() client.Context {
+	return client.Context{}
+}
+
+// Status returns the height and the time of the latest block.
+func (s *Simulator) Status(context.Context) (*ctypes.ResultStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &ctypes.ResultStatus{
+		SyncInfo: ctypes.SyncInfo{
+			LatestBlockHeight: s.height,
+			LatestBlockTime:   s.blockTime,
+		},
+	}, nil
+}
+
+// ConsensusInfo is not supported by the simulator.
+func (s *Simulator) ConsensusInfo(context.Context, int64) (cosmosclient.ConsensusInfo, error) {
+	return cosmosclient.ConsensusInfo{}, errors.New("consensus info not supported by the simulator")
+}
+
+// SearchTxs is not supported by the simulator.
+func (s *Simulator) SearchTxs(context.Context, string) ([]*ctypes.ResultTx, error) {
+	return nil, errors.New("tx search not supported by the simulator")
+}
+
+// MaxTxBytes returns the default maximum size of a tx accepted by a node.
+func (s *Simulator) MaxTxBytes(context.Context) (int64, error) {
+	return cosmosclient.DefaultMempoolMaxTxBytes, nil
+}
+
+// AddBlocks adds a block after each interval from the time of the latest block.
+// The times of the added blocks are returned by BlockTimes.
+func (s *Simulator) AddBlocks(intervals ...time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.blockTimes[s.height] = s.blockTime
+	for _, interval := range intervals {
+		s.height++
+		s.blockTime = s.blockTime.Add(interval)
+		s.blockTimes[s.height] = s.blockTime
+	}
+}
+
+// BlockTimes returns the times of the blocks added with AddBlocks.
+func (s *Simulator) BlockTimes(_ context.Context, heights ...int64) (map[int64]time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	times := make(map[int64]time.Time, len(heights))
+	for _, height := range heights {
+		blockTime, ok := s.blockTimes[height]
+		if !ok {
+			return nil, fmt.Errorf("block %d not found", height)
+		}
+		times[height] = blockTime
+	}
+	return times, nil
+}
+
+// BroadcastTx applies the messages to the state in a new block, the state is unchanged if a message fails.
+// The supported messages are MsgSendRequest, MsgSettleRequest, MsgEditChain, MsgTriggerLaunch and MsgRevertLaunch.
+func (s *Simulator) BroadcastTx(_ context.Context, _ cosmosaccount.Account, msgs ...sdk.Msg) (cosmosclient.Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot := s.snapshot()
+	responses := make([]protoiface.MessageV1, len(msgs))
+	for i, msg := range msgs {
+		res, err := s.apply(msg)
+		if err != nil {
+			s.restore(snapshot)
+			return cosmosclient.Response{}, err
+		}
+		responses[i] = res
+	}
+	s.height++
+
+	return newTxResponse(responses...), nil
+}
+
+// BroadcastTxWithMemo applies the messages like BroadcastTx, the memo is not kept.
+func (s *Simulator) BroadcastTxWithMemo(
+	ctx context.Context,
+	account cosmosaccount.Account,
+	_ string,
+	msgs ...sdk.Msg,
+) (cosmosclient.Response, error) {
+	return s.BroadcastTx(ctx, account, msgs...)
+}
+
+// apply applies the message to the state. The stateless checks of the messages are skipped since they
+// depend on the bech32 prefix of the SDK config, the addresses are compared as strings.
+func (s *Simulator) apply(msg sdk.Msg) (protoiface.MessageV1, error) {
+	switch msg := msg.(type) {
+	case *launchtypes.MsgSendRequest:
+		return s.sendRequest(msg)
+	case *launchtypes.MsgSettleRequest:
+		return s.settleRequest(msg)
+	case *launchtypes.MsgEditChain:
+		return s.editChain(msg)
+	case *launchtypes.MsgTriggerLaunch:
+		return s.triggerLaunch(msg)
+	case *launchtypes.MsgRevertLaunch:
+		return s.revertLaunch(msg)
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedMsg, msg)
+	}
+}
+
+func (s *Simulator) sendRequest(msg *launchtypes.MsgSendRequest) (*launchtypes.MsgSendRequestResponse, error) {
+	chain, ok := s.chains[msg.LaunchID]
+	if !ok {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrChainNotFound, "%d", msg.LaunchID)
+	}
+	if chain.LaunchTriggered {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrTriggeredLaunch, "%d", msg.LaunchID)
+	}
+
+	requestID := s.addRequest(launchtypes.Request{
+		LaunchID:  msg.LaunchID,
+		Creator:   msg.Creator,
+		CreatedAt: s.blockTime.Unix(),
+		Content:   msg.Content,
+		Status:    launchtypes.Request_PENDING,
+	})
+	return &launchtypes.MsgSendRequestResponse{RequestID: requestID}, nil
+}
+
+// settleRequest settles the request, the content of the approved requests is not applied to the chain.
+func (s *Simulator) settleRequest(msg *launchtypes.MsgSettleRequest) (*launchtypes.MsgSettleRequestResponse, error) {
+	chain, coordinator, err := s.chainCoordinator(msg.LaunchID)
+	if err != nil {
+		return nil, err
+	}
+	if chain.LaunchTriggered {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrTriggeredLaunch, "%d", msg.LaunchID)
+	}
+
+	request, ok := s.requests[msg.LaunchID][msg.RequestID]
+	if !ok {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrRequestNotFound,
+			"request %d for chain %d not found",
+			msg.RequestID,
+			msg.LaunchID,
+		)
+	}
+	if request.Status != launchtypes.Request_PENDING {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrRequestSettled, "request %d is not pending", msg.RequestID)
+	}
+	if (msg.Approve && msg.Signer != coordinator.Address) ||
+		(msg.Signer != request.Creator && msg.Signer != coordinator.Address) {
+		return nil, sdkerrors.Wrap(launchtypes.ErrNoAddressPermission, msg.Signer)
+	}
+
+	request.Status = launchtypes.Request_REJECTED
+	if msg.Approve {
+		request.Status = launchtypes.Request_APPROVED
+	}
+	s.requests[msg.LaunchID][msg.RequestID] = request
+	return &launchtypes.MsgSettleRequestResponse{}, nil
+}
+
+func (s *Simulator) editChain(msg *launchtypes.MsgEditChain) (*launchtypes.MsgEditChainResponse, error) {
+	chain, err := s.coordinatorChain(msg.LaunchID, msg.Coordinator)
+	if err != nil {
+		return nil, err
+	}
+	if len(msg.Metadata) > spntypes.MaxMetadataLength {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrInvalidMetadataLength,
+			"data length %d is greater than maximum %d",
+			len(msg.Metadata),
+			spntypes.MaxMetadataLength,
+		)
+	}
+	if len(msg.Metadata) > 0 {
+		chain.Metadata = msg.Metadata
+	}
+	if msg.SetCampaignID {
+		chain.HasCampaign = true
+		chain.CampaignID = msg.CampaignID
+	}
+	s.chains[msg.LaunchID] = chain
+	return &launchtypes.MsgEditChainResponse{}, nil
+}
+
+func (s *Simulator) triggerLaunch(msg *launchtypes.MsgTriggerLaunch) (*launchtypes.MsgTriggerLaunchResponse, error) {
+	chain, err := s.coordinatorChain(msg.LaunchID, msg.Coordinator)
+	if err != nil {
+		return nil, err
+	}
+	if chain.LaunchTriggered {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrTriggeredLaunch, "%d", msg.LaunchID)
+	}
+	if msg.LaunchTime.Before(s.blockTime.Add(s.params.LaunchTimeRange.MinLaunchTime)) {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrLaunchTimeTooLow, "%s", msg.LaunchTime.String())
+	}
+	if msg.LaunchTime.After(s.blockTime.Add(s.params.LaunchTimeRange.MaxLaunchTime)) {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrLaunchTimeTooHigh, "%s", msg.LaunchTime.String())
+	}
+
+	chain.LaunchTriggered = true
+	chain.LaunchTime = msg.LaunchTime
+	chain.ConsumerRevisionHeight = s.height
+	s.chains[msg.LaunchID] = chain
+	return &launchtypes.MsgTriggerLaunchResponse{}, nil
+}
+
+func (s *Simulator) revertLaunch(msg *launchtypes.MsgRevertLaunch) (*launchtypes.MsgRevertLaunchResponse, error) {
+	chain, err := s.coordinatorChain(msg.LaunchID, msg.Coordinator)
+	if err != nil {
+		return nil, err
+	}
+	if !chain.LaunchTriggered {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrNotTriggeredLaunch, "%d", msg.LaunchID)
+	}
+	if chain.MonitoringConnected {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrChainMonitoringConnected, "%d", msg.LaunchID)
+	}
+	if s.blockTime.Before(chain.LaunchTime.Add(s.params.RevertDelay)) {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrRevertDelayNotReached, "%d", msg.LaunchID)
+	}
+
+	chain.LaunchTriggered = false
+	chain.LaunchTime = time.Unix(0, 0).UTC()
+	s.chains[msg.LaunchID] = chain
+	return &launchtypes.MsgRevertLaunchResponse{}, nil
+}
+
+// coordinatorChain returns the chain if the address is the address of its coordinator.
+func (s *Simulator) coordinatorChain(launchID uint64, address string) (launchtypes.Chain, error) {
+	chain, ok := s.chains[launchID]
+	if !ok {
+		return launchtypes.Chain{}, sdkerrors.Wrapf(launchtypes.ErrChainNotFound, "%d", launchID)
+	}
+	coordinator, ok := s.coordinatorByAddress(address)
+	if !ok {
+		return launchtypes.Chain{}, sdkerrors.Wrapf(profiletypes.ErrCoordAddressNotFound, "address: %s", address)
+	}
+	if chain.CoordinatorID != coordinator.CoordinatorID {
+		return launchtypes.Chain{}, sdkerrors.Wrapf(
+			profiletypes.ErrCoordInvalid,
+			"coordinator of the chain is %d",
+			chain.CoordinatorID,
+		)
+	}
+	return chain, nil
+}
+
+// chainCoordinator returns the chain and its active coordinator.
+func (s *Simulator) chainCoordinator(launchID uint64) (launchtypes.Chain, profiletypes.Coordinator, error) {
+	chain, ok := s.chains[launchID]
+	if !ok {
+		return launchtypes.Chain{}, profiletypes.Coordinator{}, sdkerrors.Wrapf(launchtypes.ErrChainNotFound, "%d", launchID)
+	}
+	coordinator, ok := s.coordinators[chain.CoordinatorID]
+	if !ok {
+		return launchtypes.Chain{}, profiletypes.Coordinator{}, sdkerrors.Wrapf(launchtypes.ErrChainInactive,
+			"the chain %d coordinator not found", launchID)
+	}
+	if !coordinator.Active {
+		return launchtypes.Chain{}, profiletypes.Coordinator{}, sdkerrors.Wrapf(profiletypes.ErrCoordInactive,
+			"the chain %d coordinator inactive", launchID)
+	}
+	return chain, coordinator, nil
+}
+
+func (s *Simulator) coordinatorByAddress(address string) (profiletypes.Coordinator, bool) {
+	for _, coordinator := range s.coordinators {
+		if coordinator.Address == address && coordinator.Active {
+			return coordinator, true
+		}
+	}
+	return profiletypes.Coordinator{}, false
+}
+
+func (s *Simulator) addRequest(request launchtypes.Request) uint64 {
+	requests, ok := s.requests[request.LaunchID]
+	if !ok {
+		requests = make(map[uint64]launchtypes.Request)
+		s.requests[request.LaunchID] = requests
+	}
+	request.RequestID = uint64(len(requests)) + 1
+	requests[request.RequestID] = request
+	return request.RequestID
+}
+
+// simulatorState is a copy of the state of the simulator, restored when a transaction fails.
+type simulatorState struct {
+	chains   map[uint64]launchtypes.Chain
+	requests map[uint64]map[uint64]launchtypes.Request
+}
+
+func (s *Simulator) snapshot() simulatorState {
+	state := simulatorState{
+		chains:   make(map[uint64]launchtypes.Chain, len(s.chains)),
+		requests: make(map[uint64]map[uint64]launchtypes.Request, len(s.requests)),
+	}
+	for id, chain := range s.chains {
+		state.chains[id] = chain
+	}
+	for launchID, requests := range s.requests {
+		state.requests[launchID] = make(map[uint64]launchtypes.Request, len(requests))
+		for id, request := range requests {
+			state.requests[launchID][id] = request
+		}
+	}
+	return state
+}
+
+func (s *Simulator) restore(state simulatorState) {
+	s.chains = state.chains
+	s.requests = state.requests
+}
+
+// sortedIDs returns the keys of the map in ascending order, the order of the keys in the store of SPN.
+func sortedIDs[T any](m map[uint64]T) []uint64 {
+	ids := make([]uint64, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
@@ -0,0 +1,236 @@
+package testutil
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LaunchQueryClient returns the launch query client of the simulator.
+// The queries Params, Chain, ChainAll, Request and RequestAll are supported, the other queries panic.
+func (s *Simulator) LaunchQueryClient() launchtypes.QueryClient {
+	return simulatorLaunchQuery{sim: s}
+}
+
+// CampaignQueryClient returns the campaign query client of the simulator.
+// The queries Campaign and CampaignAll are supported, the other queries panic.
+func (s *Simulator) CampaignQueryClient() campaigntypes.QueryClient {
+	return simulatorCampaignQuery{sim: s}
+}
+
+// ProfileQueryClient returns the profile query client of the simulator.
+// The queries Coordinator and CoordinatorByAddress are supported, the other queries panic.
+func (s *Simulator) ProfileQueryClient() profiletypes.QueryClient {
+	return simulatorProfileQuery{sim: s}
+}
+
+type simulatorLaunchQuery struct {
+	launchtypes.QueryClient
+	sim *Simulator
+}
+
+func (q simulatorLaunchQuery) Params(
+	context.Context,
+	*launchtypes.QueryParamsRequest,
+	...grpc.CallOption,
+) (*launchtypes.QueryParamsResponse, error) {
+	q.sim.mu.Lock()
+	defer q.sim.mu.Unlock()
+	return &launchtypes.QueryParamsResponse{Params: q.sim.params}, nil
+}
+
+func (q simulatorLaunchQuery) Chain(
+	_ context.Context,
+	req *launchtypes.QueryGetChainRequest,
+	_ ...grpc.CallOption,
+) (*launchtypes.QueryGetChainResponse, error) {
+	q.sim.mu.Lock()
+	defer q.sim.mu.Unlock()
+
+	chain, ok := q.sim.chains[req.LaunchID]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &launchtypes.QueryGetChainResponse{Chain: chain}, nil
+}
+
+func (q simulatorLaunchQuery) ChainAll(
+	_ context.Context,
+	req *launchtypes.QueryAllChainRequest,
+	_ ...grpc.CallOption,
+) (*launchtypes.QueryAllChainResponse, error) {
+	q.sim.mu.Lock()
+	defer q.sim.mu.Unlock()
+
+	ids, pagination, err := paginate(sortedIDs(q.sim.chains), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	chains := make([]launchtypes.Chain, len(ids))
+	for i, id := range ids {
+		chains[i] = q.sim.chains[id]
+	}
+	return &launchtypes.QueryAllChainResponse{Chain: chains, Pagination: pagination}, nil
+}
+
+func (q simulatorLaunchQuery) Request(
+	_ context.Context,
+	req *launchtypes.QueryGetRequestRequest,
+	_ ...grpc.CallOption,
+) (*launchtypes.QueryGetRequestResponse, error) {
+	q.sim.mu.Lock()
+	defer q.sim.mu.Unlock()
+
+	request, ok := q.sim.requests[req.LaunchID][req.RequestID]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &launchtypes.QueryGetRequestResponse{Request: request}, nil
+}
+
+func (q simulatorLaunchQuery) RequestAll(
+	_ context.Context,
+	req *launchtypes.QueryAllRequestRequest,
+	_ ...grpc.CallOption,
+) (*launchtypes.QueryAllRequestResponse, error) {
+	q.sim.mu.Lock()
+	defer q.sim.mu.Unlock()
+
+	requests := q.sim.requests[req.LaunchID]
+	ids, pagination, err := paginate(sortedIDs(requests), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]launchtypes.Request, len(ids))
+	for i, id := range ids {
+		res[i] = requests[id]
+	}
+	return &launchtypes.QueryAllRequestResponse{Request: res, Pagination: pagination}, nil
+}
+
+type simulatorCampaignQuery struct {
+	campaigntypes.QueryClient
+	sim *Simulator
+}
+
+func (q simulatorCampaignQuery) Campaign(
+	_ context.Context,
+	req *campaigntypes.QueryGetCampaignRequest,
+	_ ...grpc.CallOption,
+) (*campaigntypes.QueryGetCampaignResponse, error) {
+	q.sim.mu.Lock()
+	defer q.sim.mu.Unlock()
+
+	campaign, ok := q.sim.campaigns[req.CampaignID]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &campaigntypes.QueryGetCampaignResponse{Campaign: campaign}, nil
+}
+
+func (q simulatorCampaignQuery) CampaignAll(
+	_ context.Context,
+	req *campaigntypes.QueryAllCampaignRequest,
+	_ ...grpc.CallOption,
+) (*campaigntypes.QueryAllCampaignResponse, error) {
+	q.sim.mu.Lock()
+	defer q.sim.mu.Unlock()
+
+	ids, pagination, err := paginate(sortedIDs(q.sim.campaigns), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	campaigns := make([]campaigntypes.Campaign, len(ids))
+	for i, id := range ids {
+		campaigns[i] = q.sim.campaigns[id]
+	}
+	return &campaigntypes.QueryAllCampaignResponse{Campaign: campaigns, Pagination: pagination}, nil
+}
+
+type simulatorProfileQuery struct {
+	profiletypes.QueryClient
+	sim *Simulator
+}
+
+func (q simulatorProfileQuery) Coordinator(
+	_ context.Context,
+	req *profiletypes.QueryGetCoordinatorRequest,
+	_ ...grpc.CallOption,
+) (*profiletypes.QueryGetCoordinatorResponse, error) {
+	q.sim.mu.Lock()
+	defer q.sim.mu.Unlock()
+
+	coordinator, ok := q.sim.coordinators[req.CoordinatorID]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &profiletypes.QueryGetCoordinatorResponse{Coordinator: coordinator}, nil
+}
+
+func (q simulatorProfileQuery) CoordinatorByAddress(
+	_ context.Context,
+	req *profiletypes.QueryGetCoordinatorByAddressRequest,
+	_ ...grpc.CallOption,
+) (*profiletypes.QueryGetCoordinatorByAddressResponse, error) {
+	q.sim.mu.Lock()
+	defer q.sim.mu.Unlock()
+
+	coordinator, ok := q.sim.coordinatorByAddress(req.Address)
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &profiletypes.QueryGetCoordinatorByAddressResponse{
+		CoordinatorByAddress: profiletypes.CoordinatorByAddress{
+			Address:       coordinator.Address,
+			CoordinatorID: coordinator.CoordinatorID,
+		},
+	}, nil
+}
+
+// paginate returns the page of the sorted IDs, the key of the next page is the next ID.
+func paginate(ids []uint64, page *query.PageRequest) ([]uint64, *query.PageResponse, error) {
+	if page == nil {
+		page = &query.PageRequest{}
+	}
+	if len(page.Key) > 0 && page.Offset > 0 {
+		return nil, nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+
+	limit := page.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	start := int(page.Offset)
+	if len(page.Key) > 0 {
+		if len(page.Key) != 8 {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+		next := binary.BigEndian.Uint64(page.Key)
+		start = sort.Search(len(ids), func(i int) bool { return ids[i] >= next })
+	}
+	if start > len(ids) {
+		start = len(ids)
+	}
+
+	end := start + int(limit)
+	res := &query.PageResponse{}
+	if end < len(ids) {
+		res.NextKey = make([]byte, 8)
+		binary.BigEndian.PutUint64(res.NextKey, ids[end])
+	} else {
+		end = len(ids)
+	}
+	if page.CountTotal {
+		res.Total = uint64(len(ids))
+	}
+	return ids[start:end], res, nil
+}
@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/stretchr/testify/mock"
 
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
 	"github.com/ignite/cli/ignite/services/network/mocks"
 )
 
@@ -38,6 +40,7 @@ func (s *Suite) AssertAllMocks(t *testing.T) {
 func NewSuite() Suite {
 	cosmos := new(mocks.CosmosClient)
 	cosmos.On("Context").Return(client.Context{})
+	cosmos.On("MaxTxBytes", mock.Anything).Return(int64(cosmosclient.DefaultMempoolMaxTxBytes), nil).Maybe()
 	return Suite{
 		ChainMock:                new(mocks.Chain),
 		CosmosClientMock:         cosmos,
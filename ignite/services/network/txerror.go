@@ -0,0 +1,138 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+)
+
+// TxError is the error of a tx failed on SPN. The known errors of the launch, campaign and profile
+// modules are translated into a message with a remediation hint, the raw code is kept for the others.
+type TxError struct {
+	// Codespace is the codespace of the module returning the error.
+	Codespace string
+
+	// Code is the code of the error in the codespace.
+	Code uint32
+
+	// Log is the raw log of the tx, or the error of the simulation of the tx.
+	Log string
+
+	// Message is the human message of a known error, it's empty for an unknown error.
+	Message string
+
+	// Hint tells how to fix a known error.
+	Hint string
+}
+
+// Error implements error.
+func (e TxError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("tx failed with code %d in codespace %s: %s", e.Code, e.Codespace, e.Log)
+	}
+	msg := e.Message
+	if e.Hint != "" {
+		msg = fmt.Sprintf("%s — %s", msg, e.Hint)
+	}
+	return fmt.Sprintf("%s (codespace %s, code %d)", msg, e.Codespace, e.Code)
+}
+
+// Unwrap returns the registered error of the code, errors.Is matches the errors of the SPN modules.
+func (e TxError) Unwrap() error {
+	return sdkerrors.ABCIError(e.Codespace, e.Code, e.Log)
+}
+
+// txErrorTranslation is the message and the remediation hint of a known error.
+type txErrorTranslation struct {
+	err     *sdkerrors.Error
+	message string
+	hint    string
+}
+
+// txErrorTranslations are the translations of the known errors of the SPN modules.
+var txErrorTranslations = []txErrorTranslation{
+	{launchtypes.ErrChainNotFound, "launch not found", "check the launch ID with `network chain list`"},
+	{launchtypes.ErrTriggeredLaunch, "launch already triggered", "use `network chain revert-launch` first"},
+	{launchtypes.ErrNotTriggeredLaunch, "launch not triggered yet", "trigger the launch first"},
+	{launchtypes.ErrNoAddressPermission, "permission denied", "sign with the coordinator key or the key of the address owner"},
+	{launchtypes.ErrLaunchTimeTooLow, "launch time too early", "choose a later launch time"},
+	{launchtypes.ErrLaunchTimeTooHigh, "launch time too late", "choose an earlier launch time"},
+	{launchtypes.ErrRevertDelayNotReached, "revert delay not reached", "wait for the revert delay after the launch time"},
+	{launchtypes.ErrRequestNotFound, "request not found", "check the request IDs with `network request list`"},
+	{launchtypes.ErrRequestSettled, "request already settled", "list the pending requests with `network request list`"},
+	{launchtypes.ErrAccountAlreadyExist, "account already in the genesis", "remove the account first"},
+	{launchtypes.ErrValidatorAlreadyExist, "validator already in the genesis", "remove the validator first"},
+	{launchtypes.ErrMinSelfDelegationNotReached, "self-delegation too low", "increase the self-delegation of the gentx"},
+	{launchtypes.ErrChainInactive, "launch inactive", "the coordinator of the launch must be active"},
+	{launchtypes.ErrChainHasCampaign, "launch already in a campaign", "a launch can be in one campaign only"},
+	{campaigntypes.ErrCampaignNotFound, "campaign not found", "check the campaign ID with `network campaign list`"},
+	{campaigntypes.ErrMainnetInitialized, "mainnet already initialized", "the campaign can't be changed once the mainnet is initialized"},
+	{campaigntypes.ErrTotalSharesLimit, "not enough shares left", "lower the shares or increase the total shares of the campaign"},
+	{campaigntypes.ErrInsufficientVouchers, "not enough vouchers", "check the vouchers of the account with `network campaign account list`"},
+	{campaigntypes.ErrMainnetLaunchTriggered, "mainnet launch already triggered", "the campaign can't be changed once the mainnet is launched"},
+	{profiletypes.ErrCoordAlreadyExist, "coordinator already exists", "the account is already a coordinator"},
+	{profiletypes.ErrCoordAddressNotFound, "coordinator not found", "create a coordinator by publishing a chain first"},
+	{profiletypes.ErrCoordInactive, "coordinator inactive", "the coordinator has been disabled"},
+}
+
+// translateTxError translates the error of a tx failed with a code into a TxError. The tx simulated to estimate
+// the gas fails without tx response, the code of its error is read from the registered error it wraps or
+// matched from its log since the ABCI query of the simulation only keeps the log of the error.
+// The other errors of the txs not broadcasted are returned unchanged.
+func translateTxError(res cosmosclient.Response, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var txErr TxError
+	if res.TxResponse != nil && res.TxResponse.Code != 0 {
+		txErr = TxError{
+			Codespace: res.TxResponse.Codespace,
+			Code:      res.TxResponse.Code,
+			Log:       res.TxResponse.RawLog,
+		}
+	} else {
+		var (
+			sdkErr *sdkerrors.Error
+			known  = knownTxError(err)
+		)
+		switch {
+		case known != nil:
+			sdkErr = known
+		case !errors.As(err, &sdkErr):
+			return err
+		}
+		txErr = TxError{
+			Codespace: sdkErr.Codespace(),
+			Code:      sdkErr.ABCICode(),
+			Log:       err.Error(),
+		}
+	}
+
+	for _, t := range txErrorTranslations {
+		if errors.Is(txErr.Unwrap(), t.err) {
+			txErr.Message = t.message
+			txErr.Hint = t.hint
+			break
+		}
+	}
+	return txErr
+}
+
+// knownTxError returns the known error of the SPN modules wrapped by the error or found in its log, nil if none.
+func knownTxError(err error) *sdkerrors.Error {
+	log := err.Error()
+	for _, t := range txErrorTranslations {
+		if errors.Is(err, t.err) || strings.Contains(log, t.err.Error()) {
+			return t.err
+		}
+	}
+	return nil
+}
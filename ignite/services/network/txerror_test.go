@@ -0,0 +1,169 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+// failedTxResponse returns the response of a tx failed with the code.
+func failedTxResponse(codespace string, code uint32, rawLog string) cosmosclient.Response {
+	return cosmosclient.Response{
+		TxResponse: &sdk.TxResponse{
+			Codespace: codespace,
+			Code:      code,
+			RawLog:    rawLog,
+		},
+	}
+}
+
+func TestTranslateTxError(t *testing.T) {
+	broadcastErr := errors.New("error code: '5' msg: 'launch is triggered for the chain'")
+
+	tests := []struct {
+		name      string
+		res       cosmosclient.Response
+		err       error
+		wantErr   string
+		wantIsErr error
+	}{
+		{
+			name:      "launch already triggered",
+			res:       failedTxResponse("launch", 5, "1: launch is triggered for the chain"),
+			err:       broadcastErr,
+			wantErr:   "launch already triggered — use `network chain revert-launch` first (codespace launch, code 5)",
+			wantIsErr: launchtypes.ErrTriggeredLaunch,
+		},
+		{
+			name:      "request already settled",
+			res:       failedTxResponse("launch", 29, "request 3: request is already settled"),
+			err:       broadcastErr,
+			wantErr:   "request already settled — list the pending requests with `network request list` (codespace launch, code 29)",
+			wantIsErr: launchtypes.ErrRequestSettled,
+		},
+		{
+			name:      "campaign not found",
+			res:       failedTxResponse("campaign", 3, "10: campaign not found"),
+			err:       broadcastErr,
+			wantErr:   "campaign not found — check the campaign ID with `network campaign list` (codespace campaign, code 3)",
+			wantIsErr: campaigntypes.ErrCampaignNotFound,
+		},
+		{
+			name:    "coordinator not found",
+			res:     failedTxResponse("profile", 3, "spn1abc: coordinator address not found"),
+			err:     broadcastErr,
+			wantErr: "coordinator not found — create a coordinator by publishing a chain first (codespace profile, code 3)",
+		},
+		{
+			name:    "unknown code",
+			res:     failedTxResponse("launch", 999, "something failed"),
+			err:     broadcastErr,
+			wantErr: "tx failed with code 999 in codespace launch: something failed",
+		},
+		{
+			name:      "simulation failed with a registered error",
+			res:       cosmosclient.Response{},
+			err:       sdkerrors.Wrap(launchtypes.ErrTriggeredLaunch, "1"),
+			wantErr:   "launch already triggered — use `network chain revert-launch` first (codespace launch, code 5)",
+			wantIsErr: launchtypes.ErrTriggeredLaunch,
+		},
+		{
+			name: "simulation failed through the ABCI query",
+			res:  cosmosclient.Response{},
+			err: status.Error(
+				codes.Unknown,
+				"rpc error: code = Unknown desc = failed to execute message; message index: 0: 1: launch is triggered for the chain",
+			),
+			wantErr:   "launch already triggered — use `network chain revert-launch` first (codespace launch, code 5)",
+			wantIsErr: launchtypes.ErrTriggeredLaunch,
+		},
+		{
+			name:    "tx not broadcasted",
+			res:     cosmosclient.Response{},
+			err:     errors.New("post failed: connection refused"),
+			wantErr: "post failed: connection refused",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := translateTxError(tt.res, tt.err)
+			require.EqualError(t, err, tt.wantErr)
+			if tt.wantIsErr != nil {
+				require.ErrorIs(t, err, tt.wantIsErr)
+			}
+		})
+	}
+
+	t.Run("successful tx", func(t *testing.T) {
+		require.NoError(t, translateTxError(failedTxResponse("", 0, ""), nil))
+	})
+}
+
+func TestBroadcastTxError(t *testing.T) {
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	suite, network := newSuite(account)
+	msg := launchtypes.NewMsgRevertLaunch(addr, testutil.LaunchID)
+	suite.CosmosClientMock.
+		On("BroadcastTx", context.Background(), account, msg).
+		Return(
+			failedTxResponse("launch", 10, "1: the chain launch has not been triggered"),
+			errors.New("error code: '10' msg: '1: the chain launch has not been triggered'"),
+		).
+		Once()
+
+	_, err = network.broadcastTx(context.Background(), msg)
+	require.Equal(t, TxError{
+		Codespace: "launch",
+		Code:      10,
+		Log:       "1: the chain launch has not been triggered",
+		Message:   "launch not triggered yet",
+		Hint:      "trigger the launch first",
+	}, err)
+	require.ErrorIs(t, err, launchtypes.ErrNotTriggeredLaunch)
+	suite.AssertAllMocks(t)
+}
+
+func TestBroadcastTxSimulationError(t *testing.T) {
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	suite, network := newSuite(account)
+	msg := launchtypes.NewMsgRevertLaunch(addr, testutil.LaunchID)
+
+	// the tx fails while its gas is simulated, it's not broadcasted
+	simulationErr := status.Error(
+		codes.Unknown,
+		"failed to execute message; message index: 0: 1: the chain launch has not been triggered: unknown request",
+	)
+	suite.CosmosClientMock.
+		On("BroadcastTx", context.Background(), account, msg).
+		Return(cosmosclient.Response{}, simulationErr).
+		Once()
+
+	_, err = network.broadcastTx(context.Background(), msg)
+	require.Equal(t, TxError{
+		Codespace: "launch",
+		Code:      10,
+		Log:       simulationErr.Error(),
+		Message:   "launch not triggered yet",
+		Hint:      "trigger the launch first",
+	}, err)
+	require.ErrorIs(t, err, launchtypes.ErrNotTriggeredLaunch)
+	suite.AssertAllMocks(t)
+}
@@ -0,0 +1,91 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+// txOverheadBytes is the size reserved in a tx for everything but its messages: the memo,
+// the signer infos, the fee and the signature.
+const txOverheadBytes = 1024
+
+// maxMsgsBytes returns the maximum size in bytes of the messages of a tx accepted by SPN.
+func (n Network) maxMsgsBytes(ctx context.Context) (int, error) {
+	maxTxBytes, err := n.cosmos.MaxTxBytes(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("cannot query the maximum size of a tx: %w", err)
+	}
+	return int(maxTxBytes) - txOverheadBytes, nil
+}
+
+// msgSize returns the size in bytes of the message encoded in the body of a tx.
+func msgSize(msg sdk.Msg) (int, error) {
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return 0, err
+	}
+	// the message is a length-prefixed field of the tx body
+	size := msgAny.Size()
+	return 1 + sovSize(uint64(size)) + size, nil
+}
+
+// sovSize returns the size of the varint encoding of x.
+func sovSize(x uint64) int {
+	n := 1
+	for x >= 0x80 {
+		x >>= 7
+		n++
+	}
+	return n
+}
+
+// checkMsgSize checks the message fits in a tx of at most maxBytes of messages.
+func checkMsgSize(msg sdk.Msg, maxBytes int, content string) error {
+	size, err := msgSize(msg)
+	if err != nil {
+		return err
+	}
+	if size > maxBytes {
+		return networktypes.ErrContentTooLarge{Content: content, Size: size, Max: maxBytes}
+	}
+	return nil
+}
+
+// splitMsgs splits the messages into batches of at most maxCount messages whose encoded size is
+// at most maxBytes, the messages keep their order. A message exceeding maxBytes by itself can't be
+// sent, an error with its size is returned.
+func splitMsgs(msgs []sdk.Msg, maxBytes, maxCount int) ([][]sdk.Msg, error) {
+	var (
+		batches [][]sdk.Msg
+		batch   []sdk.Msg
+		size    int
+	)
+	for i, msg := range msgs {
+		msgBytes, err := msgSize(msg)
+		if err != nil {
+			return nil, err
+		}
+		if msgBytes > maxBytes {
+			return nil, networktypes.ErrContentTooLarge{
+				Content: fmt.Sprintf("the message %d of the batch", i+1),
+				Size:    msgBytes,
+				Max:     maxBytes,
+			}
+		}
+		if len(batch) > 0 && (len(batch) == maxCount || size+msgBytes > maxBytes) {
+			batches = append(batches, batch)
+			batch, size = nil, 0
+		}
+		batch = append(batch, msg)
+		size += msgBytes
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches, nil
+}
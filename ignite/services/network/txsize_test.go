@@ -0,0 +1,114 @@
+package network
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+func TestSplitMsgs(t *testing.T) {
+	const addr = "spn1qcrl9zy7merupfkhqksp0eqs0u40mdszhqg6l0"
+	msgs := make([]sdk.Msg, 5)
+	for i := range msgs {
+		msgs[i] = launchtypes.NewMsgSettleRequest(addr, testutil.LaunchID, uint64(i+1), true)
+	}
+	size, err := msgSize(msgs[0])
+	require.NoError(t, err)
+
+	batchSizes := func(batches [][]sdk.Msg) (sizes []int) {
+		for _, batch := range batches {
+			sizes = append(sizes, len(batch))
+		}
+		return sizes
+	}
+
+	t.Run("batches at the size limit", func(t *testing.T) {
+		batches, err := splitMsgs(msgs, 2*size, 10)
+		require.NoError(t, err)
+		require.Equal(t, []int{2, 2, 1}, batchSizes(batches))
+	})
+
+	t.Run("batches a byte under the size limit", func(t *testing.T) {
+		batches, err := splitMsgs(msgs, 2*size-1, 10)
+		require.NoError(t, err)
+		require.Equal(t, []int{1, 1, 1, 1, 1}, batchSizes(batches))
+	})
+
+	t.Run("batches at the count limit", func(t *testing.T) {
+		batches, err := splitMsgs(msgs, 10*size, 3)
+		require.NoError(t, err)
+		require.Equal(t, []int{3, 2}, batchSizes(batches))
+		require.Equal(t, msgs, append(batches[0], batches[1]...))
+	})
+
+	t.Run("message over the size limit", func(t *testing.T) {
+		large := launchtypes.NewMsgSendRequest(addr, testutil.LaunchID, launchtypes.NewGenesisValidator(
+			testutil.LaunchID,
+			addr,
+			[]byte(strings.Repeat("a", 10*size)),
+			nil,
+			sdk.Coin{},
+			launchtypes.Peer{},
+		))
+		largeSize, err := msgSize(large)
+		require.NoError(t, err)
+
+		_, err = splitMsgs(append([]sdk.Msg{msgs[0], large}, msgs[1:]...), 10*size, 10)
+		require.Equal(t, networktypes.ErrContentTooLarge{
+			Content: "the message 2 of the batch",
+			Size:    largeSize,
+			Max:     10 * size,
+		}, err)
+	})
+}
+
+func TestSendRequestsLimits(t *testing.T) {
+	account := testutil.NewTestAccount(t, testutil.TestAccountName)
+	addr, err := account.Address(networktypes.SPN)
+	require.NoError(t, err)
+
+	newContent := func(gentx []byte) launchtypes.RequestContent {
+		return launchtypes.NewGenesisValidator(testutil.LaunchID, addr, gentx, nil, sdk.Coin{}, launchtypes.Peer{})
+	}
+
+	t.Run("gentx memo over the limit", func(t *testing.T) {
+		suite, network := newSuite(account)
+		mockRequestQuota(suite, addr, nil, nil)
+
+		memo := strings.Repeat("a", networktypes.MaxGentxMemoLength+1)
+		gentx := testutil.NewGentx(addr, "stake", "1000", "", memo).JSON(t)
+
+		sent, err := network.SendRequests(
+			context.Background(),
+			testutil.LaunchID,
+			launchtypes.NewAccountRemoval(addr),
+			newContent(gentx),
+		)
+		require.ErrorAs(t, err, &networktypes.ErrContentTooLarge{})
+		require.ErrorContains(t, err, "invalid content of the request 2")
+		require.Empty(t, sent.RequestIDs)
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("request over the maximum tx size", func(t *testing.T) {
+		suite, network := newSuite(account)
+		mockRequestQuota(suite, addr, nil, nil)
+
+		gentx := []byte(strings.Repeat("a", 1024*1024))
+
+		_, err := network.SendRequests(context.Background(), testutil.LaunchID, newContent(gentx))
+		var tooLarge networktypes.ErrContentTooLarge
+		require.ErrorAs(t, err, &tooLarge)
+		require.Equal(t, "the request 1", tooLarge.Content)
+		require.Greater(t, tooLarge.Size, 1024*1024)
+		require.Equal(t, 1024*1024-txOverheadBytes, tooLarge.Max)
+		suite.AssertAllMocks(t)
+	})
+}